@@ -25,17 +25,26 @@ import (
 
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/http/solver"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/go-logr/logr"
 	"github.com/spf13/cobra"
 	"k8s.io/component-base/logs"
 )
 
+// challengeServer is implemented by the solvers for each supported challenge
+// type.
+type challengeServer interface {
+	Listen(log logr.Logger) error
+	Shutdown(ctx context.Context) error
+}
+
 func NewACMESolverCommand(_ context.Context) *cobra.Command {
 	s := new(solver.HTTP01Solver)
+	challengeType := "http-01"
 	logOptions := logs.NewOptions()
 
 	cmd := &cobra.Command{
 		Use:   "acmesolver",
-		Short: "HTTP server used to solve ACME challenges.",
+		Short: "Server used to solve ACME HTTP-01 and TLS-ALPN-01 challenges.",
 
 		SilenceErrors: true, // Errors are already logged when calling cmd.Execute()
 		SilenceUsage:  true, // Don't print usage on every error
@@ -45,6 +54,10 @@ func NewACMESolverCommand(_ context.Context) *cobra.Command {
 				return fmt.Errorf("error validating options: %s", err)
 			}
 
+			if challengeType != "http-01" && challengeType != "tls-alpn-01" {
+				return fmt.Errorf("unsupported challenge type %q", challengeType)
+			}
+
 			return nil
 		},
 		//nolint:contextcheck // False positive
@@ -52,6 +65,15 @@ func NewACMESolverCommand(_ context.Context) *cobra.Command {
 			runCtx := cmd.Context()
 			log := logf.FromContext(runCtx)
 
+			var srv challengeServer = s
+			if challengeType == "tls-alpn-01" {
+				srv = &solver.TLSALPN01Solver{
+					ListenPort: s.ListenPort,
+					Domain:     s.Domain,
+					Key:        s.Key,
+				}
+			}
+
 			completedCh := make(chan struct{})
 			go func() {
 				defer close(completedCh)
@@ -62,12 +84,12 @@ func NewACMESolverCommand(_ context.Context) *cobra.Command {
 				defer cancel()
 
 				//nolint: contextcheck
-				if err := s.Shutdown(shutdownCtx); err != nil {
+				if err := srv.Shutdown(shutdownCtx); err != nil {
 					log.Error(err, "error shutting down acmesolver server")
 				}
 			}()
 
-			if err := s.Listen(log); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			if err := srv.Listen(log); err != nil && !errors.Is(err, http.ErrServerClosed) {
 				return err
 			}
 
@@ -77,9 +99,10 @@ func NewACMESolverCommand(_ context.Context) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringVar(&challengeType, "challenge-type", challengeType, "the type of ACME challenge to solve, one of http-01 or tls-alpn-01")
 	cmd.Flags().IntVar(&s.ListenPort, "listen-port", 8089, "the port number to listen on for connections")
 	cmd.Flags().StringVar(&s.Domain, "domain", "", "the domain name to verify")
-	cmd.Flags().StringVar(&s.Token, "token", "", "the challenge token to verify against")
+	cmd.Flags().StringVar(&s.Token, "token", "", "the challenge token to verify against (http-01 only)")
 	cmd.Flags().StringVar(&s.Key, "key", "", "the challenge key to respond with")
 
 	// TODO(@inteon): use flags to configure the log configuration (https://github.com/cert-manager/cert-manager/issues/6021)
@@ -33,12 +33,16 @@ import (
 	clusterissuerscontroller "github.com/cert-manager/cert-manager/pkg/controller/clusterissuers"
 	"github.com/cert-manager/cert-manager/pkg/healthz"
 	dnsutil "github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+	"github.com/cert-manager/cert-manager/pkg/keyprovider"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/ocspresponder"
 	"github.com/cert-manager/cert-manager/pkg/server"
 	"github.com/cert-manager/cert-manager/pkg/server/tls"
 	"github.com/cert-manager/cert-manager/pkg/server/tls/authority"
 	"github.com/cert-manager/cert-manager/pkg/util"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/pkg/util/pki/localkeyprovider"
 	"github.com/cert-manager/cert-manager/pkg/util/profiling"
 	"github.com/go-logr/logr"
 	"golang.org/x/sync/errgroup"
@@ -92,6 +96,20 @@ func Run(rootCtx context.Context, opts *config.ControllerConfiguration) error {
 		return fmt.Errorf("failed to configure PEM size limits: %w", err)
 	}
 
+	if opts.KeyProviderConfig.LocalDirectory != "" {
+		log.V(logf.InfoLevel).Info("enabling local key provider", "directory", opts.KeyProviderConfig.LocalDirectory)
+		pki.RegisterKeyProvider(localkeyprovider.Name, localkeyprovider.New(opts.KeyProviderConfig.LocalDirectory))
+	}
+
+	for name, socketPath := range opts.KeyProviderConfig.Plugins {
+		provider, err := keyprovider.New(socketPath)
+		if err != nil {
+			return fmt.Errorf("failed to configure key provider %q: %w", name, err)
+		}
+		log.V(logf.InfoLevel).Info("enabling key provider plugin", "name", name, "socket", socketPath)
+		pki.RegisterKeyProvider(name, provider)
+	}
+
 	enabledControllers := options.EnabledControllers(opts)
 	log.Info(fmt.Sprintf("enabled controllers: %s", sets.List(enabledControllers)))
 
@@ -187,6 +205,44 @@ func Run(rootCtx context.Context, opts *config.ControllerConfiguration) error {
 		return healthzServer.Start(rootCtx, healthzListener)
 	})
 
+	// Start the OCSP responder if it is enabled. Unlike the controllers, the
+	// responder runs on every replica, so the informers it uses are started
+	// straight away rather than once this replica has been elected leader.
+	if opts.OCSPResponderConfig.Enabled {
+		ocspListener, err := lc.Listen(rootCtx, "tcp", opts.OCSPResponderConfig.ListenAddress)
+		if err != nil {
+			return fmt.Errorf("failed to listen on OCSP responder address %s: %v", opts.OCSPResponderConfig.ListenAddress, err)
+		}
+		responder, err := ocspresponder.New(ctx, opts.OCSPResponderConfig.ResponseDuration)
+		if err != nil {
+			return fmt.Errorf("error creating OCSP responder: %v", err)
+		}
+		ocspServer := &http.Server{
+			Handler:           responder,
+			ReadHeaderTimeout: defaultReadHeaderTimeout, // Mitigation for G112: Potential slowloris attack
+		}
+
+		g.Go(func() error {
+			<-rootCtx.Done()
+			// allow a timeout for graceful shutdown
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			//nolint: contextcheck
+			return ocspServer.Shutdown(shutdownCtx)
+		})
+		g.Go(func() error {
+			log.V(logf.InfoLevel).Info("starting OCSP responder", "address", ocspListener.Addr())
+			if err := ocspServer.Serve(ocspListener); err != http.ErrServerClosed {
+				return err
+			}
+			return nil
+		})
+
+		ctx.SharedInformerFactory.Start(rootCtx.Done())
+		ctx.KubeSharedInformerFactory.Start(rootCtx.Done())
+	}
+
 	elected := make(chan struct{})
 	if opts.LeaderElectionConfig.Enabled {
 		g.Go(func() error {
@@ -359,6 +415,7 @@ func buildControllerContextFactory(ctx context.Context, opts *config.ControllerC
 			ClusterIssuerAmbientCredentials: opts.ClusterIssuerAmbientCredentials,
 			IssuerAmbientCredentials:        opts.IssuerAmbientCredentials,
 			ClusterResourceNamespace:        opts.ClusterResourceNamespace,
+			ExternalSignerSocketDirectory:   opts.ExternalSignerSocketDirectory,
 		},
 
 		IngressShimOptions: controller.IngressShimOptions{
@@ -373,6 +430,8 @@ func buildControllerContextFactory(ctx context.Context, opts *config.ControllerC
 		CertificateOptions: controller.CertificateOptions{
 			EnableOwnerRef:                           opts.EnableCertificateOwnerRef,
 			CopiedAnnotationPrefixes:                 opts.CopiedAnnotationPrefixes,
+			ConfigMapTargetNamespaces:                opts.ConfigMapTargetNamespaces,
+			TruststoreSecretNamespaces:               opts.TruststoreSecretNamespaces,
 			CertificateRequestMinimumBackoffDuration: opts.CertificateRequestMinimumBackoffDuration,
 			CertificateRequestMaximumBackoffDuration: opts.CertificateRequestMaximumBackoffDuration,
 		},
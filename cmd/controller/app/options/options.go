@@ -146,6 +146,9 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.ControllerConfiguration) {
 		"Whether a cluster-issuer may make use of ambient credentials for issuers. 'Ambient Credentials' are credentials drawn from the environment, metadata services, or local files which are not explicitly configured in the ClusterIssuer API object. "+
 		"When this flag is enabled, the following sources for credentials are also used: "+
 		"AWS - All sources the Go SDK defaults to, notably including any EC2 IAM roles available via instance metadata.")
+	fs.StringVar(&c.ExternalSignerSocketDirectory, "external-signer-socket-directory", c.ExternalSignerSocketDirectory, ""+
+		"Directory containing the unix sockets of external signer plugins. An Issuer with spec.externalSigner.name "+
+		"set to 'foo' is served by the plugin listening on the socket 'foo.sock' in this directory.")
 	fs.BoolVar(&c.IssuerAmbientCredentials, "issuer-ambient-credentials", c.IssuerAmbientCredentials, ""+
 		"Whether an issuer may make use of ambient credentials. 'Ambient Credentials' are credentials drawn from the environment, metadata services, or local files which are not explicitly configured in the Issuer API object. "+
 		"When this flag is enabled, the following sources for credentials are also used: "+
@@ -163,10 +166,11 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.ControllerConfiguration) {
 		"Group of the Issuer to use when the tls is requested but issuer group is not specified on the ingress resource.")
 
 	fs.StringSliceVar(&c.ACMEDNS01Config.RecursiveNameservers, "dns01-recursive-nameservers",
-		c.ACMEDNS01Config.RecursiveNameservers, "A list of comma separated dns server endpoints used for DNS01 and DNS-over-HTTPS (DoH) check requests. "+
-			"This should be a list containing entries of the following formats: `<ip address>:<port>` or `https://<DoH RFC 8484 server address>`. "+
-			"For example: `8.8.8.8:53,8.8.4.4:53,[2001:4860:4860::8888]:53` or `https://1.1.1.1/dns-query,https://8.8.8.8/dns-query`. "+
-			"To make sure ALL DNS requests happen through DoH, `dns01-recursive-nameservers-only` should also be set to true.")
+		c.ACMEDNS01Config.RecursiveNameservers, "A list of comma separated dns server endpoints used for DNS01, DNS-over-HTTPS (DoH) and DNS-over-TLS (DoT) check requests. "+
+			"This should be a list containing entries of the following formats: `<ip address>:<port>`, `https://<DoH RFC 8484 server address>` or `tls://<DoT RFC 7858 server address>[:<port>]`. "+
+			"For example: `8.8.8.8:53,8.8.4.4:53,[2001:4860:4860::8888]:53`, `https://1.1.1.1/dns-query,https://8.8.8.8/dns-query` or `tls://1.1.1.1,tls://dns.google:853`. "+
+			"When DoH or DoT servers are configured, the DNS01 self check queries them instead of the authoritative nameservers, "+
+			"so that it does not depend on plain DNS over port 53.")
 	fs.BoolVar(&c.ACMEDNS01Config.RecursiveNameserversOnly, "dns01-recursive-nameservers-only",
 		c.ACMEDNS01Config.RecursiveNameserversOnly,
 		"When true, cert-manager will only ever query the configured DNS resolvers "+
@@ -191,10 +195,31 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.ControllerConfiguration) {
 		"A comma-separated list of additional Gateway Listener protocol types that the Gateway API shim should treat as TLS-capable. "+
 		"By default, only HTTPS and TLS protocol types are processed. Each entry must exactly match the protocol string as it appears "+
 		"on the Gateway Listener, e.g. 'DTLS'.")
+	fs.BoolVar(&c.OCSPResponderConfig.Enabled, "enable-ocsp-responder", c.OCSPResponderConfig.Enabled, ""+
+		"Whether to serve OCSP responses for certificates issued by CA Issuers and ClusterIssuers. "+
+		"The responder runs on every controller replica, regardless of leader election.")
+	fs.StringVar(&c.OCSPResponderConfig.ListenAddress, "ocsp-responder-listen-address", c.OCSPResponderConfig.ListenAddress, ""+
+		"The host and port that the OCSP responder should listen on.")
+	fs.DurationVar(&c.OCSPResponderConfig.ResponseDuration, "ocsp-responder-response-duration", c.OCSPResponderConfig.ResponseDuration, ""+
+		"The validity period of each OCSP response. This should be a valid duration string, for example 30m or 1h.")
+	fs.StringVar(&c.KeyProviderConfig.LocalDirectory, "local-key-provider-directory", c.KeyProviderConfig.LocalDirectory, ""+
+		"The path of a directory in which the 'local' key provider stores private keys for Certificates with "+
+		"spec.privateKey.provider set to 'local'. The 'local' key provider is intended for testing only, and is disabled if this is empty.")
+	fs.Var(cliflag.NewMapStringString(&c.KeyProviderConfig.Plugins), "key-provider-plugins", ""+
+		"A list of comma separated <name>=<socket path> pairs registering out-of-process key providers, which serve the "+
+		"KeyProvider gRPC service on the given unix socket. Certificates use a provider by setting spec.privateKey.provider to its name.")
 	fs.StringSliceVar(&c.CopiedAnnotationPrefixes, "copied-annotation-prefixes", c.CopiedAnnotationPrefixes, "Specify which annotations should/shouldn't be copied"+
 		"from Certificate to CertificateRequest and Order, as well as from CertificateSigningRequest to Order, by passing a list of annotation key prefixes."+
 		"A prefix starting with a dash(-) specifies an annotation that shouldn't be copied. Example: '*,-kubectl.kubernetes.io/'- all annotations"+
 		"will be copied apart from the ones where the key is prefixed with 'kubectl.kubernetes.io/'.")
+	fs.Var(cliflag.NewColonSeparatedMultimapStringString(&c.ConfigMapTargetNamespaces), "configmap-target-namespaces", ""+
+		"A list of comma separated <certificate namespace>:<target namespace> pairs. Certificates may publish their public "+
+		"certificate data using spec.configMapTargets to their own namespace, and to the target namespaces listed for their namespace. "+
+		"For example: `team-a:shared,team-b:shared`. ConfigMap targets in any other namespace are not written.")
+	fs.Var(cliflag.NewColonSeparatedMultimapStringString(&c.TruststoreSecretNamespaces), "truststore-secret-namespaces", ""+
+		"A list of comma separated <certificate namespace>:<target namespace> pairs. Certificates may write copies of their "+
+		"truststore Secret using spec.keystores.truststore.namespaces to the target namespaces listed for their namespace. "+
+		"For example: `team-a:shared,team-b:shared`. Copies in any other namespace are not written.")
 	fs.Var(cliflag.NewMapStringBool(&c.FeatureGates), "feature-gates", "A set of key=value pairs that describe feature gates for alpha/experimental features. "+
 		"Options are:\n"+strings.Join(utilfeature.DefaultFeatureGate.KnownFeatures(), "\n"))
 
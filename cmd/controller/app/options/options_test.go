@@ -21,13 +21,17 @@ import (
 
 	config "github.com/cert-manager/cert-manager/internal/apis/config/controller"
 	defaults "github.com/cert-manager/cert-manager/internal/apis/config/controller/v1alpha1"
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"k8s.io/apimachinery/pkg/util/sets"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
 )
 
 func TestEnabledControllers(t *testing.T) {
 	tests := map[string]struct {
 		controllers []string
 		namespace   string
+		enableCSRs  bool
 		expEnabled  sets.Set[string]
 	}{
 		"if no controllers enabled, return empty": {
@@ -69,10 +73,23 @@ func TestEnabledControllers(t *testing.T) {
 			namespace:   "test-ns",
 			expEnabled:  sets.New(defaults.DefaultEnabledControllers...).Delete(defaults.ClusterScopedControllers...).Delete("issuers"),
 		},
+		"if certificatesigningrequest controllers enabled, return them with the default controllers": {
+			controllers: []string{"*"},
+			enableCSRs:  true,
+			expEnabled:  sets.New(defaults.DefaultEnabledControllers...).Insert(defaults.ExperimentalCertificateSigningRequestControllers...),
+		},
+		"if certificatesigningrequest controllers enabled and namespace set, remove them as they are cluster-scoped": {
+			controllers: []string{"*"},
+			namespace:   "test-ns",
+			enableCSRs:  true,
+			expEnabled:  sets.New(defaults.DefaultEnabledControllers...).Delete(defaults.ClusterScopedControllers...),
+		},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
+			featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultMutableFeatureGate, feature.ExperimentalCertificateSigningRequestControllers, test.enableCSRs)
+
 			o := config.ControllerConfiguration{
 				Controllers: test.controllers,
 				Namespace:   test.namespace,
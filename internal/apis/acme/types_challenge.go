@@ -62,7 +62,7 @@ type ChallengeSpec struct {
 	Wildcard bool
 
 	// The type of ACME challenge this resource represents.
-	// One of "HTTP-01" or "DNS-01".
+	// One of "HTTP-01", "DNS-01", "DNS-ACCOUNT-01" or "TLS-ALPN-01".
 	Type ACMEChallengeType
 
 	// The ACME challenge token for this challenge.
@@ -70,10 +70,13 @@ type ChallengeSpec struct {
 	Token string
 
 	// The ACME challenge key for this challenge
-	// For HTTP01 challenges, this is the value that must be responded with to
-	// complete the HTTP01 challenge in the format:
+	// For HTTP01 and TLS-ALPN-01 challenges, this is the key authorization in
+	// the format:
 	// `<private key JWK thumbprint>.<key from acme server for challenge>`.
-	// For DNS01 challenges, this is the base64 encoded SHA256 sum of the
+	// HTTP01 solvers respond with it, and TLS-ALPN-01 solvers embed its SHA256
+	// sum in the acmeIdentifier extension of the challenge certificate.
+	// For DNS01 and DNS-ACCOUNT-01 challenges, this is the base64 encoded
+	// SHA256 sum of the
 	// `<private key JWK thumbprint>.<key from acme server for challenge>`
 	// text that must be set as the TXT record content.
 	Key string
@@ -88,9 +91,16 @@ type ChallengeSpec struct {
 	// If the Issuer is not an 'ACME' Issuer, an error will be returned and the
 	// Challenge will be marked as failed.
 	IssuerRef cmmeta.IssuerReference
+
+	// accountURI is the URI of the ACME account that this challenge belongs
+	// to, as recorded in the ACME Issuer's status.
+	// It is only set for DNS-ACCOUNT-01 challenges, where it is used to
+	// compute the account-scoped validation domain name.
+	AccountURI string
 }
 
-// The type of ACME challenge. Only HTTP-01 and DNS-01 are supported.
+// The type of ACME challenge. Only HTTP-01, DNS-01, DNS-ACCOUNT-01 and
+// TLS-ALPN-01 are supported.
 type ACMEChallengeType string
 
 const (
@@ -101,6 +111,16 @@ const (
 	// ACMEChallengeTypeDNS01 denotes a Challenge is of type dns-01
 	// More info: https://letsencrypt.org/docs/challenge-types/#dns-01-challenge
 	ACMEChallengeTypeDNS01 ACMEChallengeType = "DNS-01"
+
+	// ACMEChallengeTypeDNSAccount01 denotes a Challenge is of type
+	// dns-account-01, where the TXT record is placed at an account-scoped
+	// `_<label>._acme-challenge` name.
+	// More info: https://datatracker.ietf.org/doc/draft-ietf-acme-dns-account-label/
+	ACMEChallengeTypeDNSAccount01 ACMEChallengeType = "DNS-ACCOUNT-01"
+
+	// ACMEChallengeTypeTLSALPN01 denotes a Challenge is of type tls-alpn-01
+	// More info: https://letsencrypt.org/docs/challenge-types/#tls-alpn-01
+	ACMEChallengeTypeTLSALPN01 ACMEChallengeType = "TLS-ALPN-01"
 )
 
 type ChallengeStatus struct {
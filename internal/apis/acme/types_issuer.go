@@ -37,6 +37,13 @@ type ACMEIssuer struct {
 	// This field may be updated after the account is initially registered.
 	Email string
 
+	// Contacts is a list of additional contact URIs to be associated with the
+	// ACME account, such as `mailto:admin@example.com`. They are registered
+	// after the address in `email`, if set.
+	// Which URI schemes are supported depends on the ACME server.
+	// This field may be updated after the account is initially registered.
+	Contacts []string
+
 	// Server is the URL used to access the ACME server's 'directory' endpoint.
 	// For example, for Let's Encrypt's staging endpoint, you would use:
 	// "https://acme-staging-v02.api.letsencrypt.org/directory".
@@ -75,6 +82,15 @@ type ACMEIssuer struct {
 	// external account credentials with the registered ACME account.
 	ExternalAccountBinding *ACMEExternalAccountBinding
 
+	// ExternalAccountBindingProvisioner configures an endpoint from which
+	// External Account Binding credentials are obtained whenever a new ACME
+	// account has to be registered, i.e. when the Issuer is first set up and
+	// after its account private key has been rotated.
+	// This is intended for ACME servers which issue one-time External Account
+	// Binding credentials.
+	// Mutually exclusive with ExternalAccountBinding.
+	ExternalAccountBindingProvisioner *ACMEExternalAccountBindingProvisioner
+
 	// PrivateKey is the name of a Kubernetes Secret resource that will be used to
 	// store the automatically generated ACME account private key.
 	// Optionally, a `key` may be specified to select a specific entry within
@@ -82,6 +98,13 @@ type ACMEIssuer struct {
 	// If `key` is not specified, a default of `tls.key` will be used.
 	PrivateKey cmmeta.SecretKeySelector
 
+	// AccountKeyRollover configures rolling the ACME account over to a new
+	// private key, as described in RFC 8555 section 7.3.5, so that the
+	// account's history, rate limits and External Account Binding are
+	// preserved when its private key changes.
+	// If not set, changing the private key registers a new ACME account.
+	AccountKeyRollover *ACMEAccountKeyRollover
+
 	// Solvers is a list of challenge solvers that will be used to solve
 	// ACME challenges for the matching domains.
 	// Solver configurations must be provided in order to obtain certificates
@@ -103,6 +126,14 @@ type ACMEIssuer struct {
 	// Defaults to false.
 	DisableAccountKeyGeneration bool
 
+	// Enables deactivating the ACME account when the Issuer resource is
+	// deleted. A deactivated account can no longer be used, not even by other
+	// Issuers referencing the same private key.
+	// If true, a finalizer is added to the Issuer so that the account is
+	// deactivated before the Issuer is removed.
+	// Defaults to false.
+	DeactivateAccountOnDeletion bool
+
 	// Enables requesting a Not After date on certificates that matches the
 	// duration of the certificate. This is not supported by all ACME servers
 	// like Let's Encrypt. If set to true when the ACME server does not support
@@ -117,6 +148,47 @@ type ACMEIssuer struct {
 	// RenewalInformationSource allows fetching ACME Renewal Information from the ACME CA
 	// server. Default is `ARI`.
 	RenewalInformationSource ACMERenewalInformationSource
+
+	// PreAuthorization configures pre-authorization of DNS names, as
+	// described in RFC 8555 section 7.4.1. The Issuer periodically obtains
+	// authorizations for the configured DNS names, so that orders for them
+	// can reuse the valid authorizations instead of solving a challenge for
+	// every order.
+	// Only supported by ACME servers that provide a `newAuthz` endpoint.
+	PreAuthorization *ACMEPreAuthorization
+}
+
+// ACMEPreAuthorization configures pre-authorization of DNS names.
+type ACMEPreAuthorization struct {
+	// DNSNames is the list of DNS names to pre-authorize, such as zone apexes.
+	// Wildcard DNS names cannot be pre-authorized.
+	DNSNames []string
+
+	// RenewBefore is how long before a pre-authorization expires that a new
+	// one is obtained.
+	// Defaults to 72h.
+	RenewBefore *metav1.Duration
+}
+
+// ACMEAccountKeyRollover configures rollover of the ACME account key.
+// When the private key in privateKeySecretRef no longer matches the key that
+// the account was last verified with, and the previous private key does,
+// cert-manager rolls the existing account over to the new key instead of
+// registering a new account.
+type ACMEAccountKeyRollover struct {
+	// previousPrivateKeySecretRef is a reference to the private key that was
+	// used before the key in privateKeySecretRef.
+	// If not set, the `<key>.previous` entry of the privateKeySecretRef Secret
+	// is used, where `<key>` is the entry holding the current private key.
+	PreviousPrivateKey *cmmeta.SecretKeySelector
+
+	// rotationPeriod is the interval at which cert-manager generates a new
+	// account private key. The current key is moved to the `<key>.previous`
+	// entry of the privateKeySecretRef Secret, the new key is stored in its
+	// place and the account is then rolled over to it.
+	// May not be set together with previousPrivateKeySecretRef or
+	// disableAccountKeyGeneration.
+	RotationPeriod *metav1.Duration
 }
 
 // ACMEExternalAccountBinding is a reference to a CA external account of the ACME
@@ -141,6 +213,30 @@ type ACMEExternalAccountBinding struct {
 	KeyAlgorithm HMACKeyAlgorithm
 }
 
+// ACMEExternalAccountBindingProvisioner configures an endpoint from which
+// External Account Binding credentials are obtained.
+// cert-manager authenticates to the endpoint with an OAuth 2.0 access token,
+// obtained from the token endpoint using the client credentials grant, and
+// sends it a POST request. The endpoint must respond with a JSON object
+// containing the `keyID` and the un-padded, base64 URL encoded `hmacKey` of
+// the new External Account Binding.
+// Both endpoints are trusted using the caBundle of the ACME issuer.
+type ACMEExternalAccountBindingProvisioner struct {
+	// url is the URL of the provisioning endpoint.
+	URL string
+
+	// tokenURL is the URL of the OAuth 2.0 token endpoint.
+	TokenURL string
+
+	// scopes are the OAuth 2.0 scopes requested with the access token.
+	Scopes []string
+
+	// credentialsRef is a reference to a Secret containing the OAuth 2.0
+	// client ID and client secret under the `client-id` and `client-secret`
+	// keys.
+	CredentialsRef cmmeta.LocalObjectReference
+}
+
 // HMACKeyAlgorithm is the name of a key algorithm used for HMAC encryption
 type HMACKeyAlgorithm string
 
@@ -170,6 +266,12 @@ type ACMEChallengeSolver struct {
 	// performing the DNS01 challenge flow.
 	DNS01 *ACMEChallengeSolverDNS01
 
+	// Configures cert-manager to attempt to complete authorizations by
+	// performing the TLS-ALPN-01 challenge flow.
+	// It is not possible to obtain certificates for wildcard domain names
+	// (e.g., `*.example.com`) using the TLS-ALPN-01 challenge mechanism.
+	TLSALPN01 *ACMEChallengeSolverTLSALPN01
+
 	// WaitInsteadOfSelfCheck, if set, skips cert-manager's self-check and
 	// instead waits this long after presentation before asking the ACME server
 	// to validate the challenge.
@@ -184,7 +286,8 @@ type ACMEChallengeSolver struct {
 }
 
 // CertificateDNSNameSelector selects certificates using a label selector, and
-// can optionally select individual DNS names within those certificates.
+// can optionally select individual DNS names or IP addresses within those
+// certificates.
 // If both MatchLabels and DNSNames are empty, this selector will match all
 // certificates and DNS names within them.
 type CertificateDNSNameSelector struct {
@@ -211,6 +314,22 @@ type CertificateDNSNameSelector struct {
 	// If neither has more matches, the solver defined earlier in the list
 	// will be selected.
 	DNSZones []string
+
+	// List of IP address ranges, in CIDR notation, that this solver will be
+	// used to solve IP address identifiers for. A single IP address can be
+	// selected using a /32 (IPv4) or /128 (IPv6) range.
+	// The most specific range match specified here will take precedence over
+	// other range matches, so a solver specifying 10.0.1.0/24 will be
+	// selected over one specifying 10.0.0.0/8 for the IP address 10.0.1.5.
+	// If specified and a match is found, a dnsNames selector will take
+	// precedence over an ipRanges selector.
+	// IP address identifiers never match a dnsZones selector, so ipRanges
+	// may not be combined with dnsZones.
+	// If multiple solvers match with the same ipRanges value, the solver
+	// with the most matching labels in matchLabels will be selected.
+	// If neither has more matches, the solver defined earlier in the list
+	// will be selected.
+	IPRanges []string
 }
 
 // ACMEChallengeSolverHTTP01 contains configuration detailing how to solve
@@ -290,6 +409,43 @@ type ACMEChallengeSolverHTTP01GatewayHTTPRoute struct {
 	PodTemplate *ACMEChallengeSolverHTTP01IngressPodTemplate
 }
 
+// ACMEChallengeSolverTLSALPN01 contains configuration detailing how to solve
+// TLS-ALPN-01 challenges within a Kubernetes cluster.
+// cert-manager runs a solver pod that answers TLS connections negotiating the
+// `acme-tls/1` protocol with the challenge certificate, and exposes it through
+// a Service. The Service must be reachable on port 443 of the domain being
+// validated, either directly or through a Gateway API TLSRoute.
+type ACMEChallengeSolverTLSALPN01 struct {
+	// Optional service type for Kubernetes solver service. Supported values
+	// are NodePort, ClusterIP or LoadBalancer. If unset, defaults to NodePort.
+	ServiceType corev1.ServiceType
+
+	// The Gateway API TLSRoute solver will create TLSRoute objects in
+	// passthrough mode, routing connections for the domain being validated to
+	// the solver Service.
+	GatewayTLSRoute *ACMEChallengeSolverTLSALPN01GatewayTLSRoute
+
+	// Optional pod template used to configure the ACME challenge solver pods
+	// used for TLS-ALPN-01 challenges.
+	PodTemplate *ACMEChallengeSolverHTTP01IngressPodTemplate
+}
+
+// The ACMEChallengeSolverTLSALPN01GatewayTLSRoute solver will create TLSRoute
+// objects for a Gateway listener in TLS passthrough mode, routing to an ACME
+// challenge solver pod.
+type ACMEChallengeSolverTLSALPN01GatewayTLSRoute struct {
+	// Custom labels that will be applied to TLSRoutes created by cert-manager
+	// while solving TLS-ALPN-01 challenges.
+	Labels map[string]string
+
+	// When solving a TLS-ALPN-01 challenge, cert-manager creates a TLSRoute.
+	// cert-manager needs to know which parentRefs should be used when creating
+	// the TLSRoute. Usually, the parentRef references a Gateway listener with
+	// the TLS protocol and the Passthrough TLS mode. See:
+	// https://gateway-api.sigs.k8s.io/guides/tls/#tls-passthrough
+	ParentRefs []gwapi.ParentReference
+}
+
 type ACMEChallengeSolverHTTP01IngressPodTemplate struct {
 	// ObjectMeta overrides for the pod used to solve HTTP01 challenges.
 	// Only the 'labels' and 'annotations' fields may be set.
@@ -375,15 +531,35 @@ type ACMEChallengeSolverDNS01 struct {
 	// records when found in DNS zones.
 	CNAMEStrategy CNAMEStrategy
 
+	// ValidationZone configures the solver to use CNAME delegation to a
+	// dedicated validation zone, for example `acme.example-validation.net`.
+	// When set, every `_acme-challenge.<name>` record is expected to be a CNAME
+	// into this zone, and challenge records are only ever written into this
+	// zone. Challenges for names that are not delegated to the validation zone
+	// fail until the CNAME record has been created.
+	// May not be specified together with cnameStrategy.
+	ValidationZone string
+
 	// Nameservers defines a list of DNS nameservers to use for DNS01 propagation
 	// checks. Each entry must be in the format `<host>:<port>` for plain DNS,
-	// where host may be an IP address or hostname, or
-	// `https://<DoH RFC 8484 server address>` for DNS over HTTPS. If not set,
-	// the controller's configured global DNS01 recursive nameservers are used.
+	// where host may be an IP address or hostname,
+	// `https://<DoH RFC 8484 server address>` for DNS over HTTPS, or
+	// `tls://<DoT RFC 7858 server address>[:<port>]` for DNS over TLS, where
+	// the port defaults to 853. If not set, the controller's configured global
+	// DNS01 recursive nameservers are used.
 	// When specified, this overrides the global nameservers for this solver
 	// only, and disables the authoritative nameserver check.
 	Nameservers []string
 
+	// AccountScoped configures the solver to use the dns-account-01 challenge
+	// type when the ACME server offers it. dns-account-01 places the TXT record
+	// at an `_<label>._acme-challenge` name derived from the ACME account URI,
+	// so that several ACME accounts can solve challenges for the same domain
+	// concurrently without their records colliding.
+	// If the ACME server does not offer dns-account-01 for an authorization,
+	// the dns-01 challenge type is used instead.
+	AccountScoped bool
+
 	// Use the Akamai DNS zone management API to manage DNS01 challenge records.
 	Akamai *ACMEIssuerDNS01ProviderAkamai
 
@@ -402,6 +578,10 @@ type ACMEChallengeSolverDNS01 struct {
 	// Use the DigitalOcean DNS API to manage DNS01 challenge records.
 	DigitalOcean *ACMEIssuerDNS01ProviderDigitalOcean
 
+	// Use the PowerDNS Authoritative Server HTTP API to manage DNS01 challenge
+	// records.
+	PowerDNS *ACMEIssuerDNS01ProviderPowerDNS
+
 	// Use the 'ACME DNS' (https://github.com/joohoi/acme-dns) API to manage
 	// DNS01 challenge records.
 	AcmeDNS *ACMEIssuerDNS01ProviderAcmeDNS
@@ -561,6 +741,29 @@ type ACMEIssuerDNS01ProviderDigitalOcean struct {
 	Token cmmeta.SecretKeySelector
 }
 
+// ACMEIssuerDNS01ProviderPowerDNS is a structure containing the DNS
+// configuration for the PowerDNS Authoritative Server HTTP API
+type ACMEIssuerDNS01ProviderPowerDNS struct {
+	// Host is the base URL of the PowerDNS HTTP API, for example
+	// `https://pdns.example.com:8081`.
+	Host string
+
+	// ServerID is the ID of the PowerDNS server to manage zones on.
+	// Defaults to `localhost`, which is the only ID supported by the
+	// PowerDNS Authoritative Server.
+	ServerID string
+
+	// A reference to a specific 'key' within a Secret resource.
+	// The key should contain the API key used to authenticate with the
+	// PowerDNS HTTP API.
+	APIKey cmmeta.SecretKeySelector
+
+	// Base64-encoded bundle of PEM CAs which will be used to validate the
+	// certificate chain presented by the PowerDNS HTTP API. If unset, the
+	// system certificate bundle inside the container is used.
+	CABundle []byte
+}
+
 // ACMEIssuerDNS01ProviderRoute53 is a structure containing the Route 53
 // configuration for AWS
 type ACMEIssuerDNS01ProviderRoute53 struct {
@@ -711,6 +914,49 @@ type ACMEIssuerDNS01ProviderRFC2136 struct {
 	// Protocol to use for dynamic DNS update queries. Valid values are (case-sensitive) ``TCP`` and ``UDP``; ``UDP`` (default).
 	// +optional
 	Protocol RFC2136UpdateProtocol
+
+	// Authenticate dynamic DNS updates using GSS-TSIG (RFC3645).
+	// Cannot be used together with ``tsigKeyName`` or ``sig0``.
+	GSSTSIG *ACMEIssuerDNS01ProviderRFC2136GSSTSIG
+
+	// Authenticate dynamic DNS updates using a SIG(0) (RFC2931) key pair.
+	// Cannot be used together with ``tsigKeyName`` or ``gssTSIG``.
+	SIG0 *ACMEIssuerDNS01ProviderRFC2136SIG0
+}
+
+// ACMEIssuerDNS01ProviderRFC2136GSSTSIG configures GSS-TSIG authentication of
+// RFC2136 dynamic DNS updates, using Kerberos credentials read from a keytab.
+type ACMEIssuerDNS01ProviderRFC2136GSSTSIG struct {
+	// The Kerberos realm of the principal.
+	Realm string
+
+	// The name of the Kerberos principal to authenticate as, without the
+	// realm.
+	Username string
+
+	// A reference to a key in a Secret holding a keytab containing the keys
+	// of the principal.
+	KeytabSecretRef cmmeta.SecretKeySelector
+
+	// The addresses of the Key Distribution Centers of the realm. If not set,
+	// the KDCs are discovered using DNS SRV records.
+	KDCs []string
+
+	// The service principal name of the DNS server.
+	// Defaults to ``DNS/<host>``, where ``<host>`` is the host part of
+	// ``nameserver``.
+	ServicePrincipalName string
+}
+
+// ACMEIssuerDNS01ProviderRFC2136SIG0 configures SIG(0) authentication of
+// RFC2136 dynamic DNS updates.
+type ACMEIssuerDNS01ProviderRFC2136SIG0 struct {
+	// The public KEY resource record of the key pair.
+	PublicKey string
+
+	// A reference to a key in a Secret holding the private key of the key
+	// pair, in the BIND private key format.
+	PrivateKeySecretRef cmmeta.SecretKeySelector
 }
 
 type RFC2136UpdateProtocol string
@@ -755,10 +1001,56 @@ type ACMEIssuerStatus struct {
 	// associated with the  Issuer
 	LastRegisteredEmail string
 
+	// LastRegisteredContacts are the additional contact URIs associated with
+	// the latest registered ACME account, in order to track changes made to
+	// registered account associated with the Issuer
+	LastRegisteredContacts []string
+
+	// OrdersURL is the URL from which the list of orders submitted by the
+	// ACME account can be retrieved, if the ACME server provides it.
+	OrdersURL string
+
 	// LastPrivateKeyHash is a hash of the private key associated with the latest
 	// registered ACME account, in order to track changes made to registered account
 	// associated with the Issuer
 	LastPrivateKeyHash string
+
+	// LastKeyRolloverTime is the time at which the ACME account was last
+	// rolled over to a new private key, or, if it never was, at which
+	// cert-manager started tracking the age of its private key.
+	LastKeyRolloverTime *metav1.Time
+
+	// PreAuthorizations are the authorizations obtained for the DNS names
+	// configured in preAuthorization.
+	PreAuthorizations []ACMEPreAuthorizationStatus
+}
+
+// ACMEPreAuthorizationStatus is the status of the authorization obtained for
+// a pre-authorized DNS name.
+type ACMEPreAuthorizationStatus struct {
+	// DNSName is the pre-authorized DNS name.
+	DNSName string
+
+	// URL is the URL of the ACME Authorization resource.
+	URL string
+
+	// State is the state of the ACME Authorization.
+	State State
+
+	// Expires is the time after which the ACME server considers the
+	// authorization invalid, if known.
+	Expires *metav1.Time
+
+	// LastFailureTime is set only if the latest authorization for this DNS
+	// name failed, and contains the time of the failure. A new authorization
+	// is not requested until time.Hour * 2 ^ (failedAttempts - 1) has
+	// elapsed since then, up to a maximum of 32 hours. It is unset once an
+	// authorization becomes valid.
+	LastFailureTime *metav1.Time
+
+	// FailedAttempts is the number of consecutive failed authorizations for
+	// this DNS name. It is unset once an authorization becomes valid.
+	FailedAttempts int
 }
 
 // ACMERenewalInformationSource determines whether to enable fetching ACME Renewal Information
@@ -26,12 +26,12 @@ import (
 
 	acme "github.com/cert-manager/cert-manager/internal/apis/acme"
 	meta "github.com/cert-manager/cert-manager/internal/apis/meta"
-	apismetav1 "github.com/cert-manager/cert-manager/internal/apis/meta/v1"
+	metav1 "github.com/cert-manager/cert-manager/internal/apis/meta/v1"
 	acmev1 "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	pkgapismetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apismetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -44,6 +44,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*acmev1.ACMEAccountKeyRollover)(nil), (*acme.ACMEAccountKeyRollover)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEAccountKeyRollover_To_acme_ACMEAccountKeyRollover(a.(*acmev1.ACMEAccountKeyRollover), b.(*acme.ACMEAccountKeyRollover), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEAccountKeyRollover)(nil), (*acmev1.ACMEAccountKeyRollover)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEAccountKeyRollover_To_v1_ACMEAccountKeyRollover(a.(*acme.ACMEAccountKeyRollover), b.(*acmev1.ACMEAccountKeyRollover), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*acmev1.ACMEAuthorization)(nil), (*acme.ACMEAuthorization)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEAuthorization_To_acme_ACMEAuthorization(a.(*acmev1.ACMEAuthorization), b.(*acme.ACMEAuthorization), scope)
 	}); err != nil {
@@ -184,6 +194,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*acmev1.ACMEChallengeSolverTLSALPN01)(nil), (*acme.ACMEChallengeSolverTLSALPN01)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEChallengeSolverTLSALPN01_To_acme_ACMEChallengeSolverTLSALPN01(a.(*acmev1.ACMEChallengeSolverTLSALPN01), b.(*acme.ACMEChallengeSolverTLSALPN01), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEChallengeSolverTLSALPN01)(nil), (*acmev1.ACMEChallengeSolverTLSALPN01)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEChallengeSolverTLSALPN01_To_v1_ACMEChallengeSolverTLSALPN01(a.(*acme.ACMEChallengeSolverTLSALPN01), b.(*acmev1.ACMEChallengeSolverTLSALPN01), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acmev1.ACMEChallengeSolverTLSALPN01GatewayTLSRoute)(nil), (*acme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEChallengeSolverTLSALPN01GatewayTLSRoute_To_acme_ACMEChallengeSolverTLSALPN01GatewayTLSRoute(a.(*acmev1.ACMEChallengeSolverTLSALPN01GatewayTLSRoute), b.(*acme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute)(nil), (*acmev1.ACMEChallengeSolverTLSALPN01GatewayTLSRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEChallengeSolverTLSALPN01GatewayTLSRoute_To_v1_ACMEChallengeSolverTLSALPN01GatewayTLSRoute(a.(*acme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute), b.(*acmev1.ACMEChallengeSolverTLSALPN01GatewayTLSRoute), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*acmev1.ACMEExternalAccountBinding)(nil), (*acme.ACMEExternalAccountBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEExternalAccountBinding_To_acme_ACMEExternalAccountBinding(a.(*acmev1.ACMEExternalAccountBinding), b.(*acme.ACMEExternalAccountBinding), scope)
 	}); err != nil {
@@ -194,6 +224,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*acmev1.ACMEExternalAccountBindingProvisioner)(nil), (*acme.ACMEExternalAccountBindingProvisioner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEExternalAccountBindingProvisioner_To_acme_ACMEExternalAccountBindingProvisioner(a.(*acmev1.ACMEExternalAccountBindingProvisioner), b.(*acme.ACMEExternalAccountBindingProvisioner), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEExternalAccountBindingProvisioner)(nil), (*acmev1.ACMEExternalAccountBindingProvisioner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEExternalAccountBindingProvisioner_To_v1_ACMEExternalAccountBindingProvisioner(a.(*acme.ACMEExternalAccountBindingProvisioner), b.(*acmev1.ACMEExternalAccountBindingProvisioner), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*acmev1.ACMEIssuerDNS01ProviderAcmeDNS)(nil), (*acme.ACMEIssuerDNS01ProviderAcmeDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEIssuerDNS01ProviderAcmeDNS_To_acme_ACMEIssuerDNS01ProviderAcmeDNS(a.(*acmev1.ACMEIssuerDNS01ProviderAcmeDNS), b.(*acme.ACMEIssuerDNS01ProviderAcmeDNS), scope)
 	}); err != nil {
@@ -254,6 +294,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*acmev1.ACMEIssuerDNS01ProviderPowerDNS)(nil), (*acme.ACMEIssuerDNS01ProviderPowerDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEIssuerDNS01ProviderPowerDNS_To_acme_ACMEIssuerDNS01ProviderPowerDNS(a.(*acmev1.ACMEIssuerDNS01ProviderPowerDNS), b.(*acme.ACMEIssuerDNS01ProviderPowerDNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderPowerDNS)(nil), (*acmev1.ACMEIssuerDNS01ProviderPowerDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderPowerDNS_To_v1_ACMEIssuerDNS01ProviderPowerDNS(a.(*acme.ACMEIssuerDNS01ProviderPowerDNS), b.(*acmev1.ACMEIssuerDNS01ProviderPowerDNS), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*acmev1.ACMEIssuerDNS01ProviderRFC2136)(nil), (*acme.ACMEIssuerDNS01ProviderRFC2136)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEIssuerDNS01ProviderRFC2136_To_acme_ACMEIssuerDNS01ProviderRFC2136(a.(*acmev1.ACMEIssuerDNS01ProviderRFC2136), b.(*acme.ACMEIssuerDNS01ProviderRFC2136), scope)
 	}); err != nil {
@@ -264,6 +314,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*acmev1.ACMEIssuerDNS01ProviderRFC2136GSSTSIG)(nil), (*acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(a.(*acmev1.ACMEIssuerDNS01ProviderRFC2136GSSTSIG), b.(*acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG)(nil), (*acmev1.ACMEIssuerDNS01ProviderRFC2136GSSTSIG)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(a.(*acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG), b.(*acmev1.ACMEIssuerDNS01ProviderRFC2136GSSTSIG), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acmev1.ACMEIssuerDNS01ProviderRFC2136SIG0)(nil), (*acme.ACMEIssuerDNS01ProviderRFC2136SIG0)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEIssuerDNS01ProviderRFC2136SIG0_To_acme_ACMEIssuerDNS01ProviderRFC2136SIG0(a.(*acmev1.ACMEIssuerDNS01ProviderRFC2136SIG0), b.(*acme.ACMEIssuerDNS01ProviderRFC2136SIG0), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderRFC2136SIG0)(nil), (*acmev1.ACMEIssuerDNS01ProviderRFC2136SIG0)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderRFC2136SIG0_To_v1_ACMEIssuerDNS01ProviderRFC2136SIG0(a.(*acme.ACMEIssuerDNS01ProviderRFC2136SIG0), b.(*acmev1.ACMEIssuerDNS01ProviderRFC2136SIG0), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*acmev1.ACMEIssuerDNS01ProviderRoute53)(nil), (*acme.ACMEIssuerDNS01ProviderRoute53)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEIssuerDNS01ProviderRoute53_To_acme_ACMEIssuerDNS01ProviderRoute53(a.(*acmev1.ACMEIssuerDNS01ProviderRoute53), b.(*acme.ACMEIssuerDNS01ProviderRoute53), scope)
 	}); err != nil {
@@ -294,6 +364,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*acmev1.ACMEPreAuthorization)(nil), (*acme.ACMEPreAuthorization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEPreAuthorization_To_acme_ACMEPreAuthorization(a.(*acmev1.ACMEPreAuthorization), b.(*acme.ACMEPreAuthorization), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEPreAuthorization)(nil), (*acmev1.ACMEPreAuthorization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEPreAuthorization_To_v1_ACMEPreAuthorization(a.(*acme.ACMEPreAuthorization), b.(*acmev1.ACMEPreAuthorization), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acmev1.ACMEPreAuthorizationStatus)(nil), (*acme.ACMEPreAuthorizationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEPreAuthorizationStatus_To_acme_ACMEPreAuthorizationStatus(a.(*acmev1.ACMEPreAuthorizationStatus), b.(*acme.ACMEPreAuthorizationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEPreAuthorizationStatus)(nil), (*acmev1.ACMEPreAuthorizationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEPreAuthorizationStatus_To_v1_ACMEPreAuthorizationStatus(a.(*acme.ACMEPreAuthorizationStatus), b.(*acmev1.ACMEPreAuthorizationStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*acmev1.AzureManagedIdentity)(nil), (*acme.AzureManagedIdentity)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_AzureManagedIdentity_To_acme_AzureManagedIdentity(a.(*acmev1.AzureManagedIdentity), b.(*acme.AzureManagedIdentity), scope)
 	}); err != nil {
@@ -437,6 +527,44 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1_ACMEAccountKeyRollover_To_acme_ACMEAccountKeyRollover(in *acmev1.ACMEAccountKeyRollover, out *acme.ACMEAccountKeyRollover, s conversion.Scope) error {
+	if in.PreviousPrivateKey != nil {
+		in, out := &in.PreviousPrivateKey, &out.PreviousPrivateKey
+		*out = new(meta.SecretKeySelector)
+		if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PreviousPrivateKey = nil
+	}
+	out.RotationPeriod = (*apismetav1.Duration)(unsafe.Pointer(in.RotationPeriod))
+	return nil
+}
+
+// Convert_v1_ACMEAccountKeyRollover_To_acme_ACMEAccountKeyRollover is an autogenerated conversion function.
+func Convert_v1_ACMEAccountKeyRollover_To_acme_ACMEAccountKeyRollover(in *acmev1.ACMEAccountKeyRollover, out *acme.ACMEAccountKeyRollover, s conversion.Scope) error {
+	return autoConvert_v1_ACMEAccountKeyRollover_To_acme_ACMEAccountKeyRollover(in, out, s)
+}
+
+func autoConvert_acme_ACMEAccountKeyRollover_To_v1_ACMEAccountKeyRollover(in *acme.ACMEAccountKeyRollover, out *acmev1.ACMEAccountKeyRollover, s conversion.Scope) error {
+	if in.PreviousPrivateKey != nil {
+		in, out := &in.PreviousPrivateKey, &out.PreviousPrivateKey
+		*out = new(pkgapismetav1.SecretKeySelector)
+		if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PreviousPrivateKey = nil
+	}
+	out.RotationPeriod = (*apismetav1.Duration)(unsafe.Pointer(in.RotationPeriod))
+	return nil
+}
+
+// Convert_acme_ACMEAccountKeyRollover_To_v1_ACMEAccountKeyRollover is an autogenerated conversion function.
+func Convert_acme_ACMEAccountKeyRollover_To_v1_ACMEAccountKeyRollover(in *acme.ACMEAccountKeyRollover, out *acmev1.ACMEAccountKeyRollover, s conversion.Scope) error {
+	return autoConvert_acme_ACMEAccountKeyRollover_To_v1_ACMEAccountKeyRollover(in, out, s)
+}
+
 func autoConvert_v1_ACMEAuthorization_To_acme_ACMEAuthorization(in *acmev1.ACMEAuthorization, out *acme.ACMEAuthorization, s conversion.Scope) error {
 	out.URL = in.URL
 	out.Identifier = in.Identifier
@@ -501,7 +629,8 @@ func autoConvert_v1_ACMEChallengeSolver_To_acme_ACMEChallengeSolver(in *acmev1.A
 	} else {
 		out.DNS01 = nil
 	}
-	out.WaitInsteadOfSelfCheck = (*metav1.Duration)(unsafe.Pointer(in.WaitInsteadOfSelfCheck))
+	out.TLSALPN01 = (*acme.ACMEChallengeSolverTLSALPN01)(unsafe.Pointer(in.TLSALPN01))
+	out.WaitInsteadOfSelfCheck = (*apismetav1.Duration)(unsafe.Pointer(in.WaitInsteadOfSelfCheck))
 	return nil
 }
 
@@ -522,7 +651,8 @@ func autoConvert_acme_ACMEChallengeSolver_To_v1_ACMEChallengeSolver(in *acme.ACM
 	} else {
 		out.DNS01 = nil
 	}
-	out.WaitInsteadOfSelfCheck = (*metav1.Duration)(unsafe.Pointer(in.WaitInsteadOfSelfCheck))
+	out.TLSALPN01 = (*acmev1.ACMEChallengeSolverTLSALPN01)(unsafe.Pointer(in.TLSALPN01))
+	out.WaitInsteadOfSelfCheck = (*apismetav1.Duration)(unsafe.Pointer(in.WaitInsteadOfSelfCheck))
 	return nil
 }
 
@@ -533,7 +663,9 @@ func Convert_acme_ACMEChallengeSolver_To_v1_ACMEChallengeSolver(in *acme.ACMECha
 
 func autoConvert_v1_ACMEChallengeSolverDNS01_To_acme_ACMEChallengeSolverDNS01(in *acmev1.ACMEChallengeSolverDNS01, out *acme.ACMEChallengeSolverDNS01, s conversion.Scope) error {
 	out.CNAMEStrategy = acme.CNAMEStrategy(in.CNAMEStrategy)
+	out.ValidationZone = in.ValidationZone
 	out.Nameservers = *(*[]string)(unsafe.Pointer(&in.Nameservers))
+	out.AccountScoped = in.AccountScoped
 	if in.Akamai != nil {
 		in, out := &in.Akamai, &out.Akamai
 		*out = new(acme.ACMEIssuerDNS01ProviderAkamai)
@@ -588,6 +720,15 @@ func autoConvert_v1_ACMEChallengeSolverDNS01_To_acme_ACMEChallengeSolverDNS01(in
 	} else {
 		out.DigitalOcean = nil
 	}
+	if in.PowerDNS != nil {
+		in, out := &in.PowerDNS, &out.PowerDNS
+		*out = new(acme.ACMEIssuerDNS01ProviderPowerDNS)
+		if err := Convert_v1_ACMEIssuerDNS01ProviderPowerDNS_To_acme_ACMEIssuerDNS01ProviderPowerDNS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PowerDNS = nil
+	}
 	if in.AcmeDNS != nil {
 		in, out := &in.AcmeDNS, &out.AcmeDNS
 		*out = new(acme.ACMEIssuerDNS01ProviderAcmeDNS)
@@ -617,7 +758,9 @@ func Convert_v1_ACMEChallengeSolverDNS01_To_acme_ACMEChallengeSolverDNS01(in *ac
 
 func autoConvert_acme_ACMEChallengeSolverDNS01_To_v1_ACMEChallengeSolverDNS01(in *acme.ACMEChallengeSolverDNS01, out *acmev1.ACMEChallengeSolverDNS01, s conversion.Scope) error {
 	out.CNAMEStrategy = acmev1.CNAMEStrategy(in.CNAMEStrategy)
+	out.ValidationZone = in.ValidationZone
 	out.Nameservers = *(*[]string)(unsafe.Pointer(&in.Nameservers))
+	out.AccountScoped = in.AccountScoped
 	if in.Akamai != nil {
 		in, out := &in.Akamai, &out.Akamai
 		*out = new(acmev1.ACMEIssuerDNS01ProviderAkamai)
@@ -672,6 +815,15 @@ func autoConvert_acme_ACMEChallengeSolverDNS01_To_v1_ACMEChallengeSolverDNS01(in
 	} else {
 		out.DigitalOcean = nil
 	}
+	if in.PowerDNS != nil {
+		in, out := &in.PowerDNS, &out.PowerDNS
+		*out = new(acmev1.ACMEIssuerDNS01ProviderPowerDNS)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderPowerDNS_To_v1_ACMEIssuerDNS01ProviderPowerDNS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PowerDNS = nil
+	}
 	if in.AcmeDNS != nil {
 		in, out := &in.AcmeDNS, &out.AcmeDNS
 		*out = new(acmev1.ACMEIssuerDNS01ProviderAcmeDNS)
@@ -967,9 +1119,55 @@ func Convert_acme_ACMEChallengeSolverHTTP01IngressTemplate_To_v1_ACMEChallengeSo
 	return autoConvert_acme_ACMEChallengeSolverHTTP01IngressTemplate_To_v1_ACMEChallengeSolverHTTP01IngressTemplate(in, out, s)
 }
 
+func autoConvert_v1_ACMEChallengeSolverTLSALPN01_To_acme_ACMEChallengeSolverTLSALPN01(in *acmev1.ACMEChallengeSolverTLSALPN01, out *acme.ACMEChallengeSolverTLSALPN01, s conversion.Scope) error {
+	out.ServiceType = corev1.ServiceType(in.ServiceType)
+	out.GatewayTLSRoute = (*acme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute)(unsafe.Pointer(in.GatewayTLSRoute))
+	out.PodTemplate = (*acme.ACMEChallengeSolverHTTP01IngressPodTemplate)(unsafe.Pointer(in.PodTemplate))
+	return nil
+}
+
+// Convert_v1_ACMEChallengeSolverTLSALPN01_To_acme_ACMEChallengeSolverTLSALPN01 is an autogenerated conversion function.
+func Convert_v1_ACMEChallengeSolverTLSALPN01_To_acme_ACMEChallengeSolverTLSALPN01(in *acmev1.ACMEChallengeSolverTLSALPN01, out *acme.ACMEChallengeSolverTLSALPN01, s conversion.Scope) error {
+	return autoConvert_v1_ACMEChallengeSolverTLSALPN01_To_acme_ACMEChallengeSolverTLSALPN01(in, out, s)
+}
+
+func autoConvert_acme_ACMEChallengeSolverTLSALPN01_To_v1_ACMEChallengeSolverTLSALPN01(in *acme.ACMEChallengeSolverTLSALPN01, out *acmev1.ACMEChallengeSolverTLSALPN01, s conversion.Scope) error {
+	out.ServiceType = corev1.ServiceType(in.ServiceType)
+	out.GatewayTLSRoute = (*acmev1.ACMEChallengeSolverTLSALPN01GatewayTLSRoute)(unsafe.Pointer(in.GatewayTLSRoute))
+	out.PodTemplate = (*acmev1.ACMEChallengeSolverHTTP01IngressPodTemplate)(unsafe.Pointer(in.PodTemplate))
+	return nil
+}
+
+// Convert_acme_ACMEChallengeSolverTLSALPN01_To_v1_ACMEChallengeSolverTLSALPN01 is an autogenerated conversion function.
+func Convert_acme_ACMEChallengeSolverTLSALPN01_To_v1_ACMEChallengeSolverTLSALPN01(in *acme.ACMEChallengeSolverTLSALPN01, out *acmev1.ACMEChallengeSolverTLSALPN01, s conversion.Scope) error {
+	return autoConvert_acme_ACMEChallengeSolverTLSALPN01_To_v1_ACMEChallengeSolverTLSALPN01(in, out, s)
+}
+
+func autoConvert_v1_ACMEChallengeSolverTLSALPN01GatewayTLSRoute_To_acme_ACMEChallengeSolverTLSALPN01GatewayTLSRoute(in *acmev1.ACMEChallengeSolverTLSALPN01GatewayTLSRoute, out *acme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute, s conversion.Scope) error {
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.ParentRefs = *(*[]apisv1.ParentReference)(unsafe.Pointer(&in.ParentRefs))
+	return nil
+}
+
+// Convert_v1_ACMEChallengeSolverTLSALPN01GatewayTLSRoute_To_acme_ACMEChallengeSolverTLSALPN01GatewayTLSRoute is an autogenerated conversion function.
+func Convert_v1_ACMEChallengeSolverTLSALPN01GatewayTLSRoute_To_acme_ACMEChallengeSolverTLSALPN01GatewayTLSRoute(in *acmev1.ACMEChallengeSolverTLSALPN01GatewayTLSRoute, out *acme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute, s conversion.Scope) error {
+	return autoConvert_v1_ACMEChallengeSolverTLSALPN01GatewayTLSRoute_To_acme_ACMEChallengeSolverTLSALPN01GatewayTLSRoute(in, out, s)
+}
+
+func autoConvert_acme_ACMEChallengeSolverTLSALPN01GatewayTLSRoute_To_v1_ACMEChallengeSolverTLSALPN01GatewayTLSRoute(in *acme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute, out *acmev1.ACMEChallengeSolverTLSALPN01GatewayTLSRoute, s conversion.Scope) error {
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.ParentRefs = *(*[]apisv1.ParentReference)(unsafe.Pointer(&in.ParentRefs))
+	return nil
+}
+
+// Convert_acme_ACMEChallengeSolverTLSALPN01GatewayTLSRoute_To_v1_ACMEChallengeSolverTLSALPN01GatewayTLSRoute is an autogenerated conversion function.
+func Convert_acme_ACMEChallengeSolverTLSALPN01GatewayTLSRoute_To_v1_ACMEChallengeSolverTLSALPN01GatewayTLSRoute(in *acme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute, out *acmev1.ACMEChallengeSolverTLSALPN01GatewayTLSRoute, s conversion.Scope) error {
+	return autoConvert_acme_ACMEChallengeSolverTLSALPN01GatewayTLSRoute_To_v1_ACMEChallengeSolverTLSALPN01GatewayTLSRoute(in, out, s)
+}
+
 func autoConvert_v1_ACMEExternalAccountBinding_To_acme_ACMEExternalAccountBinding(in *acmev1.ACMEExternalAccountBinding, out *acme.ACMEExternalAccountBinding, s conversion.Scope) error {
 	out.KeyID = in.KeyID
-	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.Key, &out.Key, s); err != nil {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.Key, &out.Key, s); err != nil {
 		return err
 	}
 	out.KeyAlgorithm = acme.HMACKeyAlgorithm(in.KeyAlgorithm)
@@ -983,7 +1181,7 @@ func Convert_v1_ACMEExternalAccountBinding_To_acme_ACMEExternalAccountBinding(in
 
 func autoConvert_acme_ACMEExternalAccountBinding_To_v1_ACMEExternalAccountBinding(in *acme.ACMEExternalAccountBinding, out *acmev1.ACMEExternalAccountBinding, s conversion.Scope) error {
 	out.KeyID = in.KeyID
-	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.Key, &out.Key, s); err != nil {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.Key, &out.Key, s); err != nil {
 		return err
 	}
 	out.KeyAlgorithm = acmev1.HMACKeyAlgorithm(in.KeyAlgorithm)
@@ -995,8 +1193,39 @@ func Convert_acme_ACMEExternalAccountBinding_To_v1_ACMEExternalAccountBinding(in
 	return autoConvert_acme_ACMEExternalAccountBinding_To_v1_ACMEExternalAccountBinding(in, out, s)
 }
 
+func autoConvert_v1_ACMEExternalAccountBindingProvisioner_To_acme_ACMEExternalAccountBindingProvisioner(in *acmev1.ACMEExternalAccountBindingProvisioner, out *acme.ACMEExternalAccountBindingProvisioner, s conversion.Scope) error {
+	out.URL = in.URL
+	out.TokenURL = in.TokenURL
+	out.Scopes = *(*[]string)(unsafe.Pointer(&in.Scopes))
+	if err := metav1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ACMEExternalAccountBindingProvisioner_To_acme_ACMEExternalAccountBindingProvisioner is an autogenerated conversion function.
+func Convert_v1_ACMEExternalAccountBindingProvisioner_To_acme_ACMEExternalAccountBindingProvisioner(in *acmev1.ACMEExternalAccountBindingProvisioner, out *acme.ACMEExternalAccountBindingProvisioner, s conversion.Scope) error {
+	return autoConvert_v1_ACMEExternalAccountBindingProvisioner_To_acme_ACMEExternalAccountBindingProvisioner(in, out, s)
+}
+
+func autoConvert_acme_ACMEExternalAccountBindingProvisioner_To_v1_ACMEExternalAccountBindingProvisioner(in *acme.ACMEExternalAccountBindingProvisioner, out *acmev1.ACMEExternalAccountBindingProvisioner, s conversion.Scope) error {
+	out.URL = in.URL
+	out.TokenURL = in.TokenURL
+	out.Scopes = *(*[]string)(unsafe.Pointer(&in.Scopes))
+	if err := metav1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_acme_ACMEExternalAccountBindingProvisioner_To_v1_ACMEExternalAccountBindingProvisioner is an autogenerated conversion function.
+func Convert_acme_ACMEExternalAccountBindingProvisioner_To_v1_ACMEExternalAccountBindingProvisioner(in *acme.ACMEExternalAccountBindingProvisioner, out *acmev1.ACMEExternalAccountBindingProvisioner, s conversion.Scope) error {
+	return autoConvert_acme_ACMEExternalAccountBindingProvisioner_To_v1_ACMEExternalAccountBindingProvisioner(in, out, s)
+}
+
 func autoConvert_v1_ACMEIssuer_To_acme_ACMEIssuer(in *acmev1.ACMEIssuer, out *acme.ACMEIssuer, s conversion.Scope) error {
 	out.Email = in.Email
+	out.Contacts = *(*[]string)(unsafe.Pointer(&in.Contacts))
 	out.Server = in.Server
 	out.PreferredChain = in.PreferredChain
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
@@ -1010,9 +1239,27 @@ func autoConvert_v1_ACMEIssuer_To_acme_ACMEIssuer(in *acmev1.ACMEIssuer, out *ac
 	} else {
 		out.ExternalAccountBinding = nil
 	}
-	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
+	if in.ExternalAccountBindingProvisioner != nil {
+		in, out := &in.ExternalAccountBindingProvisioner, &out.ExternalAccountBindingProvisioner
+		*out = new(acme.ACMEExternalAccountBindingProvisioner)
+		if err := Convert_v1_ACMEExternalAccountBindingProvisioner_To_acme_ACMEExternalAccountBindingProvisioner(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ExternalAccountBindingProvisioner = nil
+	}
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
 		return err
 	}
+	if in.AccountKeyRollover != nil {
+		in, out := &in.AccountKeyRollover, &out.AccountKeyRollover
+		*out = new(acme.ACMEAccountKeyRollover)
+		if err := Convert_v1_ACMEAccountKeyRollover_To_acme_ACMEAccountKeyRollover(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AccountKeyRollover = nil
+	}
 	if in.Solvers != nil {
 		in, out := &in.Solvers, &out.Solvers
 		*out = make([]acme.ACMEChallengeSolver, len(*in))
@@ -1025,14 +1272,17 @@ func autoConvert_v1_ACMEIssuer_To_acme_ACMEIssuer(in *acmev1.ACMEIssuer, out *ac
 		out.Solvers = nil
 	}
 	out.DisableAccountKeyGeneration = in.DisableAccountKeyGeneration
+	out.DeactivateAccountOnDeletion = in.DeactivateAccountOnDeletion
 	out.EnableDurationFeature = in.EnableDurationFeature
 	out.Profile = in.Profile
 	out.RenewalInformationSource = acme.ACMERenewalInformationSource(in.RenewalInformationSource)
+	out.PreAuthorization = (*acme.ACMEPreAuthorization)(unsafe.Pointer(in.PreAuthorization))
 	return nil
 }
 
 func autoConvert_acme_ACMEIssuer_To_v1_ACMEIssuer(in *acme.ACMEIssuer, out *acmev1.ACMEIssuer, s conversion.Scope) error {
 	out.Email = in.Email
+	out.Contacts = *(*[]string)(unsafe.Pointer(&in.Contacts))
 	out.Server = in.Server
 	out.PreferredChain = in.PreferredChain
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
@@ -1046,9 +1296,27 @@ func autoConvert_acme_ACMEIssuer_To_v1_ACMEIssuer(in *acme.ACMEIssuer, out *acme
 	} else {
 		out.ExternalAccountBinding = nil
 	}
-	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
+	if in.ExternalAccountBindingProvisioner != nil {
+		in, out := &in.ExternalAccountBindingProvisioner, &out.ExternalAccountBindingProvisioner
+		*out = new(acmev1.ACMEExternalAccountBindingProvisioner)
+		if err := Convert_acme_ACMEExternalAccountBindingProvisioner_To_v1_ACMEExternalAccountBindingProvisioner(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ExternalAccountBindingProvisioner = nil
+	}
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
 		return err
 	}
+	if in.AccountKeyRollover != nil {
+		in, out := &in.AccountKeyRollover, &out.AccountKeyRollover
+		*out = new(acmev1.ACMEAccountKeyRollover)
+		if err := Convert_acme_ACMEAccountKeyRollover_To_v1_ACMEAccountKeyRollover(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AccountKeyRollover = nil
+	}
 	if in.Solvers != nil {
 		in, out := &in.Solvers, &out.Solvers
 		*out = make([]acmev1.ACMEChallengeSolver, len(*in))
@@ -1061,15 +1329,17 @@ func autoConvert_acme_ACMEIssuer_To_v1_ACMEIssuer(in *acme.ACMEIssuer, out *acme
 		out.Solvers = nil
 	}
 	out.DisableAccountKeyGeneration = in.DisableAccountKeyGeneration
+	out.DeactivateAccountOnDeletion = in.DeactivateAccountOnDeletion
 	out.EnableDurationFeature = in.EnableDurationFeature
 	out.Profile = in.Profile
 	out.RenewalInformationSource = acmev1.ACMERenewalInformationSource(in.RenewalInformationSource)
+	out.PreAuthorization = (*acmev1.ACMEPreAuthorization)(unsafe.Pointer(in.PreAuthorization))
 	return nil
 }
 
 func autoConvert_v1_ACMEIssuerDNS01ProviderAcmeDNS_To_acme_ACMEIssuerDNS01ProviderAcmeDNS(in *acmev1.ACMEIssuerDNS01ProviderAcmeDNS, out *acme.ACMEIssuerDNS01ProviderAcmeDNS, s conversion.Scope) error {
 	out.Host = in.Host
-	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.AccountSecret, &out.AccountSecret, s); err != nil {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.AccountSecret, &out.AccountSecret, s); err != nil {
 		return err
 	}
 	return nil
@@ -1082,7 +1352,7 @@ func Convert_v1_ACMEIssuerDNS01ProviderAcmeDNS_To_acme_ACMEIssuerDNS01ProviderAc
 
 func autoConvert_acme_ACMEIssuerDNS01ProviderAcmeDNS_To_v1_ACMEIssuerDNS01ProviderAcmeDNS(in *acme.ACMEIssuerDNS01ProviderAcmeDNS, out *acmev1.ACMEIssuerDNS01ProviderAcmeDNS, s conversion.Scope) error {
 	out.Host = in.Host
-	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.AccountSecret, &out.AccountSecret, s); err != nil {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.AccountSecret, &out.AccountSecret, s); err != nil {
 		return err
 	}
 	return nil
@@ -1095,13 +1365,13 @@ func Convert_acme_ACMEIssuerDNS01ProviderAcmeDNS_To_v1_ACMEIssuerDNS01ProviderAc
 
 func autoConvert_v1_ACMEIssuerDNS01ProviderAkamai_To_acme_ACMEIssuerDNS01ProviderAkamai(in *acmev1.ACMEIssuerDNS01ProviderAkamai, out *acme.ACMEIssuerDNS01ProviderAkamai, s conversion.Scope) error {
 	out.ServiceConsumerDomain = in.ServiceConsumerDomain
-	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.ClientToken, &out.ClientToken, s); err != nil {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.ClientToken, &out.ClientToken, s); err != nil {
 		return err
 	}
-	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.ClientSecret, &out.ClientSecret, s); err != nil {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.ClientSecret, &out.ClientSecret, s); err != nil {
 		return err
 	}
-	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.AccessToken, &out.AccessToken, s); err != nil {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.AccessToken, &out.AccessToken, s); err != nil {
 		return err
 	}
 	return nil
@@ -1114,13 +1384,13 @@ func Convert_v1_ACMEIssuerDNS01ProviderAkamai_To_acme_ACMEIssuerDNS01ProviderAka
 
 func autoConvert_acme_ACMEIssuerDNS01ProviderAkamai_To_v1_ACMEIssuerDNS01ProviderAkamai(in *acme.ACMEIssuerDNS01ProviderAkamai, out *acmev1.ACMEIssuerDNS01ProviderAkamai, s conversion.Scope) error {
 	out.ServiceConsumerDomain = in.ServiceConsumerDomain
-	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.ClientToken, &out.ClientToken, s); err != nil {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.ClientToken, &out.ClientToken, s); err != nil {
 		return err
 	}
-	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.ClientSecret, &out.ClientSecret, s); err != nil {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.ClientSecret, &out.ClientSecret, s); err != nil {
 		return err
 	}
-	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.AccessToken, &out.AccessToken, s); err != nil {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.AccessToken, &out.AccessToken, s); err != nil {
 		return err
 	}
 	return nil
@@ -1136,7 +1406,7 @@ func autoConvert_v1_ACMEIssuerDNS01ProviderAzureDNS_To_acme_ACMEIssuerDNS01Provi
 	if in.ClientSecret != nil {
 		in, out := &in.ClientSecret, &out.ClientSecret
 		*out = new(meta.SecretKeySelector)
-		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+		if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1162,7 +1432,7 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderAzureDNS_To_v1_ACMEIssuerDNS01Provi
 	if in.ClientSecret != nil {
 		in, out := &in.ClientSecret, &out.ClientSecret
 		*out = new(pkgapismetav1.SecretKeySelector)
-		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+		if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1187,7 +1457,7 @@ func autoConvert_v1_ACMEIssuerDNS01ProviderCloudDNS_To_acme_ACMEIssuerDNS01Provi
 	if in.ServiceAccount != nil {
 		in, out := &in.ServiceAccount, &out.ServiceAccount
 		*out = new(meta.SecretKeySelector)
-		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+		if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1207,7 +1477,7 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderCloudDNS_To_v1_ACMEIssuerDNS01Provi
 	if in.ServiceAccount != nil {
 		in, out := &in.ServiceAccount, &out.ServiceAccount
 		*out = new(pkgapismetav1.SecretKeySelector)
-		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+		if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1228,7 +1498,7 @@ func autoConvert_v1_ACMEIssuerDNS01ProviderCloudflare_To_acme_ACMEIssuerDNS01Pro
 	if in.APIKey != nil {
 		in, out := &in.APIKey, &out.APIKey
 		*out = new(meta.SecretKeySelector)
-		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+		if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1237,7 +1507,7 @@ func autoConvert_v1_ACMEIssuerDNS01ProviderCloudflare_To_acme_ACMEIssuerDNS01Pro
 	if in.APIToken != nil {
 		in, out := &in.APIToken, &out.APIToken
 		*out = new(meta.SecretKeySelector)
-		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+		if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1256,7 +1526,7 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderCloudflare_To_v1_ACMEIssuerDNS01Pro
 	if in.APIKey != nil {
 		in, out := &in.APIKey, &out.APIKey
 		*out = new(pkgapismetav1.SecretKeySelector)
-		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+		if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1265,7 +1535,7 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderCloudflare_To_v1_ACMEIssuerDNS01Pro
 	if in.APIToken != nil {
 		in, out := &in.APIToken, &out.APIToken
 		*out = new(pkgapismetav1.SecretKeySelector)
-		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+		if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1280,7 +1550,7 @@ func Convert_acme_ACMEIssuerDNS01ProviderCloudflare_To_v1_ACMEIssuerDNS01Provide
 }
 
 func autoConvert_v1_ACMEIssuerDNS01ProviderDigitalOcean_To_acme_ACMEIssuerDNS01ProviderDigitalOcean(in *acmev1.ACMEIssuerDNS01ProviderDigitalOcean, out *acme.ACMEIssuerDNS01ProviderDigitalOcean, s conversion.Scope) error {
-	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.Token, &out.Token, s); err != nil {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.Token, &out.Token, s); err != nil {
 		return err
 	}
 	return nil
@@ -1292,7 +1562,7 @@ func Convert_v1_ACMEIssuerDNS01ProviderDigitalOcean_To_acme_ACMEIssuerDNS01Provi
 }
 
 func autoConvert_acme_ACMEIssuerDNS01ProviderDigitalOcean_To_v1_ACMEIssuerDNS01ProviderDigitalOcean(in *acme.ACMEIssuerDNS01ProviderDigitalOcean, out *acmev1.ACMEIssuerDNS01ProviderDigitalOcean, s conversion.Scope) error {
-	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.Token, &out.Token, s); err != nil {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.Token, &out.Token, s); err != nil {
 		return err
 	}
 	return nil
@@ -1303,14 +1573,62 @@ func Convert_acme_ACMEIssuerDNS01ProviderDigitalOcean_To_v1_ACMEIssuerDNS01Provi
 	return autoConvert_acme_ACMEIssuerDNS01ProviderDigitalOcean_To_v1_ACMEIssuerDNS01ProviderDigitalOcean(in, out, s)
 }
 
+func autoConvert_v1_ACMEIssuerDNS01ProviderPowerDNS_To_acme_ACMEIssuerDNS01ProviderPowerDNS(in *acmev1.ACMEIssuerDNS01ProviderPowerDNS, out *acme.ACMEIssuerDNS01ProviderPowerDNS, s conversion.Scope) error {
+	out.Host = in.Host
+	out.ServerID = in.ServerID
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APIKey, &out.APIKey, s); err != nil {
+		return err
+	}
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_v1_ACMEIssuerDNS01ProviderPowerDNS_To_acme_ACMEIssuerDNS01ProviderPowerDNS is an autogenerated conversion function.
+func Convert_v1_ACMEIssuerDNS01ProviderPowerDNS_To_acme_ACMEIssuerDNS01ProviderPowerDNS(in *acmev1.ACMEIssuerDNS01ProviderPowerDNS, out *acme.ACMEIssuerDNS01ProviderPowerDNS, s conversion.Scope) error {
+	return autoConvert_v1_ACMEIssuerDNS01ProviderPowerDNS_To_acme_ACMEIssuerDNS01ProviderPowerDNS(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderPowerDNS_To_v1_ACMEIssuerDNS01ProviderPowerDNS(in *acme.ACMEIssuerDNS01ProviderPowerDNS, out *acmev1.ACMEIssuerDNS01ProviderPowerDNS, s conversion.Scope) error {
+	out.Host = in.Host
+	out.ServerID = in.ServerID
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APIKey, &out.APIKey, s); err != nil {
+		return err
+	}
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderPowerDNS_To_v1_ACMEIssuerDNS01ProviderPowerDNS is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderPowerDNS_To_v1_ACMEIssuerDNS01ProviderPowerDNS(in *acme.ACMEIssuerDNS01ProviderPowerDNS, out *acmev1.ACMEIssuerDNS01ProviderPowerDNS, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderPowerDNS_To_v1_ACMEIssuerDNS01ProviderPowerDNS(in, out, s)
+}
+
 func autoConvert_v1_ACMEIssuerDNS01ProviderRFC2136_To_acme_ACMEIssuerDNS01ProviderRFC2136(in *acmev1.ACMEIssuerDNS01ProviderRFC2136, out *acme.ACMEIssuerDNS01ProviderRFC2136, s conversion.Scope) error {
 	out.Nameserver = in.Nameserver
-	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.TSIGSecret, &out.TSIGSecret, s); err != nil {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.TSIGSecret, &out.TSIGSecret, s); err != nil {
 		return err
 	}
 	out.TSIGKeyName = in.TSIGKeyName
 	out.TSIGAlgorithm = in.TSIGAlgorithm
 	out.Protocol = acme.RFC2136UpdateProtocol(in.Protocol)
+	if in.GSSTSIG != nil {
+		in, out := &in.GSSTSIG, &out.GSSTSIG
+		*out = new(acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG)
+		if err := Convert_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GSSTSIG = nil
+	}
+	if in.SIG0 != nil {
+		in, out := &in.SIG0, &out.SIG0
+		*out = new(acme.ACMEIssuerDNS01ProviderRFC2136SIG0)
+		if err := Convert_v1_ACMEIssuerDNS01ProviderRFC2136SIG0_To_acme_ACMEIssuerDNS01ProviderRFC2136SIG0(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SIG0 = nil
+	}
 	return nil
 }
 
@@ -1321,12 +1639,30 @@ func Convert_v1_ACMEIssuerDNS01ProviderRFC2136_To_acme_ACMEIssuerDNS01ProviderRF
 
 func autoConvert_acme_ACMEIssuerDNS01ProviderRFC2136_To_v1_ACMEIssuerDNS01ProviderRFC2136(in *acme.ACMEIssuerDNS01ProviderRFC2136, out *acmev1.ACMEIssuerDNS01ProviderRFC2136, s conversion.Scope) error {
 	out.Nameserver = in.Nameserver
-	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.TSIGSecret, &out.TSIGSecret, s); err != nil {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.TSIGSecret, &out.TSIGSecret, s); err != nil {
 		return err
 	}
 	out.TSIGKeyName = in.TSIGKeyName
 	out.TSIGAlgorithm = in.TSIGAlgorithm
 	out.Protocol = acmev1.RFC2136UpdateProtocol(in.Protocol)
+	if in.GSSTSIG != nil {
+		in, out := &in.GSSTSIG, &out.GSSTSIG
+		*out = new(acmev1.ACMEIssuerDNS01ProviderRFC2136GSSTSIG)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GSSTSIG = nil
+	}
+	if in.SIG0 != nil {
+		in, out := &in.SIG0, &out.SIG0
+		*out = new(acmev1.ACMEIssuerDNS01ProviderRFC2136SIG0)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderRFC2136SIG0_To_v1_ACMEIssuerDNS01ProviderRFC2136SIG0(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SIG0 = nil
+	}
 	return nil
 }
 
@@ -1335,19 +1671,77 @@ func Convert_acme_ACMEIssuerDNS01ProviderRFC2136_To_v1_ACMEIssuerDNS01ProviderRF
 	return autoConvert_acme_ACMEIssuerDNS01ProviderRFC2136_To_v1_ACMEIssuerDNS01ProviderRFC2136(in, out, s)
 }
 
+func autoConvert_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(in *acmev1.ACMEIssuerDNS01ProviderRFC2136GSSTSIG, out *acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG, s conversion.Scope) error {
+	out.Realm = in.Realm
+	out.Username = in.Username
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.KeytabSecretRef, &out.KeytabSecretRef, s); err != nil {
+		return err
+	}
+	out.KDCs = *(*[]string)(unsafe.Pointer(&in.KDCs))
+	out.ServicePrincipalName = in.ServicePrincipalName
+	return nil
+}
+
+// Convert_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG is an autogenerated conversion function.
+func Convert_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(in *acmev1.ACMEIssuerDNS01ProviderRFC2136GSSTSIG, out *acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG, s conversion.Scope) error {
+	return autoConvert_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(in *acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG, out *acmev1.ACMEIssuerDNS01ProviderRFC2136GSSTSIG, s conversion.Scope) error {
+	out.Realm = in.Realm
+	out.Username = in.Username
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.KeytabSecretRef, &out.KeytabSecretRef, s); err != nil {
+		return err
+	}
+	out.KDCs = *(*[]string)(unsafe.Pointer(&in.KDCs))
+	out.ServicePrincipalName = in.ServicePrincipalName
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(in *acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG, out *acmev1.ACMEIssuerDNS01ProviderRFC2136GSSTSIG, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(in, out, s)
+}
+
+func autoConvert_v1_ACMEIssuerDNS01ProviderRFC2136SIG0_To_acme_ACMEIssuerDNS01ProviderRFC2136SIG0(in *acmev1.ACMEIssuerDNS01ProviderRFC2136SIG0, out *acme.ACMEIssuerDNS01ProviderRFC2136SIG0, s conversion.Scope) error {
+	out.PublicKey = in.PublicKey
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PrivateKeySecretRef, &out.PrivateKeySecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ACMEIssuerDNS01ProviderRFC2136SIG0_To_acme_ACMEIssuerDNS01ProviderRFC2136SIG0 is an autogenerated conversion function.
+func Convert_v1_ACMEIssuerDNS01ProviderRFC2136SIG0_To_acme_ACMEIssuerDNS01ProviderRFC2136SIG0(in *acmev1.ACMEIssuerDNS01ProviderRFC2136SIG0, out *acme.ACMEIssuerDNS01ProviderRFC2136SIG0, s conversion.Scope) error {
+	return autoConvert_v1_ACMEIssuerDNS01ProviderRFC2136SIG0_To_acme_ACMEIssuerDNS01ProviderRFC2136SIG0(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderRFC2136SIG0_To_v1_ACMEIssuerDNS01ProviderRFC2136SIG0(in *acme.ACMEIssuerDNS01ProviderRFC2136SIG0, out *acmev1.ACMEIssuerDNS01ProviderRFC2136SIG0, s conversion.Scope) error {
+	out.PublicKey = in.PublicKey
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PrivateKeySecretRef, &out.PrivateKeySecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderRFC2136SIG0_To_v1_ACMEIssuerDNS01ProviderRFC2136SIG0 is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderRFC2136SIG0_To_v1_ACMEIssuerDNS01ProviderRFC2136SIG0(in *acme.ACMEIssuerDNS01ProviderRFC2136SIG0, out *acmev1.ACMEIssuerDNS01ProviderRFC2136SIG0, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderRFC2136SIG0_To_v1_ACMEIssuerDNS01ProviderRFC2136SIG0(in, out, s)
+}
+
 func autoConvert_v1_ACMEIssuerDNS01ProviderRoute53_To_acme_ACMEIssuerDNS01ProviderRoute53(in *acmev1.ACMEIssuerDNS01ProviderRoute53, out *acme.ACMEIssuerDNS01ProviderRoute53, s conversion.Scope) error {
 	out.Auth = (*acme.Route53Auth)(unsafe.Pointer(in.Auth))
 	out.AccessKeyID = in.AccessKeyID
 	if in.SecretAccessKeyID != nil {
 		in, out := &in.SecretAccessKeyID, &out.SecretAccessKeyID
 		*out = new(meta.SecretKeySelector)
-		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+		if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
 		out.SecretAccessKeyID = nil
 	}
-	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretAccessKey, &out.SecretAccessKey, s); err != nil {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretAccessKey, &out.SecretAccessKey, s); err != nil {
 		return err
 	}
 	out.Role = in.Role
@@ -1367,13 +1761,13 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderRoute53_To_v1_ACMEIssuerDNS01Provid
 	if in.SecretAccessKeyID != nil {
 		in, out := &in.SecretAccessKeyID, &out.SecretAccessKeyID
 		*out = new(pkgapismetav1.SecretKeySelector)
-		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+		if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
 		out.SecretAccessKeyID = nil
 	}
-	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretAccessKey, &out.SecretAccessKey, s); err != nil {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretAccessKey, &out.SecretAccessKey, s); err != nil {
 		return err
 	}
 	out.Role = in.Role
@@ -1414,7 +1808,11 @@ func Convert_acme_ACMEIssuerDNS01ProviderWebhook_To_v1_ACMEIssuerDNS01ProviderWe
 func autoConvert_v1_ACMEIssuerStatus_To_acme_ACMEIssuerStatus(in *acmev1.ACMEIssuerStatus, out *acme.ACMEIssuerStatus, s conversion.Scope) error {
 	out.URI = in.URI
 	out.LastRegisteredEmail = in.LastRegisteredEmail
+	out.LastRegisteredContacts = *(*[]string)(unsafe.Pointer(&in.LastRegisteredContacts))
+	out.OrdersURL = in.OrdersURL
 	out.LastPrivateKeyHash = in.LastPrivateKeyHash
+	out.LastKeyRolloverTime = (*apismetav1.Time)(unsafe.Pointer(in.LastKeyRolloverTime))
+	out.PreAuthorizations = *(*[]acme.ACMEPreAuthorizationStatus)(unsafe.Pointer(&in.PreAuthorizations))
 	return nil
 }
 
@@ -1426,7 +1824,11 @@ func Convert_v1_ACMEIssuerStatus_To_acme_ACMEIssuerStatus(in *acmev1.ACMEIssuerS
 func autoConvert_acme_ACMEIssuerStatus_To_v1_ACMEIssuerStatus(in *acme.ACMEIssuerStatus, out *acmev1.ACMEIssuerStatus, s conversion.Scope) error {
 	out.URI = in.URI
 	out.LastRegisteredEmail = in.LastRegisteredEmail
+	out.LastRegisteredContacts = *(*[]string)(unsafe.Pointer(&in.LastRegisteredContacts))
+	out.OrdersURL = in.OrdersURL
 	out.LastPrivateKeyHash = in.LastPrivateKeyHash
+	out.LastKeyRolloverTime = (*apismetav1.Time)(unsafe.Pointer(in.LastKeyRolloverTime))
+	out.PreAuthorizations = *(*[]acmev1.ACMEPreAuthorizationStatus)(unsafe.Pointer(&in.PreAuthorizations))
 	return nil
 }
 
@@ -1435,6 +1837,58 @@ func Convert_acme_ACMEIssuerStatus_To_v1_ACMEIssuerStatus(in *acme.ACMEIssuerSta
 	return autoConvert_acme_ACMEIssuerStatus_To_v1_ACMEIssuerStatus(in, out, s)
 }
 
+func autoConvert_v1_ACMEPreAuthorization_To_acme_ACMEPreAuthorization(in *acmev1.ACMEPreAuthorization, out *acme.ACMEPreAuthorization, s conversion.Scope) error {
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.RenewBefore = (*apismetav1.Duration)(unsafe.Pointer(in.RenewBefore))
+	return nil
+}
+
+// Convert_v1_ACMEPreAuthorization_To_acme_ACMEPreAuthorization is an autogenerated conversion function.
+func Convert_v1_ACMEPreAuthorization_To_acme_ACMEPreAuthorization(in *acmev1.ACMEPreAuthorization, out *acme.ACMEPreAuthorization, s conversion.Scope) error {
+	return autoConvert_v1_ACMEPreAuthorization_To_acme_ACMEPreAuthorization(in, out, s)
+}
+
+func autoConvert_acme_ACMEPreAuthorization_To_v1_ACMEPreAuthorization(in *acme.ACMEPreAuthorization, out *acmev1.ACMEPreAuthorization, s conversion.Scope) error {
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.RenewBefore = (*apismetav1.Duration)(unsafe.Pointer(in.RenewBefore))
+	return nil
+}
+
+// Convert_acme_ACMEPreAuthorization_To_v1_ACMEPreAuthorization is an autogenerated conversion function.
+func Convert_acme_ACMEPreAuthorization_To_v1_ACMEPreAuthorization(in *acme.ACMEPreAuthorization, out *acmev1.ACMEPreAuthorization, s conversion.Scope) error {
+	return autoConvert_acme_ACMEPreAuthorization_To_v1_ACMEPreAuthorization(in, out, s)
+}
+
+func autoConvert_v1_ACMEPreAuthorizationStatus_To_acme_ACMEPreAuthorizationStatus(in *acmev1.ACMEPreAuthorizationStatus, out *acme.ACMEPreAuthorizationStatus, s conversion.Scope) error {
+	out.DNSName = in.DNSName
+	out.URL = in.URL
+	out.State = acme.State(in.State)
+	out.Expires = (*apismetav1.Time)(unsafe.Pointer(in.Expires))
+	out.LastFailureTime = (*apismetav1.Time)(unsafe.Pointer(in.LastFailureTime))
+	out.FailedAttempts = in.FailedAttempts
+	return nil
+}
+
+// Convert_v1_ACMEPreAuthorizationStatus_To_acme_ACMEPreAuthorizationStatus is an autogenerated conversion function.
+func Convert_v1_ACMEPreAuthorizationStatus_To_acme_ACMEPreAuthorizationStatus(in *acmev1.ACMEPreAuthorizationStatus, out *acme.ACMEPreAuthorizationStatus, s conversion.Scope) error {
+	return autoConvert_v1_ACMEPreAuthorizationStatus_To_acme_ACMEPreAuthorizationStatus(in, out, s)
+}
+
+func autoConvert_acme_ACMEPreAuthorizationStatus_To_v1_ACMEPreAuthorizationStatus(in *acme.ACMEPreAuthorizationStatus, out *acmev1.ACMEPreAuthorizationStatus, s conversion.Scope) error {
+	out.DNSName = in.DNSName
+	out.URL = in.URL
+	out.State = acmev1.State(in.State)
+	out.Expires = (*apismetav1.Time)(unsafe.Pointer(in.Expires))
+	out.LastFailureTime = (*apismetav1.Time)(unsafe.Pointer(in.LastFailureTime))
+	out.FailedAttempts = in.FailedAttempts
+	return nil
+}
+
+// Convert_acme_ACMEPreAuthorizationStatus_To_v1_ACMEPreAuthorizationStatus is an autogenerated conversion function.
+func Convert_acme_ACMEPreAuthorizationStatus_To_v1_ACMEPreAuthorizationStatus(in *acme.ACMEPreAuthorizationStatus, out *acmev1.ACMEPreAuthorizationStatus, s conversion.Scope) error {
+	return autoConvert_acme_ACMEPreAuthorizationStatus_To_v1_ACMEPreAuthorizationStatus(in, out, s)
+}
+
 func autoConvert_v1_AzureManagedIdentity_To_acme_AzureManagedIdentity(in *acmev1.AzureManagedIdentity, out *acme.AzureManagedIdentity, s conversion.Scope) error {
 	out.ClientID = in.ClientID
 	out.ResourceID = in.ResourceID
@@ -1463,6 +1917,7 @@ func autoConvert_v1_CertificateDNSNameSelector_To_acme_CertificateDNSNameSelecto
 	out.MatchLabels = *(*map[string]string)(unsafe.Pointer(&in.MatchLabels))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.DNSZones = *(*[]string)(unsafe.Pointer(&in.DNSZones))
+	out.IPRanges = *(*[]string)(unsafe.Pointer(&in.IPRanges))
 	return nil
 }
 
@@ -1475,6 +1930,7 @@ func autoConvert_acme_CertificateDNSNameSelector_To_v1_CertificateDNSNameSelecto
 	out.MatchLabels = *(*map[string]string)(unsafe.Pointer(&in.MatchLabels))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.DNSZones = *(*[]string)(unsafe.Pointer(&in.DNSZones))
+	out.IPRanges = *(*[]string)(unsafe.Pointer(&in.IPRanges))
 	return nil
 }
 
@@ -1568,9 +2024,10 @@ func autoConvert_v1_ChallengeSpec_To_acme_ChallengeSpec(in *acmev1.ChallengeSpec
 	if err := Convert_v1_ACMEChallengeSolver_To_acme_ACMEChallengeSolver(&in.Solver, &out.Solver, s); err != nil {
 		return err
 	}
-	if err := apismetav1.Convert_v1_IssuerReference_To_meta_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	if err := metav1.Convert_v1_IssuerReference_To_meta_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
+	out.AccountURI = in.AccountURI
 	return nil
 }
 
@@ -1590,9 +2047,10 @@ func autoConvert_acme_ChallengeSpec_To_v1_ChallengeSpec(in *acme.ChallengeSpec,
 	if err := Convert_acme_ACMEChallengeSolver_To_v1_ACMEChallengeSolver(&in.Solver, &out.Solver, s); err != nil {
 		return err
 	}
-	if err := apismetav1.Convert_meta_IssuerReference_To_v1_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	if err := metav1.Convert_meta_IssuerReference_To_v1_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
+	out.AccountURI = in.AccountURI
 	return nil
 }
 
@@ -1604,7 +2062,7 @@ func Convert_acme_ChallengeSpec_To_v1_ChallengeSpec(in *acme.ChallengeSpec, out
 func autoConvert_v1_ChallengeStatus_To_acme_ChallengeStatus(in *acmev1.ChallengeStatus, out *acme.ChallengeStatus, s conversion.Scope) error {
 	out.Processing = in.Processing
 	out.Presented = in.Presented
-	out.PresentedAt = (*metav1.Time)(unsafe.Pointer(in.PresentedAt))
+	out.PresentedAt = (*apismetav1.Time)(unsafe.Pointer(in.PresentedAt))
 	out.Reason = in.Reason
 	out.State = acme.State(in.State)
 	return nil
@@ -1618,7 +2076,7 @@ func Convert_v1_ChallengeStatus_To_acme_ChallengeStatus(in *acmev1.ChallengeStat
 func autoConvert_acme_ChallengeStatus_To_v1_ChallengeStatus(in *acme.ChallengeStatus, out *acmev1.ChallengeStatus, s conversion.Scope) error {
 	out.Processing = in.Processing
 	out.Presented = in.Presented
-	out.PresentedAt = (*metav1.Time)(unsafe.Pointer(in.PresentedAt))
+	out.PresentedAt = (*apismetav1.Time)(unsafe.Pointer(in.PresentedAt))
 	out.Reason = in.Reason
 	out.State = acmev1.State(in.State)
 	return nil
@@ -1705,13 +2163,13 @@ func Convert_acme_OrderList_To_v1_OrderList(in *acme.OrderList, out *acmev1.Orde
 
 func autoConvert_v1_OrderSpec_To_acme_OrderSpec(in *acmev1.OrderSpec, out *acme.OrderSpec, s conversion.Scope) error {
 	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
-	if err := apismetav1.Convert_v1_IssuerReference_To_meta_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	if err := metav1.Convert_v1_IssuerReference_To_meta_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
 	out.CommonName = in.CommonName
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
-	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
 	out.Profile = in.Profile
 	out.Replaces = in.Replaces
 	return nil
@@ -1724,13 +2182,13 @@ func Convert_v1_OrderSpec_To_acme_OrderSpec(in *acmev1.OrderSpec, out *acme.Orde
 
 func autoConvert_acme_OrderSpec_To_v1_OrderSpec(in *acme.OrderSpec, out *acmev1.OrderSpec, s conversion.Scope) error {
 	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
-	if err := apismetav1.Convert_meta_IssuerReference_To_v1_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	if err := metav1.Convert_meta_IssuerReference_To_v1_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
 	out.CommonName = in.CommonName
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
-	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Duration = (*apismetav1.Duration)(unsafe.Pointer(in.Duration))
 	out.Profile = in.Profile
 	out.Replaces = in.Replaces
 	return nil
@@ -1748,7 +2206,7 @@ func autoConvert_v1_OrderStatus_To_acme_OrderStatus(in *acmev1.OrderStatus, out
 	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
 	out.State = acme.State(in.State)
 	out.Reason = in.Reason
-	out.FailureTime = (*metav1.Time)(unsafe.Pointer(in.FailureTime))
+	out.FailureTime = (*apismetav1.Time)(unsafe.Pointer(in.FailureTime))
 	return nil
 }
 
@@ -1764,7 +2222,7 @@ func autoConvert_acme_OrderStatus_To_v1_OrderStatus(in *acme.OrderStatus, out *a
 	out.State = acmev1.State(in.State)
 	out.Reason = in.Reason
 	out.Authorizations = *(*[]acmev1.ACMEAuthorization)(unsafe.Pointer(&in.Authorizations))
-	out.FailureTime = (*metav1.Time)(unsafe.Pointer(in.FailureTime))
+	out.FailureTime = (*apismetav1.Time)(unsafe.Pointer(in.FailureTime))
 	return nil
 }
 
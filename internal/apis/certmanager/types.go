@@ -69,6 +69,11 @@ const (
 	// Annotation key for the name of the certificate that a resource is related to.
 	CertificateNameKey = "cert-manager.io/certificate-name"
 
+	// Annotation key for the namespace of the certificate that a resource is
+	// related to. It is set on resources written for a Certificate outside of
+	// its Secret, such as ConfigMap targets and truststore Secrets.
+	CertificateNamespaceKey = "cert-manager.io/certificate-namespace"
+
 	// Annotation key used to denote whether a Secret is named on a Certificate
 	// as a 'next private key' Secret resource.
 	IsNextPrivateKeySecretLabelKey = "cert-manager.io/next-private-key"
@@ -106,6 +111,14 @@ const (
 
 	// Annotation to declare the CertificateRequest "revision", belonging to a Certificate Resource
 	CertificateRequestRevisionAnnotationKey = "cert-manager.io/certificate-revision"
+
+	// Annotation added to CertificateRequest resources to mark the certificate
+	// that was issued for the request as revoked. The value is the RFC 5280
+	// CRLReason name, e.g. `keyCompromise` or `superseded`; an empty value
+	// means `unspecified`.
+	// The annotation may also be added to a Certificate resource, to revoke
+	// the certificate that is currently issued for it.
+	CertificateRequestRevocationReasonAnnotationKey = "cert-manager.io/revocation-reason"
 )
 
 const (
@@ -226,4 +239,8 @@ const (
 	ECDSAWithSHA384 SignatureAlgorithm = "ECDSAWithSHA384"
 	ECDSAWithSHA512 SignatureAlgorithm = "ECDSAWithSHA512"
 	PureEd25519     SignatureAlgorithm = "PureEd25519"
+	PureEd448       SignatureAlgorithm = "PureEd448"
+	MLDSA44         SignatureAlgorithm = "MLDSA44"
+	MLDSA65         SignatureAlgorithm = "MLDSA65"
+	MLDSA87         SignatureAlgorithm = "MLDSA87"
 )
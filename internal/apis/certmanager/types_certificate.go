@@ -69,6 +69,12 @@ const (
 
 	// Ed25519 private key algorithm.
 	Ed25519KeyAlgorithm PrivateKeyAlgorithm = "Ed25519"
+
+	// Ed448 private key algorithm.
+	Ed448KeyAlgorithm PrivateKeyAlgorithm = "Ed448"
+
+	// ML-DSA (FIPS 204) post-quantum private key algorithm.
+	MLDSAKeyAlgorithm PrivateKeyAlgorithm = "MLDSA"
 )
 
 type PrivateKeyEncoding string
@@ -78,6 +84,8 @@ const (
 	// For RSA keys: produces PEM block with `BEGIN RSA PRIVATE KEY` header and private key in PKCS#1 format.
 	// For EC keys: produces PEM block with `BEGIN EC PRIVATE KEY` header and private key in SEC 1 format.
 	// For Ed25519 keys: option will be ignored and PKCS8 encoding will be used instead.
+	// For Ed448 keys: option will be ignored and PKCS8 encoding will be used instead.
+	// For ML-DSA keys: option will be ignored and PKCS8 encoding will be used instead.
 	PKCS1 PrivateKeyEncoding = "PKCS1"
 
 	// PKCS8 private key encoding.
@@ -239,6 +247,16 @@ type CertificateSpec struct {
 	// encoding and the rotation policy.
 	PrivateKey *CertificatePrivateKey
 
+	// CSR is a PEM encoded certificate signing request supplied by the user,
+	// for private keys which are generated and held outside of the cluster.
+	// If set, cert-manager does not generate or store a private key: the CSR
+	// is used as is for every CertificateRequest, including renewals, and
+	// only `tls.crt` and `ca.crt` are written to the Secret.
+	// The subject and subject alternative names are taken from the CSR, so
+	// `commonName`, `subject`, `literalSubject`, the SAN fields,
+	// `privateKey`, `keystores` and `additionalOutputFormats` cannot be set.
+	CSR *CertificateCSRSource
+
 	// Signature algorith to use.
 	SignatureAlgorithm SignatureAlgorithm
 
@@ -262,6 +280,19 @@ type CertificateSpec struct {
 	// to be written to this Certificate's target Secret.
 	AdditionalOutputFormats []CertificateAdditionalOutputFormat
 
+	// ConfigMaps which the public parts of the signed certificate are
+	// published to, in addition to the Secret named in `secretName`.
+	// Each ConfigMap is populated with the `tls.crt` (leaf and chain) and
+	// `ca.crt` entries of the Secret. The private key is never written to a
+	// ConfigMap.
+	// ConfigMaps in a namespace other than the Certificate's are only written
+	// if that namespace is listed for the Certificate's namespace in the
+	// controller's `--configmap-target-namespaces` flag. ConfigMaps which
+	// already exist are only written if they were created for this
+	// Certificate, and ConfigMaps which are removed from this list are
+	// deleted.
+	ConfigMapTargets []CertificateConfigMapTarget
+
 	// x.509 certificate NameConstraint extension which MUST NOT be used in a non-CA certificate.
 	// More Info: https://datatracker.ietf.org/doc/html/rfc5280#section-4.2.1.10
 	//
@@ -283,6 +314,19 @@ type OtherName struct {
 	UTF8Value string
 }
 
+// CertificateCSRSource configures where the certificate signing request for a
+// Certificate is read from. Exactly one of `request` or `secretRef` must be set.
+type CertificateCSRSource struct {
+	// Request is an inline PEM encoded x509 certificate signing request.
+	Request []byte
+
+	// SecretRef references a PEM encoded x509 certificate signing request
+	// stored in a Secret in the same namespace as the Certificate.
+	// If `key` is not set, the `tls.csr` entry of the Secret is used.
+	// Updating the Secret triggers a re-issuance of the Certificate.
+	SecretRef *cmmeta.SecretKeySelector
+}
+
 // CertificatePrivateKey contains configuration options for private keys
 // used by the Certificate controller.
 // These include the key algorithm and size, the used encoding and the
@@ -311,11 +355,13 @@ type CertificatePrivateKey struct {
 	// Algorithm is the private key algorithm of the corresponding private key
 	// for this certificate.
 	//
-	// If provided, allowed values are either `RSA`, `ECDSA` or `Ed25519`.
+	// If provided, allowed values are either `RSA`, `ECDSA`, `Ed25519`, `Ed448` or `MLDSA`.
 	// If `algorithm` is specified and `size` is not provided,
-	// key size of 2048 will be used for `RSA` key algorithm and
-	// key size of 256 will be used for `ECDSA` key algorithm.
-	// key size is ignored when using the `Ed25519` key algorithm.
+	// key size of 2048 will be used for `RSA` key algorithm,
+	// key size of 256 will be used for `ECDSA` key algorithm and
+	// parameter set 44 will be used for `MLDSA` key algorithm.
+	// key size is ignored when using the `Ed25519` or `Ed448` key algorithms.
+	// `Ed448` private keys cannot be used with `keystores`.
 	Algorithm PrivateKeyAlgorithm
 
 	// Size is the key bit size of the corresponding private key for this certificate.
@@ -324,9 +370,24 @@ type CertificatePrivateKey struct {
 	// and will default to `2048` if not specified.
 	// If `algorithm` is set to `ECDSA`, valid values are `256`, `384` or `521`,
 	// and will default to `256` if not specified.
-	// If `algorithm` is set to `Ed25519`, Size is ignored.
+	// If `algorithm` is set to `Ed25519` or `Ed448`, Size is ignored.
+	// If `algorithm` is set to `MLDSA`, Size selects the ML-DSA parameter set:
+	// valid values are `44`, `65` or `87`, and will default to `44` if not
+	// specified.
 	// No other values are allowed.
 	Size int
+
+	// Provider is the name of an external key provider which should generate
+	// and hold the private key for this certificate, for example a PKCS#11
+	// hardware security module or a KMS. The private key never leaves the
+	// provider: `tls.key` in the Secret contains only a handle referencing
+	// the key, so `keystores` and `additionalOutputFormats` cannot be used.
+	// The provider must be configured in the cert-manager controller. The only
+	// provider included in cert-manager is `local`, which is intended for
+	// testing; other providers are added by builds of the controller.
+	// If not set, the private key is generated by cert-manager and stored in
+	// the Secret.
+	Provider string
 }
 
 // Denotes how private keys should be generated or sourced when a Certificate
@@ -347,12 +408,23 @@ var (
 
 // CertificateOutputFormatType specifies which additional output formats should
 // be written to the Certificate's target Secret.
-// Allowed values are `DER` or `CombinedPEM`.
+// Allowed values are `DER`, `CombinedPEM`, `EncryptedPKCS8`, `PKCS7` or
+// `FullChainPEM`.
 // When Type is set to `DER` an additional entry `key.der` will be written to
 // the Secret, containing the binary format of the private key.
 // When Type is set to `CombinedPEM` an additional entry `tls-combined.pem`
 // will be written to the Secret, containing the PEM formatted private key and
 // signed certificate chain (tls.key + tls.crt concatenated).
+// When Type is set to `EncryptedPKCS8` an additional entry `tls-encrypted.key`
+// will be written to the Secret, containing the private key as a PEM formatted
+// PKCS#8 `ENCRYPTED PRIVATE KEY`, encrypted with the password referenced by
+// `passwordSecretRef`.
+// When Type is set to `PKCS7` an additional entry `tls.p7b` will be written to
+// the Secret, containing the signed certificate chain and CA as a PEM formatted
+// PKCS#7 certificate bundle, without the private key.
+// When Type is set to `FullChainPEM` an additional entry `fullchain.pem` will
+// be written to the Secret, containing the PEM formatted signed certificate
+// chain followed by the CA, without the private key.
 type CertificateOutputFormatType string
 
 const (
@@ -367,6 +439,24 @@ const (
 	// character, followed by the chain of signed certificate PEM documents
 	// (`<private key> + \n + <signed certificate chain>`).
 	CertificateOutputFormatCombinedPEM CertificateOutputFormatType = "CombinedPEM"
+
+	// CertificateOutputFormatEncryptedPKCS8 writes the Certificate's private
+	// key as a PEM formatted PKCS#8 `ENCRYPTED PRIVATE KEY` to the
+	// `tls-encrypted.key` target Secret Data key. The private key is encrypted
+	// using PBES2 with PBKDF2-HMAC-SHA256 and AES-256-CBC.
+	CertificateOutputFormatEncryptedPKCS8 CertificateOutputFormatType = "EncryptedPKCS8"
+
+	// CertificateOutputFormatPKCS7 writes the Certificate's signed certificate
+	// chain, followed by the CA certificate if one is known, as a PEM formatted
+	// degenerate PKCS#7 SignedData bundle (commonly known as a `.p7b` file) to
+	// the `tls.p7b` target Secret Data key. The private key is not included.
+	CertificateOutputFormatPKCS7 CertificateOutputFormatType = "PKCS7"
+
+	// CertificateOutputFormatFullChainPEM writes the Certificate's signed
+	// certificate chain, followed by the CA certificate if it is not already
+	// the last certificate in the chain, in PEM format to the `fullchain.pem`
+	// target Secret Data key. The private key is not included.
+	CertificateOutputFormatFullChainPEM CertificateOutputFormatType = "FullChainPEM"
 )
 
 // CertificateAdditionalOutputFormat defines an additional output format of a
@@ -376,6 +466,13 @@ type CertificateAdditionalOutputFormat struct {
 	// Type is the name of the format type that should be written to the
 	// Certificate's target Secret.
 	Type CertificateOutputFormatType
+
+	// PasswordSecretRef is a reference to a non-empty key in a Secret resource
+	// containing the password used to encrypt the private key.
+	// Required if, and only if, Type is `EncryptedPKCS8`. Changing the password
+	// in the referenced Secret causes the encrypted private key to be
+	// re-written.
+	PasswordSecretRef *cmmeta.SecretKeySelector
 }
 
 // X509Subject Full X509 name specification
@@ -408,6 +505,32 @@ type CertificateKeystores struct {
 	// PKCS12 configures options for storing a PKCS12 keystore in the
 	// `spec.secretName` Secret resource.
 	PKCS12 *PKCS12Keystore
+
+	// Truststore configures writing the JKS and PKCS12 truststores to a
+	// separate Secret resource instead of the `spec.secretName` Secret
+	// resource, so that workloads which only need to trust the issuing
+	// Certificate Authority do not need access to the private key.
+	Truststore *CertificateTruststore
+}
+
+// CertificateTruststore configures the Secret resources which the JKS and
+// PKCS12 truststores of a Certificate are written to.
+type CertificateTruststore struct {
+	// SecretName is the name of the Secret resource, in the Certificate's
+	// namespace, which the truststores are written to. The truststores are
+	// encrypted using the same passwords as the corresponding keystores, and
+	// the Secret also contains the CA certificate as `ca.crt`. It is only
+	// written if the issuer provided a CA certificate, and an existing Secret
+	// is only written if it was created for this Certificate.
+	// Must not be the same as `spec.secretName`.
+	SecretName string
+
+	// Namespaces is a list of additional namespaces which a copy of the
+	// truststore Secret, with the same name, is written to. Copies are only
+	// written to namespaces permitted for the Certificate's namespace by the
+	// controller's `--truststore-secret-namespaces` flag. Copies which are
+	// removed from this list are deleted.
+	Namespaces []string
 }
 
 // JKS configures options for storing a JKS keystore in the target secret.
@@ -453,9 +576,9 @@ type PKCS12Keystore struct {
 	// `passwordSecretRef`.
 	// The keystore file will be updated immediately.
 	// If the issuer provided a CA certificate, a file named `truststore.p12` will
-	// also be created in the target Secret resource, encrypted using the
-	// password stored in `passwordSecretRef` containing the issuing Certificate
-	// Authority
+	// also be created in the target Secret resource, or in the Secret configured
+	// by `keystores.truststore`, encrypted using the password stored in
+	// `passwordSecretRef` containing the issuing Certificate Authority
 	Create bool
 
 	// Profile specifies the key and certificate encryption algorithms and the HMAC algorithm
@@ -591,6 +714,34 @@ type CertificateStatus struct {
 	// ACME stores information that is fetched from the ACME CA server.
 	// +optional
 	ACME *CertificateACMEStatus
+
+	// Revocations records the certificates issued for this Certificate which
+	// have been requested to be revoked, and the outcome of revoking them with
+	// the issuer.
+	Revocations []CertificateRevocation
+}
+
+// CertificateRevocation records the revocation of a single certificate that
+// was issued for a Certificate.
+type CertificateRevocation struct {
+	// SerialNumber of the revoked certificate, in hexadecimal.
+	SerialNumber string
+
+	// CertificateRequestName is the name of the CertificateRequest the revoked
+	// certificate was issued for.
+	CertificateRequestName string
+
+	// Reason is the RFC 5280 CRLReason the certificate was revoked with.
+	Reason string
+
+	// Status of the revocation, one of (`True`, `False`, `Unknown`).
+	Status cmmeta.ConditionStatus
+
+	// Message is a human readable description of the revocation outcome.
+	Message string
+
+	// RevocationTime is the time at which the issuer revoked the certificate.
+	RevocationTime *metav1.Time
 }
 
 // CertificateCondition contains condition information for a Certificate.
@@ -652,6 +803,17 @@ const (
 	CertificateConditionIssuing CertificateConditionType = "Issuing"
 )
 
+// CertificateConfigMapTarget is a ConfigMap which the public parts of a
+// Certificate's signed certificate are published to.
+type CertificateConfigMapTarget struct {
+	// Name of the ConfigMap.
+	Name string
+
+	// Namespace of the ConfigMap. Defaults to the namespace of the
+	// Certificate.
+	Namespace string
+}
+
 // CertificateSecretTemplate defines the default labels and annotations
 // to be copied to the Kubernetes Secret resource named in `CertificateSpec.secretName`.
 type CertificateSecretTemplate struct {
@@ -40,6 +40,15 @@ const (
 	// be issued.
 	// The `status.failureTime` field should be set in this case.
 	CertificateRequestReasonDenied = "Denied"
+
+	// Revoked is a Revoked condition reason that indicates that the issuer has
+	// revoked the certificate issued for the CertificateRequest.
+	CertificateRequestReasonRevoked = "Revoked"
+
+	// RevocationNotSupported is a Revoked condition reason that indicates that
+	// the issuer is unable to revoke certificates, and revocation will not be
+	// retried.
+	CertificateRequestReasonRevocationNotSupported = "RevocationNotSupported"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -153,7 +162,8 @@ type CertificateRequestSpec struct {
 // resulting signed certificate.
 type CertificateRequestStatus struct {
 	// List of status conditions to indicate the status of a CertificateRequest.
-	// Known condition types are `Ready`, `InvalidRequest`, `Approved` and `Denied`.
+	// Known condition types are `Ready`, `InvalidRequest`, `Approved`,
+	// `Denied` and `Revoked`.
 	Conditions []CertificateRequestCondition
 
 	// The PEM encoded X.509 certificate resulting from the certificate
@@ -177,7 +187,7 @@ type CertificateRequestStatus struct {
 // CertificateRequestCondition contains condition information for a CertificateRequest.
 type CertificateRequestCondition struct {
 	// Type of the condition, known values are (`Ready`, `InvalidRequest`,
-	// `Approved`, `Denied`).
+	// `Approved`, `Denied`, `Revoked`).
 	Type CertificateRequestConditionType
 
 	// Status of the condition, one of (`True`, `False`, `Unknown`).
@@ -222,4 +232,11 @@ const (
 	// `False`, and cannot be modified once set. Cannot be set alongside
 	// `Approved`.
 	CertificateRequestConditionDenied CertificateRequestConditionType = "Denied"
+
+	// CertificateRequestConditionRevoked indicates whether the certificate
+	// issued for a CertificateRequest has been revoked by the issuer. It is set
+	// once the `cert-manager.io/revocation-reason` annotation has been added to
+	// an issued CertificateRequest. A status of `False` with the reason
+	// `Pending` means revocation will be retried.
+	CertificateRequestConditionRevoked CertificateRequestConditionType = "Revoked"
 )
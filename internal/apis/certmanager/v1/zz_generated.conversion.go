@@ -28,10 +28,11 @@ import (
 	acmev1 "github.com/cert-manager/cert-manager/internal/apis/acme/v1"
 	certmanager "github.com/cert-manager/cert-manager/internal/apis/certmanager"
 	meta "github.com/cert-manager/cert-manager/internal/apis/meta"
-	internalapismetav1 "github.com/cert-manager/cert-manager/internal/apis/meta/v1"
+	apismetav1 "github.com/cert-manager/cert-manager/internal/apis/meta/v1"
 	apisacmev1 "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
-	apismetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	pkgapismetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -64,6 +65,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CAIssuerCRL)(nil), (*certmanager.CAIssuerCRL)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CAIssuerCRL_To_certmanager_CAIssuerCRL(a.(*certmanagerv1.CAIssuerCRL), b.(*certmanager.CAIssuerCRL), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CAIssuerCRL)(nil), (*certmanagerv1.CAIssuerCRL)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CAIssuerCRL_To_v1_CAIssuerCRL(a.(*certmanager.CAIssuerCRL), b.(*certmanagerv1.CAIssuerCRL), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*certmanagerv1.Certificate)(nil), (*certmanager.Certificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Certificate_To_certmanager_Certificate(a.(*certmanagerv1.Certificate), b.(*certmanager.Certificate), scope)
 	}); err != nil {
@@ -104,6 +115,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateCSRSource)(nil), (*certmanager.CertificateCSRSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateCSRSource_To_certmanager_CertificateCSRSource(a.(*certmanagerv1.CertificateCSRSource), b.(*certmanager.CertificateCSRSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateCSRSource)(nil), (*certmanagerv1.CertificateCSRSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateCSRSource_To_v1_CertificateCSRSource(a.(*certmanager.CertificateCSRSource), b.(*certmanagerv1.CertificateCSRSource), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateCondition)(nil), (*certmanager.CertificateCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateCondition_To_certmanager_CertificateCondition(a.(*certmanagerv1.CertificateCondition), b.(*certmanager.CertificateCondition), scope)
 	}); err != nil {
@@ -114,6 +135,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateConfigMapTarget)(nil), (*certmanager.CertificateConfigMapTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateConfigMapTarget_To_certmanager_CertificateConfigMapTarget(a.(*certmanagerv1.CertificateConfigMapTarget), b.(*certmanager.CertificateConfigMapTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateConfigMapTarget)(nil), (*certmanagerv1.CertificateConfigMapTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateConfigMapTarget_To_v1_CertificateConfigMapTarget(a.(*certmanager.CertificateConfigMapTarget), b.(*certmanagerv1.CertificateConfigMapTarget), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateKeystores)(nil), (*certmanager.CertificateKeystores)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(a.(*certmanagerv1.CertificateKeystores), b.(*certmanager.CertificateKeystores), scope)
 	}); err != nil {
@@ -214,6 +245,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateRevocation)(nil), (*certmanager.CertificateRevocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRevocation_To_certmanager_CertificateRevocation(a.(*certmanagerv1.CertificateRevocation), b.(*certmanager.CertificateRevocation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRevocation)(nil), (*certmanagerv1.CertificateRevocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRevocation_To_v1_CertificateRevocation(a.(*certmanager.CertificateRevocation), b.(*certmanagerv1.CertificateRevocation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateSecretTemplate)(nil), (*certmanager.CertificateSecretTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(a.(*certmanagerv1.CertificateSecretTemplate), b.(*certmanager.CertificateSecretTemplate), scope)
 	}); err != nil {
@@ -244,6 +285,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateTruststore)(nil), (*certmanager.CertificateTruststore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateTruststore_To_certmanager_CertificateTruststore(a.(*certmanagerv1.CertificateTruststore), b.(*certmanager.CertificateTruststore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateTruststore)(nil), (*certmanagerv1.CertificateTruststore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateTruststore_To_v1_CertificateTruststore(a.(*certmanager.CertificateTruststore), b.(*certmanagerv1.CertificateTruststore), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*certmanagerv1.ClusterIssuer)(nil), (*certmanager.ClusterIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ClusterIssuer_To_certmanager_ClusterIssuer(a.(*certmanagerv1.ClusterIssuer), b.(*certmanager.ClusterIssuer), scope)
 	}); err != nil {
@@ -264,6 +315,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.ExternalSignerIssuer)(nil), (*certmanager.ExternalSignerIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ExternalSignerIssuer_To_certmanager_ExternalSignerIssuer(a.(*certmanagerv1.ExternalSignerIssuer), b.(*certmanager.ExternalSignerIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.ExternalSignerIssuer)(nil), (*certmanagerv1.ExternalSignerIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_ExternalSignerIssuer_To_v1_ExternalSignerIssuer(a.(*certmanager.ExternalSignerIssuer), b.(*certmanagerv1.ExternalSignerIssuer), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*certmanagerv1.Issuer)(nil), (*certmanager.Issuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Issuer_To_certmanager_Issuer(a.(*certmanagerv1.Issuer), b.(*certmanager.Issuer), scope)
 	}); err != nil {
@@ -534,6 +595,7 @@ func autoConvert_v1_CAIssuer_To_certmanager_CAIssuer(in *certmanagerv1.CAIssuer,
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
 	out.IssuingCertificateURLs = *(*[]string)(unsafe.Pointer(&in.IssuingCertificateURLs))
+	out.CRL = (*certmanager.CAIssuerCRL)(unsafe.Pointer(in.CRL))
 	return nil
 }
 
@@ -547,6 +609,7 @@ func autoConvert_certmanager_CAIssuer_To_v1_CAIssuer(in *certmanager.CAIssuer, o
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
 	out.IssuingCertificateURLs = *(*[]string)(unsafe.Pointer(&in.IssuingCertificateURLs))
+	out.CRL = (*certmanagerv1.CAIssuerCRL)(unsafe.Pointer(in.CRL))
 	return nil
 }
 
@@ -555,6 +618,28 @@ func Convert_certmanager_CAIssuer_To_v1_CAIssuer(in *certmanager.CAIssuer, out *
 	return autoConvert_certmanager_CAIssuer_To_v1_CAIssuer(in, out, s)
 }
 
+func autoConvert_v1_CAIssuerCRL_To_certmanager_CAIssuerCRL(in *certmanagerv1.CAIssuerCRL, out *certmanager.CAIssuerCRL, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	return nil
+}
+
+// Convert_v1_CAIssuerCRL_To_certmanager_CAIssuerCRL is an autogenerated conversion function.
+func Convert_v1_CAIssuerCRL_To_certmanager_CAIssuerCRL(in *certmanagerv1.CAIssuerCRL, out *certmanager.CAIssuerCRL, s conversion.Scope) error {
+	return autoConvert_v1_CAIssuerCRL_To_certmanager_CAIssuerCRL(in, out, s)
+}
+
+func autoConvert_certmanager_CAIssuerCRL_To_v1_CAIssuerCRL(in *certmanager.CAIssuerCRL, out *certmanagerv1.CAIssuerCRL, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	return nil
+}
+
+// Convert_certmanager_CAIssuerCRL_To_v1_CAIssuerCRL is an autogenerated conversion function.
+func Convert_certmanager_CAIssuerCRL_To_v1_CAIssuerCRL(in *certmanager.CAIssuerCRL, out *certmanagerv1.CAIssuerCRL, s conversion.Scope) error {
+	return autoConvert_certmanager_CAIssuerCRL_To_v1_CAIssuerCRL(in, out, s)
+}
+
 func autoConvert_v1_Certificate_To_certmanager_Certificate(in *certmanagerv1.Certificate, out *certmanager.Certificate, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_CertificateSpec_To_certmanager_CertificateSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -637,6 +722,15 @@ func Convert_certmanager_CertificateACMEStatus_To_v1_CertificateACMEStatus(in *c
 
 func autoConvert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in *certmanagerv1.CertificateAdditionalOutputFormat, out *certmanager.CertificateAdditionalOutputFormat, s conversion.Scope) error {
 	out.Type = certmanager.CertificateOutputFormatType(in.Type)
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PasswordSecretRef = nil
+	}
 	return nil
 }
 
@@ -647,6 +741,15 @@ func Convert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAddi
 
 func autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat(in *certmanager.CertificateAdditionalOutputFormat, out *certmanagerv1.CertificateAdditionalOutputFormat, s conversion.Scope) error {
 	out.Type = certmanagerv1.CertificateOutputFormatType(in.Type)
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(pkgapismetav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PasswordSecretRef = nil
+	}
 	return nil
 }
 
@@ -655,6 +758,44 @@ func Convert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAddi
 	return autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat(in, out, s)
 }
 
+func autoConvert_v1_CertificateCSRSource_To_certmanager_CertificateCSRSource(in *certmanagerv1.CertificateCSRSource, out *certmanager.CertificateCSRSource, s conversion.Scope) error {
+	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretRef = nil
+	}
+	return nil
+}
+
+// Convert_v1_CertificateCSRSource_To_certmanager_CertificateCSRSource is an autogenerated conversion function.
+func Convert_v1_CertificateCSRSource_To_certmanager_CertificateCSRSource(in *certmanagerv1.CertificateCSRSource, out *certmanager.CertificateCSRSource, s conversion.Scope) error {
+	return autoConvert_v1_CertificateCSRSource_To_certmanager_CertificateCSRSource(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateCSRSource_To_v1_CertificateCSRSource(in *certmanager.CertificateCSRSource, out *certmanagerv1.CertificateCSRSource, s conversion.Scope) error {
+	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(pkgapismetav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretRef = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_CertificateCSRSource_To_v1_CertificateCSRSource is an autogenerated conversion function.
+func Convert_certmanager_CertificateCSRSource_To_v1_CertificateCSRSource(in *certmanager.CertificateCSRSource, out *certmanagerv1.CertificateCSRSource, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateCSRSource_To_v1_CertificateCSRSource(in, out, s)
+}
+
 func autoConvert_v1_CertificateCondition_To_certmanager_CertificateCondition(in *certmanagerv1.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
 	out.Type = certmanager.CertificateConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
@@ -672,7 +813,7 @@ func Convert_v1_CertificateCondition_To_certmanager_CertificateCondition(in *cer
 
 func autoConvert_certmanager_CertificateCondition_To_v1_CertificateCondition(in *certmanager.CertificateCondition, out *certmanagerv1.CertificateCondition, s conversion.Scope) error {
 	out.Type = certmanagerv1.CertificateConditionType(in.Type)
-	out.Status = apismetav1.ConditionStatus(in.Status)
+	out.Status = pkgapismetav1.ConditionStatus(in.Status)
 	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
 	out.Reason = in.Reason
 	out.Message = in.Message
@@ -685,6 +826,28 @@ func Convert_certmanager_CertificateCondition_To_v1_CertificateCondition(in *cer
 	return autoConvert_certmanager_CertificateCondition_To_v1_CertificateCondition(in, out, s)
 }
 
+func autoConvert_v1_CertificateConfigMapTarget_To_certmanager_CertificateConfigMapTarget(in *certmanagerv1.CertificateConfigMapTarget, out *certmanager.CertificateConfigMapTarget, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_v1_CertificateConfigMapTarget_To_certmanager_CertificateConfigMapTarget is an autogenerated conversion function.
+func Convert_v1_CertificateConfigMapTarget_To_certmanager_CertificateConfigMapTarget(in *certmanagerv1.CertificateConfigMapTarget, out *certmanager.CertificateConfigMapTarget, s conversion.Scope) error {
+	return autoConvert_v1_CertificateConfigMapTarget_To_certmanager_CertificateConfigMapTarget(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateConfigMapTarget_To_v1_CertificateConfigMapTarget(in *certmanager.CertificateConfigMapTarget, out *certmanagerv1.CertificateConfigMapTarget, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_certmanager_CertificateConfigMapTarget_To_v1_CertificateConfigMapTarget is an autogenerated conversion function.
+func Convert_certmanager_CertificateConfigMapTarget_To_v1_CertificateConfigMapTarget(in *certmanager.CertificateConfigMapTarget, out *certmanagerv1.CertificateConfigMapTarget, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateConfigMapTarget_To_v1_CertificateConfigMapTarget(in, out, s)
+}
+
 func autoConvert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(in *certmanagerv1.CertificateKeystores, out *certmanager.CertificateKeystores, s conversion.Scope) error {
 	if in.JKS != nil {
 		in, out := &in.JKS, &out.JKS
@@ -704,6 +867,7 @@ func autoConvert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(in
 	} else {
 		out.PKCS12 = nil
 	}
+	out.Truststore = (*certmanager.CertificateTruststore)(unsafe.Pointer(in.Truststore))
 	return nil
 }
 
@@ -731,6 +895,7 @@ func autoConvert_certmanager_CertificateKeystores_To_v1_CertificateKeystores(in
 	} else {
 		out.PKCS12 = nil
 	}
+	out.Truststore = (*certmanagerv1.CertificateTruststore)(unsafe.Pointer(in.Truststore))
 	return nil
 }
 
@@ -786,6 +951,7 @@ func autoConvert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(i
 	out.Encoding = certmanager.PrivateKeyEncoding(in.Encoding)
 	out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
 	out.Size = in.Size
+	out.Provider = in.Provider
 	return nil
 }
 
@@ -799,6 +965,7 @@ func autoConvert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(i
 	out.Encoding = certmanagerv1.PrivateKeyEncoding(in.Encoding)
 	out.Algorithm = certmanagerv1.PrivateKeyAlgorithm(in.Algorithm)
 	out.Size = in.Size
+	out.Provider = in.Provider
 	return nil
 }
 
@@ -901,7 +1068,7 @@ func Convert_v1_CertificateRequestCondition_To_certmanager_CertificateRequestCon
 
 func autoConvert_certmanager_CertificateRequestCondition_To_v1_CertificateRequestCondition(in *certmanager.CertificateRequestCondition, out *certmanagerv1.CertificateRequestCondition, s conversion.Scope) error {
 	out.Type = certmanagerv1.CertificateRequestConditionType(in.Type)
-	out.Status = apismetav1.ConditionStatus(in.Status)
+	out.Status = pkgapismetav1.ConditionStatus(in.Status)
 	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
 	out.Reason = in.Reason
 	out.Message = in.Message
@@ -957,7 +1124,7 @@ func Convert_certmanager_CertificateRequestList_To_v1_CertificateRequestList(in
 
 func autoConvert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(in *certmanagerv1.CertificateRequestSpec, out *certmanager.CertificateRequestSpec, s conversion.Scope) error {
 	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
-	if err := internalapismetav1.Convert_v1_IssuerReference_To_meta_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	if err := apismetav1.Convert_v1_IssuerReference_To_meta_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
 	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
@@ -977,7 +1144,7 @@ func Convert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(in
 
 func autoConvert_certmanager_CertificateRequestSpec_To_v1_CertificateRequestSpec(in *certmanager.CertificateRequestSpec, out *certmanagerv1.CertificateRequestSpec, s conversion.Scope) error {
 	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
-	if err := internalapismetav1.Convert_meta_IssuerReference_To_v1_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	if err := apismetav1.Convert_meta_IssuerReference_To_v1_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
 	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
@@ -1021,6 +1188,36 @@ func Convert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestStatus
 	return autoConvert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestStatus(in, out, s)
 }
 
+func autoConvert_v1_CertificateRevocation_To_certmanager_CertificateRevocation(in *certmanagerv1.CertificateRevocation, out *certmanager.CertificateRevocation, s conversion.Scope) error {
+	out.SerialNumber = in.SerialNumber
+	out.CertificateRequestName = in.CertificateRequestName
+	out.Reason = in.Reason
+	out.Status = meta.ConditionStatus(in.Status)
+	out.Message = in.Message
+	out.RevocationTime = (*metav1.Time)(unsafe.Pointer(in.RevocationTime))
+	return nil
+}
+
+// Convert_v1_CertificateRevocation_To_certmanager_CertificateRevocation is an autogenerated conversion function.
+func Convert_v1_CertificateRevocation_To_certmanager_CertificateRevocation(in *certmanagerv1.CertificateRevocation, out *certmanager.CertificateRevocation, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRevocation_To_certmanager_CertificateRevocation(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRevocation_To_v1_CertificateRevocation(in *certmanager.CertificateRevocation, out *certmanagerv1.CertificateRevocation, s conversion.Scope) error {
+	out.SerialNumber = in.SerialNumber
+	out.CertificateRequestName = in.CertificateRequestName
+	out.Reason = in.Reason
+	out.Status = pkgapismetav1.ConditionStatus(in.Status)
+	out.Message = in.Message
+	out.RevocationTime = (*metav1.Time)(unsafe.Pointer(in.RevocationTime))
+	return nil
+}
+
+// Convert_certmanager_CertificateRevocation_To_v1_CertificateRevocation is an autogenerated conversion function.
+func Convert_certmanager_CertificateRevocation_To_v1_CertificateRevocation(in *certmanager.CertificateRevocation, out *certmanagerv1.CertificateRevocation, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRevocation_To_v1_CertificateRevocation(in, out, s)
+}
+
 func autoConvert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in *certmanagerv1.CertificateSecretTemplate, out *certmanager.CertificateSecretTemplate, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
@@ -1067,16 +1264,36 @@ func autoConvert_v1_CertificateSpec_To_certmanager_CertificateSpec(in *certmanag
 	} else {
 		out.Keystores = nil
 	}
-	if err := internalapismetav1.Convert_v1_IssuerReference_To_meta_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	if err := apismetav1.Convert_v1_IssuerReference_To_meta_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
 	out.PrivateKey = (*certmanager.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
+	if in.CSR != nil {
+		in, out := &in.CSR, &out.CSR
+		*out = new(certmanager.CertificateCSRSource)
+		if err := Convert_v1_CertificateCSRSource_To_certmanager_CertificateCSRSource(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CSR = nil
+	}
 	out.SignatureAlgorithm = certmanager.SignatureAlgorithm(in.SignatureAlgorithm)
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
-	out.AdditionalOutputFormats = *(*[]certmanager.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	if in.AdditionalOutputFormats != nil {
+		in, out := &in.AdditionalOutputFormats, &out.AdditionalOutputFormats
+		*out = make([]certmanager.CertificateAdditionalOutputFormat, len(*in))
+		for i := range *in {
+			if err := Convert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalOutputFormats = nil
+	}
+	out.ConfigMapTargets = *(*[]certmanager.CertificateConfigMapTarget)(unsafe.Pointer(&in.ConfigMapTargets))
 	out.NameConstraints = (*certmanager.NameConstraints)(unsafe.Pointer(in.NameConstraints))
 	return nil
 }
@@ -1110,16 +1327,36 @@ func autoConvert_certmanager_CertificateSpec_To_v1_CertificateSpec(in *certmanag
 	} else {
 		out.Keystores = nil
 	}
-	if err := internalapismetav1.Convert_meta_IssuerReference_To_v1_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+	if err := apismetav1.Convert_meta_IssuerReference_To_v1_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]certmanagerv1.KeyUsage)(unsafe.Pointer(&in.Usages))
 	out.PrivateKey = (*certmanagerv1.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
+	if in.CSR != nil {
+		in, out := &in.CSR, &out.CSR
+		*out = new(certmanagerv1.CertificateCSRSource)
+		if err := Convert_certmanager_CertificateCSRSource_To_v1_CertificateCSRSource(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CSR = nil
+	}
 	out.SignatureAlgorithm = certmanagerv1.SignatureAlgorithm(in.SignatureAlgorithm)
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
-	out.AdditionalOutputFormats = *(*[]certmanagerv1.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	if in.AdditionalOutputFormats != nil {
+		in, out := &in.AdditionalOutputFormats, &out.AdditionalOutputFormats
+		*out = make([]certmanagerv1.CertificateAdditionalOutputFormat, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalOutputFormats = nil
+	}
+	out.ConfigMapTargets = *(*[]certmanagerv1.CertificateConfigMapTarget)(unsafe.Pointer(&in.ConfigMapTargets))
 	out.NameConstraints = (*certmanagerv1.NameConstraints)(unsafe.Pointer(in.NameConstraints))
 	return nil
 }
@@ -1139,6 +1376,7 @@ func autoConvert_v1_CertificateStatus_To_certmanager_CertificateStatus(in *certm
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
 	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
 	out.ACME = (*certmanager.CertificateACMEStatus)(unsafe.Pointer(in.ACME))
+	out.Revocations = *(*[]certmanager.CertificateRevocation)(unsafe.Pointer(&in.Revocations))
 	return nil
 }
 
@@ -1157,6 +1395,7 @@ func autoConvert_certmanager_CertificateStatus_To_v1_CertificateStatus(in *certm
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
 	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
 	out.ACME = (*certmanagerv1.CertificateACMEStatus)(unsafe.Pointer(in.ACME))
+	out.Revocations = *(*[]certmanagerv1.CertificateRevocation)(unsafe.Pointer(&in.Revocations))
 	return nil
 }
 
@@ -1165,6 +1404,28 @@ func Convert_certmanager_CertificateStatus_To_v1_CertificateStatus(in *certmanag
 	return autoConvert_certmanager_CertificateStatus_To_v1_CertificateStatus(in, out, s)
 }
 
+func autoConvert_v1_CertificateTruststore_To_certmanager_CertificateTruststore(in *certmanagerv1.CertificateTruststore, out *certmanager.CertificateTruststore, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	return nil
+}
+
+// Convert_v1_CertificateTruststore_To_certmanager_CertificateTruststore is an autogenerated conversion function.
+func Convert_v1_CertificateTruststore_To_certmanager_CertificateTruststore(in *certmanagerv1.CertificateTruststore, out *certmanager.CertificateTruststore, s conversion.Scope) error {
+	return autoConvert_v1_CertificateTruststore_To_certmanager_CertificateTruststore(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateTruststore_To_v1_CertificateTruststore(in *certmanager.CertificateTruststore, out *certmanagerv1.CertificateTruststore, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	return nil
+}
+
+// Convert_certmanager_CertificateTruststore_To_v1_CertificateTruststore is an autogenerated conversion function.
+func Convert_certmanager_CertificateTruststore_To_v1_CertificateTruststore(in *certmanager.CertificateTruststore, out *certmanagerv1.CertificateTruststore, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateTruststore_To_v1_CertificateTruststore(in, out, s)
+}
+
 func autoConvert_v1_ClusterIssuer_To_certmanager_ClusterIssuer(in *certmanagerv1.ClusterIssuer, out *certmanager.ClusterIssuer, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_IssuerSpec_To_certmanager_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1239,6 +1500,28 @@ func Convert_certmanager_ClusterIssuerList_To_v1_ClusterIssuerList(in *certmanag
 	return autoConvert_certmanager_ClusterIssuerList_To_v1_ClusterIssuerList(in, out, s)
 }
 
+func autoConvert_v1_ExternalSignerIssuer_To_certmanager_ExternalSignerIssuer(in *certmanagerv1.ExternalSignerIssuer, out *certmanager.ExternalSignerIssuer, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Config = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Config))
+	return nil
+}
+
+// Convert_v1_ExternalSignerIssuer_To_certmanager_ExternalSignerIssuer is an autogenerated conversion function.
+func Convert_v1_ExternalSignerIssuer_To_certmanager_ExternalSignerIssuer(in *certmanagerv1.ExternalSignerIssuer, out *certmanager.ExternalSignerIssuer, s conversion.Scope) error {
+	return autoConvert_v1_ExternalSignerIssuer_To_certmanager_ExternalSignerIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_ExternalSignerIssuer_To_v1_ExternalSignerIssuer(in *certmanager.ExternalSignerIssuer, out *certmanagerv1.ExternalSignerIssuer, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Config = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Config))
+	return nil
+}
+
+// Convert_certmanager_ExternalSignerIssuer_To_v1_ExternalSignerIssuer is an autogenerated conversion function.
+func Convert_certmanager_ExternalSignerIssuer_To_v1_ExternalSignerIssuer(in *certmanager.ExternalSignerIssuer, out *certmanagerv1.ExternalSignerIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_ExternalSignerIssuer_To_v1_ExternalSignerIssuer(in, out, s)
+}
+
 func autoConvert_v1_Issuer_To_certmanager_Issuer(in *certmanagerv1.Issuer, out *certmanager.Issuer, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_IssuerSpec_To_certmanager_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1288,7 +1571,7 @@ func Convert_v1_IssuerCondition_To_certmanager_IssuerCondition(in *certmanagerv1
 
 func autoConvert_certmanager_IssuerCondition_To_v1_IssuerCondition(in *certmanager.IssuerCondition, out *certmanagerv1.IssuerCondition, s conversion.Scope) error {
 	out.Type = certmanagerv1.IssuerConditionType(in.Type)
-	out.Status = apismetav1.ConditionStatus(in.Status)
+	out.Status = pkgapismetav1.ConditionStatus(in.Status)
 	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
 	out.Reason = in.Reason
 	out.Message = in.Message
@@ -1331,6 +1614,7 @@ func autoConvert_v1_IssuerConfig_To_certmanager_IssuerConfig(in *certmanagerv1.I
 	} else {
 		out.Venafi = nil
 	}
+	out.ExternalSigner = (*certmanager.ExternalSignerIssuer)(unsafe.Pointer(in.ExternalSigner))
 	return nil
 }
 
@@ -1369,6 +1653,7 @@ func autoConvert_certmanager_IssuerConfig_To_v1_IssuerConfig(in *certmanager.Iss
 	} else {
 		out.Venafi = nil
 	}
+	out.ExternalSigner = (*certmanagerv1.ExternalSignerIssuer)(unsafe.Pointer(in.ExternalSigner))
 	return nil
 }
 
@@ -1468,7 +1753,7 @@ func Convert_certmanager_IssuerStatus_To_v1_IssuerStatus(in *certmanager.IssuerS
 func autoConvert_v1_JKSKeystore_To_certmanager_JKSKeystore(in *certmanagerv1.JKSKeystore, out *certmanager.JKSKeystore, s conversion.Scope) error {
 	out.Create = in.Create
 	out.Alias = (*string)(unsafe.Pointer(in.Alias))
-	if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	out.Password = (*string)(unsafe.Pointer(in.Password))
@@ -1483,7 +1768,7 @@ func Convert_v1_JKSKeystore_To_certmanager_JKSKeystore(in *certmanagerv1.JKSKeys
 func autoConvert_certmanager_JKSKeystore_To_v1_JKSKeystore(in *certmanager.JKSKeystore, out *certmanagerv1.JKSKeystore, s conversion.Scope) error {
 	out.Create = in.Create
 	out.Alias = (*string)(unsafe.Pointer(in.Alias))
-	if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	out.Password = (*string)(unsafe.Pointer(in.Password))
@@ -1570,7 +1855,7 @@ func Convert_certmanager_OtherName_To_v1_OtherName(in *certmanager.OtherName, ou
 func autoConvert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in *certmanagerv1.PKCS12Keystore, out *certmanager.PKCS12Keystore, s conversion.Scope) error {
 	out.Create = in.Create
 	out.Profile = certmanager.PKCS12Profile(in.Profile)
-	if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	out.Password = (*string)(unsafe.Pointer(in.Password))
@@ -1585,7 +1870,7 @@ func Convert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in *certmanagerv1.P
 func autoConvert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(in *certmanager.PKCS12Keystore, out *certmanagerv1.PKCS12Keystore, s conversion.Scope) error {
 	out.Create = in.Create
 	out.Profile = certmanagerv1.PKCS12Profile(in.Profile)
-	if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	out.Password = (*string)(unsafe.Pointer(in.Password))
@@ -1672,7 +1957,7 @@ func Convert_certmanager_VaultAWSAuth_To_v1_VaultAWSAuth(in *certmanager.VaultAW
 func autoConvert_v1_VaultAppRole_To_certmanager_VaultAppRole(in *certmanagerv1.VaultAppRole, out *certmanager.VaultAppRole, s conversion.Scope) error {
 	out.Path = in.Path
 	out.RoleId = in.RoleId
-	if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1686,7 +1971,7 @@ func Convert_v1_VaultAppRole_To_certmanager_VaultAppRole(in *certmanagerv1.Vault
 func autoConvert_certmanager_VaultAppRole_To_v1_VaultAppRole(in *certmanager.VaultAppRole, out *certmanagerv1.VaultAppRole, s conversion.Scope) error {
 	out.Path = in.Path
 	out.RoleId = in.RoleId
-	if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1701,7 +1986,7 @@ func autoConvert_v1_VaultAuth_To_certmanager_VaultAuth(in *certmanagerv1.VaultAu
 	if in.TokenSecretRef != nil {
 		in, out := &in.TokenSecretRef, &out.TokenSecretRef
 		*out = new(meta.SecretKeySelector)
-		if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1738,8 +2023,8 @@ func Convert_v1_VaultAuth_To_certmanager_VaultAuth(in *certmanagerv1.VaultAuth,
 func autoConvert_certmanager_VaultAuth_To_v1_VaultAuth(in *certmanager.VaultAuth, out *certmanagerv1.VaultAuth, s conversion.Scope) error {
 	if in.TokenSecretRef != nil {
 		in, out := &in.TokenSecretRef, &out.TokenSecretRef
-		*out = new(apismetav1.SecretKeySelector)
-		if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+		*out = new(pkgapismetav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1809,7 +2094,7 @@ func autoConvert_v1_VaultIssuer_To_certmanager_VaultIssuer(in *certmanagerv1.Vau
 	if in.CABundleSecretRef != nil {
 		in, out := &in.CABundleSecretRef, &out.CABundleSecretRef
 		*out = new(meta.SecretKeySelector)
-		if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1818,7 +2103,7 @@ func autoConvert_v1_VaultIssuer_To_certmanager_VaultIssuer(in *certmanagerv1.Vau
 	if in.ClientCertSecretRef != nil {
 		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
 		*out = new(meta.SecretKeySelector)
-		if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1827,7 +2112,7 @@ func autoConvert_v1_VaultIssuer_To_certmanager_VaultIssuer(in *certmanagerv1.Vau
 	if in.ClientKeySecretRef != nil {
 		in, out := &in.ClientKeySecretRef, &out.ClientKeySecretRef
 		*out = new(meta.SecretKeySelector)
-		if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1852,8 +2137,8 @@ func autoConvert_certmanager_VaultIssuer_To_v1_VaultIssuer(in *certmanager.Vault
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
 	if in.CABundleSecretRef != nil {
 		in, out := &in.CABundleSecretRef, &out.CABundleSecretRef
-		*out = new(apismetav1.SecretKeySelector)
-		if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+		*out = new(pkgapismetav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1861,8 +2146,8 @@ func autoConvert_certmanager_VaultIssuer_To_v1_VaultIssuer(in *certmanager.Vault
 	}
 	if in.ClientCertSecretRef != nil {
 		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
-		*out = new(apismetav1.SecretKeySelector)
-		if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+		*out = new(pkgapismetav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1870,8 +2155,8 @@ func autoConvert_certmanager_VaultIssuer_To_v1_VaultIssuer(in *certmanager.Vault
 	}
 	if in.ClientKeySecretRef != nil {
 		in, out := &in.ClientKeySecretRef, &out.ClientKeySecretRef
-		*out = new(apismetav1.SecretKeySelector)
-		if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+		*out = new(pkgapismetav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1887,7 +2172,7 @@ func Convert_certmanager_VaultIssuer_To_v1_VaultIssuer(in *certmanager.VaultIssu
 
 func autoConvert_v1_VaultKubernetesAuth_To_certmanager_VaultKubernetesAuth(in *certmanagerv1.VaultKubernetesAuth, out *certmanager.VaultKubernetesAuth, s conversion.Scope) error {
 	out.Path = in.Path
-	if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
 		return err
 	}
 	out.ServiceAccountRef = (*certmanager.ServiceAccountRef)(unsafe.Pointer(in.ServiceAccountRef))
@@ -1902,7 +2187,7 @@ func Convert_v1_VaultKubernetesAuth_To_certmanager_VaultKubernetesAuth(in *certm
 
 func autoConvert_certmanager_VaultKubernetesAuth_To_v1_VaultKubernetesAuth(in *certmanager.VaultKubernetesAuth, out *certmanagerv1.VaultKubernetesAuth, s conversion.Scope) error {
 	out.Path = in.Path
-	if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
+	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretRef, &out.SecretRef, s); err != nil {
 		return err
 	}
 	out.ServiceAccountRef = (*certmanagerv1.ServiceAccountRef)(unsafe.Pointer(in.ServiceAccountRef))
@@ -1917,7 +2202,7 @@ func Convert_certmanager_VaultKubernetesAuth_To_v1_VaultKubernetesAuth(in *certm
 
 func autoConvert_v1_VenafiCloud_To_certmanager_VenafiCloud(in *certmanagerv1.VenafiCloud, out *certmanager.VenafiCloud, s conversion.Scope) error {
 	out.URL = in.URL
-	if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APITokenSecretRef, &out.APITokenSecretRef, s); err != nil {
+	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APITokenSecretRef, &out.APITokenSecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -1930,7 +2215,7 @@ func Convert_v1_VenafiCloud_To_certmanager_VenafiCloud(in *certmanagerv1.VenafiC
 
 func autoConvert_certmanager_VenafiCloud_To_v1_VenafiCloud(in *certmanager.VenafiCloud, out *certmanagerv1.VenafiCloud, s conversion.Scope) error {
 	out.URL = in.URL
-	if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APITokenSecretRef, &out.APITokenSecretRef, s); err != nil {
+	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APITokenSecretRef, &out.APITokenSecretRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -2019,7 +2304,7 @@ func autoConvert_v1_VenafiNGTS_To_certmanager_VenafiNGTS(in *certmanagerv1.Venaf
 	out.URL = in.URL
 	out.TokenEndpoint = in.TokenEndpoint
 	out.TSGID = in.TSGID
-	if err := internalapismetav1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
+	if err := apismetav1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -2034,7 +2319,7 @@ func autoConvert_certmanager_VenafiNGTS_To_v1_VenafiNGTS(in *certmanager.VenafiN
 	out.URL = in.URL
 	out.TokenEndpoint = in.TokenEndpoint
 	out.TSGID = in.TSGID
-	if err := internalapismetav1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
+	if err := apismetav1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
 		return err
 	}
 	return nil
@@ -2047,14 +2332,14 @@ func Convert_certmanager_VenafiNGTS_To_v1_VenafiNGTS(in *certmanager.VenafiNGTS,
 
 func autoConvert_v1_VenafiTPP_To_certmanager_VenafiTPP(in *certmanagerv1.VenafiTPP, out *certmanager.VenafiTPP, s conversion.Scope) error {
 	out.URL = in.URL
-	if err := internalapismetav1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
+	if err := apismetav1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
 		return err
 	}
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
 	if in.CABundleSecretRef != nil {
 		in, out := &in.CABundleSecretRef, &out.CABundleSecretRef
 		*out = new(meta.SecretKeySelector)
-		if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -2070,14 +2355,14 @@ func Convert_v1_VenafiTPP_To_certmanager_VenafiTPP(in *certmanagerv1.VenafiTPP,
 
 func autoConvert_certmanager_VenafiTPP_To_v1_VenafiTPP(in *certmanager.VenafiTPP, out *certmanagerv1.VenafiTPP, s conversion.Scope) error {
 	out.URL = in.URL
-	if err := internalapismetav1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
+	if err := apismetav1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(&in.CredentialsRef, &out.CredentialsRef, s); err != nil {
 		return err
 	}
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
 	if in.CABundleSecretRef != nil {
 		in, out := &in.CABundleSecretRef, &out.CABundleSecretRef
-		*out = new(apismetav1.SecretKeySelector)
-		if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+		*out = new(pkgapismetav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -57,6 +57,21 @@ var keyAlgToAllowedSigAlgs = map[internalcmapi.PrivateKeyAlgorithm][]internalcma
 	internalcmapi.Ed25519KeyAlgorithm: {
 		internalcmapi.PureEd25519,
 	},
+	internalcmapi.Ed448KeyAlgorithm: {
+		internalcmapi.PureEd448,
+	},
+	internalcmapi.MLDSAKeyAlgorithm: {
+		internalcmapi.MLDSA44,
+		internalcmapi.MLDSA65,
+		internalcmapi.MLDSA87,
+	},
+}
+
+// mapping for ML-DSA key size to the only signature algorithm usable with it
+var mldsaKeySizeToSigAlg = map[int]internalcmapi.SignatureAlgorithm{
+	pki.MLDSA44: internalcmapi.MLDSA44,
+	pki.MLDSA65: internalcmapi.MLDSA65,
+	pki.MLDSA87: internalcmapi.MLDSA87,
 }
 
 // Validation functions for cert-manager Certificate types
@@ -122,7 +137,8 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 		}
 	}
 
-	if len(commonName) == 0 &&
+	if crt.CSR == nil &&
+		len(commonName) == 0 &&
 		len(crt.DNSNames) == 0 &&
 		len(crt.URIs) == 0 &&
 		len(crt.EmailAddresses) == 0 &&
@@ -174,10 +190,14 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 			if crt.PrivateKey.Size > 0 && crt.PrivateKey.Size != 256 && crt.PrivateKey.Size != 384 && crt.PrivateKey.Size != 521 {
 				el = append(el, field.NotSupported(fldPath.Child("privateKey", "size"), crt.PrivateKey.Size, []string{"256", "384", "521"}))
 			}
-		case internalcmapi.Ed25519KeyAlgorithm:
+		case internalcmapi.Ed25519KeyAlgorithm, internalcmapi.Ed448KeyAlgorithm:
 			break
+		case internalcmapi.MLDSAKeyAlgorithm:
+			if crt.PrivateKey.Size > 0 && crt.PrivateKey.Size != pki.MLDSA44 && crt.PrivateKey.Size != pki.MLDSA65 && crt.PrivateKey.Size != pki.MLDSA87 {
+				el = append(el, field.NotSupported(fldPath.Child("privateKey", "size"), crt.PrivateKey.Size, []string{"44", "65", "87"}))
+			}
 		default:
-			el = append(el, field.Invalid(fldPath.Child("privateKey", "algorithm"), crt.PrivateKey.Algorithm, "must be either empty or one of rsa, ecdsa or ed25519"))
+			el = append(el, field.Invalid(fldPath.Child("privateKey", "algorithm"), crt.PrivateKey.Algorithm, "must be either empty or one of rsa, ecdsa, ed25519, ed448 or mldsa"))
 		}
 	}
 
@@ -190,6 +210,16 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 		if ok && !slices.Contains(allowed, crt.SignatureAlgorithm) {
 			el = append(el, field.Invalid(fldPath.Child("signatureAlgorithm"), crt.SignatureAlgorithm,
 				fmt.Sprintf("for key algorithm %s the allowed signature algorithms are %v", actualKeyAlg, allowed)))
+		} else if actualKeyAlg == internalcmapi.MLDSAKeyAlgorithm {
+			// ML-DSA signatures are bound to the key's parameter set
+			keySize := pki.MLDSA44
+			if crt.PrivateKey.Size > 0 {
+				keySize = crt.PrivateKey.Size
+			}
+			if expected, ok := mldsaKeySizeToSigAlg[keySize]; ok && expected != crt.SignatureAlgorithm {
+				el = append(el, field.Invalid(fldPath.Child("signatureAlgorithm"), crt.SignatureAlgorithm,
+					fmt.Sprintf("for key algorithm %s with size %d the signature algorithm must be %s", actualKeyAlg, keySize, expected)))
+			}
 		}
 	}
 
@@ -217,11 +247,20 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 	}
 
 	el = append(el, validateAdditionalOutputFormats(crt, fldPath)...)
+	el = append(el, validateConfigMapTargets(crt, fldPath)...)
 
 	if crt.Keystores != nil {
 		el = append(el, validateKeystores(crt, fldPath)...)
 	}
 
+	if crt.PrivateKey != nil && crt.PrivateKey.Provider != "" {
+		el = append(el, validatePrivateKeyProvider(crt, fldPath)...)
+	}
+
+	if crt.CSR != nil {
+		el = append(el, validateCSR(crt, fldPath)...)
+	}
+
 	if crt.Renewal != nil {
 		el = append(el, validateCertificateRenewal(crt, fldPath)...)
 	}
@@ -232,15 +271,35 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 func ValidateCertificate(a *admissionv1.AdmissionRequest, obj runtime.Object) (allErrs field.ErrorList, warnings []string) {
 	crt := obj.(*internalcmapi.Certificate)
 	allErrs = ValidateCertificateSpec(&crt.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateCertificateRevocationReason(crt, field.NewPath("metadata", "annotations"))...)
 	return allErrs, warnings
 }
 
 func ValidateUpdateCertificate(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
 	crt := obj.(*internalcmapi.Certificate)
 	allErrs := ValidateCertificateSpec(&crt.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateCertificateRevocationReason(crt, field.NewPath("metadata", "annotations"))...)
 	return allErrs, nil
 }
 
+// validateCertificateRevocationReason ensures that the revocation reason
+// annotation, used to revoke the current certificate of a Certificate, is a
+// known RFC 5280 CRLReason.
+func validateCertificateRevocationReason(crt *internalcmapi.Certificate, fldPath *field.Path) field.ErrorList {
+	reason, ok := crt.Annotations[internalcmapi.CertificateRequestRevocationReasonAnnotationKey]
+	if !ok {
+		return nil
+	}
+
+	if _, err := pki.ParseRevocationReason(reason); err != nil {
+		return field.ErrorList{
+			field.Invalid(fldPath.Child(internalcmapi.CertificateRequestRevocationReasonAnnotationKey), reason, err.Error()),
+		}
+	}
+
+	return nil
+}
+
 func validateIssuerRef(issuerRef cmmeta.IssuerReference, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
@@ -434,7 +493,23 @@ func validateAdditionalOutputFormats(crt *internalcmapi.CertificateSpec, fldPath
 
 	// Ensure the set of output formats is unique, keyed on "Type".
 	aofSet := sets.NewString()
-	for _, val := range crt.AdditionalOutputFormats {
+	for i, val := range crt.AdditionalOutputFormats {
+		// Only the EncryptedPKCS8 output format takes a password.
+		passwordPath := fldPath.Child("additionalOutputFormats").Index(i).Child("passwordSecretRef")
+		switch {
+		case val.Type == internalcmapi.CertificateOutputFormatEncryptedPKCS8 && val.PasswordSecretRef == nil:
+			el = append(el, field.Required(passwordPath, "must be specified for the EncryptedPKCS8 output format"))
+		case val.Type == internalcmapi.CertificateOutputFormatEncryptedPKCS8:
+			if val.PasswordSecretRef.Name == "" {
+				el = append(el, field.Required(passwordPath.Child("name"), "must be specified"))
+			}
+			if val.PasswordSecretRef.Key == "" {
+				el = append(el, field.Required(passwordPath.Child("key"), "must be specified"))
+			}
+		case val.PasswordSecretRef != nil:
+			el = append(el, field.Forbidden(passwordPath, "may only be specified for the EncryptedPKCS8 output format"))
+		}
+
 		if aofSet.Has(string(val.Type)) {
 			el = append(el, field.Duplicate(fldPath.Child("additionalOutputFormats").Key("type"), string(val.Type)))
 			continue
@@ -445,6 +520,39 @@ func validateAdditionalOutputFormats(crt *internalcmapi.CertificateSpec, fldPath
 	return el
 }
 
+// validateConfigMapTargets ensures that each ConfigMap target has a valid name
+// and namespace, and that no ConfigMap is targeted more than once.
+func validateConfigMapTargets(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	targetSet := sets.New[internalcmapi.CertificateConfigMapTarget]()
+	for i, target := range crt.ConfigMapTargets {
+		targetPath := fldPath.Child("configMapTargets").Index(i)
+
+		if target.Name == "" {
+			el = append(el, field.Required(targetPath.Child("name"), "must be specified"))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSSubdomain(target.Name, false) {
+				el = append(el, field.Invalid(targetPath.Child("name"), target.Name, msg))
+			}
+		}
+
+		if target.Namespace != "" {
+			for _, msg := range apivalidation.ValidateNamespaceName(target.Namespace, false) {
+				el = append(el, field.Invalid(targetPath.Child("namespace"), target.Namespace, msg))
+			}
+		}
+
+		if targetSet.Has(target) {
+			el = append(el, field.Duplicate(targetPath, target))
+			continue
+		}
+		targetSet.Insert(target)
+	}
+
+	return el
+}
+
 const (
 	keystoresMutuallyExclusivePasswordsFmt = "exactly one of passwordSecretRef and password must be provided for %s keystores; cannot set both"
 
@@ -453,9 +561,113 @@ const (
 	keystoresLiteralPasswordMustNotBeEmptyFmt = "literal password cannot be empty if set on %s keystores"
 )
 
+// validatePrivateKeyProvider ensures that no fields which require the private
+// key itself are set when the private key is held by a key provider, since the
+// Secret will only contain a handle referencing the key.
+func validatePrivateKeyProvider(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	if createsKeystores(crt) {
+		el = append(el, field.Forbidden(fldPath.Child("keystores"), "keystores cannot be used when the private key is held by a key provider"))
+	}
+
+	if len(crt.AdditionalOutputFormats) > 0 {
+		el = append(el, field.Forbidden(fldPath.Child("additionalOutputFormats"), "additional output formats cannot be used when the private key is held by a key provider"))
+	}
+
+	return el
+}
+
+// validateCSR validates a user supplied CSR. Fields which are taken from the
+// CSR, or which require cert-manager to hold the private key, cannot be set
+// alongside it.
+func validateCSR(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	csrPath := fldPath.Child("csr")
+	switch {
+	case len(crt.CSR.Request) > 0 && crt.CSR.SecretRef != nil:
+		el = append(el, field.Forbidden(csrPath, "only one of request or secretRef may be specified"))
+	case len(crt.CSR.Request) > 0:
+		usages := make([]cmapi.KeyUsage, 0, len(crt.Usages))
+		for _, usage := range crt.Usages {
+			usages = append(usages, cmapi.KeyUsage(usage))
+		}
+		keyUsage, extKeyUsage, err := pki.KeyUsagesForCertificateOrCertificateRequest(usages, crt.IsCA)
+		if err != nil {
+			// Invalid usages are reported by validateUsages.
+			break
+		}
+		if _, err := pki.CertificateTemplateFromCSRPEM(
+			crt.CSR.Request,
+			pki.CertificateTemplateValidateAndOverrideBasicConstraints(crt.IsCA, nil),
+			pki.CertificateTemplateValidateAndOverrideKeyUsages(keyUsage, extKeyUsage),
+		); err != nil {
+			// truncate the request to avoid creating a ridiculously long error message with the whole CSR in it
+			el = append(el, field.Invalid(csrPath.Child("request"), truncateString(string(crt.CSR.Request)), err.Error()))
+		}
+	case crt.CSR.SecretRef != nil:
+		if crt.CSR.SecretRef.Name == "" {
+			el = append(el, field.Required(csrPath.Child("secretRef", "name"), "must be specified"))
+		}
+	default:
+		el = append(el, field.Required(csrPath, "one of request or secretRef must be specified"))
+	}
+
+	if crt.CommonName != "" {
+		el = append(el, field.Forbidden(fldPath.Child("commonName"), "cannot be set when using a CSR"))
+	}
+	if crt.LiteralSubject != "" {
+		el = append(el, field.Forbidden(fldPath.Child("literalSubject"), "cannot be set when using a CSR"))
+	}
+	if crt.Subject != nil {
+		el = append(el, field.Forbidden(fldPath.Child("subject"), "cannot be set when using a CSR"))
+	}
+	if len(crt.DNSNames) > 0 {
+		el = append(el, field.Forbidden(fldPath.Child("dnsNames"), "cannot be set when using a CSR"))
+	}
+	if len(crt.IPAddresses) > 0 {
+		el = append(el, field.Forbidden(fldPath.Child("ipAddresses"), "cannot be set when using a CSR"))
+	}
+	if len(crt.URIs) > 0 {
+		el = append(el, field.Forbidden(fldPath.Child("uris"), "cannot be set when using a CSR"))
+	}
+	if len(crt.EmailAddresses) > 0 {
+		el = append(el, field.Forbidden(fldPath.Child("emailAddresses"), "cannot be set when using a CSR"))
+	}
+	if len(crt.OtherNames) > 0 {
+		el = append(el, field.Forbidden(fldPath.Child("otherNames"), "cannot be set when using a CSR"))
+	}
+	if crt.PrivateKey != nil {
+		el = append(el, field.Forbidden(fldPath.Child("privateKey"), "cannot be set when using a CSR, as the private key is not held by cert-manager"))
+	}
+	if crt.SignatureAlgorithm != "" {
+		el = append(el, field.Forbidden(fldPath.Child("signatureAlgorithm"), "cannot be set when using a CSR, as the private key is not held by cert-manager"))
+	}
+	if crt.Keystores != nil {
+		el = append(el, field.Forbidden(fldPath.Child("keystores"), "cannot be set when using a CSR, as the private key is not held by cert-manager"))
+	}
+	if len(crt.AdditionalOutputFormats) > 0 {
+		el = append(el, field.Forbidden(fldPath.Child("additionalOutputFormats"), "cannot be set when using a CSR, as the private key is not held by cert-manager"))
+	}
+
+	return el
+}
+
+// createsKeystores returns true if a JKS or PKCS#12 keystore containing the
+// private key is written to the Certificate's Secret.
+func createsKeystores(crt *internalcmapi.CertificateSpec) bool {
+	return crt.Keystores != nil && ((crt.Keystores.JKS != nil && crt.Keystores.JKS.Create) || (crt.Keystores.PKCS12 != nil && crt.Keystores.PKCS12.Create))
+}
+
 func validateKeystores(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
 	var el field.ErrorList
 
+	// Neither JKS nor PKCS#12 keystores support Ed448 private keys.
+	if crt.PrivateKey != nil && crt.PrivateKey.Algorithm == internalcmapi.Ed448KeyAlgorithm && createsKeystores(crt) {
+		el = append(el, field.Forbidden(fldPath.Child("keystores"), "keystores cannot be used with Ed448 private keys"))
+	}
+
 	if crt.Keystores.JKS != nil {
 		if crt.Keystores.JKS.Password != nil && crt.Keystores.JKS.PasswordSecretRef.Name != "" {
 			el = append(el, field.Forbidden(fldPath.Child("keystores", "jks"), fmt.Sprintf(keystoresMutuallyExclusivePasswordsFmt, "JKS")))
@@ -484,6 +696,44 @@ func validateKeystores(crt *internalcmapi.CertificateSpec, fldPath *field.Path)
 		}
 	}
 
+	if crt.Keystores.Truststore != nil {
+		el = append(el, validateTruststore(crt, fldPath.Child("keystores", "truststore"))...)
+	}
+
+	return el
+}
+
+func validateTruststore(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+	truststore := crt.Keystores.Truststore
+
+	if (crt.Keystores.JKS == nil || !crt.Keystores.JKS.Create) && (crt.Keystores.PKCS12 == nil || !crt.Keystores.PKCS12.Create) {
+		el = append(el, field.Forbidden(fldPath, "a truststore Secret can only be configured if a JKS or PKCS#12 keystore is created"))
+	}
+
+	switch {
+	case truststore.SecretName == "":
+		el = append(el, field.Required(fldPath.Child("secretName"), "must be specified"))
+	case truststore.SecretName == crt.SecretName:
+		el = append(el, field.Invalid(fldPath.Child("secretName"), truststore.SecretName, "must not be the same as spec.secretName"))
+	default:
+		for _, msg := range apivalidation.NameIsDNSSubdomain(truststore.SecretName, false) {
+			el = append(el, field.Invalid(fldPath.Child("secretName"), truststore.SecretName, msg))
+		}
+	}
+
+	namespaceSet := sets.New[string]()
+	for i, namespace := range truststore.Namespaces {
+		for _, msg := range apivalidation.ValidateNamespaceName(namespace, false) {
+			el = append(el, field.Invalid(fldPath.Child("namespaces").Index(i), namespace, msg))
+		}
+		if namespaceSet.Has(namespace) {
+			el = append(el, field.Duplicate(fldPath.Child("namespaces").Index(i), namespace))
+			continue
+		}
+		namespaceSet.Insert(namespace)
+	}
+
 	return el
 }
 
@@ -24,15 +24,18 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	admissionv1 "k8s.io/api/admission/v1"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	"k8s.io/utils/ptr"
 
 	internalcmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
 	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
 	"github.com/cert-manager/cert-manager/internal/webhook/feature"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
 )
 
 var (
@@ -333,6 +336,20 @@ func TestValidateCertificate(t *testing.T) {
 			},
 			a: someAdmissionRequest,
 		},
+		"valid certificate with ed448 keyAlgorithm": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Size:      521,
+						Algorithm: internalcmapi.Ed448KeyAlgorithm,
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
 		"valid certificate with keyAlgorithm not specified and keySize specified": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
@@ -346,6 +363,89 @@ func TestValidateCertificate(t *testing.T) {
 			},
 			a: someAdmissionRequest,
 		},
+		"valid certificate with private key provider": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Algorithm: internalcmapi.ECDSAKeyAlgorithm,
+						Provider:  "local",
+					},
+					Keystores: &internalcmapi.CertificateKeystores{
+						PKCS12: &internalcmapi.PKCS12Keystore{
+							Create:   false,
+							Password: ptr.To("changeit"),
+						},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"certificate with ed448 keyAlgorithm and keystores": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Algorithm: internalcmapi.Ed448KeyAlgorithm,
+					},
+					Keystores: &internalcmapi.CertificateKeystores{
+						PKCS12: &internalcmapi.PKCS12Keystore{
+							Create:   true,
+							Password: ptr.To("changeit"),
+						},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("keystores"), "keystores cannot be used with Ed448 private keys"),
+			},
+		},
+		"certificate with private key provider and keystores": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Provider: "local",
+					},
+					Keystores: &internalcmapi.CertificateKeystores{
+						JKS: &internalcmapi.JKSKeystore{
+							Create:   true,
+							Password: ptr.To("changeit"),
+						},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("keystores"), "keystores cannot be used when the private key is held by a key provider"),
+			},
+		},
+		"certificate with private key provider and additional output formats": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Provider: "local",
+					},
+					AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+						{Type: internalcmapi.CertificateOutputFormatCombinedPEM},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("additionalOutputFormats"), "additional output formats cannot be used when the private key is held by a key provider"),
+			},
+		},
 		"certificate with rsa keyAlgorithm specified and invalid keysize 1024": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
@@ -397,6 +497,55 @@ func TestValidateCertificate(t *testing.T) {
 				field.NotSupported(fldPath.Child("privateKey", "size"), 100, []string{"256", "384", "521"}),
 			},
 		},
+		"valid certificate with mldsa keyAlgorithm and matching signatureAlgorithm": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Algorithm: internalcmapi.MLDSAKeyAlgorithm,
+						Size:      65,
+					},
+					SignatureAlgorithm: internalcmapi.MLDSA65,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"certificate with mldsa keyAlgorithm and invalid keySize": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Algorithm: internalcmapi.MLDSAKeyAlgorithm,
+						Size:      256,
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("privateKey", "size"), 256, []string{"44", "65", "87"}),
+			},
+		},
+		"certificate with mldsa keyAlgorithm and mismatched signatureAlgorithm": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Algorithm: internalcmapi.MLDSAKeyAlgorithm,
+					},
+					SignatureAlgorithm: internalcmapi.MLDSA87,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("signatureAlgorithm"), internalcmapi.MLDSA87, "for key algorithm MLDSA with size 44 the signature algorithm must be MLDSA44"),
+			},
+		},
 		"certificate with invalid keyAlgorithm": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
@@ -410,7 +559,7 @@ func TestValidateCertificate(t *testing.T) {
 			},
 			a: someAdmissionRequest,
 			errs: []*field.Error{
-				field.Invalid(fldPath.Child("privateKey", "algorithm"), internalcmapi.PrivateKeyAlgorithm("blah"), "must be either empty or one of rsa, ecdsa or ed25519"),
+				field.Invalid(fldPath.Child("privateKey", "algorithm"), internalcmapi.PrivateKeyAlgorithm("blah"), "must be either empty or one of rsa, ecdsa, ed25519, ed448 or mldsa"),
 			},
 		},
 		"valid certificate with ipAddresses": {
@@ -929,6 +1078,54 @@ func TestValidateCertificate(t *testing.T) {
 				},
 			},
 		},
+		"signature algorithm Ed448 allowed for Ed448 key": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Algorithm: internalcmapi.Ed448KeyAlgorithm,
+					},
+					SignatureAlgorithm: internalcmapi.PureEd448,
+				},
+			},
+		},
+		"signature algorithm Ed25519 not allowed for Ed448 key": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Algorithm: internalcmapi.Ed448KeyAlgorithm,
+					},
+					SignatureAlgorithm: internalcmapi.PureEd25519,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("signatureAlgorithm"), internalcmapi.PureEd25519,
+					"for key algorithm Ed448 the allowed signature algorithms are [PureEd448]"),
+			},
+		},
+		"signature algorithm Ed448 not allowed for ECDSA P-521 key": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Algorithm: internalcmapi.ECDSAKeyAlgorithm,
+						Size:      521,
+					},
+					SignatureAlgorithm: internalcmapi.PureEd448,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("signatureAlgorithm"), internalcmapi.PureEd448,
+					"for key algorithm ECDSA the allowed signature algorithms are [ECDSAWithSHA256 ECDSAWithSHA384 ECDSAWithSHA512]"),
+			},
+		},
 		"explicit rotation policy": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
@@ -1010,6 +1207,39 @@ func TestValidateCertificate(t *testing.T) {
 				field.Invalid(fldPath.Child("renewal", "windows").Index(0).Child("cron"), "CRON_TZ=UTC", "invalid cron syntax: failed to parse cron spec 'CRON_TZ=UTC': expected timezone prefix to be followed by a cron spec: CRON_TZ=UTC. cron needs to follow: cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow"),
 			},
 		},
+		"valid with revocation reason annotation": {
+			cfg: &internalcmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						internalcmapi.CertificateRequestRevocationReasonAnnotationKey: "keyCompromise",
+					},
+				},
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid revocation reason annotation": {
+			cfg: &internalcmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						internalcmapi.CertificateRequestRevocationReasonAnnotationKey: "stolen",
+					},
+				},
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(field.NewPath("metadata", "annotations").Child(internalcmapi.CertificateRequestRevocationReasonAnnotationKey), "stolen", `unknown revocation reason "stolen"`),
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -1021,6 +1251,101 @@ func TestValidateCertificate(t *testing.T) {
 	}
 }
 
+func TestValidateCertificateCSR(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	csrPEM := mustGenerateCSR(t, gen.Certificate("test", gen.SetCertificateDNSNames("example.com")))
+	csrSecretRef := &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "csr"}}
+
+	scenarios := map[string]struct {
+		spec internalcmapi.CertificateSpec
+		errs []*field.Error
+	}{
+		"valid certificate with inline csr": {
+			spec: internalcmapi.CertificateSpec{
+				SecretName: "abc",
+				IssuerRef:  validIssuerRef,
+				CSR:        &internalcmapi.CertificateCSRSource{Request: csrPEM},
+			},
+		},
+		"valid certificate with csr secretRef": {
+			spec: internalcmapi.CertificateSpec{
+				SecretName: "abc",
+				IssuerRef:  validIssuerRef,
+				CSR:        &internalcmapi.CertificateCSRSource{SecretRef: csrSecretRef},
+			},
+		},
+		"certificate with both inline csr and csr secretRef": {
+			spec: internalcmapi.CertificateSpec{
+				SecretName: "abc",
+				IssuerRef:  validIssuerRef,
+				CSR:        &internalcmapi.CertificateCSRSource{Request: csrPEM, SecretRef: csrSecretRef},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("csr"), "only one of request or secretRef may be specified"),
+			},
+		},
+		"certificate with empty csr": {
+			spec: internalcmapi.CertificateSpec{
+				SecretName: "abc",
+				IssuerRef:  validIssuerRef,
+				CSR:        &internalcmapi.CertificateCSRSource{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("csr"), "one of request or secretRef must be specified"),
+			},
+		},
+		"certificate with csr secretRef without a name": {
+			spec: internalcmapi.CertificateSpec{
+				SecretName: "abc",
+				IssuerRef:  validIssuerRef,
+				CSR:        &internalcmapi.CertificateCSRSource{SecretRef: &cmmeta.SecretKeySelector{Key: "csr.pem"}},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("csr", "secretRef", "name"), "must be specified"),
+			},
+		},
+		"certificate with inline csr not matching usages": {
+			spec: internalcmapi.CertificateSpec{
+				SecretName: "abc",
+				IssuerRef:  validIssuerRef,
+				Usages:     []internalcmapi.KeyUsage{internalcmapi.UsageServerAuth},
+				CSR:        &internalcmapi.CertificateCSRSource{Request: csrPEM},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("csr", "request"), truncateString(string(csrPEM)), "encoded CSR error: the KeyUsages [ 'digital signature', 'key encipherment' ] do not match the expected KeyUsages []"),
+			},
+		},
+		"certificate with csr and fields taken from the csr or private key": {
+			spec: internalcmapi.CertificateSpec{
+				CommonName:         "testcn",
+				DNSNames:           []string{"example.com"},
+				SecretName:         "abc",
+				IssuerRef:          validIssuerRef,
+				PrivateKey:         &internalcmapi.CertificatePrivateKey{Algorithm: internalcmapi.ECDSAKeyAlgorithm},
+				SignatureAlgorithm: internalcmapi.ECDSAWithSHA256,
+				AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+					{Type: internalcmapi.CertificateOutputFormatCombinedPEM},
+				},
+				CSR: &internalcmapi.CertificateCSRSource{SecretRef: csrSecretRef},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("commonName"), "cannot be set when using a CSR"),
+				field.Forbidden(fldPath.Child("dnsNames"), "cannot be set when using a CSR"),
+				field.Forbidden(fldPath.Child("privateKey"), "cannot be set when using a CSR, as the private key is not held by cert-manager"),
+				field.Forbidden(fldPath.Child("signatureAlgorithm"), "cannot be set when using a CSR, as the private key is not held by cert-manager"),
+				field.Forbidden(fldPath.Child("additionalOutputFormats"), "cannot be set when using a CSR, as the private key is not held by cert-manager"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs, warnings := ValidateCertificate(someAdmissionRequest, &internalcmapi.Certificate{Spec: s.spec})
+			assert.ElementsMatch(t, errs, s.errs)
+			assert.Empty(t, warnings)
+		})
+	}
+}
+
 func TestValidateDuration(t *testing.T) {
 	usefulDurations := map[string]*metav1.Duration{
 		"one second":  {Duration: time.Second},
@@ -1279,6 +1604,50 @@ func Test_validateAdditionalOutputFormats(t *testing.T) {
 				field.Duplicate(field.NewPath("spec", "additionalOutputFormats").Key("type"), "bar"),
 			},
 		},
+		"if EncryptedPKCS8 format defined with a password Secret, expect no error": {
+			spec: &internalcmapi.CertificateSpec{
+				AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+					{Type: internalcmapi.CertificateOutputFormatEncryptedPKCS8, PasswordSecretRef: &cmmeta.SecretKeySelector{
+						LocalObjectReference: cmmeta.LocalObjectReference{Name: "key-password"}, Key: "password",
+					}},
+				},
+			},
+			expErr: nil,
+		},
+		"if EncryptedPKCS8 format defined without a password Secret, expect error": {
+			spec: &internalcmapi.CertificateSpec{
+				AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+					{Type: internalcmapi.CertificateOutputFormatDER},
+					{Type: internalcmapi.CertificateOutputFormatEncryptedPKCS8},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Required(field.NewPath("spec", "additionalOutputFormats").Index(1).Child("passwordSecretRef"), "must be specified for the EncryptedPKCS8 output format"),
+			},
+		},
+		"if EncryptedPKCS8 format defined with an incomplete password Secret reference, expect error": {
+			spec: &internalcmapi.CertificateSpec{
+				AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+					{Type: internalcmapi.CertificateOutputFormatEncryptedPKCS8, PasswordSecretRef: &cmmeta.SecretKeySelector{}},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Required(field.NewPath("spec", "additionalOutputFormats").Index(0).Child("passwordSecretRef", "name"), "must be specified"),
+				field.Required(field.NewPath("spec", "additionalOutputFormats").Index(0).Child("passwordSecretRef", "key"), "must be specified"),
+			},
+		},
+		"if a password Secret is defined for a format other than EncryptedPKCS8, expect error": {
+			spec: &internalcmapi.CertificateSpec{
+				AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+					{Type: internalcmapi.CertificateOutputFormatDER, PasswordSecretRef: &cmmeta.SecretKeySelector{
+						LocalObjectReference: cmmeta.LocalObjectReference{Name: "key-password"}, Key: "password",
+					}},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "additionalOutputFormats").Index(0).Child("passwordSecretRef"), "may only be specified for the EncryptedPKCS8 output format"),
+			},
+		},
 	}
 
 	for name, test := range tests {
@@ -1289,6 +1658,67 @@ func Test_validateAdditionalOutputFormats(t *testing.T) {
 	}
 }
 
+func Test_validateConfigMapTargets(t *testing.T) {
+	targetsPath := field.NewPath("spec", "configMapTargets")
+	tests := map[string]struct {
+		spec   *internalcmapi.CertificateSpec
+		expErr field.ErrorList
+	}{
+		"if no targets defined, expect no error": {
+			spec:   &internalcmapi.CertificateSpec{},
+			expErr: nil,
+		},
+		"if targets with and without a namespace are defined, expect no error": {
+			spec: &internalcmapi.CertificateSpec{
+				ConfigMapTargets: []internalcmapi.CertificateConfigMapTarget{
+					{Name: "public-chain"},
+					{Name: "public-chain", Namespace: "frontend"},
+				},
+			},
+			expErr: nil,
+		},
+		"if a target has no name, expect error": {
+			spec: &internalcmapi.CertificateSpec{
+				ConfigMapTargets: []internalcmapi.CertificateConfigMapTarget{
+					{Namespace: "frontend"},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Required(targetsPath.Index(0).Child("name"), "must be specified"),
+			},
+		},
+		"if a target has an invalid name and namespace, expect errors": {
+			spec: &internalcmapi.CertificateSpec{
+				ConfigMapTargets: []internalcmapi.CertificateConfigMapTarget{
+					{Name: "Public_Chain", Namespace: "front.end"},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Invalid(targetsPath.Index(0).Child("name"), "Public_Chain", apivalidation.NameIsDNSSubdomain("Public_Chain", false)[0]),
+				field.Invalid(targetsPath.Index(0).Child("namespace"), "front.end", apivalidation.ValidateNamespaceName("front.end", false)[0]),
+			},
+		},
+		"if the same target is defined twice, expect error": {
+			spec: &internalcmapi.CertificateSpec{
+				ConfigMapTargets: []internalcmapi.CertificateConfigMapTarget{
+					{Name: "public-chain", Namespace: "frontend"},
+					{Name: "public-chain", Namespace: "frontend"},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Duplicate(targetsPath.Index(1), internalcmapi.CertificateConfigMapTarget{Name: "public-chain", Namespace: "frontend"}),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validateConfigMapTargets(test.spec, field.NewPath("spec"))
+			assert.Equal(t, test.expErr, gotErr)
+		})
+	}
+}
+
 func Test_validateLiteralSubject(t *testing.T) {
 	fldPath := field.NewPath("spec")
 	tests := map[string]struct {
@@ -1566,6 +1996,94 @@ func Test_validateKeystores(t *testing.T) {
 			},
 			a: someAdmissionRequest,
 		},
+		"truststore Secret with a PKCS#12 keystore and permitted copies is valid": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Keystores: &internalcmapi.CertificateKeystores{
+						PKCS12: &internalcmapi.PKCS12Keystore{
+							Create:   true,
+							Password: &keystorePassword,
+						},
+						Truststore: &internalcmapi.CertificateTruststore{
+							SecretName: "abc-truststore",
+							Namespaces: []string{"frontend", "backend"},
+						},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"truststore Secret requires a keystore to be created": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Keystores: &internalcmapi.CertificateKeystores{
+						JKS: &internalcmapi.JKSKeystore{
+							Create:   false,
+							Password: &keystorePassword,
+						},
+						Truststore: &internalcmapi.CertificateTruststore{
+							SecretName: "abc-truststore",
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("keystores", "truststore"), "a truststore Secret can only be configured if a JKS or PKCS#12 keystore is created"),
+			},
+			a: someAdmissionRequest,
+		},
+		"truststore Secret name must be set and differ from spec.secretName": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Keystores: &internalcmapi.CertificateKeystores{
+						JKS: &internalcmapi.JKSKeystore{
+							Create:   true,
+							Password: &keystorePassword,
+						},
+						Truststore: &internalcmapi.CertificateTruststore{
+							SecretName: "abc",
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("keystores", "truststore", "secretName"), "abc", "must not be the same as spec.secretName"),
+			},
+			a: someAdmissionRequest,
+		},
+		"truststore Secret namespaces must be valid and unique": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Keystores: &internalcmapi.CertificateKeystores{
+						JKS: &internalcmapi.JKSKeystore{
+							Create:   true,
+							Password: &keystorePassword,
+						},
+						Truststore: &internalcmapi.CertificateTruststore{
+							SecretName: "abc-truststore",
+							Namespaces: []string{"frontend", "Invalid_Namespace", "frontend"},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("keystores", "truststore", "namespaces").Index(1), "Invalid_Namespace", apivalidation.ValidateNamespaceName("Invalid_Namespace", false)[0]),
+				field.Duplicate(fldPath.Child("keystores", "truststore", "namespaces").Index(2), "frontend"),
+			},
+			a: someAdmissionRequest,
+		},
 	}
 
 	for name, test := range tests {
@@ -38,6 +38,8 @@ func ValidateCertificateRequest(a *admissionv1.AdmissionRequest, obj runtime.Obj
 	allErrs := ValidateCertificateRequestSpec(&cr.Spec, field.NewPath("spec"))
 	allErrs = append(allErrs,
 		ValidateCertificateRequestApprovalCondition(cr.Status.Conditions, field.NewPath("status", "conditions"))...)
+	allErrs = append(allErrs,
+		validateCertificateRequestRevocationReason(cr, field.NewPath("metadata", "annotations"))...)
 
 	return allErrs, nil
 }
@@ -54,6 +56,7 @@ func ValidateUpdateCertificateRequest(a *admissionv1.AdmissionRequest, oldObj, n
 	annotationField := field.NewPath("metadata", "annotations")
 	el = append(el, validateCertificateRequestAnnotations(oldCR, newCR, annotationField)...)
 	el = append(el, validateCertificateRequestAnnotations(newCR, oldCR, annotationField)...)
+	el = append(el, validateUpdateCertificateRequestRevocationReason(oldCR, newCR, annotationField)...)
 	el = append(el,
 		ValidateUpdateCertificateRequestApprovalCondition(oldCR.Status.Conditions, newCR.Status.Conditions, field.NewPath("status", "conditions"))...)
 
@@ -67,6 +70,11 @@ func ValidateUpdateCertificateRequest(a *admissionv1.AdmissionRequest, oldObj, n
 func validateCertificateRequestAnnotations(objA, objB *cmapi.CertificateRequest, fieldPath *field.Path) field.ErrorList {
 	var el field.ErrorList
 	for k, v := range objA.Annotations {
+		// The revocation reason may be added after creation, and is validated
+		// separately.
+		if k == cmapi.CertificateRequestRevocationReasonAnnotationKey {
+			continue
+		}
 		if strings.HasPrefix(k, certmanager.GroupName) ||
 			strings.HasPrefix(k, acme.GroupName) {
 			if vNew, ok := objB.Annotations[k]; !ok || v != vNew {
@@ -78,6 +86,37 @@ func validateCertificateRequestAnnotations(objA, objB *cmapi.CertificateRequest,
 	return el
 }
 
+// validateUpdateCertificateRequestRevocationReason ensures that the revocation
+// reason annotation can be added to an existing CertificateRequest, but not
+// changed or removed once it has been set.
+func validateUpdateCertificateRequestRevocationReason(oldCR, newCR *cmapi.CertificateRequest, fieldPath *field.Path) field.ErrorList {
+	oldReason, wasRevoked := oldCR.Annotations[cmapi.CertificateRequestRevocationReasonAnnotationKey]
+	newReason, isRevoked := newCR.Annotations[cmapi.CertificateRequestRevocationReasonAnnotationKey]
+
+	if wasRevoked && (!isRevoked || oldReason != newReason) {
+		return field.ErrorList{
+			field.Forbidden(fieldPath.Child(cmapi.CertificateRequestRevocationReasonAnnotationKey), "cannot change or remove revocation reason once set"),
+		}
+	}
+
+	return validateCertificateRequestRevocationReason(newCR, fieldPath)
+}
+
+func validateCertificateRequestRevocationReason(cr *cmapi.CertificateRequest, fieldPath *field.Path) field.ErrorList {
+	reason, ok := cr.Annotations[cmapi.CertificateRequestRevocationReasonAnnotationKey]
+	if !ok {
+		return nil
+	}
+
+	if _, err := pki.ParseRevocationReason(reason); err != nil {
+		return field.ErrorList{
+			field.Invalid(fieldPath.Child(cmapi.CertificateRequestRevocationReasonAnnotationKey), reason, err.Error()),
+		}
+	}
+
+	return nil
+}
+
 func ValidateCertificateRequestSpec(crSpec *cmapi.CertificateRequestSpec, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
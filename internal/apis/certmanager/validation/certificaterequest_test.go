@@ -118,6 +118,44 @@ func TestValidateCertificateRequestUpdate(t *testing.T) {
 			a:     someAdmissionRequest,
 			wantE: nil,
 		},
+		"if a revocation reason annotation is added, don't error": {
+			oldCR: baseCR.DeepCopy(),
+			newCR: func() *cminternal.CertificateRequest {
+				cr := baseCR.DeepCopy()
+				cr.Annotations[cminternal.CertificateRequestRevocationReasonAnnotationKey] = "keyCompromise"
+				return cr
+			}(),
+			a:     someAdmissionRequest,
+			wantE: nil,
+		},
+		"if an invalid revocation reason annotation is added, error": {
+			oldCR: baseCR.DeepCopy(),
+			newCR: func() *cminternal.CertificateRequest {
+				cr := baseCR.DeepCopy()
+				cr.Annotations[cminternal.CertificateRequestRevocationReasonAnnotationKey] = "stolen"
+				return cr
+			}(),
+			a: someAdmissionRequest,
+			wantE: []*field.Error{
+				field.Invalid(field.NewPath("metadata", "annotations", cminternal.CertificateRequestRevocationReasonAnnotationKey), nil, `unknown revocation reason "stolen"`),
+			},
+		},
+		"if a revocation reason annotation is changed, error": {
+			oldCR: func() *cminternal.CertificateRequest {
+				cr := baseCR.DeepCopy()
+				cr.Annotations[cminternal.CertificateRequestRevocationReasonAnnotationKey] = "superseded"
+				return cr
+			}(),
+			newCR: func() *cminternal.CertificateRequest {
+				cr := baseCR.DeepCopy()
+				cr.Annotations[cminternal.CertificateRequestRevocationReasonAnnotationKey] = "keyCompromise"
+				return cr
+			}(),
+			a: someAdmissionRequest,
+			wantE: []*field.Error{
+				field.Forbidden(field.NewPath("metadata", "annotations", cminternal.CertificateRequestRevocationReasonAnnotationKey), "cannot change or remove revocation reason once set"),
+			},
+		},
 		"CertificateRequest with single Approved=true condition that doesn't change, shouldn't error": {
 			oldCR: &cminternal.CertificateRequest{
 				Spec: cminternal.CertificateRequestSpec{
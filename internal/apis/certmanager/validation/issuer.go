@@ -19,8 +19,11 @@ package validation
 import (
 	"crypto/x509"
 	"fmt"
+	"net/netip"
+	"net/url"
 	"slices"
 	"strings"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -32,6 +35,7 @@ import (
 	"github.com/cert-manager/cert-manager/internal/apis/certmanager"
 	"github.com/cert-manager/cert-manager/internal/apis/certmanager/validation/util"
 	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 )
 
 // Validation functions for cert-manager Issuer types.
@@ -98,6 +102,14 @@ func ValidateIssuerConfig(iss *certmanager.IssuerConfig, fldPath *field.Path) (f
 			el = append(el, ValidateVenafiIssuerConfig(iss.Venafi, fldPath.Child("venafi"))...)
 		}
 	}
+	if iss.ExternalSigner != nil {
+		if numConfigs > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("externalSigner"), "may not specify more than one issuer type"))
+		} else {
+			numConfigs++
+			el = append(el, ValidateExternalSignerIssuerConfig(iss.ExternalSigner, fldPath.Child("externalSigner"))...)
+		}
+	}
 	if numConfigs == 0 {
 		el = append(el, field.Required(fldPath, "at least one issuer must be configured"))
 	}
@@ -129,6 +141,10 @@ func ValidateACMEIssuerConfig(iss *cmacme.ACMEIssuer, fldPath *field.Path) (fiel
 		el = append(el, field.Required(fldPath.Child("server"), "acme server URL is a required field"))
 	}
 
+	for i, contact := range iss.Contacts {
+		el = append(el, validateContactURI(contact, fldPath.Child("contacts").Index(i))...)
+	}
+
 	if eab := iss.ExternalAccountBinding; eab != nil {
 		eabFldPath := fldPath.Child("externalAccountBinding")
 		if len(eab.KeyID) == 0 {
@@ -143,6 +159,23 @@ func ValidateACMEIssuerConfig(iss *cmacme.ACMEIssuer, fldPath *field.Path) (fiel
 		}
 	}
 
+	if rollover := iss.AccountKeyRollover; rollover != nil {
+		el = append(el, ValidateACMEAccountKeyRollover(iss, fldPath.Child("accountKeyRollover"))...)
+	}
+
+	if preAuthz := iss.PreAuthorization; preAuthz != nil {
+		el = append(el, ValidateACMEPreAuthorization(preAuthz, fldPath.Child("preAuthorization"))...)
+	}
+
+	if prov := iss.ExternalAccountBindingProvisioner; prov != nil {
+		provFldPath := fldPath.Child("externalAccountBindingProvisioner")
+		if iss.ExternalAccountBinding != nil {
+			el = append(el, field.Forbidden(provFldPath, "may not be specified together with externalAccountBinding"))
+		}
+
+		el = append(el, ValidateACMEExternalAccountBindingProvisioner(prov, provFldPath)...)
+	}
+
 	for i, sol := range iss.Solvers {
 		el = append(el, ValidateACMEIssuerChallengeSolverConfig(&sol, fldPath.Child("solvers").Index(i))...)
 	}
@@ -150,9 +183,122 @@ func ValidateACMEIssuerConfig(iss *cmacme.ACMEIssuer, fldPath *field.Path) (fiel
 	return el, warnings
 }
 
+// minAccountKeyRotationPeriod is the minimum interval at which ACME account
+// keys may be rotated.
+const minAccountKeyRotationPeriod = time.Hour
+
+func ValidateACMEAccountKeyRollover(iss *cmacme.ACMEIssuer, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	rollover := iss.AccountKeyRollover
+
+	if rollover.PreviousPrivateKey != nil && len(rollover.PreviousPrivateKey.Name) == 0 {
+		el = append(el, field.Required(fldPath.Child("previousPrivateKeySecretRef", "name"), "secret name is required"))
+	}
+
+	if rollover.RotationPeriod != nil {
+		rotationFldPath := fldPath.Child("rotationPeriod")
+		if rollover.RotationPeriod.Duration < minAccountKeyRotationPeriod {
+			el = append(el, field.Invalid(rotationFldPath, rollover.RotationPeriod.Duration, fmt.Sprintf("rotationPeriod must be at least %s", minAccountKeyRotationPeriod)))
+		}
+		if rollover.PreviousPrivateKey != nil {
+			el = append(el, field.Forbidden(rotationFldPath, "may not be specified together with previousPrivateKeySecretRef"))
+		}
+		if iss.DisableAccountKeyGeneration {
+			el = append(el, field.Forbidden(rotationFldPath, "may not be specified when disableAccountKeyGeneration is true"))
+		}
+	}
+
+	return el
+}
+
+func ValidateACMEPreAuthorization(preAuthz *cmacme.ACMEPreAuthorization, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if len(preAuthz.DNSNames) == 0 {
+		el = append(el, field.Required(fldPath.Child("dnsNames"), "at least one DNS name must be specified"))
+	}
+
+	seen := make(map[string]struct{}, len(preAuthz.DNSNames))
+	for i, dnsName := range preAuthz.DNSNames {
+		dnsNameFldPath := fldPath.Child("dnsNames").Index(i)
+		if strings.HasPrefix(dnsName, "*.") {
+			el = append(el, field.Invalid(dnsNameFldPath, dnsName, "wildcard DNS names cannot be pre-authorized"))
+			continue
+		}
+		if errs := validation.IsDNS1123Subdomain(dnsName); len(errs) > 0 {
+			el = append(el, field.Invalid(dnsNameFldPath, dnsName, strings.Join(errs, ", ")))
+			continue
+		}
+		if _, ok := seen[dnsName]; ok {
+			el = append(el, field.Duplicate(dnsNameFldPath, dnsName))
+		}
+		seen[dnsName] = struct{}{}
+	}
+
+	if preAuthz.RenewBefore != nil && preAuthz.RenewBefore.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("renewBefore"), preAuthz.RenewBefore.Duration, "renewBefore must be greater than 0"))
+	}
+
+	return el
+}
+
+func ValidateACMEExternalAccountBindingProvisioner(prov *cmacme.ACMEExternalAccountBindingProvisioner, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	el = append(el, validateHTTPSURL(prov.URL, fldPath.Child("url"))...)
+	el = append(el, validateHTTPSURL(prov.TokenURL, fldPath.Child("tokenURL"))...)
+
+	if len(prov.CredentialsRef.Name) == 0 {
+		el = append(el, field.Required(fldPath.Child("credentialsRef", "name"), "secret name is required"))
+	}
+
+	return el
+}
+
+// validateHTTPSURL checks that the given value is an absolute https URL, as
+// OAuth 2.0 client credentials and access tokens must not be sent in plain
+// text.
+// validateContactURI validates an ACME account contact, which must be an
+// absolute URI such as mailto:admin@example.com.
+func validateContactURI(value string, fldPath *field.Path) field.ErrorList {
+	if len(value) == 0 {
+		return field.ErrorList{field.Required(fldPath, "")}
+	}
+
+	u, err := url.Parse(value)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, value, err.Error())}
+	}
+	if u.Scheme == "" || (u.Opaque == "" && u.Host == "") {
+		return field.ErrorList{field.Invalid(fldPath, value, "must be an absolute URI, such as mailto:admin@example.com")}
+	}
+
+	return nil
+}
+
+func validateHTTPSURL(value string, fldPath *field.Path) field.ErrorList {
+	if len(value) == 0 {
+		return field.ErrorList{field.Required(fldPath, "")}
+	}
+
+	u, err := url.Parse(value)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, value, err.Error())}
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return field.ErrorList{field.Invalid(fldPath, value, "must be an absolute https URL")}
+	}
+
+	return nil
+}
+
 func ValidateACMEIssuerChallengeSolverConfig(sol *cmacme.ACMEChallengeSolver, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
+	if sol.Selector != nil {
+		el = append(el, ValidateCertificateDNSNameSelector(sol.Selector, fldPath.Child("selector"))...)
+	}
+
 	numProviders := 0
 	if sol.HTTP01 != nil {
 		numProviders++
@@ -167,6 +313,14 @@ func ValidateACMEIssuerChallengeSolverConfig(sol *cmacme.ACMEChallengeSolver, fl
 			el = append(el, solverErrs...)
 		}
 	}
+	if sol.TLSALPN01 != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath, "may not specify more than one solver type in a single solver"))
+		} else {
+			numProviders++
+			el = append(el, ValidateACMEIssuerChallengeSolverTLSALPN01Config(sol.TLSALPN01, fldPath.Child("tlsALPN01"))...)
+		}
+	}
 	if numProviders == 0 {
 		el = append(el, field.Required(fldPath, "no solver type configured"))
 	}
@@ -264,6 +418,51 @@ func ValidateACMEIssuerChallengeSolverHTTP01GatewayConfig(gateway *cmacme.ACMECh
 	return el
 }
 
+func ValidateCertificateDNSNameSelector(sel *cmacme.CertificateDNSNameSelector, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	for i, r := range sel.IPRanges {
+		if _, err := netip.ParsePrefix(r); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("ipRanges").Index(i), r, "must be an IP address range in CIDR notation"))
+		}
+	}
+
+	if len(sel.IPRanges) > 0 && len(sel.DNSZones) > 0 {
+		el = append(el, field.Forbidden(fldPath.Child("ipRanges"), "may not be specified together with dnsZones, as IP addresses never match a dnsZones selector"))
+	}
+
+	return el
+}
+
+func ValidateACMEIssuerChallengeSolverTLSALPN01Config(tlsALPN01 *cmacme.ACMEChallengeSolverTLSALPN01, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	switch tlsALPN01.ServiceType {
+	case "", corev1.ServiceTypeClusterIP, corev1.ServiceTypeNodePort, corev1.ServiceTypeLoadBalancer:
+	default:
+		el = append(el, field.Invalid(fldPath.Child("serviceType"), tlsALPN01.ServiceType, `must be empty, "ClusterIP", "NodePort" or "LoadBalancer"`))
+	}
+
+	if tlsALPN01.GatewayTLSRoute != nil {
+		if len(tlsALPN01.GatewayTLSRoute.ParentRefs) == 0 {
+			el = append(el, field.Required(fldPath.Child("gatewayTLSRoute", "parentRefs"), "at least one parentRef must be specified"))
+		}
+		for i, g := range tlsALPN01.GatewayTLSRoute.ParentRefs {
+			if g.Kind != nil && g.Name == "" {
+				el = append(el, field.Required(fldPath.Child("gatewayTLSRoute", "parentRefs").Index(i).Child("name"), "name is required when kind is specified"))
+				return el
+			}
+
+			if g.Name != "" && g.Kind == nil {
+				el = append(el, field.Required(fldPath.Child("gatewayTLSRoute", "parentRefs").Index(i).Child("kind"), "kind is required when name is specified"))
+				return el
+			}
+		}
+	}
+
+	return el
+}
+
 func ValidateCAIssuerConfig(iss *certmanager.CAIssuer, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 	if len(iss.SecretName) == 0 {
@@ -279,6 +478,24 @@ func ValidateCAIssuerConfig(iss *certmanager.CAIssuer, fldPath *field.Path) fiel
 			el = append(el, field.Invalid(fldPath.Child("issuingCertificateURLs").Index(i), issuerURL, "must be a valid URL"))
 		}
 	}
+	if iss.CRL != nil {
+		el = append(el, validateCAIssuerCRL(iss.CRL, iss.SecretName, fldPath.Child("crl"))...)
+	}
+	return el
+}
+
+func validateCAIssuerCRL(crl *certmanager.CAIssuerCRL, caSecretName string, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(crl.SecretName) == 0 {
+		el = append(el, field.Required(fldPath.Child("secretName"), ""))
+	} else if crl.SecretName == caSecretName {
+		// The CRL Secret is meant to be mounted into an HTTP server, so it
+		// must never be the Secret holding the CA private key.
+		el = append(el, field.Invalid(fldPath.Child("secretName"), crl.SecretName, "must not be the same as the CA keypair secretName"))
+	}
+	if crl.Duration != nil && crl.Duration.Duration < cmapi.MinimumCRLDuration {
+		el = append(el, field.Invalid(fldPath.Child("duration"), crl.Duration.Duration, fmt.Sprintf("duration must be greater than or equal to %s", cmapi.MinimumCRLDuration)))
+	}
 	return el
 }
 
@@ -286,6 +503,22 @@ func ValidateSelfSignedIssuerConfig(iss *certmanager.SelfSignedIssuer, fldPath *
 	return nil
 }
 
+func ValidateExternalSignerIssuerConfig(iss *certmanager.ExternalSignerIssuer, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	// The name is used to build the path of the plugin's socket, so it must
+	// not be able to escape the external signer socket directory.
+	if len(iss.Name) == 0 {
+		el = append(el, field.Required(fldPath.Child("name"), ""))
+	} else {
+		for _, msg := range validation.IsDNS1123Label(iss.Name) {
+			el = append(el, field.Invalid(fldPath.Child("name"), iss.Name, msg))
+		}
+	}
+
+	return el
+}
+
 func ValidateVaultIssuerConfig(iss *certmanager.VaultIssuer, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
@@ -520,6 +753,15 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			el = append(el, field.Invalid(fldPath.Child("cnameStrategy"), p.CNAMEStrategy, fmt.Sprintf("must be one of %q or %q", cmacme.NoneStrategy, cmacme.FollowStrategy)))
 		}
 	}
+	if len(p.ValidationZone) > 0 {
+		// A validation zone implies following the CNAME records into it.
+		if len(p.CNAMEStrategy) > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("cnameStrategy"), "may not be specified together with validationZone"))
+		}
+		if errs := validation.IsDNS1123Subdomain(strings.TrimSuffix(p.ValidationZone, ".")); len(errs) > 0 {
+			el = append(el, field.Invalid(fldPath.Child("validationZone"), p.ValidationZone, strings.Join(errs, ", ")))
+		}
+	}
 	for i, ns := range p.Nameservers {
 		if err := util.ValidDNS01Nameserver(ns); err != nil {
 			el = append(el, field.Invalid(fldPath.Child("nameservers").Index(i), ns, err.Error()))
@@ -672,6 +914,25 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			requiredSecrets = append(requiredSecrets, &p.DigitalOcean.Token)
 		}
 	}
+	if p.PowerDNS != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("powerDNS"), "may not specify more than one provider type"))
+		} else {
+			numProviders++
+			if len(p.PowerDNS.Host) == 0 {
+				el = append(el, field.Required(fldPath.Child("powerDNS", "host"), ""))
+			} else if u, err := url.Parse(p.PowerDNS.Host); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				el = append(el, field.Invalid(fldPath.Child("powerDNS", "host"), p.PowerDNS.Host, "host must be an http or https URL"))
+			}
+			if len(p.PowerDNS.CABundle) > 0 {
+				if err := validateCABundleNotEmpty(p.PowerDNS.CABundle); err != nil {
+					el = append(el, field.Invalid(fldPath.Child("powerDNS", "caBundle"), "", err.Error()))
+				}
+			}
+			el = append(el, ValidateSecretKeySelector(&p.PowerDNS.APIKey, fldPath.Child("powerDNS", "apiKeySecretRef"))...)
+			requiredSecrets = append(requiredSecrets, &p.PowerDNS.APIKey)
+		}
+	}
 	if p.RFC2136 != nil {
 		if numProviders > 0 {
 			el = append(el, field.Forbidden(fldPath.Child("rfc2136"), "may not specify more than one provider type"))
@@ -709,6 +970,35 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 					el = append(el, field.Required(fldPath.Child("rfc2136", "tsigKeyName"), ""))
 				}
 			}
+
+			if p.RFC2136.GSSTSIG != nil {
+				gssPath := fldPath.Child("rfc2136", "gssTSIG")
+				if len(p.RFC2136.TSIGKeyName) > 0 {
+					el = append(el, field.Forbidden(gssPath, "may not be specified together with tsigKeyName"))
+				}
+				if p.RFC2136.SIG0 != nil {
+					el = append(el, field.Forbidden(gssPath, "may not be specified together with sig0"))
+				}
+				if len(p.RFC2136.GSSTSIG.Realm) == 0 {
+					el = append(el, field.Required(gssPath.Child("realm"), ""))
+				}
+				if len(p.RFC2136.GSSTSIG.Username) == 0 {
+					el = append(el, field.Required(gssPath.Child("username"), ""))
+				}
+				el = append(el, ValidateSecretKeySelector(&p.RFC2136.GSSTSIG.KeytabSecretRef, gssPath.Child("keytabSecretRef"))...)
+				requiredSecrets = append(requiredSecrets, &p.RFC2136.GSSTSIG.KeytabSecretRef)
+			}
+			if p.RFC2136.SIG0 != nil {
+				sig0Path := fldPath.Child("rfc2136", "sig0")
+				if len(p.RFC2136.TSIGKeyName) > 0 {
+					el = append(el, field.Forbidden(sig0Path, "may not be specified together with tsigKeyName"))
+				}
+				if len(p.RFC2136.SIG0.PublicKey) == 0 {
+					el = append(el, field.Required(sig0Path.Child("publicKey"), ""))
+				}
+				el = append(el, ValidateSecretKeySelector(&p.RFC2136.SIG0.PrivateKeySecretRef, sig0Path.Child("privateKeySecretRef"))...)
+				requiredSecrets = append(requiredSecrets, &p.RFC2136.SIG0.PrivateKeySecretRef)
+			}
 		}
 	}
 	if p.Webhook != nil {
@@ -18,13 +18,16 @@ package validation
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -689,6 +692,171 @@ func TestValidateACMEIssuerConfig(t *testing.T) {
 			},
 			warnings: []string{deprecatedACMEEABKeyAlgorithmField},
 		},
+		"acme solver with a valid external account binding provisioner": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				ExternalAccountBindingProvisioner: &cmacme.ACMEExternalAccountBindingProvisioner{
+					URL:            "https://ca.example.com/eab",
+					TokenURL:       "https://auth.example.com/token",
+					CredentialsRef: cmmeta.LocalObjectReference{Name: "oauth-client"},
+				},
+				Solvers: []cmacme.ACMEChallengeSolver{
+					{
+						DNS01: &cmacme.ACMEChallengeSolverDNS01{
+							CloudDNS: &validCloudDNSProvider,
+						},
+					},
+				},
+			},
+		},
+		"acme solver with external account binding provisioner missing required fields": {
+			spec: &cmacme.ACMEIssuer{
+				Email:                             "valid-email",
+				Server:                            "valid-server",
+				PrivateKey:                        validSecretKeyRef,
+				ExternalAccountBindingProvisioner: &cmacme.ACMEExternalAccountBindingProvisioner{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("externalAccountBindingProvisioner", "url"), ""),
+				field.Required(fldPath.Child("externalAccountBindingProvisioner", "tokenURL"), ""),
+				field.Required(fldPath.Child("externalAccountBindingProvisioner", "credentialsRef", "name"), "secret name is required"),
+			},
+		},
+		"acme solver with external account binding provisioner using plain http URLs": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				ExternalAccountBindingProvisioner: &cmacme.ACMEExternalAccountBindingProvisioner{
+					URL:            "http://ca.example.com/eab",
+					TokenURL:       "auth.example.com/token",
+					CredentialsRef: cmmeta.LocalObjectReference{Name: "oauth-client"},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("externalAccountBindingProvisioner", "url"), "http://ca.example.com/eab", "must be an absolute https URL"),
+				field.Invalid(fldPath.Child("externalAccountBindingProvisioner", "tokenURL"), "auth.example.com/token", "must be an absolute https URL"),
+			},
+		},
+		"acme solver with both an external account binding and a provisioner": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				ExternalAccountBinding: &cmacme.ACMEExternalAccountBinding{
+					KeyID: "test",
+					Key:   validSecretKeyRef,
+				},
+				ExternalAccountBindingProvisioner: &cmacme.ACMEExternalAccountBindingProvisioner{
+					URL:            "https://ca.example.com/eab",
+					TokenURL:       "https://auth.example.com/token",
+					CredentialsRef: cmmeta.LocalObjectReference{Name: "oauth-client"},
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("externalAccountBindingProvisioner"), "may not be specified together with externalAccountBinding"),
+			},
+		},
+		"acme issuer with a valid account key rotation period": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				AccountKeyRollover: &cmacme.ACMEAccountKeyRollover{
+					RotationPeriod: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+				},
+			},
+		},
+		"acme issuer with a valid previous account key reference": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				AccountKeyRollover: &cmacme.ACMEAccountKeyRollover{
+					PreviousPrivateKey: &validSecretKeyRef,
+				},
+			},
+		},
+		"acme issuer with an invalid account key rollover config": {
+			spec: &cmacme.ACMEIssuer{
+				Email:                       "valid-email",
+				Server:                      "valid-server",
+				PrivateKey:                  validSecretKeyRef,
+				DisableAccountKeyGeneration: true,
+				AccountKeyRollover: &cmacme.ACMEAccountKeyRollover{
+					PreviousPrivateKey: &cmmeta.SecretKeySelector{},
+					RotationPeriod:     &metav1.Duration{Duration: time.Minute},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("accountKeyRollover", "previousPrivateKeySecretRef", "name"), "secret name is required"),
+				field.Invalid(fldPath.Child("accountKeyRollover", "rotationPeriod"), time.Minute, "rotationPeriod must be at least 1h0m0s"),
+				field.Forbidden(fldPath.Child("accountKeyRollover", "rotationPeriod"), "may not be specified together with previousPrivateKeySecretRef"),
+				field.Forbidden(fldPath.Child("accountKeyRollover", "rotationPeriod"), "may not be specified when disableAccountKeyGeneration is true"),
+			},
+		},
+		"acme issuer with valid pre-authorization": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				PreAuthorization: &cmacme.ACMEPreAuthorization{
+					DNSNames:    []string{"example.com", "example.org"},
+					RenewBefore: &metav1.Duration{Duration: 72 * time.Hour},
+				},
+			},
+		},
+		"acme issuer with invalid pre-authorization": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				PreAuthorization: &cmacme.ACMEPreAuthorization{
+					DNSNames:    []string{"*.example.com", "example.com", "Example_com", "example.com"},
+					RenewBefore: &metav1.Duration{},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("preAuthorization", "dnsNames").Index(0), "*.example.com", "wildcard DNS names cannot be pre-authorized"),
+				field.Invalid(fldPath.Child("preAuthorization", "dnsNames").Index(2), "Example_com", strings.Join(validation.IsDNS1123Subdomain("Example_com"), ", ")),
+				field.Duplicate(fldPath.Child("preAuthorization", "dnsNames").Index(3), "example.com"),
+				field.Invalid(fldPath.Child("preAuthorization", "renewBefore"), time.Duration(0), "renewBefore must be greater than 0"),
+			},
+		},
+		"acme issuer with empty pre-authorization": {
+			spec: &cmacme.ACMEIssuer{
+				Email:            "valid-email",
+				Server:           "valid-server",
+				PrivateKey:       validSecretKeyRef,
+				PreAuthorization: &cmacme.ACMEPreAuthorization{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("preAuthorization", "dnsNames"), "at least one DNS name must be specified"),
+			},
+		},
+		"acme issuer with valid contacts": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Contacts:   []string{"mailto:admin@example.com", "tel:+12025550123"},
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+			},
+		},
+		"acme issuer with invalid contacts": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Contacts:   []string{"", "admin@example.com", "mailto:"},
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("contacts").Index(0), ""),
+				field.Invalid(fldPath.Child("contacts").Index(1), "admin@example.com", "must be an absolute URI, such as mailto:admin@example.com"),
+				field.Invalid(fldPath.Child("contacts").Index(2), "mailto:", "must be an absolute URI, such as mailto:admin@example.com"),
+			},
+		},
 		"acme solver with missing http01 config type": {
 			spec: &cmacme.ACMEIssuer{
 				Email:      "valid-email",
@@ -1104,6 +1272,51 @@ func TestValidateIssuerSpec(t *testing.T) {
 				field.Invalid(fldPath.Child("ca", "issuingCertificateURLs").Index(0), "", `must be a valid URL`),
 			},
 		},
+		"valid CRL": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName: "valid",
+						CRL: &cmapi.CAIssuerCRL{
+							SecretName: "valid-crl",
+							Duration:   &metav1.Duration{Duration: time.Hour * 12},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"invalid CRL": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName: "valid",
+						CRL: &cmapi.CAIssuerCRL{
+							Duration: &metav1.Duration{Duration: time.Minute},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("ca", "crl", "secretName"), ""),
+				field.Invalid(fldPath.Child("ca", "crl", "duration"), time.Minute, `duration must be greater than or equal to 1h0m0s`),
+			},
+		},
+		"CRL secretName same as the CA secretName": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName: "ca-keypair",
+						CRL: &cmapi.CAIssuerCRL{
+							SecretName: "ca-keypair",
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("ca", "crl", "secretName"), "ca-keypair", `must not be the same as the CA keypair secretName`),
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -1252,6 +1465,134 @@ func TestValidateACMEIssuerHTTP01Config(t *testing.T) {
 	}
 }
 
+func TestValidateACMEIssuerTLSALPN01Config(t *testing.T) {
+	fldPath := (*field.Path)(nil)
+
+	scenarios := map[string]struct {
+		cfg  *cmacme.ACMEChallengeSolverTLSALPN01
+		errs []*field.Error
+	}{
+		"no fields specified": {
+			cfg: &cmacme.ACMEChallengeSolverTLSALPN01{},
+		},
+		"serviceType LoadBalancer": {
+			cfg: &cmacme.ACMEChallengeSolverTLSALPN01{
+				ServiceType: corev1.ServiceTypeLoadBalancer,
+			},
+		},
+		"invalid serviceType": {
+			cfg: &cmacme.ACMEChallengeSolverTLSALPN01{
+				ServiceType: corev1.ServiceType("InvalidServiceType"),
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("serviceType"), corev1.ServiceType("InvalidServiceType"), `must be empty, "ClusterIP", "NodePort" or "LoadBalancer"`),
+			},
+		},
+		"gatewayTLSRoute with a parentRef": {
+			cfg: &cmacme.ACMEChallengeSolverTLSALPN01{
+				GatewayTLSRoute: &cmacme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute{
+					ParentRefs: []gwapi.ParentReference{
+						{Name: "gateway", Kind: new(gwapi.Kind("Gateway"))},
+					},
+				},
+			},
+		},
+		"gatewayTLSRoute without parentRefs": {
+			cfg: &cmacme.ACMEChallengeSolverTLSALPN01{
+				GatewayTLSRoute: &cmacme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("gatewayTLSRoute", "parentRefs"), "at least one parentRef must be specified"),
+			},
+		},
+		"gatewayTLSRoute parentRef with a name but no kind": {
+			cfg: &cmacme.ACMEChallengeSolverTLSALPN01{
+				GatewayTLSRoute: &cmacme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute{
+					ParentRefs: []gwapi.ParentReference{
+						{Name: "gateway"},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("gatewayTLSRoute", "parentRefs").Index(0).Child("kind"), "kind is required when name is specified"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateACMEIssuerChallengeSolverTLSALPN01Config(s.cfg, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateCertificateDNSNameSelector(t *testing.T) {
+	fldPath := (*field.Path)(nil)
+
+	scenarios := map[string]struct {
+		sel  *cmacme.CertificateDNSNameSelector
+		errs []*field.Error
+	}{
+		"no fields specified": {
+			sel: &cmacme.CertificateDNSNameSelector{},
+		},
+		"valid IPv4 and IPv6 ranges": {
+			sel: &cmacme.CertificateDNSNameSelector{
+				IPRanges: []string{"10.0.0.0/8", "192.0.2.1/32", "2001:db8::/32"},
+			},
+		},
+		"ipRanges with dnsNames": {
+			sel: &cmacme.CertificateDNSNameSelector{
+				DNSNames: []string{"10.0.0.1"},
+				IPRanges: []string{"10.0.0.0/8"},
+			},
+		},
+		"invalid ranges": {
+			sel: &cmacme.CertificateDNSNameSelector{
+				IPRanges: []string{"10.0.0.1", "10.0.0.0/33", "example.com"},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("ipRanges").Index(0), "10.0.0.1", "must be an IP address range in CIDR notation"),
+				field.Invalid(fldPath.Child("ipRanges").Index(1), "10.0.0.0/33", "must be an IP address range in CIDR notation"),
+				field.Invalid(fldPath.Child("ipRanges").Index(2), "example.com", "must be an IP address range in CIDR notation"),
+			},
+		},
+		"ipRanges with dnsZones": {
+			sel: &cmacme.CertificateDNSNameSelector{
+				DNSZones: []string{"example.com"},
+				IPRanges: []string{"10.0.0.0/8"},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("ipRanges"), "may not be specified together with dnsZones, as IP addresses never match a dnsZones selector"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateCertificateDNSNameSelector(s.sel, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
 func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 	fldPath := field.NewPath("test")
 	scenarios := map[string]struct {
@@ -1833,6 +2174,81 @@ func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 				field.Required(fldPath.Child("rfc2136", "tsigKeyName"), ""),
 			},
 		},
+		"valid rfc2136 provider with GSS-TSIG": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver: "dc1.example.com",
+					GSSTSIG: &cmacme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG{
+						Realm:           "EXAMPLE.COM",
+						Username:        "cert-manager",
+						KeytabSecretRef: validSecretKeyRef,
+					},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"rfc2136 provider with GSS-TSIG missing fields": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver: "dc1.example.com",
+					GSSTSIG:    &cmacme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG{},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("rfc2136", "gssTSIG", "realm"), ""),
+				field.Required(fldPath.Child("rfc2136", "gssTSIG", "username"), ""),
+				field.Required(fldPath.Child("rfc2136", "gssTSIG", "keytabSecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("rfc2136", "gssTSIG", "keytabSecretRef", "key"), "secret key is required"),
+			},
+		},
+		"valid rfc2136 provider with SIG(0)": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver: "127.0.0.1",
+					SIG0: &cmacme.ACMEIssuerDNS01ProviderRFC2136SIG0{
+						PublicKey:           "update.example.com. IN KEY 512 3 13 AAAA",
+						PrivateKeySecretRef: validSecretKeyRef,
+					},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"rfc2136 provider with SIG(0) missing fields": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver: "127.0.0.1",
+					SIG0:       &cmacme.ACMEIssuerDNS01ProviderRFC2136SIG0{},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("rfc2136", "sig0", "publicKey"), ""),
+				field.Required(fldPath.Child("rfc2136", "sig0", "privateKeySecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("rfc2136", "sig0", "privateKeySecretRef", "key"), "secret key is required"),
+			},
+		},
+		"rfc2136 provider with TSIG, GSS-TSIG and SIG(0)": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver:  "127.0.0.1",
+					TSIGKeyName: "some-name",
+					TSIGSecret:  validSecretKeyRef,
+					GSSTSIG: &cmacme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG{
+						Realm:           "EXAMPLE.COM",
+						Username:        "cert-manager",
+						KeytabSecretRef: validSecretKeyRef,
+					},
+					SIG0: &cmacme.ACMEIssuerDNS01ProviderRFC2136SIG0{
+						PublicKey:           "update.example.com. IN KEY 512 3 13 AAAA",
+						PrivateKeySecretRef: validSecretKeyRef,
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("rfc2136", "gssTSIG"), "may not be specified together with tsigKeyName"),
+				field.Forbidden(fldPath.Child("rfc2136", "gssTSIG"), "may not be specified together with sig0"),
+				field.Forbidden(fldPath.Child("rfc2136", "sig0"), "may not be specified together with tsigKeyName"),
+			},
+		},
 		"multiple providers configured": {
 			cfg: &cmacme.ACMEChallengeSolverDNS01{
 				CloudDNS: &cmacme.ACMEIssuerDNS01ProviderCloudDNS{
@@ -1844,6 +2260,78 @@ func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 				field.Forbidden(fldPath.Child("cloudflare"), "may not specify more than one provider type"),
 			},
 		},
+		"valid powerdns config": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				PowerDNS: &cmacme.ACMEIssuerDNS01ProviderPowerDNS{
+					Host:   "https://pdns.example.com:8081",
+					APIKey: validSecretKeyRef,
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"missing powerdns host and api key": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				PowerDNS: &cmacme.ACMEIssuerDNS01ProviderPowerDNS{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("powerDNS", "host"), ""),
+				field.Required(fldPath.Child("powerDNS", "apiKeySecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("powerDNS", "apiKeySecretRef", "key"), "secret key is required"),
+			},
+		},
+		"invalid powerdns host and ca bundle": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				PowerDNS: &cmacme.ACMEIssuerDNS01ProviderPowerDNS{
+					Host:     "pdns.example.com:8081",
+					APIKey:   validSecretKeyRef,
+					CABundle: []byte("not a certificate"),
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("powerDNS", "host"), "pdns.example.com:8081", "host must be an http or https URL"),
+				field.Invalid(fldPath.Child("powerDNS", "caBundle"), "", "cert bundle didn't contain any valid certificates"),
+			},
+		},
+		"multiple providers configured with powerdns": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				DigitalOcean: &cmacme.ACMEIssuerDNS01ProviderDigitalOcean{
+					Token: validSecretKeyRef,
+				},
+				PowerDNS: &cmacme.ACMEIssuerDNS01ProviderPowerDNS{
+					Host:   "https://pdns.example.com:8081",
+					APIKey: validSecretKeyRef,
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("powerDNS"), "may not specify more than one provider type"),
+			},
+		},
+		"valid validation zone": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				ValidationZone: "acme.example-validation.net.",
+				CloudDNS:       &cmacme.ACMEIssuerDNS01ProviderCloudDNS{Project: "valid"},
+			},
+			errs: []*field.Error{},
+		},
+		"invalid validation zone": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				ValidationZone: "acme_example.net",
+				CloudDNS:       &cmacme.ACMEIssuerDNS01ProviderCloudDNS{Project: "valid"},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("validationZone"), "acme_example.net", strings.Join(validation.IsDNS1123Subdomain("acme_example.net"), ", ")),
+			},
+		},
+		"validation zone with cname strategy": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				CNAMEStrategy:  cmacme.FollowStrategy,
+				ValidationZone: "acme.example-validation.net",
+				CloudDNS:       &cmacme.ACMEIssuerDNS01ProviderCloudDNS{Project: "valid"},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("cnameStrategy"), "may not be specified together with validationZone"),
+			},
+		},
 		"valid nameservers with ip:port": {
 			cfg: &cmacme.ACMEChallengeSolverDNS01{
 				Nameservers: []string{"8.8.8.8:53", "1.1.1.1:53"},
@@ -1865,6 +2353,13 @@ func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 			},
 			errs: []*field.Error{},
 		},
+		"valid nameservers with dot address": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Nameservers: []string{"tls://dns.example.com", "tls://1.1.1.1:853", "tls://[2606:4700:4700::1111]:853"},
+				CloudDNS:    &cmacme.ACMEIssuerDNS01ProviderCloudDNS{Project: "valid"},
+			},
+			errs: []*field.Error{},
+		},
 		"invalid nameserver missing port": {
 			cfg: &cmacme.ACMEChallengeSolverDNS01{
 				Nameservers: []string{"8.8.8.8"},
@@ -1883,6 +2378,24 @@ func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 				field.Invalid(fldPath.Child("nameservers").Index(0), "https://", "must be in the format https://<DoH RFC 8484 server address>"),
 			},
 		},
+		"invalid nameserver dot address missing host": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Nameservers: []string{"tls://:853"},
+				CloudDNS:    &cmacme.ACMEIssuerDNS01ProviderCloudDNS{Project: "valid"},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("nameservers").Index(0), "tls://:853", "must be in the format tls://<DoT RFC 7858 server address>[:<port>]"),
+			},
+		},
+		"invalid nameserver dot address with path": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Nameservers: []string{"tls://dns.example.com/dns-query"},
+				CloudDNS:    &cmacme.ACMEIssuerDNS01ProviderCloudDNS{Project: "valid"},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("nameservers").Index(0), "tls://dns.example.com/dns-query", "must be in the format tls://<DoT RFC 7858 server address>[:<port>]"),
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -2079,6 +2592,48 @@ func TestValidateVenafiIssuerConfig(t *testing.T) {
 	}
 }
 
+func TestValidateExternalSignerIssuerConfig(t *testing.T) {
+	fldPath := field.NewPath("test")
+	scenarios := map[string]struct {
+		cfg  *cmapi.ExternalSignerIssuer
+		errs []*field.Error
+	}{
+		"valid": {
+			cfg: &cmapi.ExternalSignerIssuer{
+				Name: "my-signer",
+				Config: &apiextensionsv1.JSON{
+					Raw: []byte(`{"profile":"server"}`),
+				},
+			},
+		},
+		"missing name": {
+			cfg: &cmapi.ExternalSignerIssuer{},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("name"), ""),
+			},
+		},
+		"name with a path separator": {
+			cfg: &cmapi.ExternalSignerIssuer{
+				Name: "../my-signer",
+			},
+			errs: func() []*field.Error {
+				var errs []*field.Error
+				for _, msg := range validation.IsDNS1123Label("../my-signer") {
+					errs = append(errs, field.Invalid(fldPath.Child("name"), "../my-signer", msg))
+				}
+				return errs
+			}(),
+		},
+	}
+
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateExternalSignerIssuerConfig(s.cfg, fldPath)
+			assert.ElementsMatch(t, s.errs, errs)
+		})
+	}
+}
+
 func TestValidateVenafiTPP(t *testing.T) {
 	caBundle := unitcrypto.MustCreateCryptoBundle(t,
 		&pubcmapi.Certificate{Spec: pubcmapi.CertificateSpec{CommonName: "test"}},
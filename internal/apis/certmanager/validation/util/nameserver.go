@@ -67,7 +67,8 @@ func ValidNameserver(nameserver string) (string, error) {
 }
 
 // ValidDNS01Nameserver validates a DNS01 nameserver entry. Each entry must be
-// either <ip address>:<port> for plain DNS or https://<host> for DNS-over-HTTPS (RFC 8484).
+// either <ip address>:<port> for plain DNS, https://<host> for DNS-over-HTTPS (RFC 8484)
+// or tls://<host>[:<port>] for DNS-over-TLS (RFC 7858).
 func ValidDNS01Nameserver(nameserver string) error {
 	if strings.HasPrefix(nameserver, "https://") {
 		u, err := url.ParseRequestURI(nameserver)
@@ -76,6 +77,13 @@ func ValidDNS01Nameserver(nameserver string) error {
 		}
 		return nil
 	}
+	if strings.HasPrefix(nameserver, "tls://") {
+		u, err := url.Parse(nameserver)
+		if err != nil || u.Hostname() == "" || u.Path != "" || u.User != nil || u.RawQuery != "" {
+			return fmt.Errorf("must be in the format tls://<DoT RFC 7858 server address>[:<port>]")
+		}
+		return nil
+	}
 	if _, _, err := net.SplitHostPort(nameserver); err != nil {
 		return fmt.Errorf("must be in the format <ip address>:<port>")
 	}
@@ -45,6 +45,10 @@ var Funcs = func(codecs runtimeserializer.CodecFactory) []any {
 				s.CopiedAnnotationPrefixes = []string{"test-roundtrip"}
 			}
 
+			if s.ExternalSignerSocketDirectory == "" {
+				s.ExternalSignerSocketDirectory = "test-roundtrip"
+			}
+
 			if s.MetricsListenAddress == "" {
 				s.MetricsListenAddress = "test-roundtrip"
 			}
@@ -119,6 +123,14 @@ var Funcs = func(codecs runtimeserializer.CodecFactory) []any {
 				s.ACMEDNS01Config.CheckRetryPeriod = time.Second * 8875
 			}
 
+			if s.OCSPResponderConfig.ListenAddress == "" {
+				s.OCSPResponderConfig.ListenAddress = "test-roundtrip"
+			}
+
+			if s.OCSPResponderConfig.ResponseDuration == time.Duration(0) {
+				s.OCSPResponderConfig.ResponseDuration = time.Second * 8875
+			}
+
 			// The deprecated top-level fields are always overwritten by the defaulter
 			// to mirror the canonical GatewayAPIConfig fields, so keep them in sync here
 			// to ensure the round-trip produces an identical object.
@@ -76,6 +76,11 @@ type ControllerConfiguration struct {
 	// notably including any EC2 IAM roles available via instance metadata.
 	ClusterIssuerAmbientCredentials bool
 
+	// Directory containing the unix sockets of external signer plugins. An
+	// Issuer with spec.externalSigner.name set to 'foo' is served by the
+	// plugin listening on the socket 'foo.sock' in this directory.
+	ExternalSignerSocketDirectory string
+
 	// Whether to set the certificate resource as an owner of secret where the
 	// tls certificate is stored. When this flag is enabled, the secret will be
 	// automatically removed when the certificate resource is deleted.
@@ -103,6 +108,21 @@ type ControllerConfiguration struct {
 	// ones where the key is prefixed with 'kubectl.kubernetes.io/'.
 	CopiedAnnotationPrefixes []string
 
+	// Namespaces, other than the Certificate's own, which Certificates may
+	// publish their public certificate data to using spec.configMapTargets,
+	// keyed by the namespace of the Certificate. ConfigMap targets in any
+	// other namespace are not written, and ConfigMaps which already exist
+	// are only written if they were created for the same Certificate.
+	ConfigMapTargetNamespaces map[string][]string
+
+	// Namespaces, other than the Certificate's own, which Certificates may
+	// write copies of their truststore Secret to using
+	// spec.keystores.truststore.namespaces, keyed by the namespace of the
+	// Certificate. Copies in any other namespace are not written, and Secrets
+	// which already exist are only written if they were created for the same
+	// Certificate.
+	TruststoreSecretNamespaces map[string][]string
+
 	// The number of concurrent workers for each controller.
 	NumberOfConcurrentWorkers int
 
@@ -149,6 +169,13 @@ type ControllerConfiguration struct {
 	// GatewayAPIConfig configures the behaviour of the Gateway API integration
 	GatewayAPIConfig GatewayAPIConfig
 
+	// OCSPResponderConfig configures the OCSP responder for CA issuers
+	OCSPResponderConfig OCSPResponderConfig
+
+	// KeyProviderConfig configures the key providers which can hold the
+	// private keys of Certificates
+	KeyProviderConfig KeyProviderConfig
+
 	// CertificateRequestMinimumBackoffDuration configures the minimum backoff duration
 	// when a certificate request fails (default 1h). The backoff delay starts at
 	// this value and is exponentially increased with each consecutive failure,
@@ -279,6 +306,36 @@ type GatewayAPIConfig struct {
 	ExtraProtocols []string
 }
 
+type OCSPResponderConfig struct {
+	// Enabled specifies whether the controller should serve RFC 6960 OCSP
+	// responses for certificates issued by CA Issuers and ClusterIssuers.
+	// The responder runs on every controller replica, regardless of leader
+	// election.
+	Enabled bool
+
+	// ListenAddress is the host and port that the OCSP responder should
+	// listen on.
+	ListenAddress string
+
+	// ResponseDuration is the validity period of each OCSP response, i.e.
+	// the time between its thisUpdate and nextUpdate fields.
+	ResponseDuration time.Duration
+}
+
+type KeyProviderConfig struct {
+	// LocalDirectory is the path of a directory in which the `local` key
+	// provider stores private keys. The `local` provider stands in for a
+	// hardware security module or KMS and is intended for testing only.
+	// If empty, the `local` key provider is disabled.
+	LocalDirectory string
+
+	// Plugins maps the names of out-of-process key providers to the paths
+	// of the unix sockets on which they serve the KeyProvider gRPC service.
+	// Certificates refer to these providers by name in
+	// spec.privateKey.provider.
+	Plugins map[string]string
+}
+
 type PEMSizeLimitsConfig struct {
 	// Maximum size for a single PEM-encoded certificate (in bytes).
 	// Defaults to 36500 bytes.
@@ -28,11 +28,13 @@ import (
 	sharedv1alpha1 "github.com/cert-manager/cert-manager/pkg/apis/config/shared/v1alpha1"
 	challengescontroller "github.com/cert-manager/cert-manager/pkg/controller/acmechallenges"
 	orderscontroller "github.com/cert-manager/cert-manager/pkg/controller/acmeorders"
+	cacrlcontroller "github.com/cert-manager/cert-manager/pkg/controller/cacrl"
 	shimgatewaycontroller "github.com/cert-manager/cert-manager/pkg/controller/certificate-shim/gateways"
 	shimingresscontroller "github.com/cert-manager/cert-manager/pkg/controller/certificate-shim/ingresses"
 	cracmecontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/acme"
 	crapprovercontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/approver"
 	crcacontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/ca"
+	crexternalsignercontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/externalsigner"
 	crselfsignedcontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/selfsigned"
 	crvaultcontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/vault"
 	crvenaficontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/venafi"
@@ -42,6 +44,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/readiness"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/requestmanager"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/revisionmanager"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/revocation"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/trigger"
 	csracmecontroller "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/acme"
 	csrcacontroller "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/ca"
@@ -68,6 +71,8 @@ var (
 	defaultClusterIssuerAmbientCredentials = true
 	defaultIssuerAmbientCredentials        = false
 
+	defaultExternalSignerSocketDirectory = "/var/run/cert-manager/external-signers"
+
 	defaultTLSACMEIssuerName           = ""
 	defaultTLSACMEIssuerKind           = "Issuer"
 	defaultTLSACMEIssuerGroup          = cm.GroupName
@@ -90,6 +95,10 @@ var (
 	// https://github.com/kubernetes/kubernetes/blob/806b30170c61a38fedd54cc9ede4cd6275a1ad3b/cmd/kube-controller-manager/app/controllermanager.go#L202-L209
 	defaultHealthzLeaderElectionTimeout = 20 * time.Second
 
+	defaultEnableOCSPResponder           = false
+	defaultOCSPResponderListenAddress    = "0.0.0.0:9408"
+	defaultOCSPResponderResponseDuration = time.Hour
+
 	// default time period to wait between checking DNS01 and HTTP01 challenge propagation
 	defaultACMEHTTP01SolverImage                 = fmt.Sprintf("quay.io/jetstack/cert-manager-acmesolver:%s", util.AppVersion)
 	defaultACMEHTTP01SolverResourceRequestCPU    = "10m"
@@ -126,6 +135,8 @@ var (
 		crselfsignedcontroller.CRControllerName,
 		crvaultcontroller.CRControllerName,
 		crvenaficontroller.CRControllerName,
+		crexternalsignercontroller.CRControllerName,
+		cacrlcontroller.ControllerName,
 		// certificate controllers
 		trigger.ControllerName,
 		issuing.ControllerName,
@@ -133,6 +144,7 @@ var (
 		requestmanager.ControllerName,
 		readiness.ControllerName,
 		revisionmanager.ControllerName,
+		revocation.ControllerName,
 		// experimental CSR controllers
 		csracmecontroller.CSRControllerName,
 		csrcacontroller.CSRControllerName,
@@ -154,6 +166,8 @@ var (
 		crselfsignedcontroller.CRControllerName,
 		crvaultcontroller.CRControllerName,
 		crvenaficontroller.CRControllerName,
+		crexternalsignercontroller.CRControllerName,
+		cacrlcontroller.ControllerName,
 		// certificate controllers
 		trigger.ControllerName,
 		issuing.ControllerName,
@@ -161,6 +175,7 @@ var (
 		requestmanager.ControllerName,
 		readiness.ControllerName,
 		revisionmanager.ControllerName,
+		revocation.ControllerName,
 	}
 
 	ExperimentalCertificateSigningRequestControllers = []string{
@@ -232,6 +247,10 @@ func SetDefaults_ControllerConfiguration(obj *v1alpha1.ControllerConfiguration)
 		obj.ClusterIssuerAmbientCredentials = &defaultClusterIssuerAmbientCredentials
 	}
 
+	if obj.ExternalSignerSocketDirectory == "" {
+		obj.ExternalSignerSocketDirectory = defaultExternalSignerSocketDirectory
+	}
+
 	if obj.EnableCertificateOwnerRef == nil {
 		obj.EnableCertificateOwnerRef = &defaultEnableCertificateOwnerRef
 	}
@@ -375,6 +394,20 @@ func SetDefaults_ACMEDNS01Config(obj *v1alpha1.ACMEDNS01Config) {
 	}
 }
 
+func SetDefaults_OCSPResponderConfig(obj *v1alpha1.OCSPResponderConfig) {
+	if obj.Enabled == nil {
+		obj.Enabled = &defaultEnableOCSPResponder
+	}
+
+	if obj.ListenAddress == "" {
+		obj.ListenAddress = defaultOCSPResponderListenAddress
+	}
+
+	if obj.ResponseDuration.IsZero() {
+		obj.ResponseDuration = sharedv1alpha1.DurationFromTime(defaultOCSPResponderResponseDuration)
+	}
+}
+
 // SetDefaults_PEMSizeLimitsConfig sets default values for PEM size limits configuration.
 // These limits control the maximum sizes for PEM-encoded certificates and keys.
 // For configuration examples, see deploy/examples/controller-config-example.yaml
@@ -89,6 +89,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*controllerv1alpha1.KeyProviderConfig)(nil), (*controller.KeyProviderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KeyProviderConfig_To_controller_KeyProviderConfig(a.(*controllerv1alpha1.KeyProviderConfig), b.(*controller.KeyProviderConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controller.KeyProviderConfig)(nil), (*controllerv1alpha1.KeyProviderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controller_KeyProviderConfig_To_v1alpha1_KeyProviderConfig(a.(*controller.KeyProviderConfig), b.(*controllerv1alpha1.KeyProviderConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*controllerv1alpha1.LeaderElectionConfig)(nil), (*controller.LeaderElectionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LeaderElectionConfig_To_controller_LeaderElectionConfig(a.(*controllerv1alpha1.LeaderElectionConfig), b.(*controller.LeaderElectionConfig), scope)
 	}); err != nil {
@@ -99,6 +109,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*controllerv1alpha1.OCSPResponderConfig)(nil), (*controller.OCSPResponderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OCSPResponderConfig_To_controller_OCSPResponderConfig(a.(*controllerv1alpha1.OCSPResponderConfig), b.(*controller.OCSPResponderConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controller.OCSPResponderConfig)(nil), (*controllerv1alpha1.OCSPResponderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controller_OCSPResponderConfig_To_v1alpha1_OCSPResponderConfig(a.(*controller.OCSPResponderConfig), b.(*controllerv1alpha1.OCSPResponderConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*controllerv1alpha1.PEMSizeLimitsConfig)(nil), (*controller.PEMSizeLimitsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PEMSizeLimitsConfig_To_controller_PEMSizeLimitsConfig(a.(*controllerv1alpha1.PEMSizeLimitsConfig), b.(*controller.PEMSizeLimitsConfig), scope)
 	}); err != nil {
@@ -205,6 +225,7 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_controller_ControllerConfig
 	if err := v1.Convert_Pointer_bool_To_bool(&in.ClusterIssuerAmbientCredentials, &out.ClusterIssuerAmbientCredentials, s); err != nil {
 		return err
 	}
+	out.ExternalSignerSocketDirectory = in.ExternalSignerSocketDirectory
 	if err := v1.Convert_Pointer_bool_To_bool(&in.EnableCertificateOwnerRef, &out.EnableCertificateOwnerRef, s); err != nil {
 		return err
 	}
@@ -215,6 +236,8 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_controller_ControllerConfig
 		return err
 	}
 	out.CopiedAnnotationPrefixes = *(*[]string)(unsafe.Pointer(&in.CopiedAnnotationPrefixes))
+	out.ConfigMapTargetNamespaces = *(*map[string][]string)(unsafe.Pointer(&in.ConfigMapTargetNamespaces))
+	out.TruststoreSecretNamespaces = *(*map[string][]string)(unsafe.Pointer(&in.TruststoreSecretNamespaces))
 	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.NumberOfConcurrentWorkers, &out.NumberOfConcurrentWorkers, s); err != nil {
 		return err
 	}
@@ -247,6 +270,12 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_controller_ControllerConfig
 	if err := Convert_v1alpha1_GatewayAPIConfig_To_controller_GatewayAPIConfig(&in.GatewayAPIConfig, &out.GatewayAPIConfig, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_OCSPResponderConfig_To_controller_OCSPResponderConfig(&in.OCSPResponderConfig, &out.OCSPResponderConfig, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_KeyProviderConfig_To_controller_KeyProviderConfig(&in.KeyProviderConfig, &out.KeyProviderConfig, s); err != nil {
+		return err
+	}
 	if err := sharedv1alpha1.Convert_Pointer_v1alpha1_Duration_To_time_Duration(&in.CertificateRequestMinimumBackoffDuration, &out.CertificateRequestMinimumBackoffDuration, s); err != nil {
 		return err
 	}
@@ -282,6 +311,7 @@ func autoConvert_controller_ControllerConfiguration_To_v1alpha1_ControllerConfig
 	if err := v1.Convert_bool_To_Pointer_bool(&in.ClusterIssuerAmbientCredentials, &out.ClusterIssuerAmbientCredentials, s); err != nil {
 		return err
 	}
+	out.ExternalSignerSocketDirectory = in.ExternalSignerSocketDirectory
 	if err := v1.Convert_bool_To_Pointer_bool(&in.EnableCertificateOwnerRef, &out.EnableCertificateOwnerRef, s); err != nil {
 		return err
 	}
@@ -292,6 +322,8 @@ func autoConvert_controller_ControllerConfiguration_To_v1alpha1_ControllerConfig
 		return err
 	}
 	out.CopiedAnnotationPrefixes = *(*[]string)(unsafe.Pointer(&in.CopiedAnnotationPrefixes))
+	out.ConfigMapTargetNamespaces = *(*map[string][]string)(unsafe.Pointer(&in.ConfigMapTargetNamespaces))
+	out.TruststoreSecretNamespaces = *(*map[string][]string)(unsafe.Pointer(&in.TruststoreSecretNamespaces))
 	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.NumberOfConcurrentWorkers, &out.NumberOfConcurrentWorkers, s); err != nil {
 		return err
 	}
@@ -324,6 +356,12 @@ func autoConvert_controller_ControllerConfiguration_To_v1alpha1_ControllerConfig
 	if err := Convert_controller_GatewayAPIConfig_To_v1alpha1_GatewayAPIConfig(&in.GatewayAPIConfig, &out.GatewayAPIConfig, s); err != nil {
 		return err
 	}
+	if err := Convert_controller_OCSPResponderConfig_To_v1alpha1_OCSPResponderConfig(&in.OCSPResponderConfig, &out.OCSPResponderConfig, s); err != nil {
+		return err
+	}
+	if err := Convert_controller_KeyProviderConfig_To_v1alpha1_KeyProviderConfig(&in.KeyProviderConfig, &out.KeyProviderConfig, s); err != nil {
+		return err
+	}
 	if err := sharedv1alpha1.Convert_time_Duration_To_Pointer_v1alpha1_Duration(&in.CertificateRequestMinimumBackoffDuration, &out.CertificateRequestMinimumBackoffDuration, s); err != nil {
 		return err
 	}
@@ -398,6 +436,28 @@ func Convert_controller_IngressShimConfig_To_v1alpha1_IngressShimConfig(in *cont
 	return autoConvert_controller_IngressShimConfig_To_v1alpha1_IngressShimConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_KeyProviderConfig_To_controller_KeyProviderConfig(in *controllerv1alpha1.KeyProviderConfig, out *controller.KeyProviderConfig, s conversion.Scope) error {
+	out.LocalDirectory = in.LocalDirectory
+	out.Plugins = *(*map[string]string)(unsafe.Pointer(&in.Plugins))
+	return nil
+}
+
+// Convert_v1alpha1_KeyProviderConfig_To_controller_KeyProviderConfig is an autogenerated conversion function.
+func Convert_v1alpha1_KeyProviderConfig_To_controller_KeyProviderConfig(in *controllerv1alpha1.KeyProviderConfig, out *controller.KeyProviderConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KeyProviderConfig_To_controller_KeyProviderConfig(in, out, s)
+}
+
+func autoConvert_controller_KeyProviderConfig_To_v1alpha1_KeyProviderConfig(in *controller.KeyProviderConfig, out *controllerv1alpha1.KeyProviderConfig, s conversion.Scope) error {
+	out.LocalDirectory = in.LocalDirectory
+	out.Plugins = *(*map[string]string)(unsafe.Pointer(&in.Plugins))
+	return nil
+}
+
+// Convert_controller_KeyProviderConfig_To_v1alpha1_KeyProviderConfig is an autogenerated conversion function.
+func Convert_controller_KeyProviderConfig_To_v1alpha1_KeyProviderConfig(in *controller.KeyProviderConfig, out *controllerv1alpha1.KeyProviderConfig, s conversion.Scope) error {
+	return autoConvert_controller_KeyProviderConfig_To_v1alpha1_KeyProviderConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_LeaderElectionConfig_To_controller_LeaderElectionConfig(in *controllerv1alpha1.LeaderElectionConfig, out *controller.LeaderElectionConfig, s conversion.Scope) error {
 	if err := sharedv1alpha1.Convert_v1alpha1_LeaderElectionConfig_To_shared_LeaderElectionConfig(&in.LeaderElectionConfig, &out.LeaderElectionConfig, s); err != nil {
 		return err
@@ -428,6 +488,38 @@ func Convert_controller_LeaderElectionConfig_To_v1alpha1_LeaderElectionConfig(in
 	return autoConvert_controller_LeaderElectionConfig_To_v1alpha1_LeaderElectionConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_OCSPResponderConfig_To_controller_OCSPResponderConfig(in *controllerv1alpha1.OCSPResponderConfig, out *controller.OCSPResponderConfig, s conversion.Scope) error {
+	if err := v1.Convert_Pointer_bool_To_bool(&in.Enabled, &out.Enabled, s); err != nil {
+		return err
+	}
+	out.ListenAddress = in.ListenAddress
+	if err := sharedv1alpha1.Convert_Pointer_v1alpha1_Duration_To_time_Duration(&in.ResponseDuration, &out.ResponseDuration, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_OCSPResponderConfig_To_controller_OCSPResponderConfig is an autogenerated conversion function.
+func Convert_v1alpha1_OCSPResponderConfig_To_controller_OCSPResponderConfig(in *controllerv1alpha1.OCSPResponderConfig, out *controller.OCSPResponderConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OCSPResponderConfig_To_controller_OCSPResponderConfig(in, out, s)
+}
+
+func autoConvert_controller_OCSPResponderConfig_To_v1alpha1_OCSPResponderConfig(in *controller.OCSPResponderConfig, out *controllerv1alpha1.OCSPResponderConfig, s conversion.Scope) error {
+	if err := v1.Convert_bool_To_Pointer_bool(&in.Enabled, &out.Enabled, s); err != nil {
+		return err
+	}
+	out.ListenAddress = in.ListenAddress
+	if err := sharedv1alpha1.Convert_time_Duration_To_Pointer_v1alpha1_Duration(&in.ResponseDuration, &out.ResponseDuration, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_controller_OCSPResponderConfig_To_v1alpha1_OCSPResponderConfig is an autogenerated conversion function.
+func Convert_controller_OCSPResponderConfig_To_v1alpha1_OCSPResponderConfig(in *controller.OCSPResponderConfig, out *controllerv1alpha1.OCSPResponderConfig, s conversion.Scope) error {
+	return autoConvert_controller_OCSPResponderConfig_To_v1alpha1_OCSPResponderConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_PEMSizeLimitsConfig_To_controller_PEMSizeLimitsConfig(in *controllerv1alpha1.PEMSizeLimitsConfig, out *controller.PEMSizeLimitsConfig, s conversion.Scope) error {
 	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.MaxCertificateSize, &out.MaxCertificateSize, s); err != nil {
 		return err
@@ -17,11 +17,15 @@ limitations under the License.
 package validation
 
 import (
+	"maps"
 	"net"
+	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	logsapi "k8s.io/component-base/logs/api/v1"
 
@@ -84,6 +88,15 @@ func ValidateControllerConfiguration(cfg *config.ControllerConfiguration, fldPat
 		}
 	}
 
+	if cfg.OCSPResponderConfig.Enabled && cfg.OCSPResponderConfig.ResponseDuration < time.Minute {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("ocspResponderConfig").Child("responseDuration"), cfg.OCSPResponderConfig.ResponseDuration.String(), "must be at least 1m"))
+	}
+
+	allErrors = append(allErrors, validateTargetNamespaces(cfg.ConfigMapTargetNamespaces, fldPath.Child("configMapTargetNamespaces"))...)
+	allErrors = append(allErrors, validateTargetNamespaces(cfg.TruststoreSecretNamespaces, fldPath.Child("truststoreSecretNamespaces"))...)
+
+	allErrors = append(allErrors, validateKeyProviderConfig(&cfg.KeyProviderConfig, fldPath.Child("keyProviderConfig"))...)
+
 	allErrors = append(allErrors, validatePEMSizeLimitsConfig(&cfg.PEMSizeLimitsConfig, fldPath.Child("pemSizeLimitsConfig"))...)
 
 	allErrors = append(allErrors, validateCertificateRequestBackoffConfig(&cfg.CertificateRequestMinimumBackoffDuration, &cfg.CertificateRequestMaximumBackoffDuration, fldPath)...)
@@ -91,6 +104,23 @@ func ValidateControllerConfiguration(cfg *config.ControllerConfiguration, fldPat
 	return allErrors
 }
 
+// validateTargetNamespaces validates a set of namespaces which resources may
+// be written to, keyed by the namespace of the Certificate writing them.
+func validateTargetNamespaces(namespaces map[string][]string, fldPath *field.Path) field.ErrorList {
+	var allErrors field.ErrorList
+	for _, source := range slices.Sorted(maps.Keys(namespaces)) {
+		for _, msg := range validation.IsDNS1123Label(source) {
+			allErrors = append(allErrors, field.Invalid(fldPath.Key(source), source, msg))
+		}
+		for i, target := range namespaces[source] {
+			for _, msg := range validation.IsDNS1123Label(target) {
+				allErrors = append(allErrors, field.Invalid(fldPath.Key(source).Index(i), target, msg))
+			}
+		}
+	}
+	return allErrors
+}
+
 func validatePEMSizeLimitsConfig(cfg *config.PEMSizeLimitsConfig, fldPath *field.Path) field.ErrorList {
 	var allErrors field.ErrorList
 
@@ -157,3 +187,21 @@ func validateCertificateRequestBackoffConfig(minBackoff, maxBackoff *time.Durati
 
 	return allErrors
 }
+
+// validateKeyProviderConfig checks that key provider plugins have names
+// which do not collide with the built-in `local` provider, and absolute
+// socket paths.
+func validateKeyProviderConfig(cfg *config.KeyProviderConfig, fldPath *field.Path) field.ErrorList {
+	var allErrors field.ErrorList
+
+	for _, name := range slices.Sorted(maps.Keys(cfg.Plugins)) {
+		if name == "" || name == "local" {
+			allErrors = append(allErrors, field.Invalid(fldPath.Child("plugins"), name, "must be a non-empty name other than \"local\""))
+		}
+		if socketPath := cfg.Plugins[name]; !filepath.IsAbs(socketPath) {
+			allErrors = append(allErrors, field.Invalid(fldPath.Child("plugins").Key(name), socketPath, "must be an absolute path"))
+		}
+	}
+
+	return allErrors
+}
@@ -237,6 +237,57 @@ func TestValidateControllerConfiguration(t *testing.T) {
 				}
 			},
 		},
+		{
+			"with invalid ocsp responder response duration",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:  1,
+				KubernetesAPIQPS:    1,
+				PEMSizeLimitsConfig: validPEMSizeLimitsConfig(),
+				OCSPResponderConfig: config.OCSPResponderConfig{
+					Enabled:          true,
+					ListenAddress:    "0.0.0.0:9408",
+					ResponseDuration: time.Second,
+				},
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("ocspResponderConfig.responseDuration"), "1s", "must be at least 1m"),
+				}
+			},
+		},
+		{
+			"with invalid key provider plugins",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:  1,
+				KubernetesAPIQPS:    1,
+				PEMSizeLimitsConfig: validPEMSizeLimitsConfig(),
+				KeyProviderConfig: config.KeyProviderConfig{
+					Plugins: map[string]string{
+						"hsm":   "/run/hsm/keyprovider.sock",
+						"kms":   "kms.sock",
+						"local": "/run/local.sock",
+					},
+				},
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("keyProviderConfig.plugins").Key("kms"), "kms.sock", "must be an absolute path"),
+					field.Invalid(field.NewPath("keyProviderConfig.plugins"), "local", "must be a non-empty name other than \"local\""),
+				}
+			},
+		},
 		{
 			"with valid acme http solver nameservers",
 			&config.ControllerConfiguration{
@@ -299,6 +350,8 @@ func TestValidateControllerConfiguration(t *testing.T) {
 					RecursiveNameservers: []string{
 						"1.1.1.1:53",
 						"https://example.com",
+						"tls://example.com",
+						"tls://1.1.1.1:853",
 					},
 				},
 			},
@@ -587,3 +640,37 @@ func TestValidateCertificateRequestBackoffConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTargetNamespaces(t *testing.T) {
+	tests := []struct {
+		name       string
+		namespaces map[string][]string
+		errs       field.ErrorList
+	}{
+		{
+			"with no namespaces",
+			nil,
+			nil,
+		},
+		{
+			"with valid namespaces",
+			map[string][]string{"team-a": {"shared"}, "team-b": {"shared", "monitoring"}},
+			nil,
+		},
+		{
+			"with invalid namespaces",
+			map[string][]string{"Team-A": {"shared", "shared/ns"}},
+			field.ErrorList{
+				field.Invalid(field.NewPath("configMapTargetNamespaces").Key("Team-A"), "Team-A", "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"),
+				field.Invalid(field.NewPath("configMapTargetNamespaces").Key("Team-A").Index(1), "shared/ns", "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := validateTargetNamespaces(test.namespaces, field.NewPath("configMapTargetNamespaces"))
+			assert.ElementsMatch(t, test.errs, errs)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"crypto/x509"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/errors"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// CSRForCertificate returns the user supplied certificate signing request of a
+// Certificate with spec.csr set, reading it from the referenced Secret if
+// needed. The signature of the CSR is verified.
+// If the Secret does not exist, the error from the lister is returned as is.
+// If the CSR is missing or cannot be decoded, an invalid data error is
+// returned.
+func CSRForCertificate(secretLister internalinformers.SecretLister, crt *cmapi.Certificate) (*x509.CertificateRequest, error) {
+	csrPEM := crt.Spec.CSR.Request
+
+	if ref := crt.Spec.CSR.SecretRef; ref != nil {
+		secret, err := secretLister.Secrets(crt.Namespace).Get(ref.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		key := ref.Key
+		if key == "" {
+			key = cmapi.CertificateCSRDefaultSecretKey
+		}
+
+		var ok bool
+		csrPEM, ok = secret.Data[key]
+		if !ok {
+			return nil, errors.NewInvalidData("secret %q does not contain key %q", ref.Name, key)
+		}
+	}
+
+	csr, err := utilpki.DecodeSignedX509CertificateRequestBytes(csrPEM)
+	if err != nil {
+		if errors.IsInvalidData(err) {
+			return nil, err
+		}
+		return nil, errors.NewInvalidData("error decoding certificate request: %s", err)
+	}
+
+	return csr, nil
+}
@@ -19,8 +19,11 @@ package policies
 import (
 	"bytes"
 	"cmp"
+	"context"
+	"crypto"
 	"crypto/x509"
 	"fmt"
+	"maps"
 	"slices"
 	"strings"
 	"time"
@@ -28,6 +31,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -38,9 +42,30 @@ import (
 	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
+// usesCSR returns true if the Certificate uses a user supplied CSR, in which
+// case cert-manager does not hold the private key for the certificate in the
+// Secret.
+func usesCSR(input Input) bool {
+	return input.Certificate != nil && input.Certificate.Spec.CSR != nil
+}
+
+// secretPrivateKey decodes the private key stored in the Secret. Keys held by
+// a KeyProvider are resolved for the Certificate, as their handles can only be
+// used by the Certificate the key was generated for.
+func secretPrivateKey(input Input) (crypto.Signer, error) {
+	var crtName types.NamespacedName
+	if input.Certificate != nil {
+		crtName = types.NamespacedName{Namespace: input.Certificate.Namespace, Name: input.Certificate.Name}
+	}
+	// Policies are evaluated without a context, so calls to a KeyProvider
+	// cannot be cancelled.
+	return pki.DecodeCertificatePrivateKeyBytes(context.Background(), crtName, input.Secret.Data[corev1.TLSPrivateKeyKey])
+}
+
 func SecretDoesNotExist(input Input) (string, string, bool) {
 	if input.Secret == nil {
 		return DoesNotExist, "Issuing certificate as Secret does not exist", true
@@ -54,7 +79,7 @@ func SecretIsMissingData(input Input) (string, string, bool) {
 	}
 	pkData := input.Secret.Data[corev1.TLSPrivateKeyKey]
 	certData := input.Secret.Data[corev1.TLSCertKey]
-	if len(pkData) == 0 {
+	if len(pkData) == 0 && !usesCSR(input) {
 		return MissingData, "Issuing certificate as Secret does not contain a private key", true
 	}
 	if len(certData) == 0 {
@@ -64,7 +89,11 @@ func SecretIsMissingData(input Input) (string, string, bool) {
 }
 
 func SecretPublicKeysDiffer(input Input) (string, string, bool) {
-	pk, err := pki.DecodePrivateKeyBytes(input.Secret.Data[corev1.TLSPrivateKeyKey])
+	if usesCSR(input) {
+		return secretCertificateDiffersFromCSR(input)
+	}
+
+	pk, err := secretPrivateKey(input)
 	if err != nil {
 		return InvalidKeyPair, fmt.Sprintf("Issuing certificate as Secret contains invalid private key data: %v", err), true
 	}
@@ -84,8 +113,35 @@ func SecretPublicKeysDiffer(input Input) (string, string, bool) {
 	return "", "", false
 }
 
+// secretCertificateDiffersFromCSR is the counterpart of SecretPublicKeysDiffer
+// for Certificates using a user supplied CSR, and checks that the certificate
+// in the Secret was issued for the public key of the CSR.
+func secretCertificateDiffersFromCSR(input Input) (string, string, bool) {
+	x509Cert, err := pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return InvalidCertificate, fmt.Sprintf("Issuing certificate as Secret contains an invalid certificate: %v", err), true
+	}
+	if input.CSR == nil {
+		return "", "", false
+	}
+
+	equal, err := pki.PublicKeysEqual(x509Cert.PublicKey, input.CSR.PublicKey)
+	if err != nil {
+		return InvalidKeyPair, fmt.Sprintf("Secret contains an invalid certificate public key: %v", err), true
+	}
+	if !equal {
+		return InvalidKeyPair, "Issuing certificate as Secret contains a certificate that does not match the public key of spec.csr", true
+	}
+
+	return "", "", false
+}
+
 func SecretPrivateKeyMismatchesSpec(input Input) (string, string, bool) {
-	pk, err := pki.DecodePrivateKeyBytes(input.Secret.Data[corev1.TLSPrivateKeyKey])
+	if usesCSR(input) {
+		return "", "", false
+	}
+
+	pk, err := secretPrivateKey(input)
 	if err != nil {
 		return InvalidKeyPair, fmt.Sprintf("Issuing certificate as Secret contains invalid private key data: %v", err), true
 	}
@@ -103,6 +159,8 @@ func SecretPrivateKeyMismatchesSpec(input Input) (string, string, bool) {
 // corresponding secrets are generated.
 // If the private key rotation is set to "Never", the key store related values are re-encoded
 // as per the certificate specification
+// When a separate truststore Secret is configured, the truststores must not
+// be present in the Certificate's Secret.
 func SecretKeystoreFormatMismatch(input Input) (string, string, bool) {
 	_, issuerProvidesCA := input.Secret.Data[cmmeta.TLSCAKey]
 
@@ -116,6 +174,16 @@ func SecretKeystoreFormatMismatch(input Input) (string, string, bool) {
 		return "", "", false
 	}
 
+	// Truststores are expected in the Certificate's Secret only if no separate
+	// truststore Secret is configured.
+	if input.Certificate.Spec.Keystores.Truststore != nil {
+		if len(input.Secret.Data[cmapi.PKCS12TruststoreKey]) != 0 ||
+			len(input.Secret.Data[cmapi.JKSTruststoreKey]) != 0 {
+			return SecretMismatch, "Truststore is configured to be written to a separate Secret", true
+		}
+		issuerProvidesCA = false
+	}
+
 	if input.Certificate.Spec.Keystores.JKS != nil {
 		if input.Certificate.Spec.Keystores.JKS.Create {
 			if len(input.Secret.Data[cmapi.JKSSecretKey]) == 0 ||
@@ -187,10 +255,10 @@ func SecretCertificateNameAnnotationsMismatch(input Input) (string, string, bool
 // Secret being changed outside of the control of cert-manager, causing the current CertificateRequest
 // to no longer match what is stored in the Secret.
 func SecretPublicKeyDiffersFromCurrentCertificateRequest(input Input) (string, string, bool) {
-	if input.CurrentRevisionRequest == nil {
+	if input.CurrentRevisionRequest == nil || usesCSR(input) {
 		return "", "", false
 	}
-	pk, err := pki.DecodePrivateKeyBytes(input.Secret.Data[corev1.TLSPrivateKeyKey])
+	pk, err := secretPrivateKey(input)
 	if err != nil {
 		return InvalidKeyPair, fmt.Sprintf("Issuing certificate as Secret contains invalid private key data: %v", err), true
 	}
@@ -232,6 +300,16 @@ func CurrentCertificateRequestMismatchesSpec(input Input) (string, string, bool)
 		return RequestChanged, fmt.Sprintf("Fields on existing CertificateRequest resource not up to date: %v", violations), true
 	}
 
+	if usesCSR(input) && input.CSR != nil {
+		csr, err := pki.DecodeX509CertificateRequestBytes(input.CurrentRevisionRequest.Spec.Request)
+		if err != nil {
+			return "", "", false
+		}
+		if !bytes.Equal(csr.Raw, input.CSR.Raw) {
+			return RequestChanged, "Existing CertificateRequest resource does not contain the CSR in spec.csr", true
+		}
+	}
+
 	return "", "", false
 }
 
@@ -243,6 +321,12 @@ func currentSecretValidForSpec(input Input) (string, string, bool) {
 	if err != nil {
 		return InvalidCertificate, fmt.Sprintf("Issuing certificate as Secret contains an invalid certificate: %v", err), true
 	}
+	if usesCSR(input) {
+		// The names of a certificate issued for a user supplied CSR are not
+		// part of the spec. Its public key has already been compared with the
+		// CSR by SecretPublicKeysDiffer.
+		return "", "", false
+	}
 	//nolint: staticcheck // FuzzyX509AltNamesMatchSpec is used here for backwards compatibility
 	violations := pki.FuzzyX509AltNamesMatchSpec(x509Cert, input.Certificate.Spec)
 	if len(violations) > 0 {
@@ -339,6 +423,29 @@ func CurrentCertificateHasExpired(c clock.Clock) Func {
 	}
 }
 
+// CurrentCertificateRevoked returns a policy violation if the certificate in
+// the Secret has been revoked by the issuer, as recorded in the Certificate's
+// `status.revocations`.
+func CurrentCertificateRevoked(input Input) (string, string, bool) {
+	if len(input.Certificate.Status.Revocations) == 0 {
+		return "", "", false
+	}
+
+	x509Cert, err := pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return InvalidCertificate, fmt.Sprintf("Issuing certificate as Secret contains an invalid certificate: %v", err), true
+	}
+
+	serialNumber := x509Cert.SerialNumber.Text(16)
+	for _, revocation := range input.Certificate.Status.Revocations {
+		if revocation.SerialNumber == serialNumber && revocation.Status == cmmetav1.ConditionTrue {
+			return Revoked, fmt.Sprintf("Issuing certificate as the certificate with serial number %s has been revoked", serialNumber), true
+		}
+	}
+
+	return "", "", false
+}
+
 func formatIssuerRef(name, kind, group string) string {
 	if group == "" {
 		group = "cert-manager.io"
@@ -455,10 +562,11 @@ func SecretManagedLabelsAndAnnotationsManagedFieldsMismatch(fieldManager string)
 
 		// Ignore the CertificateName and IssuerRef annotations as these cannot be set by the postIssuance controller.
 		managedAnnotations.Delete(
-			cmapi.CertificateNameKey,       // SecretCertificateNameAnnotationMismatch checks the value
-			cmapi.IssuerNameAnnotationKey,  // SecretIssuerAnnotationsMismatch checks the value
-			cmapi.IssuerKindAnnotationKey,  // SecretIssuerAnnotationsMismatch checks the value
-			cmapi.IssuerGroupAnnotationKey, // SecretIssuerAnnotationsMismatch checks the value
+			cmapi.CertificateNameKey,                  // SecretCertificateNameAnnotationMismatch checks the value
+			cmapi.IssuerNameAnnotationKey,             // SecretIssuerAnnotationsMismatch checks the value
+			cmapi.IssuerKindAnnotationKey,             // SecretIssuerAnnotationsMismatch checks the value
+			cmapi.IssuerGroupAnnotationKey,            // SecretIssuerAnnotationsMismatch checks the value
+			cmapi.EncryptedPKCS8ChecksumAnnotationKey, // SecretAdditionalOutputFormatsMismatch checks the value
 		)
 
 		// Remove the non cert-manager labels from the managed labels so we can compare
@@ -621,6 +729,7 @@ func SecretCertificateDetailsAnnotationsMismatch(input Input) (string, string, b
 // the following:
 //   - Secret key is missing
 //   - Secret value is incorrect
+//   - Secret value was not encrypted using the current password
 func SecretAdditionalOutputFormatsMismatch(input Input) (string, string, bool) {
 	const message = "Certificate's AdditionalOutputFormats doesn't match Secret Data"
 	for _, format := range input.Certificate.Spec.AdditionalOutputFormats {
@@ -639,12 +748,51 @@ func SecretAdditionalOutputFormatsMismatch(input Input) (string, string, bool) {
 			if !ok || !bytes.Equal(v, internalcertificates.OutputFormatDER(input.Secret.Data[corev1.TLSPrivateKeyKey])) {
 				return AdditionalOutputFormatsMismatch, message, true
 			}
+
+		case cmapi.CertificateOutputFormatEncryptedPKCS8:
+			// The encryption is randomised, so the checksum recorded when the
+			// value was written is compared instead. The checksum covers the
+			// password, so a password rotation causes a mismatch.
+			v, ok := input.Secret.Data[cmapi.CertificateOutputFormatEncryptedPKCS8Key]
+			if !ok || input.Secret.Annotations[cmapi.EncryptedPKCS8ChecksumAnnotationKey] != internalcertificates.EncryptedPKCS8Checksum(
+				v,
+				input.Secret.Data[corev1.TLSPrivateKeyKey],
+				input.EncryptedPKCS8Password,
+			) {
+				return AdditionalOutputFormatsMismatch, message, true
+			}
+
+		case cmapi.CertificateOutputFormatPKCS7, cmapi.CertificateOutputFormatFullChainPEM:
+			// Both formats contain ca.crt as well as tls.crt, so a changed
+			// chain is detected even if the leaf certificate is unchanged.
+			outputFormat := internalcertificates.OutputFormatPKCS7
+			if format.Type == cmapi.CertificateOutputFormatFullChainPEM {
+				outputFormat = internalcertificates.OutputFormatFullChainPEM
+			}
+			want, err := outputFormat(input.Secret.Data[corev1.TLSCertKey], input.Secret.Data[cmmeta.TLSCAKey])
+			if err != nil {
+				return AdditionalOutputFormatsMismatch, message, true
+			}
+			v, ok := input.Secret.Data[additionalOutputFormatKeys[format.Type]]
+			if !ok || !bytes.Equal(v, want) {
+				return AdditionalOutputFormatsMismatch, message, true
+			}
 		}
 	}
 
 	return "", "", false
 }
 
+// additionalOutputFormatKeys maps each additional output format to the
+// Secret Data key it is written to.
+var additionalOutputFormatKeys = map[cmapi.CertificateOutputFormatType]string{
+	cmapi.CertificateOutputFormatCombinedPEM:    cmapi.CertificateOutputFormatCombinedPEMKey,
+	cmapi.CertificateOutputFormatDER:            cmapi.CertificateOutputFormatDERKey,
+	cmapi.CertificateOutputFormatEncryptedPKCS8: cmapi.CertificateOutputFormatEncryptedPKCS8Key,
+	cmapi.CertificateOutputFormatPKCS7:          cmapi.CertificateOutputFormatPKCS7Key,
+	cmapi.CertificateOutputFormatFullChainPEM:   cmapi.CertificateOutputFormatFullChainPEMKey,
+}
+
 // SecretAdditionalOutputFormatsManagedFieldsMismatch validates that the field manager
 // owns the correct Certificate's AdditionalOutputFormats in the Secret.
 // Returns true (violation) if:
@@ -656,19 +804,14 @@ func SecretAdditionalOutputFormatsMismatch(input Input) (string, string, bool) {
 func SecretAdditionalOutputFormatsManagedFieldsMismatch(fieldManager string) Func {
 	const message = "Certificate's AdditionalOutputFormats doesn't match Secret ManagedFields"
 	return func(input Input) (string, string, bool) {
-		var (
-			crtHasCombinedPEM, crtHasDER       bool
-			secretHasCombinedPEM, secretHasDER bool
-		)
+		crtFormats := sets.New[cmapi.CertificateOutputFormatType]()
+		secretFormats := sets.New[cmapi.CertificateOutputFormatType]()
 
 		// Gather which additional output formats have been defined on the
 		// Certificate.
 		for _, format := range input.Certificate.Spec.AdditionalOutputFormats {
-			switch format.Type {
-			case cmapi.CertificateOutputFormatCombinedPEM:
-				crtHasCombinedPEM = true
-			case cmapi.CertificateOutputFormatDER:
-				crtHasDER = true
+			if _, ok := additionalOutputFormatKeys[format.Type]; ok {
+				crtFormats.Insert(format.Type)
 			}
 		}
 
@@ -684,24 +827,19 @@ func SecretAdditionalOutputFormatsManagedFieldsMismatch(fieldManager string) Fun
 				return ManagedFieldsParseError, fmt.Sprintf("failed to decode managed fields on Secret: %s", err), true
 			}
 
-			if fieldset.Has(fieldpath.Path{
-				{FieldName: new("data")},
-				{FieldName: ptr.To(cmapi.CertificateOutputFormatCombinedPEMKey)},
-			}) {
-				secretHasCombinedPEM = true
-			}
-
-			if fieldset.Has(fieldpath.Path{
-				{FieldName: new("data")},
-				{FieldName: ptr.To(cmapi.CertificateOutputFormatDERKey)},
-			}) {
-				secretHasDER = true
+			for formatType, key := range additionalOutputFormatKeys {
+				if fieldset.Has(fieldpath.Path{
+					{FieldName: new("data")},
+					{FieldName: ptr.To(key)},
+				}) {
+					secretFormats.Insert(formatType)
+				}
 			}
 		}
 
 		// Format present or missing on the Certificate should be reflected on the
 		// Secret.
-		if crtHasCombinedPEM != secretHasCombinedPEM || crtHasDER != secretHasDER {
+		if !crtFormats.Equal(secretFormats) {
 			return AdditionalOutputFormatsMismatch, message, true
 		}
 
@@ -813,3 +951,92 @@ func mapsHaveMatchingValues[Key cmp.Ordered, Value comparable](a, b map[Key]Valu
 	var zero Key
 	return true, zero
 }
+
+// SecretTruststoreSecretsMismatch validates that the Certificate's truststore
+// Secrets hold the truststores for the CA certificate of the Certificate's
+// Secret. Truststore Secrets are only written if the Secret has a CA
+// certificate.
+// Returns true (violation) if any of the truststore Secrets:
+//   - does not exist
+//   - has a `ca.crt` value which differs from the Secret
+//   - is missing the truststore of a keystore which is enabled, or has the
+//     truststore of a keystore which is not
+//
+// It also returns true (violation) if a truststore Secret which was written
+// for the Certificate is no longer configured, so that it is deleted.
+func SecretTruststoreSecretsMismatch(input Input) (string, string, bool) {
+	if len(input.RemovedTruststoreSecrets) > 0 {
+		return TruststoreSecretMismatch, fmt.Sprintf("Truststore Secret %s is no longer configured", input.RemovedTruststoreSecrets[0]), true
+	}
+
+	ca := input.Secret.Data[cmmetav1.TLSCAKey]
+	if len(ca) == 0 {
+		return "", "", false
+	}
+
+	keystores := input.Certificate.Spec.Keystores
+	expectedTruststores := map[string]bool{
+		cmapi.JKSTruststoreKey:    keystores != nil && keystores.JKS != nil && keystores.JKS.Create,
+		cmapi.PKCS12TruststoreKey: keystores != nil && keystores.PKCS12 != nil && keystores.PKCS12.Create,
+	}
+
+	keys := slices.SortedFunc(maps.Keys(input.TruststoreSecrets), func(a, b types.NamespacedName) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	for _, key := range keys {
+		secret := input.TruststoreSecrets[key]
+		if secret == nil {
+			return TruststoreSecretMismatch, fmt.Sprintf("Truststore Secret %s does not exist", key), true
+		}
+
+		if !bytes.Equal(secret.Data[cmmetav1.TLSCAKey], ca) {
+			return TruststoreSecretMismatch, fmt.Sprintf("Truststore Secret %s has a %s value which differs from the Secret", key, cmmetav1.TLSCAKey), true
+		}
+
+		for _, truststoreKey := range []string{cmapi.JKSTruststoreKey, cmapi.PKCS12TruststoreKey} {
+			if expectedTruststores[truststoreKey] != (len(secret.Data[truststoreKey]) > 0) {
+				return TruststoreSecretMismatch, fmt.Sprintf("Truststore Secret %s has an unexpected set of truststores", key), true
+			}
+		}
+	}
+
+	return "", "", false
+}
+
+// SecretConfigMapTargetsMismatch validates that the Certificate's ConfigMap
+// targets hold the public certificate data of the Certificate's Secret.
+// Returns true (violation) if any of the ConfigMap targets:
+//   - does not exist
+//   - has a `tls.crt` value which differs from the Secret
+//   - has a `ca.crt` value which differs from the Secret, including when
+//     only one of the two has a `ca.crt` value
+//
+// It also returns true (violation) if a ConfigMap which was written for the
+// Certificate is no longer one of its ConfigMap targets, so that it is
+// deleted.
+func SecretConfigMapTargetsMismatch(input Input) (string, string, bool) {
+	if len(input.RemovedConfigMapTargets) > 0 {
+		return ConfigMapTargetMismatch, fmt.Sprintf("ConfigMap %s is no longer a ConfigMap target", input.RemovedConfigMapTargets[0]), true
+	}
+
+	keys := slices.SortedFunc(maps.Keys(input.ConfigMapTargets), func(a, b types.NamespacedName) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	for _, key := range keys {
+		configMap := input.ConfigMapTargets[key]
+		if configMap == nil {
+			return ConfigMapTargetMismatch, fmt.Sprintf("ConfigMap target %s does not exist", key), true
+		}
+
+		if configMap.Data[corev1.TLSCertKey] != string(input.Secret.Data[corev1.TLSCertKey]) {
+			return ConfigMapTargetMismatch, fmt.Sprintf("ConfigMap target %s has a %s value which differs from the Secret", key, corev1.TLSCertKey), true
+		}
+
+		ca, hasCA := configMap.Data[cmmetav1.TLSCAKey]
+		if hasCA != (len(input.Secret.Data[cmmetav1.TLSCAKey]) > 0) || ca != string(input.Secret.Data[cmmetav1.TLSCAKey]) {
+			return ConfigMapTargetMismatch, fmt.Sprintf("ConfigMap target %s has a %s value which differs from the Secret", key, cmmetav1.TLSCAKey), true
+		}
+	}
+
+	return "", "", false
+}
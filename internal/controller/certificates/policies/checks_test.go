@@ -17,6 +17,8 @@ limitations under the License.
 package policies
 
 import (
+	"crypto/x509"
+	"fmt"
 	"testing"
 	"time"
 
@@ -26,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	fakeclock "k8s.io/utils/clock/testing"
 
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/pem"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -42,11 +45,61 @@ import (
 func Test_NewTriggerPolicyChain(t *testing.T) {
 	clock := &fakeclock.FakeClock{}
 	staticFixedPrivateKey := testcrypto.MustCreatePEMPrivateKey(t)
+	revokedCertPEM := testcrypto.MustCreateCert(t, staticFixedPrivateKey,
+		&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+	)
+	revokedCert, err := pki.DecodeX509CertificateBytes(revokedCertPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	revokedSerialNumber := revokedCert.SerialNumber.Text(16)
+	userCSRPEM := testcrypto.MustGenerateCSRImpl(t, staticFixedPrivateKey, &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}})
+	userCSR, err := pki.DecodeX509CertificateRequestBytes(userCSRPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherCSRPEM := testcrypto.MustGenerateCSRImpl(t, testcrypto.MustCreatePEMPrivateKey(t), &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}})
+	otherCSR, err := pki.DecodeX509CertificateRequestBytes(otherCSRPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	userCSRCertificate := &cmapi.Certificate{
+		Spec: cmapi.CertificateSpec{
+			IssuerRef: cmmeta.IssuerReference{
+				Name:  "testissuer",
+				Kind:  "IssuerKind",
+				Group: "group.example.com",
+			},
+			CSR:         &cmapi.CertificateCSRSource{Request: userCSRPEM},
+			RenewBefore: &metav1.Duration{Duration: time.Minute * 1},
+		},
+		Status: cmapi.CertificateStatus{
+			RenewalTime: &metav1.Time{Time: clock.Now().Add(time.Minute)},
+		},
+	}
+	userCSRSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "something",
+			Annotations: map[string]string{
+				cmapi.IssuerNameAnnotationKey:  "testissuer",
+				cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+				cmapi.IssuerGroupAnnotationKey: "group.example.com",
+			},
+		},
+		Data: map[string][]byte{
+			corev1.TLSPrivateKeyKey: {},
+			corev1.TLSCertKey: testcrypto.MustCreateCertWithNotBeforeAfter(t, staticFixedPrivateKey,
+				&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+				clock.Now().Add(time.Minute*-30),
+				clock.Now().Add(time.Minute*5),
+			),
+		},
+	}
 	tests := map[string]struct {
 		// policy inputs
 		certificate *cmapi.Certificate
 		request     *cmapi.CertificateRequest
 		secret      *corev1.Secret
+		csr         *x509.CertificateRequest
 
 		// expected outputs
 		reason, message string
@@ -242,6 +295,36 @@ func Test_NewTriggerPolicyChain(t *testing.T) {
 			message: "Existing private key is not up to date for spec: [spec.privateKey.algorithm]",
 			reissue: true,
 		},
+		"trigger issuance as Ed448 private key does not meet the requested key algorithm": {
+			certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+				SecretName: "something",
+				PrivateKey: &cmapi.CertificatePrivateKey{Algorithm: cmapi.Ed25519KeyAlgorithm},
+			}},
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "something"},
+				Data: func() map[string][]byte {
+					pk, err := pki.GenerateEd448PrivateKey()
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					pkData, err := pki.EncodePrivateKey(pk, cmapi.PKCS8)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					return map[string][]byte{
+						corev1.TLSPrivateKeyKey: pkData,
+						corev1.TLSCertKey: testcrypto.MustCreateCert(
+							t, pkData,
+							&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+						),
+					}
+				}(),
+			},
+			reason:  SecretMismatch,
+			message: "Existing private key is not up to date for spec: [spec.privateKey.algorithm]",
+			reissue: true,
+		},
 		"trigger if the Secret contains a different private key than was used to sign the CSR": {
 			certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{SecretName: "something"}},
 			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "something"},
@@ -401,6 +484,69 @@ func Test_NewTriggerPolicyChain(t *testing.T) {
 				},
 			},
 		},
+		"trigger issuance if the certificate in the Secret has been revoked": {
+			certificate: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					CommonName: "example.com",
+					IssuerRef: cmmeta.IssuerReference{
+						Name:  "testissuer",
+						Kind:  "IssuerKind",
+						Group: "group.example.com",
+					},
+				},
+				Status: cmapi.CertificateStatus{
+					Revocations: []cmapi.CertificateRevocation{
+						{SerialNumber: revokedSerialNumber, Reason: "keyCompromise", Status: cmmeta.ConditionTrue},
+					},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "something",
+					Annotations: map[string]string{
+						cmapi.IssuerNameAnnotationKey:  "testissuer",
+						cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+						cmapi.IssuerGroupAnnotationKey: "group.example.com",
+					},
+				},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+					corev1.TLSCertKey:       revokedCertPEM,
+				},
+			},
+			reason:  Revoked,
+			message: fmt.Sprintf("Issuing certificate as the certificate with serial number %s has been revoked", revokedSerialNumber),
+			reissue: true,
+		},
+		"do nothing if the revocation of the certificate in the Secret has not completed": {
+			certificate: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					CommonName: "example.com",
+					IssuerRef: cmmeta.IssuerReference{
+						Name:  "testissuer",
+						Kind:  "IssuerKind",
+						Group: "group.example.com",
+					},
+				},
+				Status: cmapi.CertificateStatus{
+					Revocations: []cmapi.CertificateRevocation{
+						{SerialNumber: revokedSerialNumber, Reason: "keyCompromise", Status: cmmeta.ConditionFalse},
+					},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "something",
+					Annotations: map[string]string{
+						cmapi.IssuerNameAnnotationKey:  "testissuer",
+						cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+						cmapi.IssuerGroupAnnotationKey: "group.example.com",
+					},
+				},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+					corev1.TLSCertKey:       revokedCertPEM,
+				},
+			},
+		},
 		"trigger renewal if renewalTime is right now": {
 			certificate: &cmapi.Certificate{
 				Spec: cmapi.CertificateSpec{
@@ -579,6 +725,35 @@ func Test_NewTriggerPolicyChain(t *testing.T) {
 				},
 			},
 		},
+		"does not trigger issuance for a Certificate using spec.csr without a private key in the Secret": {
+			certificate: userCSRCertificate,
+			secret:      userCSRSecret,
+			csr:         userCSR,
+			request: &cmapi.CertificateRequest{Spec: cmapi.CertificateRequestSpec{
+				IssuerRef: userCSRCertificate.Spec.IssuerRef,
+				Request:   userCSRPEM,
+			}},
+		},
+		"trigger issuance for a Certificate using spec.csr if the certificate in the Secret does not match the CSR": {
+			certificate: userCSRCertificate,
+			secret:      userCSRSecret,
+			csr:         otherCSR,
+			reason:      InvalidKeyPair,
+			message:     "Issuing certificate as Secret contains a certificate that does not match the public key of spec.csr",
+			reissue:     true,
+		},
+		"trigger issuance for a Certificate using spec.csr if the current CertificateRequest does not contain the CSR": {
+			certificate: userCSRCertificate,
+			secret:      userCSRSecret,
+			csr:         userCSR,
+			request: &cmapi.CertificateRequest{Spec: cmapi.CertificateRequestSpec{
+				IssuerRef: userCSRCertificate.Spec.IssuerRef,
+				Request:   otherCSRPEM,
+			}},
+			reason:  RequestChanged,
+			message: "Existing CertificateRequest resource does not contain the CSR in spec.csr",
+			reissue: true,
+		},
 	}
 	policyChain := NewTriggerPolicyChain(clock)
 	for name, test := range tests {
@@ -587,6 +762,7 @@ func Test_NewTriggerPolicyChain(t *testing.T) {
 				Certificate:            test.certificate,
 				CurrentRevisionRequest: test.request,
 				Secret:                 test.secret,
+				CSR:                    test.csr,
 			})
 
 			if test.reason != reason {
@@ -1134,6 +1310,38 @@ func Test_SecretAdditionalOutputFormatsMismatch(t *testing.T) {
 	pkDER := block.Bytes
 	combinedPEM := append(append(pk, '\n'), cert...)
 
+	encryptedPK, err := internalcertificates.OutputFormatEncryptedPKCS8(pk, []byte("password"))
+	if err != nil {
+		t.Fatalf("got unexpected error encrypting private key: %s", err)
+	}
+	encryptedPKAnnotations := map[string]string{
+		cmapi.EncryptedPKCS8ChecksumAnnotationKey: internalcertificates.EncryptedPKCS8Checksum(encryptedPK, pk, []byte("password")),
+	}
+	certWithEncryptedPKCS8 := &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+		AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+			{Type: "EncryptedPKCS8", PasswordSecretRef: &cmmeta.SecretKeySelector{
+				LocalObjectReference: cmmeta.LocalObjectReference{Name: "key-password"}, Key: "password",
+			}},
+		}},
+	}
+
+	leafCert := testcrypto.MustCreateCert(t, pk, &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}})
+	caCert := testcrypto.MustCreateCert(t, testcrypto.MustCreatePEMPrivateKey(t), &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "ca"}})
+	rotatedCACert := testcrypto.MustCreateCert(t, testcrypto.MustCreatePEMPrivateKey(t), &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "rotated-ca"}})
+	fullChainPEM, err := internalcertificates.OutputFormatFullChainPEM(leafCert, caCert)
+	if err != nil {
+		t.Fatalf("got unexpected error encoding full chain: %s", err)
+	}
+	pkcs7Bundle, err := internalcertificates.OutputFormatPKCS7(leafCert, caCert)
+	if err != nil {
+		t.Fatalf("got unexpected error encoding PKCS#7 bundle: %s", err)
+	}
+	certWithChainFormats := &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+		AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+			{Type: "PKCS7"},
+			{Type: "FullChainPEM"},
+		}},
+	}
 	tests := map[string]struct {
 		input        Input
 		expReason    string
@@ -1380,6 +1588,104 @@ func Test_SecretAdditionalOutputFormatsMismatch(t *testing.T) {
 			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret Data",
 			expViolation: true,
 		},
+		"if additional output has encrypted pkcs8 and Secret has a key encrypted with the current password, should return false": {
+			input: Input{
+				Certificate:            certWithEncryptedPKCS8,
+				Secret:                 &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Annotations: encryptedPKAnnotations}, Data: map[string][]byte{"tls.crt": cert, "tls.key": pk, "tls-encrypted.key": encryptedPK}},
+				EncryptedPKCS8Password: []byte("password"),
+			},
+			expReason:    "",
+			expMessage:   "",
+			expViolation: false,
+		},
+		"if additional output has encrypted pkcs8 and Secret has no encrypted key, should return true": {
+			input: Input{
+				Certificate:            certWithEncryptedPKCS8,
+				Secret:                 &corev1.Secret{Data: map[string][]byte{"tls.crt": cert, "tls.key": pk}},
+				EncryptedPKCS8Password: []byte("password"),
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret Data",
+			expViolation: true,
+		},
+		"if additional output has encrypted pkcs8 and the password has been rotated, should return true": {
+			input: Input{
+				Certificate:            certWithEncryptedPKCS8,
+				Secret:                 &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Annotations: encryptedPKAnnotations}, Data: map[string][]byte{"tls.crt": cert, "tls.key": pk, "tls-encrypted.key": encryptedPK}},
+				EncryptedPKCS8Password: []byte("rotated-password"),
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret Data",
+			expViolation: true,
+		},
+		"if additional output has encrypted pkcs8 and the encrypted key is for a different private key, should return true": {
+			input: Input{
+				Certificate:            certWithEncryptedPKCS8,
+				Secret:                 &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Annotations: encryptedPKAnnotations}, Data: map[string][]byte{"tls.crt": cert, "tls.key": testcrypto.MustCreatePEMPrivateKey(t), "tls-encrypted.key": encryptedPK}},
+				EncryptedPKCS8Password: []byte("password"),
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret Data",
+			expViolation: true,
+		},
+		"if additional output has encrypted pkcs8 and Secret has no checksum annotation, should return true": {
+			input: Input{
+				Certificate:            certWithEncryptedPKCS8,
+				Secret:                 &corev1.Secret{Data: map[string][]byte{"tls.crt": cert, "tls.key": pk, "tls-encrypted.key": encryptedPK}},
+				EncryptedPKCS8Password: []byte("password"),
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret Data",
+			expViolation: true,
+		},
+		"if additional output has pkcs7 and full chain pem and Secret has both for the current chain, should return false": {
+			input: Input{
+				Certificate: certWithChainFormats,
+				Secret: &corev1.Secret{Data: map[string][]byte{
+					"tls.crt": leafCert, "tls.key": pk, "ca.crt": caCert, "tls.p7b": pkcs7Bundle, "fullchain.pem": fullChainPEM,
+				}},
+			},
+			expReason:    "",
+			expMessage:   "",
+			expViolation: false,
+		},
+		"if additional output has pkcs7 and full chain pem and Secret has no full chain, should return true": {
+			input: Input{
+				Certificate: certWithChainFormats,
+				Secret: &corev1.Secret{Data: map[string][]byte{
+					"tls.crt": leafCert, "tls.key": pk, "ca.crt": caCert, "tls.p7b": pkcs7Bundle,
+				}},
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret Data",
+			expViolation: true,
+		},
+		"if additional output has full chain pem and the CA changed but the leaf did not, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{{Type: "FullChainPEM"}},
+				}},
+				Secret: &corev1.Secret{Data: map[string][]byte{
+					"tls.crt": leafCert, "tls.key": pk, "ca.crt": rotatedCACert, "fullchain.pem": fullChainPEM,
+				}},
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret Data",
+			expViolation: true,
+		},
+		"if additional output has pkcs7 and the CA changed but the leaf did not, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{{Type: "PKCS7"}},
+				}},
+				Secret: &corev1.Secret{Data: map[string][]byte{
+					"tls.crt": leafCert, "tls.key": pk, "ca.crt": rotatedCACert, "tls.p7b": pkcs7Bundle,
+				}},
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret Data",
+			expViolation: true,
+		},
 	}
 
 	for name, test := range tests {
@@ -1780,6 +2086,109 @@ func Test_SecretAdditionalOutputFormatsManagedFieldsMismatch(t *testing.T) {
 			expMessage:   "",
 			expViolation: false,
 		},
+		"if additional output formats has encrypted pkcs8 and secret has no managed fields, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+						{Type: "EncryptedPKCS8"},
+					}},
+				},
+				Secret: &corev1.Secret{},
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret ManagedFields",
+			expViolation: true,
+		},
+		"if additional output formats has encrypted pkcs8 and secret has managed fields for encrypted pkcs8, should return false": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+						{Type: "EncryptedPKCS8"},
+					}},
+				},
+				Secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						ManagedFields: []metav1.ManagedFieldsEntry{
+							{Manager: fieldManager, FieldsV1: metav1.NewFieldsV1(`
+							{"f:data": {
+								".": {},
+								"f:tls-encrypted.key": {}
+							}}`)},
+						},
+					},
+				},
+			},
+			expReason:    "",
+			expMessage:   "",
+			expViolation: false,
+		},
+		"if additional output formats is empty and secret has managed fields for encrypted pkcs8, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{},
+				Secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						ManagedFields: []metav1.ManagedFieldsEntry{
+							{Manager: fieldManager, FieldsV1: metav1.NewFieldsV1(`
+							{"f:data": {
+								".": {},
+								"f:tls-encrypted.key": {}
+							}}`)},
+						},
+					},
+				},
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret ManagedFields",
+			expViolation: true,
+		},
+		"if additional output formats has pkcs7 and full chain pem and secret has managed fields for both, should return false": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+						{Type: "PKCS7"},
+						{Type: "FullChainPEM"},
+					}},
+				},
+				Secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						ManagedFields: []metav1.ManagedFieldsEntry{
+							{Manager: fieldManager, FieldsV1: metav1.NewFieldsV1(`
+							{"f:data": {
+								".": {},
+								"f:tls.p7b": {},
+								"f:fullchain.pem": {}
+							}}`)},
+						},
+					},
+				},
+			},
+			expReason:    "",
+			expMessage:   "",
+			expViolation: false,
+		},
+		"if additional output formats has pkcs7 and secret has managed fields for full chain pem, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+						{Type: "PKCS7"},
+					}},
+				},
+				Secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						ManagedFields: []metav1.ManagedFieldsEntry{
+							{Manager: fieldManager, FieldsV1: metav1.NewFieldsV1(`
+							{"f:data": {
+								".": {},
+								"f:fullchain.pem": {}
+							}}`)},
+						},
+					},
+				},
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret ManagedFields",
+			expViolation: true,
+		},
 	}
 
 	for name, test := range tests {
@@ -2276,3 +2685,280 @@ func Test_SecretCertificateNameAnnotationsMismatch(t *testing.T) {
 		})
 	}
 }
+
+func Test_SecretConfigMapTargetsMismatch(t *testing.T) {
+	secret := &corev1.Secret{
+		Data: map[string][]byte{
+			"tls.crt": []byte("cert"),
+			"tls.key": []byte("key"),
+			"ca.crt":  []byte("ca"),
+		},
+	}
+	target := types.NamespacedName{Namespace: "frontend", Name: "public-chain"}
+
+	tests := map[string]struct {
+		input        Input
+		expReason    string
+		expMessage   string
+		expViolation bool
+	}{
+		"if there are no ConfigMap targets, should return false": {
+			input: Input{
+				Certificate: &cmapi.Certificate{},
+				Secret:      secret,
+			},
+			expReason:    "",
+			expMessage:   "",
+			expViolation: false,
+		},
+		"if the ConfigMap target matches the Secret, should return false": {
+			input: Input{
+				Certificate: &cmapi.Certificate{},
+				Secret:      secret,
+				ConfigMapTargets: map[types.NamespacedName]*corev1.ConfigMap{
+					target: {Data: map[string]string{"tls.crt": "cert", "ca.crt": "ca"}},
+				},
+			},
+			expReason:    "",
+			expMessage:   "",
+			expViolation: false,
+		},
+		"if the ConfigMap target does not exist, should return true": {
+			input: Input{
+				Certificate:      &cmapi.Certificate{},
+				Secret:           secret,
+				ConfigMapTargets: map[types.NamespacedName]*corev1.ConfigMap{target: nil},
+			},
+			expReason:    "ConfigMapTargetMismatch",
+			expMessage:   "ConfigMap target frontend/public-chain does not exist",
+			expViolation: true,
+		},
+		"if a ConfigMap is no longer a ConfigMap target, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{},
+				Secret:      secret,
+				ConfigMapTargets: map[types.NamespacedName]*corev1.ConfigMap{
+					target: {Data: map[string]string{"tls.crt": "cert", "ca.crt": "ca"}},
+				},
+				RemovedConfigMapTargets: []types.NamespacedName{{Namespace: "frontend", Name: "old-chain"}},
+			},
+			expReason:    "ConfigMapTargetMismatch",
+			expMessage:   "ConfigMap frontend/old-chain is no longer a ConfigMap target",
+			expViolation: true,
+		},
+		"if the ConfigMap target has a different certificate, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{},
+				Secret:      secret,
+				ConfigMapTargets: map[types.NamespacedName]*corev1.ConfigMap{
+					target: {Data: map[string]string{"tls.crt": "old-cert", "ca.crt": "ca"}},
+				},
+			},
+			expReason:    "ConfigMapTargetMismatch",
+			expMessage:   "ConfigMap target frontend/public-chain has a tls.crt value which differs from the Secret",
+			expViolation: true,
+		},
+		"if the ConfigMap target is missing the CA, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{},
+				Secret:      secret,
+				ConfigMapTargets: map[types.NamespacedName]*corev1.ConfigMap{
+					target: {Data: map[string]string{"tls.crt": "cert"}},
+				},
+			},
+			expReason:    "ConfigMapTargetMismatch",
+			expMessage:   "ConfigMap target frontend/public-chain has a ca.crt value which differs from the Secret",
+			expViolation: true,
+		},
+		"if the ConfigMap target has a CA but the Secret does not, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{},
+				Secret:      &corev1.Secret{Data: map[string][]byte{"tls.crt": []byte("cert")}},
+				ConfigMapTargets: map[types.NamespacedName]*corev1.ConfigMap{
+					target: {Data: map[string]string{"tls.crt": "cert", "ca.crt": ""}},
+				},
+			},
+			expReason:    "ConfigMapTargetMismatch",
+			expMessage:   "ConfigMap target frontend/public-chain has a ca.crt value which differs from the Secret",
+			expViolation: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotReason, gotMessage, gotViolation := SecretConfigMapTargetsMismatch(test.input)
+			assert.Equal(t, test.expReason, gotReason)
+			assert.Equal(t, test.expMessage, gotMessage)
+			assert.Equal(t, test.expViolation, gotViolation)
+		})
+	}
+}
+
+func Test_SecretKeystoreFormatMismatch_Truststore(t *testing.T) {
+	crt := &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+		Keystores: &cmapi.CertificateKeystores{
+			JKS:        &cmapi.JKSKeystore{Create: true},
+			Truststore: &cmapi.CertificateTruststore{SecretName: "truststore"},
+		},
+	}}
+
+	tests := map[string]struct {
+		input        Input
+		expReason    string
+		expMessage   string
+		expViolation bool
+	}{
+		"if a truststore Secret is configured and the Secret has a keystore but no truststore, should return false": {
+			input: Input{
+				Certificate: crt,
+				Secret:      &corev1.Secret{Data: map[string][]byte{"ca.crt": []byte("ca"), "keystore.jks": []byte("keystore")}},
+			},
+			expReason:    "",
+			expMessage:   "",
+			expViolation: false,
+		},
+		"if a truststore Secret is configured and the Secret still has a truststore, should return true": {
+			input: Input{
+				Certificate: crt,
+				Secret:      &corev1.Secret{Data: map[string][]byte{"ca.crt": []byte("ca"), "keystore.jks": []byte("keystore"), "truststore.jks": []byte("truststore")}},
+			},
+			expReason:    "SecretMismatch",
+			expMessage:   "Truststore is configured to be written to a separate Secret",
+			expViolation: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotReason, gotMessage, gotViolation := SecretKeystoreFormatMismatch(test.input)
+			assert.Equal(t, test.expReason, gotReason)
+			assert.Equal(t, test.expMessage, gotMessage)
+			assert.Equal(t, test.expViolation, gotViolation)
+		})
+	}
+}
+
+func Test_SecretTruststoreSecretsMismatch(t *testing.T) {
+	crt := &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+		Keystores: &cmapi.CertificateKeystores{
+			JKS:        &cmapi.JKSKeystore{Create: true},
+			Truststore: &cmapi.CertificateTruststore{SecretName: "truststore"},
+		},
+	}}
+	secret := &corev1.Secret{
+		Data: map[string][]byte{
+			"tls.crt": []byte("cert"),
+			"tls.key": []byte("key"),
+			"ca.crt":  []byte("ca"),
+		},
+	}
+	truststore := types.NamespacedName{Namespace: "frontend", Name: "truststore"}
+
+	tests := map[string]struct {
+		input        Input
+		expReason    string
+		expMessage   string
+		expViolation bool
+	}{
+		"if there are no truststore Secrets, should return false": {
+			input: Input{
+				Certificate: &cmapi.Certificate{},
+				Secret:      secret,
+			},
+			expReason:    "",
+			expMessage:   "",
+			expViolation: false,
+		},
+		"if the truststore Secret matches the Secret, should return false": {
+			input: Input{
+				Certificate: crt,
+				Secret:      secret,
+				TruststoreSecrets: map[types.NamespacedName]*corev1.Secret{
+					truststore: {Data: map[string][]byte{"ca.crt": []byte("ca"), "truststore.jks": []byte("truststore")}},
+				},
+			},
+			expReason:    "",
+			expMessage:   "",
+			expViolation: false,
+		},
+		"if the Secret has no CA, should return false even if the truststore Secret does not exist": {
+			input: Input{
+				Certificate: crt,
+				Secret:      &corev1.Secret{Data: map[string][]byte{"tls.crt": []byte("cert"), "tls.key": []byte("key")}},
+				TruststoreSecrets: map[types.NamespacedName]*corev1.Secret{
+					truststore: nil,
+				},
+			},
+			expReason:    "",
+			expMessage:   "",
+			expViolation: false,
+		},
+		"if the truststore Secret does not exist, should return true": {
+			input: Input{
+				Certificate: crt,
+				Secret:      secret,
+				TruststoreSecrets: map[types.NamespacedName]*corev1.Secret{
+					truststore: nil,
+				},
+			},
+			expReason:    "TruststoreSecretMismatch",
+			expMessage:   "Truststore Secret frontend/truststore does not exist",
+			expViolation: true,
+		},
+		"if a truststore Secret is no longer configured, should return true even if the Secret has no CA": {
+			input: Input{
+				Certificate:              &cmapi.Certificate{},
+				Secret:                   &corev1.Secret{Data: map[string][]byte{"tls.crt": []byte("cert"), "tls.key": []byte("key")}},
+				RemovedTruststoreSecrets: []types.NamespacedName{{Namespace: "backend", Name: "truststore"}},
+			},
+			expReason:    "TruststoreSecretMismatch",
+			expMessage:   "Truststore Secret backend/truststore is no longer configured",
+			expViolation: true,
+		},
+		"if the truststore Secret has a different CA, should return true": {
+			input: Input{
+				Certificate: crt,
+				Secret:      secret,
+				TruststoreSecrets: map[types.NamespacedName]*corev1.Secret{
+					truststore: {Data: map[string][]byte{"ca.crt": []byte("old-ca"), "truststore.jks": []byte("truststore")}},
+				},
+			},
+			expReason:    "TruststoreSecretMismatch",
+			expMessage:   "Truststore Secret frontend/truststore has a ca.crt value which differs from the Secret",
+			expViolation: true,
+		},
+		"if the truststore Secret is missing the JKS truststore, should return true": {
+			input: Input{
+				Certificate: crt,
+				Secret:      secret,
+				TruststoreSecrets: map[types.NamespacedName]*corev1.Secret{
+					truststore: {Data: map[string][]byte{"ca.crt": []byte("ca")}},
+				},
+			},
+			expReason:    "TruststoreSecretMismatch",
+			expMessage:   "Truststore Secret frontend/truststore has an unexpected set of truststores",
+			expViolation: true,
+		},
+		"if the truststore Secret has a PKCS12 truststore which is not enabled, should return true": {
+			input: Input{
+				Certificate: crt,
+				Secret:      secret,
+				TruststoreSecrets: map[types.NamespacedName]*corev1.Secret{
+					truststore: {Data: map[string][]byte{"ca.crt": []byte("ca"), "truststore.jks": []byte("truststore"), "truststore.p12": []byte("truststore")}},
+				},
+			},
+			expReason:    "TruststoreSecretMismatch",
+			expMessage:   "Truststore Secret frontend/truststore has an unexpected set of truststores",
+			expViolation: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotReason, gotMessage, gotViolation := SecretTruststoreSecretsMismatch(test.input)
+			assert.Equal(t, test.expReason, gotReason)
+			assert.Equal(t, test.expMessage, gotMessage)
+			assert.Equal(t, test.expViolation, gotViolation)
+		})
+	}
+}
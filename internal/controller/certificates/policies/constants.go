@@ -53,6 +53,9 @@ const (
 	// Expired is a policy violation reason for a scenario where Certificate has
 	// expired.
 	Expired string = "Expired"
+	// Revoked is a policy violation reason for a scenario where the
+	// certificate in the Certificate's Secret has been revoked by the issuer.
+	Revoked string = "Revoked"
 	// WindowError is a policy violation for a scenario where a time couldn't be
 	// found due to a cron misconfiguration or validation.
 	WindowError string = "WindowError"
@@ -81,4 +84,12 @@ const (
 	// a missing owner reference to the Certificate, or has an owner reference it
 	// shouldn't have.
 	SecretOwnerRefMismatch string = "SecretOwnerRefMismatch"
+	// ConfigMapTargetMismatch is a policy violation whereby a ConfigMap target
+	// of the Certificate is missing, or does not hold the public certificate
+	// data of the Certificate's Secret.
+	ConfigMapTargetMismatch string = "ConfigMapTargetMismatch"
+	// TruststoreSecretMismatch is a policy violation whereby a truststore
+	// Secret of the Certificate is missing, or does not hold the truststores
+	// for the CA certificate of the Certificate's Secret.
+	TruststoreSecretMismatch string = "TruststoreSecretMismatch"
 )
@@ -209,16 +209,19 @@ package policies
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/util/predicate"
 	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
@@ -241,6 +244,8 @@ type Gatherer struct {
 // "current" or the "next" revision. DataForCertificate does not return any
 // apierrors.NewNotFound; instead, if either of the objects (current CR, next CR
 // or secret) is not found, then the returned value of this object is left nil.
+// The same applies to the user supplied CSR of a Certificate with spec.csr
+// set, which is also left nil if it is invalid.
 func (g *Gatherer) DataForCertificate(ctx context.Context, crt *cmapi.Certificate) (Input, error) {
 	log := logf.FromContext(ctx)
 	// Attempt to fetch the Secret being managed but tolerate NotFound errors.
@@ -314,11 +319,23 @@ func (g *Gatherer) DataForCertificate(ctx context.Context, crt *cmapi.Certificat
 		log.V(logf.DebugLevel).Info("Found no CertificateRequest resources owned by this Certificate for the next revision", "revision", nextCRRevision)
 	}
 
+	var csr *x509.CertificateRequest
+	if crt.Spec.CSR != nil {
+		csr, err = internalcertificates.CSRForCertificate(g.SecretLister, crt)
+		if err != nil && !apierrors.IsNotFound(err) && !cmerrors.IsInvalidData(err) {
+			return Input{}, err
+		}
+		if err != nil {
+			log.V(logf.DebugLevel).Info("The CSR in spec.csr is not available", "error", err.Error())
+		}
+	}
+
 	i := Input{
 		Certificate:            crt,
 		Secret:                 secret,
 		CurrentRevisionRequest: curCR,
 		NextRevisionRequest:    nextCR,
+		CSR:                    csr,
 	}
 
 	// NB: We don't care if issuer has enabled/disabled the ARI feature because there is a null check here.
@@ -18,7 +18,10 @@ limitations under the License.
 package policies
 
 import (
+	"crypto/x509"
+
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/clock"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -42,6 +45,36 @@ type Input struct {
 	// we care about the "next" certificate request.
 	NextRevisionRequest *cmapi.CertificateRequest
 
+	// CSR is the user supplied certificate signing request of a Certificate
+	// with spec.csr set. It is nil if the CSR is not available or invalid.
+	CSR *x509.CertificateRequest
+
+	// EncryptedPKCS8Password is the password referenced by the Certificate's
+	// EncryptedPKCS8 additional output format, if it has one.
+	EncryptedPKCS8Password []byte
+
+	// TruststoreSecrets holds the Certificate's spec.keystores.truststore
+	// Secrets which the controller is permitted to write to, keyed by
+	// namespace and name. A nil value means that the Secret does not exist.
+	// Existing Secrets which were not created for the Certificate are not
+	// included.
+	TruststoreSecrets map[types.NamespacedName]*corev1.Secret
+
+	// RemovedTruststoreSecrets holds the truststore Secrets which were written
+	// for the Certificate, but are no longer among its permitted truststore
+	// Secrets.
+	RemovedTruststoreSecrets []types.NamespacedName
+
+	// ConfigMapTargets holds the Certificate's spec.configMapTargets which the
+	// controller is permitted to write to, keyed by namespace and name. A nil
+	// value means that the ConfigMap does not exist. Existing ConfigMaps which
+	// were not created for the Certificate are not included.
+	ConfigMapTargets map[types.NamespacedName]*corev1.ConfigMap
+
+	// RemovedConfigMapTargets holds the ConfigMaps which were written for the
+	// Certificate, but are no longer among its permitted ConfigMap targets.
+	RemovedConfigMapTargets []types.NamespacedName
+
 	ARIRenewalInfo *acmeapi.RenewalInfoResponse
 }
 
@@ -80,6 +113,7 @@ func NewTriggerPolicyChain(c clock.Clock) Chain {
 		SecretPrivateKeyMismatchesSpec,                      // Make sure the PrivateKey Type and Size match the Certificate spec
 		SecretPublicKeyDiffersFromCurrentCertificateRequest, // Make sure the Secret's PublicKey matches the current CertificateRequest
 		CurrentCertificateRequestMismatchesSpec,             // Make sure the current CertificateRequest matches the Certificate spec
+		CurrentCertificateRevoked,                           // Make sure the Certificate in the Secret has not been revoked
 		CurrentCertificateNearingExpiry(c),                  // Make sure the Certificate in the Secret is not nearing expiry
 	}
 }
@@ -98,13 +132,15 @@ func NewReadinessPolicyChain(c clock.Clock) Chain {
 		SecretPrivateKeyMismatchesSpec,                      // Make sure the PrivateKey Type and Size match the Certificate spec
 		SecretPublicKeyDiffersFromCurrentCertificateRequest, // Make sure the Secret's PublicKey matches the current CertificateRequest
 		CurrentCertificateRequestMismatchesSpec,             // Make sure the current CertificateRequest matches the Certificate spec
+		CurrentCertificateRevoked,                           // Make sure the Certificate in the Secret has not been revoked
 		CurrentCertificateHasExpired(c),                     // Make sure the Certificate in the Secret has not expired
 	}
 }
 
 // NewSecretPostIssuancePolicyChain includes policy checks that are to be
 // performed _after_ issuance has been successful, testing for the presence and
-// correctness of metadata and output formats of Certificate's Secrets.
+// correctness of metadata and output formats of Certificate's Secrets and
+// ConfigMap targets.
 func NewSecretPostIssuancePolicyChain(ownerRefEnabled bool, fieldManager string) Chain {
 	return Chain{
 		SecretBaseLabelsMismatch,                                             // Make sure the managed labels have the correct values
@@ -118,6 +154,9 @@ func NewSecretPostIssuancePolicyChain(ownerRefEnabled bool, fieldManager string)
 		SecretOwnerReferenceManagedFieldMismatch(ownerRefEnabled, fieldManager),
 
 		SecretKeystoreFormatMismatch,
+		SecretTruststoreSecretsMismatch, // Make sure the truststore Secrets hold the truststores for the Secret's CA
+
+		SecretConfigMapTargetsMismatch, // Make sure the ConfigMap targets hold the Secret's public certificate data
 	}
 }
 
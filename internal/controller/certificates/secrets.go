@@ -18,7 +18,12 @@ package certificates
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/binary"
+	"encoding/hex"
+	"slices"
 
 	"github.com/cert-manager/cert-manager/internal/pem"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -102,3 +107,102 @@ func OutputFormatDER(privateKey []byte) []byte {
 func OutputFormatCombinedPEM(privateKey, certificate []byte) []byte {
 	return bytes.Join([][]byte{privateKey, certificate}, []byte("\n"))
 }
+
+// OutputFormatEncryptedPKCS8 returns the PEM encoded private key as a PKCS#8
+// `ENCRYPTED PRIVATE KEY` PEM block, encrypted with the given password. To be
+// used for Certificate's Additional Output Format EncryptedPKCS8.
+func OutputFormatEncryptedPKCS8(privateKey, password []byte) ([]byte, error) {
+	der, err := pkcs8PrivateKeyDER(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return utilpki.EncryptPKCS8PrivateKey(der, password)
+}
+
+// EncryptedPKCS8Checksum returns a checksum of the given encrypted PKCS#8
+// private key, the PEM encoded private key it was created from and the
+// password it was encrypted with. Since the encryption is randomised and
+// decrypting the key is expensive, the checksum is stored alongside the
+// output of OutputFormatEncryptedPKCS8 and compared instead.
+func EncryptedPKCS8Checksum(encrypted, privateKey, password []byte) string {
+	// The password is used as the HMAC key so that it cannot be recovered
+	// from the checksum.
+	mac := hmac.New(sha256.New, password)
+	for _, b := range [][]byte{encrypted, privateKey} {
+		_ = binary.Write(mac, binary.BigEndian, uint64(len(b)))
+		mac.Write(b)
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// OutputFormatFullChainPEM returns the PEM encoded signed certificate chain,
+// followed by any certificates in ca which are not already part of the chain.
+// The private key is not included. To be used for Certificate's Additional
+// Output Format FullChainPEM.
+func OutputFormatFullChainPEM(certificate, ca []byte) ([]byte, error) {
+	certs, err := fullChainCertificates(certificate, ca)
+	if err != nil {
+		return nil, err
+	}
+	var fullChain []byte
+	for _, cert := range certs {
+		certPEM, err := utilpki.EncodeX509(cert)
+		if err != nil {
+			return nil, err
+		}
+		fullChain = append(fullChain, certPEM...)
+	}
+	return fullChain, nil
+}
+
+// OutputFormatPKCS7 returns the same certificates as OutputFormatFullChainPEM
+// as a PEM encoded PKCS#7 certificate bundle. To be used for Certificate's
+// Additional Output Format PKCS7.
+func OutputFormatPKCS7(certificate, ca []byte) ([]byte, error) {
+	certs, err := fullChainCertificates(certificate, ca)
+	if err != nil {
+		return nil, err
+	}
+	return utilpki.EncodePKCS7Certificates(certs)
+}
+
+// fullChainCertificates decodes the signed certificate chain and appends the
+// certificates in ca which do not already appear in it, so that a CA which is
+// both the last certificate in the chain and in ca is only included once.
+func fullChainCertificates(certificate, ca []byte) ([]*x509.Certificate, error) {
+	certs, err := utilpki.DecodeX509CertificateChainBytes(certificate)
+	if err != nil {
+		return nil, err
+	}
+	if len(ca) == 0 {
+		return certs, nil
+	}
+	caCerts, err := utilpki.DecodeX509CertificateSetBytes(ca)
+	if err != nil {
+		return nil, err
+	}
+	for _, caCert := range caCerts {
+		if !slices.ContainsFunc(certs, caCert.Equal) {
+			certs = append(certs, caCert)
+		}
+	}
+	return certs, nil
+}
+
+// pkcs8PrivateKeyDER returns the DER encoded PKCS#8 form of the given PEM
+// encoded private key, regardless of the key's original encoding.
+func pkcs8PrivateKeyDER(privateKey []byte) ([]byte, error) {
+	signer, err := utilpki.DecodePrivateKeyBytes(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	pkcs8PEM, err := utilpki.EncodePKCS8PrivateKey(signer)
+	if err != nil {
+		return nil, err
+	}
+	block, _, err := pem.SafeDecodePrivateKey(pkcs8PEM)
+	if err != nil {
+		return nil, err
+	}
+	return block.Bytes, nil
+}
@@ -21,9 +21,14 @@ import (
 	"crypto/x509/pkix"
 	"net"
 	"net/url"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
 )
 
 func Test_AnnotationsForCertificateSecret(t *testing.T) {
@@ -132,3 +137,62 @@ func Test_AnnotationsForCertificateSecret(t *testing.T) {
 		})
 	}
 }
+
+func Test_OutputFormatFullChainPEM(t *testing.T) {
+	leaf := testcrypto.MustCreateCert(t, testcrypto.MustCreatePEMPrivateKey(t), &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "leaf"}})
+	intermediate := testcrypto.MustCreateCert(t, testcrypto.MustCreatePEMPrivateKey(t), &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "intermediate"}})
+	ca := testcrypto.MustCreateCert(t, testcrypto.MustCreatePEMPrivateKey(t), &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "ca"}})
+	chain := slices.Concat(leaf, intermediate)
+
+	tests := map[string]struct {
+		certificate  []byte
+		ca           []byte
+		expFullChain []byte
+	}{
+		"if no CA is known, expect only the signed certificate chain": {
+			certificate:  chain,
+			ca:           nil,
+			expFullChain: chain,
+		},
+		"if the CA is not part of the chain, expect it to be appended": {
+			certificate:  chain,
+			ca:           ca,
+			expFullChain: slices.Concat(chain, ca),
+		},
+		"if the CA is already the last certificate in the chain, expect it only once": {
+			certificate:  slices.Concat(chain, ca),
+			ca:           ca,
+			expFullChain: slices.Concat(chain, ca),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotFullChain, err := OutputFormatFullChainPEM(test.certificate, test.ca)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expFullChain, gotFullChain)
+
+			gotPKCS7, err := OutputFormatPKCS7(test.certificate, test.ca)
+			assert.NoError(t, err)
+			certs, err := utilpki.DecodeX509CertificateSetBytes(gotFullChain)
+			assert.NoError(t, err)
+			expPKCS7, err := utilpki.EncodePKCS7Certificates(certs)
+			assert.NoError(t, err)
+			assert.Equal(t, expPKCS7, gotPKCS7)
+		})
+	}
+}
+
+func Test_EncryptedPKCS8Checksum(t *testing.T) {
+	checksum := EncryptedPKCS8Checksum([]byte("encrypted"), []byte("key"), []byte("password"))
+	assert.Equal(t, checksum, EncryptedPKCS8Checksum([]byte("encrypted"), []byte("key"), []byte("password")))
+
+	for name, other := range map[string]string{
+		"encrypted key": EncryptedPKCS8Checksum([]byte("re-encrypted"), []byte("key"), []byte("password")),
+		"private key":   EncryptedPKCS8Checksum([]byte("encrypted"), []byte("other-key"), []byte("password")),
+		"password":      EncryptedPKCS8Checksum([]byte("encrypted"), []byte("key"), []byte("rotated-password")),
+		"boundary":      EncryptedPKCS8Checksum([]byte("encryptedk"), []byte("ey"), []byte("password")),
+	} {
+		assert.NotEqual(t, checksum, other, "expected the checksum to change with the %s", name)
+	}
+}
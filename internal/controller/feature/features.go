@@ -43,6 +43,7 @@ const (
 
 	// Owner: N/A
 	// Alpha: v1.4
+	// Beta: v1.21
 	//
 	// ExperimentalCertificateSigningRequestControllers enables all CertificateSigningRequest
 	// controllers that sign Kubernetes CertificateSigningRequest resources
@@ -221,7 +222,7 @@ var defaultCertManagerFeatureGates = map[featuregate.Feature]featuregate.Feature
 	StableCertificateRequestName:       {Default: true, PreRelease: featuregate.Beta},
 	SecretsFilteredCaching:             {Default: true, PreRelease: featuregate.Beta},
 
-	ExperimentalCertificateSigningRequestControllers: {Default: false, PreRelease: featuregate.Alpha},
+	ExperimentalCertificateSigningRequestControllers: {Default: true, PreRelease: featuregate.Beta},
 	ExperimentalGatewayAPISupport:                    {Default: true, PreRelease: featuregate.Beta},
 	ListenerSets:                                     {Default: false, PreRelease: featuregate.Alpha},
 	AdditionalCertificateOutputFormats:               {Default: true, PreRelease: featuregate.GA},
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuers
+
+import (
+	"context"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmapiac "github.com/cert-manager/cert-manager/pkg/client/applyconfigurations/certmanager/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+)
+
+// Finalizers returns the cert-manager finalizers that the given issuer should
+// carry, based on its spec.
+func Finalizers(issuer cmapi.GenericIssuer) []string {
+	if acme := issuer.GetSpec().ACME; acme != nil && acme.DeactivateAccountOnDeletion {
+		return []string{cmacme.ACMEAccountFinalizer}
+	}
+	return nil
+}
+
+// HasFinalizers returns true if the given issuer carries any of the finalizers
+// managed by cert-manager.
+func HasFinalizers(issuer cmapi.GenericIssuer) bool {
+	return slices.Contains(issuer.GetFinalizers(), cmacme.ACMEAccountFinalizer)
+}
+
+// FinalizersUpToDate returns true if the given issuer carries exactly the
+// cert-manager finalizers returned by Finalizers.
+func FinalizersUpToDate(issuer cmapi.GenericIssuer) bool {
+	return HasFinalizers(issuer) == (len(Finalizers(issuer)) > 0)
+}
+
+// ApplyIssuerFinalizers will make an Apply API call with the given client to
+// set the cert-manager finalizers of the Issuer. Finalizers set by other field
+// managers are left untouched.
+// Always sets Force Apply to true.
+func ApplyIssuerFinalizers(ctx context.Context, cl cmclient.Interface, fieldManager string, issuer *cmapi.Issuer, finalizers []string) error {
+	ac := cmapiac.Issuer(issuer.Name, issuer.Namespace).
+		// Set UID to ensure we never create a new issuer.
+		WithUID(issuer.UID).
+		WithFinalizers(finalizers...)
+	if _, err := cl.CertmanagerV1().Issuers(issuer.Namespace).Apply(
+		ctx, ac,
+		metav1.ApplyOptions{Force: true, FieldManager: fieldManager},
+	); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// ApplyClusterIssuerFinalizers will make an Apply API call with the given
+// client to set the cert-manager finalizers of the ClusterIssuer. Finalizers
+// set by other field managers are left untouched.
+// Always sets Force Apply to true.
+func ApplyClusterIssuerFinalizers(ctx context.Context, cl cmclient.Interface, fieldManager string, issuer *cmapi.ClusterIssuer, finalizers []string) error {
+	ac := cmapiac.ClusterIssuer(issuer.Name).
+		// Set UID to ensure we never create a new issuer.
+		WithUID(issuer.UID).
+		WithFinalizers(finalizers...)
+	if _, err := cl.CertmanagerV1().ClusterIssuers().Apply(
+		ctx, ac,
+		metav1.ApplyOptions{Force: true, FieldManager: fieldManager},
+	); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
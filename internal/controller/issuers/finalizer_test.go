@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestFinalizers(t *testing.T) {
+	deactivate := func(iss cmapi.GenericIssuer) {
+		iss.GetSpec().ACME.DeactivateAccountOnDeletion = true
+	}
+	withFinalizers := func(finalizers ...string) gen.IssuerModifier {
+		return func(iss cmapi.GenericIssuer) {
+			iss.GetObjectMeta().SetFinalizers(finalizers)
+		}
+	}
+
+	tests := map[string]struct {
+		issuer *cmapi.Issuer
+
+		expFinalizers []string
+		expUpToDate   bool
+	}{
+		"non-ACME issuer has no finalizers": {
+			issuer:      gen.Issuer("test", gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{})),
+			expUpToDate: true,
+		},
+		"ACME issuer without account deactivation has no finalizers": {
+			issuer:      gen.Issuer("test", gen.SetIssuerACMEURL("https://acme.example.com")),
+			expUpToDate: true,
+		},
+		"ACME issuer with account deactivation needs the finalizer": {
+			issuer:        gen.Issuer("test", gen.SetIssuerACMEURL("https://acme.example.com"), deactivate),
+			expFinalizers: []string{cmacme.ACMEAccountFinalizer},
+		},
+		"ACME issuer with account deactivation and the finalizer is up to date": {
+			issuer:        gen.Issuer("test", gen.SetIssuerACMEURL("https://acme.example.com"), deactivate, withFinalizers("other", cmacme.ACMEAccountFinalizer)),
+			expFinalizers: []string{cmacme.ACMEAccountFinalizer},
+			expUpToDate:   true,
+		},
+		"finalizer is removed once account deactivation is disabled": {
+			issuer:      gen.Issuer("test", gen.SetIssuerACMEURL("https://acme.example.com"), withFinalizers(cmacme.ACMEAccountFinalizer)),
+			expUpToDate: false,
+		},
+		"finalizers of other controllers are ignored": {
+			issuer:      gen.Issuer("test", gen.SetIssuerACMEURL("https://acme.example.com"), withFinalizers("other")),
+			expUpToDate: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expFinalizers, Finalizers(test.issuer))
+			assert.Equal(t, test.expUpToDate, FinalizersUpToDate(test.issuer))
+		})
+	}
+}
@@ -79,6 +79,13 @@ func (bf *baseFactory) Secrets() SecretInformer {
 	}
 }
 
+func (bf *baseFactory) ConfigMaps() corev1informers.ConfigMapInformer {
+	return &configMapInformer{
+		f:         bf.f,
+		namespace: bf.namespace,
+	}
+}
+
 func (bf *baseFactory) CertificateSigningRequests() certificatesv1.CertificateSigningRequestInformer {
 	return bf.f.Certificates().V1().CertificateSigningRequests()
 }
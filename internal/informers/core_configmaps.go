@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package informers
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	kubeinformers "k8s.io/client-go/informers"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// This file contains an implementation of a ConfigMap informer that is shared
+// by all KubeInformerFactory implementations. cert-manager only ever reads
+// ConfigMaps that it has written itself, so the informer is filtered to those
+// ConfigMaps to avoid caching every ConfigMap in the cluster.
+
+var _ corev1informers.ConfigMapInformer = &configMapInformer{}
+
+type configMapInformer struct {
+	f         kubeinformers.SharedInformerFactory
+	namespace string
+}
+
+func (c *configMapInformer) Informer() cache.SharedIndexInformer {
+	return c.f.InformerFor(&corev1.ConfigMap{}, c.new)
+}
+
+func (c *configMapInformer) Lister() corev1listers.ConfigMapLister {
+	return corev1listers.NewConfigMapLister(c.Informer().GetIndexer())
+}
+
+func (c *configMapInformer) new(client kubernetes.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	selector := labels.SelectorFromSet(labels.Set{cmapi.PartOfCertManagerControllerLabelKey: "true"}).String()
+	return corev1informers.NewFilteredConfigMapInformer(client, c.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, func(listOptions *metav1.ListOptions) {
+		listOptions.LabelSelector = selector
+	})
+}
@@ -128,6 +128,13 @@ func (bf *filteredSecretsFactory) CertificateSigningRequests() certificatesv1.Ce
 	return bf.typedInformerFactory.Certificates().V1().CertificateSigningRequests()
 }
 
+func (bf *filteredSecretsFactory) ConfigMaps() corev1informers.ConfigMapInformer {
+	return &configMapInformer{
+		f:         bf.typedInformerFactory,
+		namespace: bf.namespace,
+	}
+}
+
 func (bf *filteredSecretsFactory) Secrets() SecretInformer {
 	f := func(client kubernetes.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
 		return corev1informers.NewFilteredSecretInformer(client, bf.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, func(listOptions *metav1.ListOptions) {
@@ -28,6 +28,7 @@ import (
 type Vault struct {
 	NewFn                           func(string, internalinformers.SecretLister, cmapi.GenericIssuer) (*Vault, error)
 	SignFn                          func([]byte, time.Duration) ([]byte, []byte, error)
+	RevokeFn                        func([]byte) error
 	IsVaultInitializedAndUnsealedFn func() error
 }
 
@@ -37,6 +38,9 @@ func New() *Vault {
 		SignFn: func([]byte, time.Duration) ([]byte, []byte, error) {
 			return nil, nil, nil
 		},
+		RevokeFn: func([]byte) error {
+			return nil
+		},
 		IsVaultInitializedAndUnsealedFn: func() error {
 			return nil
 		},
@@ -62,6 +66,19 @@ func (v *Vault) WithSign(certPEM, caPEM []byte, err error) *Vault {
 	return v
 }
 
+// Revoke implements `vault.Interface`.
+func (v *Vault) Revoke(certPEM []byte) error {
+	return v.RevokeFn(certPEM)
+}
+
+// WithRevoke sets the fake Vault's Revoke function.
+func (v *Vault) WithRevoke(err error) *Vault {
+	v.RevokeFn = func([]byte) error {
+		return err
+	}
+	return v
+}
+
 // WithNew sets the fake Vault's New function.
 func (v *Vault) WithNew(f func(string, internalinformers.SecretLister, cmapi.GenericIssuer) (*Vault, error)) *Vault {
 	v.NewFn = f
@@ -60,6 +60,7 @@ type ClientBuilder func(ctx context.Context, namespace string, _ func(ns string)
 // Vault's certificate.
 type Interface interface {
 	Sign(csrPEM []byte, duration time.Duration) (certPEM []byte, caPEM []byte, err error)
+	Revoke(certPEM []byte) error
 	IsVaultInitializedAndUnsealed() error
 }
 
@@ -202,6 +203,52 @@ func (v *Vault) Sign(csrPEM []byte, duration time.Duration) (cert []byte, ca []b
 	return extractCertificatesFromVaultCertificateSecret(&vaultResult)
 }
 
+// Revoke will connect to a Vault instance to revoke a certificate that was
+// signed by the PKI secrets engine mounted at the issuer's path. Vault does
+// not record a reason for revocations.
+func (v *Vault) Revoke(certPEM []byte) error {
+	cert, err := pki.DecodeX509CertificateBytes(certPEM)
+	if err != nil {
+		return fmt.Errorf("failed to decode certificate for revocation: %s", err)
+	}
+
+	revokePath := path.Join(pkiMountPath(v.issuer.GetSpec().Vault.Path), "revoke")
+	if _, err := v.client.Write(revokePath, map[string]any{
+		"serial_number": vaultSerialNumber(cert),
+	}); err != nil {
+		return fmt.Errorf("failed to revoke certificate by vault: %s", err)
+	}
+
+	return nil
+}
+
+// pkiMountPath returns the mount path of the PKI secrets engine from the
+// signing path configured on a Vault issuer, e.g. `pki_int` for
+// `pki_int/sign/example-dot-com` or `pki_int/issuer/default/sign/role`.
+func pkiMountPath(signPath string) string {
+	segments := strings.Split(strings.Trim(signPath, "/"), "/")
+	for i, segment := range segments {
+		switch segment {
+		case "sign", "sign-verbatim", "issuer", "root":
+			return strings.Join(segments[:i], "/")
+		}
+	}
+
+	return path.Dir(strings.Trim(signPath, "/"))
+}
+
+// vaultSerialNumber formats the serial number of a certificate as colon
+// separated hex, as expected by the Vault PKI API.
+func vaultSerialNumber(cert *x509.Certificate) string {
+	serial := cert.SerialNumber.Bytes()
+	parts := make([]string, len(serial))
+	for i, b := range serial {
+		parts[i] = fmt.Sprintf("%02x", b)
+	}
+
+	return strings.Join(parts, ":")
+}
+
 func (v *Vault) setToken(ctx context.Context, client Client) error {
 	// IMPORTANT: Because of backwards compatibility with older versions that
 	// incorrectly allowed multiple authentication methods to be specified at
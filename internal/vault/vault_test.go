@@ -377,6 +377,83 @@ func TestSign(t *testing.T) {
 	}
 }
 
+func TestRevoke(t *testing.T) {
+	leaf, err := pki.DecodeX509CertificateBytes([]byte(testLeafCertificate))
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		certPEM      []byte
+		writeErr     error
+		expectedPath string
+		expectedErr  string
+	}{
+		"a garbage certificate should return err": {
+			certPEM:     []byte("a bad certificate"),
+			expectedErr: "failed to decode certificate for revocation: error decoding certificate PEM block: no valid certificates found",
+		},
+		"a failed request should return err": {
+			certPEM:      []byte(testLeafCertificate),
+			writeErr:     errors.New("request failed"),
+			expectedPath: "pki_int/revoke",
+			expectedErr:  "failed to revoke certificate by vault: request failed",
+		},
+		"a certificate should be revoked by serial number on the PKI mount": {
+			certPEM:      []byte(testLeafCertificate),
+			expectedPath: "pki_int/revoke",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotPath string
+			var gotData map[string]any
+			fakeClient := vaultfake.NewFakeClient()
+			fakeClient.WriteFn = func(path string, data map[string]any) (*vault.Secret, error) {
+				gotPath, gotData = path, data
+				return nil, test.writeErr
+			}
+
+			v := &Vault{
+				issuer: gen.Issuer("vault-issuer",
+					gen.SetIssuerVault(cmapiv1.VaultIssuer{Path: "pki_int/sign/example-dot-com"}),
+				),
+				client: fakeClient,
+			}
+
+			err := v.Revoke(test.certPEM)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, test.expectedPath, gotPath)
+			if gotData != nil {
+				serial := gotData["serial_number"].(string)
+				assert.Regexp(t, "^[0-9a-f]{2}(:[0-9a-f]{2})*$", serial)
+				assert.Equal(t, leaf.SerialNumber.Text(16), strings.TrimLeft(strings.ReplaceAll(serial, ":", ""), "0"))
+			}
+		})
+	}
+}
+
+func TestPKIMountPath(t *testing.T) {
+	tests := map[string]string{
+		"pki/sign/role":                    "pki",
+		"/pki/sign/role/":                  "pki",
+		"team/pki_int/sign-verbatim/role":  "team/pki_int",
+		"pki_int/issuer/default/sign/role": "pki_int",
+		"pki_root/root/sign-intermediate":  "pki_root",
+		"pki_other/custom":                 "pki_other",
+	}
+
+	for signPath, expected := range tests {
+		t.Run(signPath, func(t *testing.T) {
+			assert.Equal(t, expected, pkiMountPath(signPath))
+		})
+	}
+}
+
 type testExtractCertificatesFromVaultCertT struct {
 	secret       *certutil.Secret
 	expectedCert string
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accounts
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+	"github.com/cert-manager/cert-manager/pkg/util/errors"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
+)
+
+// maxEABResponseSize is the maximum size of a response from an External
+// Account Binding provisioning endpoint.
+const maxEABResponseSize = 1 << 16
+
+type ProvisionEABOptions struct {
+	SkipTLSVerify bool
+	CABundle      []byte
+
+	// URL of the provisioning endpoint.
+	URL string
+
+	// TokenURL, ClientID, ClientSecret and Scopes configure the OAuth 2.0
+	// client credentials grant used to authenticate to the provisioning
+	// endpoint.
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+}
+
+// ProvisionEABFunc is a function type for obtaining new External Account
+// Binding credentials from a provisioning endpoint.
+type ProvisionEABFunc func(ctx context.Context, options ProvisionEABOptions) (*acmeapi.ExternalAccountBinding, error)
+
+// eabResponse is the response of a provisioning endpoint.
+type eabResponse struct {
+	KeyID   string `json:"keyID"`
+	HMACKey string `json:"hmacKey"`
+}
+
+// NewEABProvisioner is an implementation of ProvisionEABFunc that requests
+// new External Account Binding credentials from the provisioning endpoint.
+// Errors caused by invalid responses are of type errors.InvalidData.
+func NewEABProvisioner(
+	metrics *metrics.Metrics,
+	userAgent string,
+) ProvisionEABFunc {
+	return func(ctx context.Context, options ProvisionEABOptions) (*acmeapi.ExternalAccountBinding, error) {
+		ctx = context.WithValue(ctx, acmecl.AcmeActionLabel, "provision_eab")
+		httpClient := buildHTTPClientWithCABundle(metrics, options.SkipTLSVerify, options.CABundle)
+
+		cfg := clientcredentials.Config{
+			ClientID:     options.ClientID,
+			ClientSecret: options.ClientSecret,
+			TokenURL:     options.TokenURL,
+			Scopes:       options.Scopes,
+		}
+		// the token endpoint is requested with the same HTTP client, so that it
+		// is trusted using the same CA bundle.
+		cl := cfg.Client(context.WithValue(ctx, oauth2.HTTPClient, httpClient))
+		cl.Timeout = httpClient.Timeout
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, options.URL, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("User-Agent", userAgent)
+
+		resp, err := cl.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to request external account binding: %w", err)
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxEABResponseSize))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read external account binding response: %w", err)
+		}
+		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+			return nil, fmt.Errorf("unexpected status code %d from external account binding provisioning endpoint: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+		}
+
+		var eab eabResponse
+		if err := json.Unmarshal(body, &eab); err != nil {
+			return nil, errors.NewInvalidData("failed to decode external account binding response: %v", err)
+		}
+		if eab.KeyID == "" || eab.HMACKey == "" {
+			return nil, errors.NewInvalidData("external account binding response is missing the keyID or hmacKey")
+		}
+
+		key, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(eab.HMACKey, "="))
+		if err != nil {
+			return nil, errors.NewInvalidData("failed to decode external account binding hmacKey: %v", err)
+		}
+
+		return &acmeapi.ExternalAccountBinding{
+			KID: eab.KeyID,
+			Key: key,
+		}, nil
+	}
+}
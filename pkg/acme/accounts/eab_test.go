@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accounts
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/clock"
+
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+	"github.com/cert-manager/cert-manager/pkg/util/errors"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
+)
+
+func TestNewEABProvisioner(t *testing.T) {
+	tests := map[string]struct {
+		status int
+		body   string
+
+		expEAB         *acmeapi.ExternalAccountBinding
+		expErr         bool
+		expInvalidData bool
+	}{
+		"valid response should return the provisioned EAB": {
+			status: http.StatusCreated,
+			body:   `{"keyID":"kid-1","hmacKey":"dGVzdA"}`,
+			expEAB: &acmeapi.ExternalAccountBinding{KID: "kid-1", Key: []byte("test")},
+		},
+		"padded hmacKey should be accepted": {
+			status: http.StatusOK,
+			body:   `{"keyID":"kid-1","hmacKey":"dGVzdA=="}`,
+			expEAB: &acmeapi.ExternalAccountBinding{KID: "kid-1", Key: []byte("test")},
+		},
+		"error status code should return an error": {
+			status: http.StatusServiceUnavailable,
+			body:   `unavailable`,
+			expErr: true,
+		},
+		"response without a keyID should return invalid data": {
+			status:         http.StatusOK,
+			body:           `{"hmacKey":"dGVzdA"}`,
+			expErr:         true,
+			expInvalidData: true,
+		},
+		"response with an invalid hmacKey should return invalid data": {
+			status:         http.StatusOK,
+			body:           `{"keyID":"kid-1","hmacKey":"!!"}`,
+			expErr:         true,
+			expInvalidData: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+				id, secret, ok := r.BasicAuth()
+				if !ok || id != "client-id" || secret != "client-secret" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer"}`))
+			})
+			mux.HandleFunc("/eab", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.Header.Get("Authorization") != "Bearer token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			})
+			srv := httptest.NewTLSServer(mux)
+			defer srv.Close()
+
+			caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+			provision := NewEABProvisioner(metrics.New(logr.Discard(), clock.RealClock{}), "test-agent")
+
+			eab, err := provision(t.Context(), ProvisionEABOptions{
+				CABundle:     caBundle,
+				URL:          srv.URL + "/eab",
+				TokenURL:     srv.URL + "/token",
+				ClientID:     "client-id",
+				ClientSecret: "client-secret",
+			})
+			if !test.expErr {
+				require.NoError(t, err)
+				assert.Equal(t, test.expEAB, eab)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, test.expInvalidData, errors.IsInvalidData(err))
+		})
+	}
+}
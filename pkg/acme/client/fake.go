@@ -18,6 +18,7 @@ package client
 
 import (
 	"context"
+	"crypto"
 	"crypto/x509"
 	"fmt"
 
@@ -46,6 +47,10 @@ type FakeACME struct {
 	FakeDiscover                func(ctx context.Context) (acme.Directory, error)
 	FakeUpdateReg               func(ctx context.Context, a *acme.Account) (*acme.Account, error)
 	FakeGetRenewalInfo          func(ctx context.Context, cert *x509.Certificate) (*acme.RenewalInfoResponse, error)
+	FakeRevokeCert              func(ctx context.Context, key crypto.Signer, cert []byte, reason acme.CRLReasonCode) error
+	FakeAccountKeyRollover      func(ctx context.Context, newKey crypto.Signer) error
+	FakeDeactivateReg           func(ctx context.Context) error
+	FakeAuthorize               func(ctx context.Context, domain string) (*acme.Authorization, error)
 }
 
 var _ Interface = &FakeACME{}
@@ -170,3 +175,31 @@ func (f *FakeACME) GetRenewalInfo(ctx context.Context, cert *x509.Certificate) (
 	}
 	return nil, fmt.Errorf("GetRenewalInfo not implemented")
 }
+
+func (f *FakeACME) RevokeCert(ctx context.Context, key crypto.Signer, cert []byte, reason acme.CRLReasonCode) error {
+	if f.FakeRevokeCert != nil {
+		return f.FakeRevokeCert(ctx, key, cert, reason)
+	}
+	return fmt.Errorf("RevokeCert not implemented")
+}
+
+func (f *FakeACME) AccountKeyRollover(ctx context.Context, newKey crypto.Signer) error {
+	if f.FakeAccountKeyRollover != nil {
+		return f.FakeAccountKeyRollover(ctx, newKey)
+	}
+	return fmt.Errorf("AccountKeyRollover not implemented")
+}
+
+func (f *FakeACME) DeactivateReg(ctx context.Context) error {
+	if f.FakeDeactivateReg != nil {
+		return f.FakeDeactivateReg(ctx)
+	}
+	return fmt.Errorf("DeactivateReg not implemented")
+}
+
+func (f *FakeACME) Authorize(ctx context.Context, domain string) (*acme.Authorization, error) {
+	if f.FakeAuthorize != nil {
+		return f.FakeAuthorize(ctx, domain)
+	}
+	return nil, fmt.Errorf("Authorize not implemented")
+}
@@ -18,6 +18,7 @@ package client
 
 import (
 	"context"
+	"crypto"
 	"crypto/x509"
 
 	acmeutil "github.com/cert-manager/cert-manager/pkg/acme/util"
@@ -63,6 +64,22 @@ type Interface interface { //nolint:interfacebloat
 	// server's renewal information for the certificate. A non-nil error means
 	// the renewal information could not be retrieved or parsed.
 	GetRenewalInfo(ctx context.Context, cert *x509.Certificate) (*acme.RenewalInfoResponse, error)
+	// RevokeCert will be called when a CertificateRequest for an issued
+	// certificate has been marked for revocation. A nil key means the request
+	// is signed with the ACME account key.
+	RevokeCert(ctx context.Context, key crypto.Signer, cert []byte, reason acme.CRLReasonCode) error
+	// AccountKeyRollover will be called when the private key of an ACME
+	// account has been changed, to roll the account over from the client's
+	// key to the new key.
+	AccountKeyRollover(ctx context.Context, newKey crypto.Signer) error
+	// DeactivateReg will be called when an Issuer configured to deactivate
+	// its ACME account is deleted. A deactivated account can no longer be
+	// used.
+	DeactivateReg(ctx context.Context) error
+	// Authorize will be called when an Issuer pre-authorizes a DNS name, to
+	// create a new authorization for it using the server's newAuthz
+	// endpoint.
+	Authorize(ctx context.Context, domain string) (*acme.Authorization, error)
 }
 
 // Compile-time assertion that *acme.Client satisfies Interface.
@@ -18,6 +18,7 @@ package middleware
 
 import (
 	"context"
+	"crypto"
 	"crypto/x509"
 
 	"github.com/go-logr/logr"
@@ -160,3 +161,31 @@ func (l *Logger) GetRenewalInfo(ctx context.Context, cert *x509.Certificate) (*a
 
 	return l.baseCl.GetRenewalInfo(ctx, cert)
 }
+
+func (l *Logger) RevokeCert(ctx context.Context, key crypto.Signer, cert []byte, reason acme.CRLReasonCode) error {
+	l.log.V(logf.TraceLevel).Info("Calling RevokeCert")
+	ctx = context.WithValue(ctx, client.AcmeActionLabel, "revoke_cert")
+
+	return l.baseCl.RevokeCert(ctx, key, cert, reason)
+}
+
+func (l *Logger) AccountKeyRollover(ctx context.Context, newKey crypto.Signer) error {
+	l.log.V(logf.TraceLevel).Info("Calling AccountKeyRollover")
+	ctx = context.WithValue(ctx, client.AcmeActionLabel, "account_key_rollover")
+
+	return l.baseCl.AccountKeyRollover(ctx, newKey)
+}
+
+func (l *Logger) DeactivateReg(ctx context.Context) error {
+	l.log.V(logf.TraceLevel).Info("Calling DeactivateReg")
+	ctx = context.WithValue(ctx, client.AcmeActionLabel, "deactivate_reg")
+
+	return l.baseCl.DeactivateReg(ctx)
+}
+
+func (l *Logger) Authorize(ctx context.Context, domain string) (*acme.Authorization, error) {
+	l.log.V(logf.TraceLevel).Info("Calling Authorize")
+	ctx = context.WithValue(ctx, client.AcmeActionLabel, "authorize")
+
+	return l.baseCl.Authorize(ctx, domain)
+}
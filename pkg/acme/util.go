@@ -17,9 +17,12 @@ limitations under the License.
 package acme
 
 import (
+	"fmt"
+
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -46,6 +49,37 @@ func IsFailureState(s cmacme.State) bool {
 	}
 }
 
+// ChallengeType returns the Challenge type for the given ACME challenge type.
+func ChallengeType(t string) (cmacme.ACMEChallengeType, error) {
+	switch t {
+	case "http-01":
+		return cmacme.ACMEChallengeTypeHTTP01, nil
+	case "dns-01":
+		return cmacme.ACMEChallengeTypeDNS01, nil
+	case "dns-account-01":
+		return cmacme.ACMEChallengeTypeDNSAccount01, nil
+	case "tls-alpn-01":
+		return cmacme.ACMEChallengeTypeTLSALPN01, nil
+	default:
+		return "", fmt.Errorf("unsupported challenge type: %v", t)
+	}
+}
+
+// ChallengeKey returns the key that has to be presented to solve a challenge
+// of the given type and token, as computed by the given ACME client.
+func ChallengeKey(cl acmecl.Interface, t cmacme.ACMEChallengeType, token string) (string, error) {
+	switch t {
+	case cmacme.ACMEChallengeTypeHTTP01, cmacme.ACMEChallengeTypeTLSALPN01:
+		// the tls-alpn-01 challenge certificate is derived from the
+		// same key authorization as the http-01 response
+		return cl.HTTP01ChallengeResponse(token)
+	case cmacme.ACMEChallengeTypeDNS01, cmacme.ACMEChallengeTypeDNSAccount01:
+		return cl.DNS01ChallengeRecord(token)
+	default:
+		return "", fmt.Errorf("unsupported challenge type: %s", t)
+	}
+}
+
 // PrivateKeySelector will default the SecretKeySelector with a default secret key
 // if one is not already specified.
 func PrivateKeySelector(sel cmmeta.SecretKeySelector) cmmeta.SecretKeySelector {
@@ -81,7 +81,9 @@ type ChallengeRequest struct {
 	// ResolvedFQDN is the fully-qualified domain name that should be
 	// updated/presented after resolving all CNAMEs.
 	// This should be honoured when using the DNS01 solver type.
-	// This will be of the form '_acme-challenge.example.com.'.
+	// This will be of the form '_acme-challenge.example.com.', or
+	// '_<label>._acme-challenge.example.com.' when solving an account scoped
+	// dns-account-01 challenge.
 	// +optional
 	ResolvedFQDN string `json:"resolvedFQDN,omitempty"`
 
@@ -217,7 +217,7 @@ func schema_webhook_apis_acme_v1alpha1_ChallengeRequest(ref common.ReferenceCall
 					},
 					"resolvedFQDN": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResolvedFQDN is the fully-qualified domain name that should be updated/presented after resolving all CNAMEs. This should be honoured when using the DNS01 solver type. This will be of the form '_acme-challenge.example.com.'.",
+							Description: "ResolvedFQDN is the fully-qualified domain name that should be updated/presented after resolving all CNAMEs. This should be honoured when using the DNS01 solver type. This will be of the form '_acme-challenge.example.com.', or '_<label>._acme-challenge.example.com.' when solving an account scoped dns-account-01 challenge.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -37,6 +37,8 @@ const (
 	IssuerSelfSigned string = "selfsigned"
 	// IssuerVenafi uses CyberArk Certificate Manager
 	IssuerVenafi string = "venafi"
+	// IssuerExternalSigner forwards requests to an external signer plugin
+	IssuerExternalSigner string = "externalsigner"
 )
 
 // NameForIssuer determines the name of the Issuer implementation given an
@@ -53,6 +55,8 @@ func NameForIssuer(i cmapi.GenericIssuer) (string, error) {
 		return IssuerSelfSigned, nil
 	case i.GetSpec().Venafi != nil:
 		return IssuerVenafi, nil
+	case i.GetSpec().ExternalSigner != nil:
+		return IssuerExternalSigner, nil
 	}
 	return "", fmt.Errorf("no issuer specified for Issuer '%s/%s'", i.GetNamespace(), i.GetName())
 }
@@ -19,4 +19,8 @@ package v1
 const (
 	ACMELegacyFinalizer          = "finalizer.acme.cert-manager.io"
 	ACMEDomainQualifiedFinalizer = "acme.cert-manager.io/finalizer"
+
+	// ACMEAccountFinalizer is added to Issuers and ClusterIssuers that
+	// deactivate their ACME account when they are deleted.
+	ACMEAccountFinalizer = "acme.cert-manager.io/account-deactivation"
 )
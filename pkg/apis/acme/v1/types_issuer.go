@@ -38,6 +38,15 @@ type ACMEIssuer struct {
 	// +optional
 	Email string `json:"email,omitempty"`
 
+	// Contacts is a list of additional contact URIs to be associated with the
+	// ACME account, such as `mailto:admin@example.com`. They are registered
+	// after the address in `email`, if set.
+	// Which URI schemes are supported depends on the ACME server.
+	// This field may be updated after the account is initially registered.
+	// +optional
+	// +listType=atomic
+	Contacts []string `json:"contacts,omitempty"`
+
 	// Server is the URL used to access the ACME server's 'directory' endpoint.
 	// For example, for Let's Encrypt's staging endpoint, you would use:
 	// "https://acme-staging-v02.api.letsencrypt.org/directory".
@@ -84,6 +93,16 @@ type ACMEIssuer struct {
 	// +optional
 	ExternalAccountBinding *ACMEExternalAccountBinding `json:"externalAccountBinding,omitempty"`
 
+	// ExternalAccountBindingProvisioner configures an endpoint from which
+	// External Account Binding credentials are obtained whenever a new ACME
+	// account has to be registered, i.e. when the Issuer is first set up and
+	// after its account private key has been rotated.
+	// This is intended for ACME servers which issue one-time External Account
+	// Binding credentials.
+	// Mutually exclusive with ExternalAccountBinding.
+	// +optional
+	ExternalAccountBindingProvisioner *ACMEExternalAccountBindingProvisioner `json:"externalAccountBindingProvisioner,omitempty"`
+
 	// PrivateKey is the name of a Kubernetes Secret resource that will be used to
 	// store the automatically generated ACME account private key.
 	// Optionally, a `key` may be specified to select a specific entry within
@@ -91,6 +110,14 @@ type ACMEIssuer struct {
 	// If `key` is not specified, a default of `tls.key` will be used.
 	PrivateKey cmmeta.SecretKeySelector `json:"privateKeySecretRef"`
 
+	// AccountKeyRollover configures rolling the ACME account over to a new
+	// private key, as described in RFC 8555 section 7.3.5, so that the
+	// account's history, rate limits and External Account Binding are
+	// preserved when its private key changes.
+	// If not set, changing the private key registers a new ACME account.
+	// +optional
+	AccountKeyRollover *ACMEAccountKeyRollover `json:"accountKeyRollover,omitempty"`
+
 	// Solvers is a list of challenge solvers that will be used to solve
 	// ACME challenges for the matching domains.
 	// Solver configurations must be provided in order to obtain certificates
@@ -115,6 +142,15 @@ type ACMEIssuer struct {
 	// +optional
 	DisableAccountKeyGeneration bool `json:"disableAccountKeyGeneration,omitempty"`
 
+	// Enables deactivating the ACME account when the Issuer resource is
+	// deleted. A deactivated account can no longer be used, not even by other
+	// Issuers referencing the same private key.
+	// If true, a finalizer is added to the Issuer so that the account is
+	// deactivated before the Issuer is removed.
+	// Defaults to false.
+	// +optional
+	DeactivateAccountOnDeletion bool `json:"deactivateAccountOnDeletion,omitempty"`
+
 	// Enables requesting a Not After date on certificates that matches the
 	// duration of the certificate. This is not supported by all ACME servers
 	// like Let's Encrypt. If set to true when the ACME server does not support
@@ -133,6 +169,52 @@ type ACMEIssuer struct {
 	// +optional
 	// +kubebuilder:default=ARI
 	RenewalInformationSource ACMERenewalInformationSource `json:"renewalInformationSource,omitempty"`
+
+	// PreAuthorization configures pre-authorization of DNS names, as
+	// described in RFC 8555 section 7.4.1. The Issuer periodically obtains
+	// authorizations for the configured DNS names, so that orders for them
+	// can reuse the valid authorizations instead of solving a challenge for
+	// every order.
+	// Only supported by ACME servers that provide a `newAuthz` endpoint.
+	// +optional
+	PreAuthorization *ACMEPreAuthorization `json:"preAuthorization,omitempty"`
+}
+
+// ACMEPreAuthorization configures pre-authorization of DNS names.
+type ACMEPreAuthorization struct {
+	// DNSNames is the list of DNS names to pre-authorize, such as zone apexes.
+	// Wildcard DNS names cannot be pre-authorized.
+	// +listType=atomic
+	DNSNames []string `json:"dnsNames"`
+
+	// RenewBefore is how long before a pre-authorization expires that a new
+	// one is obtained.
+	// Defaults to 72h.
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// ACMEAccountKeyRollover configures rollover of the ACME account key.
+// When the private key in privateKeySecretRef no longer matches the key that
+// the account was last verified with, and the previous private key does,
+// cert-manager rolls the existing account over to the new key instead of
+// registering a new account.
+type ACMEAccountKeyRollover struct {
+	// previousPrivateKeySecretRef is a reference to the private key that was
+	// used before the key in privateKeySecretRef.
+	// If not set, the `<key>.previous` entry of the privateKeySecretRef Secret
+	// is used, where `<key>` is the entry holding the current private key.
+	// +optional
+	PreviousPrivateKey *cmmeta.SecretKeySelector `json:"previousPrivateKeySecretRef,omitempty"`
+
+	// rotationPeriod is the interval at which cert-manager generates a new
+	// account private key. The current key is moved to the `<key>.previous`
+	// entry of the privateKeySecretRef Secret, the new key is stored in its
+	// place and the account is then rolled over to it.
+	// May not be set together with previousPrivateKeySecretRef or
+	// disableAccountKeyGeneration.
+	// +optional
+	RotationPeriod *metav1.Duration `json:"rotationPeriod,omitempty"`
 }
 
 // ACMEExternalAccountBinding is a reference to a CA external account of the ACME
@@ -157,6 +239,32 @@ type ACMEExternalAccountBinding struct {
 	KeyAlgorithm HMACKeyAlgorithm `json:"keyAlgorithm,omitempty"`
 }
 
+// ACMEExternalAccountBindingProvisioner configures an endpoint from which
+// External Account Binding credentials are obtained.
+// cert-manager authenticates to the endpoint with an OAuth 2.0 access token,
+// obtained from the token endpoint using the client credentials grant, and
+// sends it a POST request. The endpoint must respond with a JSON object
+// containing the `keyID` and the un-padded, base64 URL encoded `hmacKey` of
+// the new External Account Binding.
+// Both endpoints are trusted using the caBundle of the ACME issuer.
+type ACMEExternalAccountBindingProvisioner struct {
+	// url is the URL of the provisioning endpoint.
+	URL string `json:"url"`
+
+	// tokenURL is the URL of the OAuth 2.0 token endpoint.
+	TokenURL string `json:"tokenURL"`
+
+	// scopes are the OAuth 2.0 scopes requested with the access token.
+	// +optional
+	// +listType=atomic
+	Scopes []string `json:"scopes,omitempty"`
+
+	// credentialsRef is a reference to a Secret containing the OAuth 2.0
+	// client ID and client secret under the `client-id` and `client-secret`
+	// keys.
+	CredentialsRef cmmeta.LocalObjectReference `json:"credentialsRef"`
+}
+
 // HMACKeyAlgorithm is the name of a key algorithm used for HMAC encryption
 // +kubebuilder:validation:Enum=HS256;HS384;HS512
 type HMACKeyAlgorithm string
@@ -191,6 +299,13 @@ type ACMEChallengeSolver struct {
 	// +optional
 	DNS01 *ACMEChallengeSolverDNS01 `json:"dns01,omitempty"`
 
+	// Configures cert-manager to attempt to complete authorizations by
+	// performing the TLS-ALPN-01 challenge flow.
+	// It is not possible to obtain certificates for wildcard domain names
+	// (e.g., `*.example.com`) using the TLS-ALPN-01 challenge mechanism.
+	// +optional
+	TLSALPN01 *ACMEChallengeSolverTLSALPN01 `json:"tlsALPN01,omitempty"`
+
 	// WaitInsteadOfSelfCheck, if set, skips cert-manager's self-check and
 	// instead waits this long after presentation before asking the ACME server
 	// to validate the challenge.
@@ -211,7 +326,8 @@ type ACMEChallengeSolver struct {
 }
 
 // CertificateDNSNameSelector selects certificates using a label selector, and
-// can optionally select individual DNS names within those certificates.
+// can optionally select individual DNS names or IP addresses within those
+// certificates.
 // If both MatchLabels and DNSNames are empty, this selector will match all
 // certificates and DNS names within them.
 type CertificateDNSNameSelector struct {
@@ -243,6 +359,24 @@ type CertificateDNSNameSelector struct {
 	// +optional
 	// +listType=atomic
 	DNSZones []string `json:"dnsZones,omitempty"`
+
+	// List of IP address ranges, in CIDR notation, that this solver will be
+	// used to solve IP address identifiers for. A single IP address can be
+	// selected using a /32 (IPv4) or /128 (IPv6) range.
+	// The most specific range match specified here will take precedence over
+	// other range matches, so a solver specifying 10.0.1.0/24 will be
+	// selected over one specifying 10.0.0.0/8 for the IP address 10.0.1.5.
+	// If specified and a match is found, a dnsNames selector will take
+	// precedence over an ipRanges selector.
+	// IP address identifiers never match a dnsZones selector, so ipRanges
+	// may not be combined with dnsZones.
+	// If multiple solvers match with the same ipRanges value, the solver
+	// with the most matching labels in matchLabels will be selected.
+	// If neither has more matches, the solver defined earlier in the list
+	// will be selected.
+	// +optional
+	// +listType=atomic
+	IPRanges []string `json:"ipRanges,omitempty"`
 }
 
 // ACMEChallengeSolverHTTP01 contains configuration detailing how to solve
@@ -334,6 +468,49 @@ type ACMEChallengeSolverHTTP01GatewayHTTPRoute struct {
 	PodTemplate *ACMEChallengeSolverHTTP01IngressPodTemplate `json:"podTemplate,omitempty"`
 }
 
+// ACMEChallengeSolverTLSALPN01 contains configuration detailing how to solve
+// TLS-ALPN-01 challenges within a Kubernetes cluster.
+// cert-manager runs a solver pod that answers TLS connections negotiating the
+// `acme-tls/1` protocol with the challenge certificate, and exposes it through
+// a Service. The Service must be reachable on port 443 of the domain being
+// validated, either directly or through a Gateway API TLSRoute.
+type ACMEChallengeSolverTLSALPN01 struct {
+	// Optional service type for Kubernetes solver service. Supported values
+	// are NodePort, ClusterIP or LoadBalancer. If unset, defaults to NodePort.
+	// +optional
+	ServiceType corev1.ServiceType `json:"serviceType,omitempty"`
+
+	// The Gateway API TLSRoute solver will create TLSRoute objects in
+	// passthrough mode, routing connections for the domain being validated to
+	// the solver Service.
+	// +optional
+	GatewayTLSRoute *ACMEChallengeSolverTLSALPN01GatewayTLSRoute `json:"gatewayTLSRoute,omitempty"`
+
+	// Optional pod template used to configure the ACME challenge solver pods
+	// used for TLS-ALPN-01 challenges.
+	// +optional
+	PodTemplate *ACMEChallengeSolverHTTP01IngressPodTemplate `json:"podTemplate,omitempty"`
+}
+
+// The ACMEChallengeSolverTLSALPN01GatewayTLSRoute solver will create TLSRoute
+// objects for a Gateway listener in TLS passthrough mode, routing to an ACME
+// challenge solver pod.
+type ACMEChallengeSolverTLSALPN01GatewayTLSRoute struct {
+	// Custom labels that will be applied to TLSRoutes created by cert-manager
+	// while solving TLS-ALPN-01 challenges.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// When solving a TLS-ALPN-01 challenge, cert-manager creates a TLSRoute.
+	// cert-manager needs to know which parentRefs should be used when creating
+	// the TLSRoute. Usually, the parentRef references a Gateway listener with
+	// the TLS protocol and the Passthrough TLS mode. See:
+	// https://gateway-api.sigs.k8s.io/guides/tls/#tls-passthrough
+	// +optional
+	// +listType=atomic
+	ParentRefs []gwapi.ParentReference `json:"parentRefs,omitempty"`
+}
+
 type ACMEChallengeSolverHTTP01IngressPodTemplate struct {
 	// ObjectMeta overrides for the pod used to solve HTTP01 challenges.
 	// Only the 'labels' and 'annotations' fields may be set.
@@ -433,17 +610,39 @@ type ACMEChallengeSolverDNS01 struct {
 	// +optional
 	CNAMEStrategy CNAMEStrategy `json:"cnameStrategy,omitempty"`
 
+	// ValidationZone configures the solver to use CNAME delegation to a
+	// dedicated validation zone, for example `acme.example-validation.net`.
+	// When set, every `_acme-challenge.<name>` record is expected to be a CNAME
+	// into this zone, and challenge records are only ever written into this
+	// zone. Challenges for names that are not delegated to the validation zone
+	// fail until the CNAME record has been created.
+	// May not be specified together with cnameStrategy.
+	// +optional
+	ValidationZone string `json:"validationZone,omitempty"`
+
 	// Nameservers defines a list of DNS nameservers to use for DNS01 propagation
 	// checks. Each entry must be in the format `<host>:<port>` for plain DNS,
-	// where host may be an IP address or hostname, or
-	// `https://<DoH RFC 8484 server address>` for DNS over HTTPS. If not set,
-	// the controller's configured global DNS01 recursive nameservers are used.
+	// where host may be an IP address or hostname,
+	// `https://<DoH RFC 8484 server address>` for DNS over HTTPS, or
+	// `tls://<DoT RFC 7858 server address>[:<port>]` for DNS over TLS, where
+	// the port defaults to 853. If not set, the controller's configured global
+	// DNS01 recursive nameservers are used.
 	// When specified, this overrides the global nameservers for this solver
 	// only, and disables the authoritative nameserver check.
 	// +optional
 	// +listType=atomic
 	Nameservers []string `json:"nameservers,omitempty"`
 
+	// AccountScoped configures the solver to use the dns-account-01 challenge
+	// type when the ACME server offers it. dns-account-01 places the TXT record
+	// at an `_<label>._acme-challenge` name derived from the ACME account URI,
+	// so that several ACME accounts can solve challenges for the same domain
+	// concurrently without their records colliding.
+	// If the ACME server does not offer dns-account-01 for an authorization,
+	// the dns-01 challenge type is used instead.
+	// +optional
+	AccountScoped bool `json:"accountScoped,omitempty"`
+
 	// Use the Akamai DNS zone management API to manage DNS01 challenge records.
 	// +optional
 	Akamai *ACMEIssuerDNS01ProviderAkamai `json:"akamai,omitempty"`
@@ -468,6 +667,11 @@ type ACMEChallengeSolverDNS01 struct {
 	// +optional
 	DigitalOcean *ACMEIssuerDNS01ProviderDigitalOcean `json:"digitalocean,omitempty"`
 
+	// Use the PowerDNS Authoritative Server HTTP API to manage DNS01 challenge
+	// records.
+	// +optional
+	PowerDNS *ACMEIssuerDNS01ProviderPowerDNS `json:"powerDNS,omitempty"`
+
 	// Use the 'ACME DNS' (https://github.com/joohoi/acme-dns) API to manage
 	// DNS01 challenge records.
 	// +optional
@@ -642,6 +846,31 @@ type ACMEIssuerDNS01ProviderDigitalOcean struct {
 	Token cmmeta.SecretKeySelector `json:"tokenSecretRef"`
 }
 
+// ACMEIssuerDNS01ProviderPowerDNS is a structure containing the DNS
+// configuration for the PowerDNS Authoritative Server HTTP API
+type ACMEIssuerDNS01ProviderPowerDNS struct {
+	// Host is the base URL of the PowerDNS HTTP API, for example
+	// `https://pdns.example.com:8081`.
+	Host string `json:"host"`
+
+	// ServerID is the ID of the PowerDNS server to manage zones on.
+	// Defaults to `localhost`, which is the only ID supported by the
+	// PowerDNS Authoritative Server.
+	// +optional
+	ServerID string `json:"serverID,omitempty"`
+
+	// A reference to a specific 'key' within a Secret resource.
+	// The key should contain the API key used to authenticate with the
+	// PowerDNS HTTP API.
+	APIKey cmmeta.SecretKeySelector `json:"apiKeySecretRef"`
+
+	// Base64-encoded bundle of PEM CAs which will be used to validate the
+	// certificate chain presented by the PowerDNS HTTP API. If unset, the
+	// system certificate bundle inside the container is used.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+}
+
 // ACMEIssuerDNS01ProviderRoute53 is a structure containing the Route 53
 // configuration for AWS
 type ACMEIssuerDNS01ProviderRoute53 struct {
@@ -868,6 +1097,58 @@ type ACMEIssuerDNS01ProviderRFC2136 struct {
 	// Protocol to use for dynamic DNS update queries. Valid values are (case-sensitive) ``TCP`` and ``UDP``; ``UDP`` (default).
 	// +optional
 	Protocol RFC2136UpdateProtocol `json:"protocol,omitempty"`
+
+	// Authenticate dynamic DNS updates using GSS-TSIG (RFC3645), as required
+	// by Active Directory integrated zones accepting only secure updates.
+	// Cannot be used together with ``tsigKeyName`` or ``sig0``.
+	// +optional
+	GSSTSIG *ACMEIssuerDNS01ProviderRFC2136GSSTSIG `json:"gssTSIG,omitempty"`
+
+	// Authenticate dynamic DNS updates using a SIG(0) (RFC2931) key pair.
+	// Cannot be used together with ``tsigKeyName`` or ``gssTSIG``.
+	// +optional
+	SIG0 *ACMEIssuerDNS01ProviderRFC2136SIG0 `json:"sig0,omitempty"`
+}
+
+// ACMEIssuerDNS01ProviderRFC2136GSSTSIG configures GSS-TSIG authentication of
+// RFC2136 dynamic DNS updates, using Kerberos credentials read from a keytab.
+type ACMEIssuerDNS01ProviderRFC2136GSSTSIG struct {
+	// The Kerberos realm of the principal, for example ``EXAMPLE.COM``.
+	Realm string `json:"realm"`
+
+	// The name of the Kerberos principal to authenticate as, without the
+	// realm, for example ``cert-manager``.
+	Username string `json:"username"`
+
+	// A reference to a key in a Secret holding a keytab containing the keys
+	// of the principal.
+	KeytabSecretRef cmmeta.SecretKeySelector `json:"keytabSecretRef"`
+
+	// The addresses of the Key Distribution Centers of the realm, in the form
+	// host:port. If not set, the KDCs are discovered using DNS SRV records.
+	// +optional
+	// +listType=atomic
+	KDCs []string `json:"kdcs,omitempty"`
+
+	// The service principal name of the DNS server.
+	// Defaults to ``DNS/<host>``, where ``<host>`` is the host part of
+	// ``nameserver``.
+	// +optional
+	ServicePrincipalName string `json:"servicePrincipalName,omitempty"`
+}
+
+// ACMEIssuerDNS01ProviderRFC2136SIG0 configures SIG(0) authentication of
+// RFC2136 dynamic DNS updates.
+type ACMEIssuerDNS01ProviderRFC2136SIG0 struct {
+	// The public KEY resource record of the key pair, as generated by
+	// ``dnssec-keygen -T KEY`` in the ``K<name>+<alg>+<id>.key`` file, for
+	// example ``update.example.com. IN KEY 512 3 13 <base64 key>``.
+	PublicKey string `json:"publicKey"`
+
+	// A reference to a key in a Secret holding the private key of the key
+	// pair, in the BIND private key format of the
+	// ``K<name>+<alg>+<id>.private`` file.
+	PrivateKeySecretRef cmmeta.SecretKeySelector `json:"privateKeySecretRef"`
 }
 
 // +kubebuilder:validation:Enum=TCP;UDP
@@ -918,11 +1199,68 @@ type ACMEIssuerStatus struct {
 	// +optional
 	LastRegisteredEmail string `json:"lastRegisteredEmail,omitempty"`
 
+	// LastRegisteredContacts are the additional contact URIs associated with
+	// the latest registered ACME account, in order to track changes made to
+	// registered account associated with the Issuer
+	// +optional
+	// +listType=atomic
+	LastRegisteredContacts []string `json:"lastRegisteredContacts,omitempty"`
+
+	// OrdersURL is the URL from which the list of orders submitted by the
+	// ACME account can be retrieved, if the ACME server provides it.
+	// +optional
+	OrdersURL string `json:"ordersURL,omitempty"`
+
 	// LastPrivateKeyHash is a hash of the private key associated with the latest
 	// registered ACME account, in order to track changes made to registered account
 	// associated with the Issuer
 	// +optional
 	LastPrivateKeyHash string `json:"lastPrivateKeyHash,omitempty"`
+
+	// LastKeyRolloverTime is the time at which the ACME account was last
+	// rolled over to a new private key, or, if it never was, at which
+	// cert-manager started tracking the age of its private key.
+	// +optional
+	LastKeyRolloverTime *metav1.Time `json:"lastKeyRolloverTime,omitempty"`
+
+	// PreAuthorizations are the authorizations obtained for the DNS names
+	// configured in preAuthorization.
+	// +optional
+	// +listType=map
+	// +listMapKey=dnsName
+	PreAuthorizations []ACMEPreAuthorizationStatus `json:"preAuthorizations,omitempty"`
+}
+
+// ACMEPreAuthorizationStatus is the status of the authorization obtained for
+// a pre-authorized DNS name.
+type ACMEPreAuthorizationStatus struct {
+	// DNSName is the pre-authorized DNS name.
+	DNSName string `json:"dnsName"`
+
+	// URL is the URL of the ACME Authorization resource.
+	URL string `json:"url"`
+
+	// State is the state of the ACME Authorization.
+	// +optional
+	State State `json:"state,omitempty"`
+
+	// Expires is the time after which the ACME server considers the
+	// authorization invalid, if known.
+	// +optional
+	Expires *metav1.Time `json:"expires,omitempty"`
+
+	// LastFailureTime is set only if the latest authorization for this DNS
+	// name failed, and contains the time of the failure. A new authorization
+	// is not requested until time.Hour * 2 ^ (failedAttempts - 1) has
+	// elapsed since then, up to a maximum of 32 hours. It is unset once an
+	// authorization becomes valid.
+	// +optional
+	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
+
+	// FailedAttempts is the number of consecutive failed authorizations for
+	// this DNS name. It is unset once an authorization becomes valid.
+	// +optional
+	FailedAttempts int `json:"failedAttempts,omitempty"`
 }
 
 // ACMERenewalInformationSource determines whether to fetch ACME Renewal Information
@@ -32,6 +32,15 @@ const (
 	DefaultRenewBefore = time.Hour * 24 * 30
 )
 
+const (
+	// minimum permitted validity period of a CRL published by a CA issuer
+	MinimumCRLDuration = time.Hour
+
+	// default validity period of a CRL published by a CA issuer if
+	// Issuer.spec.ca.crl.duration is not set
+	DefaultCRLDuration = time.Hour * 24
+)
+
 const (
 	// Default index key for the Secret reference for Token authentication
 	DefaultVaultTokenAuthSecretKey = "token"
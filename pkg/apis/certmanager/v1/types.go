@@ -95,6 +95,11 @@ const (
 	// Annotation key for the name of the certificate that a resource is related to.
 	CertificateNameKey = "cert-manager.io/certificate-name"
 
+	// Annotation key for the namespace of the certificate that a resource is
+	// related to. It is set on resources written for a Certificate outside of
+	// its Secret, such as ConfigMap targets and truststore Secrets.
+	CertificateNamespaceKey = "cert-manager.io/certificate-namespace"
+
 	// Annotation key used to denote whether a Secret is named on a Certificate
 	// as a 'next private key' Secret resource.
 	IsNextPrivateKeySecretLabelKey = "cert-manager.io/next-private-key"
@@ -104,11 +109,17 @@ const (
 	// If unset all CertificateRequests will be kept.
 	RevisionHistoryLimitAnnotationKey = "cert-manager.io/revision-history-limit"
 
+	// Annotation key used to record a checksum of the EncryptedPKCS8
+	// additional output format stored in a Secret, so that a changed private
+	// key or password can be detected without decrypting it.
+	EncryptedPKCS8ChecksumAnnotationKey = "cert-manager.io/encrypted-pkcs8-checksum"
+
 	// Annotation key used to set the PrivateKeyAlgorithm for a Certificate.
 	// If PrivateKeyAlgorithm is specified and `size` is not provided,
 	// key size of 256 will be used for `ECDSA` key algorithm and
 	// key size of 2048 will be used for `RSA` key algorithm.
-	// key size is ignored when using the `Ed25519` key algorithm.
+	// key size is ignored when using the `Ed25519` or `Ed448` key algorithms and
+	// parameter set 44 will be used for `MLDSA` key algorithm.
 	// If unset an algorithm `RSA` will be used.
 	PrivateKeyAlgorithmAnnotationKey = "cert-manager.io/private-key-algorithm"
 
@@ -123,7 +134,9 @@ const (
 	// and will default to `2048` if not specified.
 	// If PrivateKeyAlgorithm is set to `ECDSA`, valid values are `256`, `384` or `521`,
 	// and will default to `256` if not specified.
-	// If PrivateKeyAlgorithm is set to `Ed25519`, Size is ignored.
+	// If PrivateKeyAlgorithm is set to `Ed25519` or `Ed448`, Size is ignored.
+	// If PrivateKeyAlgorithm is set to `MLDSA`, valid values are `44`, `65` or `87`,
+	// and will default to `44` if not specified.
 	// No other values are allowed.
 	PrivateKeySizeAnnotationKey = "cert-manager.io/private-key-size"
 
@@ -177,6 +190,14 @@ const (
 
 	// Annotation to declare the CertificateRequest "revision", belonging to a Certificate Resource
 	CertificateRequestRevisionAnnotationKey = "cert-manager.io/certificate-revision"
+
+	// Annotation added to CertificateRequest resources to mark the certificate
+	// that was issued for the request as revoked. The value is the RFC 5280
+	// CRLReason name, e.g. `keyCompromise` or `superseded`; an empty value
+	// means `unspecified`.
+	// The annotation may also be added to a Certificate resource, to revoke
+	// the certificate that is currently issued for it.
+	CertificateRequestRevocationReasonAnnotationKey = "cert-manager.io/revocation-reason"
 )
 
 const (
@@ -311,6 +332,14 @@ const (
 	KeystorePassword = "keystorePassword"
 )
 
+// CA issuer specific secret keys
+const (
+	// CRLSecretKey is the name of the data entry in the Secret resource used
+	// to store the DER encoded certificate revocation list published by a CA
+	// issuer.
+	CRLSecretKey = "ca.crl"
+)
+
 // DefaultKeyUsages contains the default list of key usages
 func DefaultKeyUsages() []KeyUsage {
 	// The serverAuth EKU is required as of Mac OS Catalina: https://support.apple.com/en-us/HT210176
@@ -77,7 +77,7 @@ type CertificateList struct {
 	Items []Certificate `json:"items"`
 }
 
-// +kubebuilder:validation:Enum=RSA;ECDSA;Ed25519
+// +kubebuilder:validation:Enum=RSA;ECDSA;Ed25519;Ed448;MLDSA
 type PrivateKeyAlgorithm string
 
 const (
@@ -89,6 +89,12 @@ const (
 
 	// Ed25519 private key algorithm.
 	Ed25519KeyAlgorithm PrivateKeyAlgorithm = "Ed25519"
+
+	// Ed448 private key algorithm.
+	Ed448KeyAlgorithm PrivateKeyAlgorithm = "Ed448"
+
+	// ML-DSA (FIPS 204) post-quantum private key algorithm.
+	MLDSAKeyAlgorithm PrivateKeyAlgorithm = "MLDSA"
 )
 
 // +kubebuilder:validation:Enum=PKCS1;PKCS8
@@ -99,6 +105,8 @@ const (
 	// For RSA keys: produces PEM block with `BEGIN RSA PRIVATE KEY` header and private key in PKCS#1 format.
 	// For EC keys: produces PEM block with `BEGIN EC PRIVATE KEY` header and private key in SEC 1 format.
 	// For Ed25519 keys: option will be ignored and PKCS8 encoding will be used instead.
+	// For Ed448 keys: option will be ignored and PKCS8 encoding will be used instead.
+	// For ML-DSA keys: option will be ignored and PKCS8 encoding will be used instead.
 	PKCS1 PrivateKeyEncoding = "PKCS1"
 
 	// PKCS8 private key encoding.
@@ -108,7 +116,7 @@ const (
 	PKCS8 PrivateKeyEncoding = "PKCS8"
 )
 
-// +kubebuilder:validation:Enum=SHA256WithRSA;SHA384WithRSA;SHA512WithRSA;ECDSAWithSHA256;ECDSAWithSHA384;ECDSAWithSHA512;PureEd25519
+// +kubebuilder:validation:Enum=SHA256WithRSA;SHA384WithRSA;SHA512WithRSA;ECDSAWithSHA256;ECDSAWithSHA384;ECDSAWithSHA512;PureEd25519;PureEd448;MLDSA44;MLDSA65;MLDSA87
 type SignatureAlgorithm string
 
 const (
@@ -119,6 +127,10 @@ const (
 	ECDSAWithSHA384 SignatureAlgorithm = "ECDSAWithSHA384"
 	ECDSAWithSHA512 SignatureAlgorithm = "ECDSAWithSHA512"
 	PureEd25519     SignatureAlgorithm = "PureEd25519"
+	PureEd448       SignatureAlgorithm = "PureEd448"
+	MLDSA44         SignatureAlgorithm = "MLDSA44"
+	MLDSA65         SignatureAlgorithm = "MLDSA65"
+	MLDSA87         SignatureAlgorithm = "MLDSA87"
 )
 
 // CertificateSpec defines the desired state of Certificate.
@@ -291,10 +303,24 @@ type CertificateSpec struct {
 	// +optional
 	PrivateKey *CertificatePrivateKey `json:"privateKey,omitempty"`
 
+	// CSR is a PEM encoded certificate signing request supplied by the user,
+	// for private keys which are generated and held outside of the cluster.
+	// If set, cert-manager does not generate or store a private key: the CSR
+	// is used as is for every CertificateRequest, including renewals, and
+	// only `tls.crt` and `ca.crt` are written to the Secret.
+	// The subject and subject alternative names are taken from the CSR, so
+	// `commonName`, `subject`, `literalSubject`, the SAN fields,
+	// `privateKey`, `keystores` and `additionalOutputFormats` cannot be set.
+	// +optional
+	CSR *CertificateCSRSource `json:"csr,omitempty"`
+
 	// Signature algorithm to use.
 	// Allowed values for RSA keys: SHA256WithRSA, SHA384WithRSA, SHA512WithRSA.
 	// Allowed values for ECDSA keys: ECDSAWithSHA256, ECDSAWithSHA384, ECDSAWithSHA512.
 	// Allowed values for Ed25519 keys: PureEd25519.
+	// Allowed values for Ed448 keys: PureEd448.
+	// Allowed values for ML-DSA keys: MLDSA44, MLDSA65, MLDSA87, matching the
+	// key's parameter set.
 	// +optional
 	SignatureAlgorithm SignatureAlgorithm `json:"signatureAlgorithm,omitempty"`
 
@@ -322,6 +348,21 @@ type CertificateSpec struct {
 	// +listType=atomic
 	AdditionalOutputFormats []CertificateAdditionalOutputFormat `json:"additionalOutputFormats,omitempty"`
 
+	// ConfigMaps which the public parts of the signed certificate are
+	// published to, in addition to the Secret named in `secretName`.
+	// Each ConfigMap is populated with the `tls.crt` (leaf and chain) and
+	// `ca.crt` entries of the Secret. The private key is never written to a
+	// ConfigMap.
+	// ConfigMaps in a namespace other than the Certificate's are only written
+	// if that namespace is listed for the Certificate's namespace in the
+	// controller's `--configmap-target-namespaces` flag. ConfigMaps which
+	// already exist are only written if they were created for this
+	// Certificate, and ConfigMaps which are removed from this list are
+	// deleted.
+	// +optional
+	// +listType=atomic
+	ConfigMapTargets []CertificateConfigMapTarget `json:"configMapTargets,omitempty"`
+
 	// x.509 certificate NameConstraint extension which MUST NOT be used in a non-CA certificate.
 	// More Info: https://datatracker.ietf.org/doc/html/rfc5280#section-4.2.1.10
 	//
@@ -343,6 +384,25 @@ type OtherName struct {
 	UTF8Value string `json:"utf8Value,omitempty"`
 }
 
+// CertificateCSRSource configures where the certificate signing request for a
+// Certificate is read from. Exactly one of `request` or `secretRef` must be set.
+type CertificateCSRSource struct {
+	// Request is an inline PEM encoded x509 certificate signing request.
+	// +optional
+	Request []byte `json:"request,omitempty"`
+
+	// SecretRef references a PEM encoded x509 certificate signing request
+	// stored in a Secret in the same namespace as the Certificate.
+	// If `key` is not set, the `tls.csr` entry of the Secret is used.
+	// Updating the Secret triggers a re-issuance of the Certificate.
+	// +optional
+	SecretRef *cmmeta.SecretKeySelector `json:"secretRef,omitempty"`
+}
+
+// CertificateCSRDefaultSecretKey is the Secret entry which a Certificate's
+// spec.csr.secretRef is read from if no key is set.
+const CertificateCSRDefaultSecretKey = "tls.csr"
+
 // CertificatePrivateKey contains configuration options for private keys
 // used by the Certificate controller.
 // These include the key algorithm and size, the used encoding and the
@@ -374,11 +434,13 @@ type CertificatePrivateKey struct {
 	// Algorithm is the private key algorithm of the corresponding private key
 	// for this certificate.
 	//
-	// If provided, allowed values are either `RSA`, `ECDSA` or `Ed25519`.
+	// If provided, allowed values are either `RSA`, `ECDSA`, `Ed25519`, `Ed448` or `MLDSA`.
 	// If `algorithm` is specified and `size` is not provided,
-	// key size of 2048 will be used for `RSA` key algorithm and
-	// key size of 256 will be used for `ECDSA` key algorithm.
-	// key size is ignored when using the `Ed25519` key algorithm.
+	// key size of 2048 will be used for `RSA` key algorithm,
+	// key size of 256 will be used for `ECDSA` key algorithm and
+	// parameter set 44 will be used for `MLDSA` key algorithm.
+	// key size is ignored when using the `Ed25519` or `Ed448` key algorithms.
+	// `Ed448` private keys cannot be used with `keystores`.
 	// +optional
 	Algorithm PrivateKeyAlgorithm `json:"algorithm,omitempty"`
 
@@ -388,10 +450,26 @@ type CertificatePrivateKey struct {
 	// and will default to `2048` if not specified.
 	// If `algorithm` is set to `ECDSA`, valid values are `256`, `384` or `521`,
 	// and will default to `256` if not specified.
-	// If `algorithm` is set to `Ed25519`, Size is ignored.
+	// If `algorithm` is set to `Ed25519` or `Ed448`, Size is ignored.
+	// If `algorithm` is set to `MLDSA`, Size selects the ML-DSA parameter set:
+	// valid values are `44`, `65` or `87`, and will default to `44` if not
+	// specified.
 	// No other values are allowed.
 	// +optional
 	Size int `json:"size,omitempty"`
+
+	// Provider is the name of an external key provider which should generate
+	// and hold the private key for this certificate, for example a PKCS#11
+	// hardware security module or a KMS. The private key never leaves the
+	// provider: `tls.key` in the Secret contains only a handle referencing
+	// the key, so `keystores` and `additionalOutputFormats` cannot be used.
+	// The provider must be configured in the cert-manager controller. The only
+	// provider included in cert-manager is `local`, which is intended for
+	// testing; other providers are added by builds of the controller.
+	// If not set, the private key is generated by cert-manager and stored in
+	// the Secret.
+	// +optional
+	Provider string `json:"provider,omitempty"`
 }
 
 // Denotes how private keys should be generated or sourced when a Certificate
@@ -413,13 +491,24 @@ var (
 
 // CertificateOutputFormatType specifies which additional output formats should
 // be written to the Certificate's target Secret.
-// Allowed values are `DER` or `CombinedPEM`.
+// Allowed values are `DER`, `CombinedPEM`, `EncryptedPKCS8`, `PKCS7` or
+// `FullChainPEM`.
 // When Type is set to `DER` an additional entry `key.der` will be written to
 // the Secret, containing the binary format of the private key.
 // When Type is set to `CombinedPEM` an additional entry `tls-combined.pem`
 // will be written to the Secret, containing the PEM formatted private key and
 // signed certificate chain (tls.key + tls.crt concatenated).
-// +kubebuilder:validation:Enum=DER;CombinedPEM
+// When Type is set to `EncryptedPKCS8` an additional entry `tls-encrypted.key`
+// will be written to the Secret, containing the private key as a PEM formatted
+// PKCS#8 `ENCRYPTED PRIVATE KEY`, encrypted with the password referenced by
+// `passwordSecretRef`.
+// When Type is set to `PKCS7` an additional entry `tls.p7b` will be written to
+// the Secret, containing the signed certificate chain and CA as a PEM formatted
+// PKCS#7 certificate bundle, without the private key.
+// When Type is set to `FullChainPEM` an additional entry `fullchain.pem` will
+// be written to the Secret, containing the PEM formatted signed certificate
+// chain followed by the CA, without the private key.
+// +kubebuilder:validation:Enum=DER;CombinedPEM;EncryptedPKCS8;PKCS7;FullChainPEM
 type CertificateOutputFormatType string
 
 const (
@@ -442,6 +531,36 @@ const (
 	// character, followed by the chain of signed certificate PEM documents
 	// (`<private key> + \n + <signed certificate chain>`).
 	CertificateOutputFormatCombinedPEM CertificateOutputFormatType = "CombinedPEM"
+
+	// CertificateOutputFormatEncryptedPKCS8Key is the name of the data entry in
+	// the Secret resource used to store the encrypted PKCS#8 private key.
+	CertificateOutputFormatEncryptedPKCS8Key string = "tls-encrypted.key"
+
+	// CertificateOutputFormatEncryptedPKCS8 writes the Certificate's private
+	// key as a PEM formatted PKCS#8 `ENCRYPTED PRIVATE KEY` to the
+	// `tls-encrypted.key` target Secret Data key. The private key is encrypted
+	// using PBES2 with PBKDF2-HMAC-SHA256 and AES-256-CBC.
+	CertificateOutputFormatEncryptedPKCS8 CertificateOutputFormatType = "EncryptedPKCS8"
+
+	// CertificateOutputFormatPKCS7Key is the name of the data entry in the
+	// Secret resource used to store the PKCS#7 certificate bundle.
+	CertificateOutputFormatPKCS7Key string = "tls.p7b"
+
+	// CertificateOutputFormatPKCS7 writes the Certificate's signed certificate
+	// chain, followed by the CA certificate if one is known, as a PEM formatted
+	// degenerate PKCS#7 SignedData bundle (commonly known as a `.p7b` file) to
+	// the `tls.p7b` target Secret Data key. The private key is not included.
+	CertificateOutputFormatPKCS7 CertificateOutputFormatType = "PKCS7"
+
+	// CertificateOutputFormatFullChainPEMKey is the name of the data entry in
+	// the Secret resource used to store the full certificate chain.
+	CertificateOutputFormatFullChainPEMKey string = "fullchain.pem"
+
+	// CertificateOutputFormatFullChainPEM writes the Certificate's signed
+	// certificate chain, followed by the CA certificate if it is not already
+	// the last certificate in the chain, in PEM format to the `fullchain.pem`
+	// target Secret Data key. The private key is not included.
+	CertificateOutputFormatFullChainPEM CertificateOutputFormatType = "FullChainPEM"
 )
 
 // CertificateAdditionalOutputFormat defines an additional output format of a
@@ -451,6 +570,14 @@ type CertificateAdditionalOutputFormat struct {
 	// Type is the name of the format type that should be written to the
 	// Certificate's target Secret.
 	Type CertificateOutputFormatType `json:"type"`
+
+	// PasswordSecretRef is a reference to a non-empty key in a Secret resource
+	// containing the password used to encrypt the private key.
+	// Required if, and only if, Type is `EncryptedPKCS8`. Changing the password
+	// in the referenced Secret causes the encrypted private key to be
+	// re-written.
+	// +optional
+	PasswordSecretRef *cmmeta.SecretKeySelector `json:"passwordSecretRef,omitempty"`
 }
 
 // X509Subject Full X509 name specification
@@ -500,6 +627,35 @@ type CertificateKeystores struct {
 	// `spec.secretName` Secret resource.
 	// +optional
 	PKCS12 *PKCS12Keystore `json:"pkcs12,omitempty"`
+
+	// Truststore configures writing the JKS and PKCS12 truststores to a
+	// separate Secret resource instead of the `spec.secretName` Secret
+	// resource, so that workloads which only need to trust the issuing
+	// Certificate Authority do not need access to the private key.
+	// +optional
+	Truststore *CertificateTruststore `json:"truststore,omitempty"`
+}
+
+// CertificateTruststore configures the Secret resources which the JKS and
+// PKCS12 truststores of a Certificate are written to.
+type CertificateTruststore struct {
+	// SecretName is the name of the Secret resource, in the Certificate's
+	// namespace, which the truststores are written to. The truststores are
+	// encrypted using the same passwords as the corresponding keystores, and
+	// the Secret also contains the CA certificate as `ca.crt`. It is only
+	// written if the issuer provided a CA certificate, and an existing Secret
+	// is only written if it was created for this Certificate.
+	// Must not be the same as `spec.secretName`.
+	SecretName string `json:"secretName"`
+
+	// Namespaces is a list of additional namespaces which a copy of the
+	// truststore Secret, with the same name, is written to. Copies are only
+	// written to namespaces permitted for the Certificate's namespace by the
+	// controller's `--truststore-secret-namespaces` flag. Copies which are
+	// removed from this list are deleted.
+	// +listType=set
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
 }
 
 // JKS configures options for storing a JKS keystore in the target secret.
@@ -511,7 +667,8 @@ type JKSKeystore struct {
 	// `passwordSecretRef` or `password`.
 	// The keystore file will be updated immediately.
 	// If the issuer provided a CA certificate, a file named `truststore.jks`
-	// will also be created in the target Secret resource, encrypted using the
+	// will also be created in the target Secret resource, or in the Secret
+	// configured by `keystores.truststore`, encrypted using the
 	// password stored in `passwordSecretRef`
 	// containing the issuing Certificate Authority
 	Create bool `json:"create"`
@@ -544,9 +701,9 @@ type PKCS12Keystore struct {
 	// `passwordSecretRef` or in `password`.
 	// The keystore file will be updated immediately.
 	// If the issuer provided a CA certificate, a file named `truststore.p12` will
-	// also be created in the target Secret resource, encrypted using the
-	// password stored in `passwordSecretRef` containing the issuing Certificate
-	// Authority
+	// also be created in the target Secret resource, or in the Secret configured
+	// by `keystores.truststore`, encrypted using the password stored in
+	// `passwordSecretRef` containing the issuing Certificate Authority
 	Create bool `json:"create"`
 
 	// Profile specifies the key and certificate encryption algorithms and the HMAC algorithm
@@ -713,6 +870,44 @@ type CertificateStatus struct {
 	// ACME stores information that is fetched from the ACME CA server.
 	// +optional
 	ACME *CertificateACMEStatus `json:"acme,omitempty"`
+
+	// Revocations records the certificates issued for this Certificate which
+	// have been requested to be revoked, and the outcome of revoking them with
+	// the issuer. Revocation of the current certificate is requested by adding
+	// the `cert-manager.io/revocation-reason` annotation to the Certificate.
+	// +optional
+	// +listType=map
+	// +listMapKey=serialNumber
+	Revocations []CertificateRevocation `json:"revocations,omitempty"`
+}
+
+// CertificateRevocation records the revocation of a single certificate that
+// was issued for a Certificate.
+type CertificateRevocation struct {
+	// SerialNumber of the revoked certificate, in hexadecimal.
+	SerialNumber string `json:"serialNumber"`
+
+	// CertificateRequestName is the name of the CertificateRequest the revoked
+	// certificate was issued for.
+	// +optional
+	CertificateRequestName string `json:"certificateRequestName,omitempty"`
+
+	// Reason is the RFC 5280 CRLReason the certificate was revoked with, e.g.
+	// `keyCompromise` or `superseded`.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Status of the revocation, one of (`True`, `False`, `Unknown`).
+	// `True` means the issuer has revoked the certificate.
+	Status cmmeta.ConditionStatus `json:"status"`
+
+	// Message is a human readable description of the revocation outcome.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// RevocationTime is the time at which the issuer revoked the certificate.
+	// +optional
+	RevocationTime *metav1.Time `json:"revocationTime,omitempty"`
 }
 
 // CertificateCondition contains condition information for a Certificate.
@@ -778,6 +973,18 @@ const (
 	CertificateConditionIssuing CertificateConditionType = "Issuing"
 )
 
+// CertificateConfigMapTarget is a ConfigMap which the public parts of a
+// Certificate's signed certificate are published to.
+type CertificateConfigMapTarget struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap. Defaults to the namespace of the
+	// Certificate.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
 // CertificateSecretTemplate defines the default labels and annotations
 // to be copied to the Kubernetes Secret resource named in `CertificateSpec.secretName`.
 type CertificateSecretTemplate struct {
@@ -17,6 +17,7 @@ limitations under the License.
 package v1
 
 import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
@@ -129,6 +130,34 @@ type IssuerConfig struct {
 	// or SaaS policy zone.
 	// +optional
 	Venafi *VenafiIssuer `json:"venafi,omitempty"`
+
+	// ExternalSigner configures this issuer to forward certificate signing
+	// requests to an external signer plugin, which runs alongside the
+	// cert-manager controller and is reached over a unix socket. Plugins
+	// implement the versioned JSON-over-gRPC protocol defined by the
+	// github.com/cert-manager/cert-manager/pkg/externalsigner package.
+	// +optional
+	ExternalSigner *ExternalSignerIssuer `json:"externalSigner,omitempty"`
+}
+
+// Configures an issuer to forward certificate signing requests to an external
+// signer plugin.
+type ExternalSignerIssuer struct {
+	// Name is the name of the external signer plugin. The controller connects
+	// to the plugin on the unix socket `<name>.sock` in its external signer
+	// socket directory, see the `--external-signer-socket-directory` flag.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Additional configuration that should be passed to the external signer
+	// plugin with every request.
+	// This can contain arbitrary JSON data.
+	// Secret values should not be specified in this stanza.
+	// For details on the schema of this field, consult the external signer
+	// plugin's documentation.
+	// +optional
+	Config *apiextensionsv1.JSON `json:"config,omitempty"`
 }
 
 // Configures an issuer to sign certificates using a CyberArk Certificate Manager Self-Hosted
@@ -453,6 +482,8 @@ type CAIssuer struct {
 	// revocation status of an issued certificate. If not set, the
 	// certificate will be issued with no OCSP servers set. For example, an
 	// OCSP server URL could be "http://ocsp.int-x3.letsencrypt.org".
+	// The cert-manager controller can act as the OCSP responder for CA
+	// issuers when started with `--enable-ocsp-responder`.
 	// +optional
 	// +listType=atomic
 	OCSPServers []string `json:"ocspServers,omitempty"`
@@ -463,6 +494,35 @@ type CAIssuer struct {
 	// +optional
 	// +listType=atomic
 	IssuingCertificateURLs []string `json:"issuingCertificateURLs,omitempty"`
+
+	// CRL configures this issuer to periodically sign a certificate revocation
+	// list (CRL) using the CA keypair and to publish it to a Secret resource.
+	// A certificate is added to the list once the CertificateRequest it was
+	// issued for is annotated with `cert-manager.io/revocation-reason`.
+	// If not set, no CRL will be published.
+	// +optional
+	CRL *CAIssuerCRL `json:"crl,omitempty"`
+}
+
+// CAIssuerCRL configures how a CA issuer publishes its certificate revocation
+// list.
+type CAIssuerCRL struct {
+	// SecretName is the name of the Secret resource that the DER encoded CRL
+	// will be written to, under the `ca.crl` key. The Secret is stored in the
+	// same namespace as the CA keypair Secret.
+	// A CRL does not contain any sensitive data, so this Secret can be
+	// mounted into an HTTP server that serves the URLs listed in
+	// `crlDistributionPoints`.
+	SecretName string `json:"secretName"`
+
+	// Duration is the validity period of each published CRL, i.e. the time
+	// between its `thisUpdate` and `nextUpdate` fields. The CRL is signed
+	// again once two thirds of this period have elapsed, or as soon as a new
+	// certificate is revoked.
+	// Minimum accepted duration is 1 hour.
+	// If not set, a duration of 24 hours is used.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
 }
 
 // IssuerStatus contains status information about an Issuer
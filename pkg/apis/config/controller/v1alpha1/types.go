@@ -77,6 +77,11 @@ type ControllerConfiguration struct {
 	// notably including any EC2 IAM roles available via instance metadata.
 	ClusterIssuerAmbientCredentials *bool `json:"clusterIssuerAmbientCredentials,omitempty"`
 
+	// Directory containing the unix sockets of external signer plugins. An
+	// Issuer with spec.externalSigner.name set to 'foo' is served by the
+	// plugin listening on the socket 'foo.sock' in this directory.
+	ExternalSignerSocketDirectory string `json:"externalSignerSocketDirectory,omitempty"`
+
 	// Whether to set the certificate resource as an owner of secret where the
 	// tls certificate is stored. When this flag is enabled, the secret will be
 	// automatically removed when the certificate resource is deleted.
@@ -104,6 +109,21 @@ type ControllerConfiguration struct {
 	// ones where the key is prefixed with 'kubectl.kubernetes.io/'.
 	CopiedAnnotationPrefixes []string `json:"copiedAnnotationPrefixes,omitempty"`
 
+	// Namespaces, other than the Certificate's own, which Certificates may
+	// publish their public certificate data to using spec.configMapTargets,
+	// keyed by the namespace of the Certificate. ConfigMap targets in any
+	// other namespace are not written, and ConfigMaps which already exist
+	// are only written if they were created for the same Certificate.
+	ConfigMapTargetNamespaces map[string][]string `json:"configMapTargetNamespaces,omitempty"`
+
+	// Namespaces, other than the Certificate's own, which Certificates may
+	// write copies of their truststore Secret to using
+	// spec.keystores.truststore.namespaces, keyed by the namespace of the
+	// Certificate. Copies in any other namespace are not written, and Secrets
+	// which already exist are only written if they were created for the same
+	// Certificate.
+	TruststoreSecretNamespaces map[string][]string `json:"truststoreSecretNamespaces,omitempty"`
+
 	// The number of concurrent workers for each controller.
 	NumberOfConcurrentWorkers *int32 `json:"numberOfConcurrentWorkers,omitempty"`
 
@@ -152,6 +172,13 @@ type ControllerConfiguration struct {
 	// gatewayAPI configures the behaviour of the Gateway API integration
 	GatewayAPIConfig GatewayAPIConfig `json:"gatewayAPI,omitzero"`
 
+	// ocspResponderConfig configures the OCSP responder for CA issuers
+	OCSPResponderConfig OCSPResponderConfig `json:"ocspResponderConfig,omitzero"`
+
+	// keyProviderConfig configures the key providers which can hold the
+	// private keys of Certificates
+	KeyProviderConfig KeyProviderConfig `json:"keyProviderConfig,omitzero"`
+
 	// certificateRequestMinimumBackoffDuration configures the minimum backoff duration
 	// when a certificate request fails (default 1h). The backoff delay starts at
 	// this value and is exponentially increased with each consecutive failure,
@@ -243,10 +270,15 @@ type ACMEHTTP01Config struct {
 
 type ACMEDNS01Config struct {
 	// Each nameserver can be either the IP address and port of a standard
-	// recursive DNS server, or the endpoint to an RFC 8484 DNS over HTTPS
-	// endpoint. For example, the following values are valid:
+	// recursive DNS server, the endpoint to an RFC 8484 DNS over HTTPS
+	// endpoint, or the address of an RFC 7858 DNS over TLS server. For
+	// example, the following values are valid:
 	//  - "8.8.8.8:53" (Standard DNS)
 	//  - "https://1.1.1.1/dns-query" (DNS over HTTPS)
+	//  - "tls://1.1.1.1:853" (DNS over TLS)
+	// When any nameserver uses DNS over HTTPS or DNS over TLS, the DNS01 self
+	// check does not query the authoritative nameservers directly, as this
+	// can only be done using plain DNS.
 	RecursiveNameservers []string `json:"recursiveNameservers,omitempty"`
 
 	// When true, cert-manager will only ever query the configured DNS resolvers
@@ -283,6 +315,37 @@ type GatewayAPIConfig struct {
 	ExtraProtocols []string `json:"extraProtocols,omitempty"`
 }
 
+type OCSPResponderConfig struct {
+	// Enabled specifies whether the controller should serve RFC 6960 OCSP
+	// responses for certificates issued by CA Issuers and ClusterIssuers.
+	// The responder runs on every controller replica, regardless of leader
+	// election.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// ListenAddress is the host and port that the OCSP responder should
+	// listen on. Defaults to 0.0.0.0:9408.
+	ListenAddress string `json:"listenAddress,omitempty"`
+
+	// ResponseDuration is the validity period of each OCSP response, i.e.
+	// the time between its thisUpdate and nextUpdate fields. This should be a
+	// valid duration string, for example 30m or 1h. Defaults to 1h.
+	ResponseDuration *sharedv1alpha1.Duration `json:"responseDuration,omitempty"`
+}
+
+type KeyProviderConfig struct {
+	// LocalDirectory is the path of a directory in which the `local` key
+	// provider stores private keys. The `local` provider stands in for a
+	// hardware security module or KMS and is intended for testing only.
+	// If empty, the `local` key provider is disabled.
+	LocalDirectory string `json:"localDirectory,omitempty"`
+
+	// Plugins maps the names of out-of-process key providers to the paths
+	// of the unix sockets on which they serve the KeyProvider gRPC service.
+	// Certificates refer to these providers by name in
+	// spec.privateKey.provider.
+	Plugins map[string]string `json:"plugins,omitempty"`
+}
+
 type PEMSizeLimitsConfig struct {
 	// Maximum size for a single PEM-encoded certificate (in bytes).
 	// Defaults to 36500 bytes.
@@ -140,6 +140,36 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ConfigMapTargetNamespaces != nil {
+		in, out := &in.ConfigMapTargetNamespaces, &out.ConfigMapTargetNamespaces
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.TruststoreSecretNamespaces != nil {
+		in, out := &in.TruststoreSecretNamespaces, &out.TruststoreSecretNamespaces
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
 	if in.NumberOfConcurrentWorkers != nil {
 		in, out := &in.NumberOfConcurrentWorkers, &out.NumberOfConcurrentWorkers
 		*out = new(int32)
@@ -169,6 +199,8 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	in.ACMEDNS01Config.DeepCopyInto(&out.ACMEDNS01Config)
 	in.PEMSizeLimitsConfig.DeepCopyInto(&out.PEMSizeLimitsConfig)
 	in.GatewayAPIConfig.DeepCopyInto(&out.GatewayAPIConfig)
+	in.OCSPResponderConfig.DeepCopyInto(&out.OCSPResponderConfig)
+	in.KeyProviderConfig.DeepCopyInto(&out.KeyProviderConfig)
 	if in.CertificateRequestMinimumBackoffDuration != nil {
 		in, out := &in.CertificateRequestMinimumBackoffDuration, &out.CertificateRequestMinimumBackoffDuration
 		*out = new(sharedv1alpha1.Duration)
@@ -257,6 +289,29 @@ func (in *IngressShimConfig) DeepCopy() *IngressShimConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyProviderConfig) DeepCopyInto(out *KeyProviderConfig) {
+	*out = *in
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyProviderConfig.
+func (in *KeyProviderConfig) DeepCopy() *KeyProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LeaderElectionConfig) DeepCopyInto(out *LeaderElectionConfig) {
 	*out = *in
@@ -279,6 +334,32 @@ func (in *LeaderElectionConfig) DeepCopy() *LeaderElectionConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCSPResponderConfig) DeepCopyInto(out *OCSPResponderConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResponseDuration != nil {
+		in, out := &in.ResponseDuration, &out.ResponseDuration
+		*out = new(sharedv1alpha1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCSPResponderConfig.
+func (in *OCSPResponderConfig) DeepCopy() *OCSPResponderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OCSPResponderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PEMSizeLimitsConfig) DeepCopyInto(out *PEMSizeLimitsConfig) {
 	*out = *in
@@ -47,6 +47,22 @@ const (
 	CertificateSigningRequestPrivateKeyAnnotationKey = "experimental.cert-manager.io/private-key-secret-name"
 )
 
+// ACME Issuer specific Annotations
+const (
+	// CertificateSigningRequestACMEProfileAnnotationKey is the annotation key
+	// used to request a particular ACME certificate profile for the Order
+	// created for a CertificateSigningRequest. If not present, the profile
+	// configured on the ACME Issuer is used.
+	CertificateSigningRequestACMEProfileAnnotationKey = "acme.experimental.cert-manager.io/profile"
+
+	// CertificateSigningRequestACMEReplacesAnnotationKey is the annotation key
+	// used to pass the ARI CertID (RFC 9773 §4.1) of the certificate that a
+	// CertificateSigningRequest is renewing. The value has the form
+	// "base64url(AKI).base64url(serial)" and is only sent to the ACME server
+	// when the Issuer uses ARI.
+	CertificateSigningRequestACMEReplacesAnnotationKey = "acme.experimental.cert-manager.io/replaces"
+)
+
 // Certificate Manager specific Annotations
 const (
 	// CertificateSigningRequestVenafiCustomFieldsAnnotationKey is the annotation
@@ -0,0 +1,69 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "github.com/cert-manager/cert-manager/pkg/client/applyconfigurations/meta/v1"
+	apismetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ACMEAccountKeyRolloverApplyConfiguration represents a declarative configuration of the ACMEAccountKeyRollover type for use
+// with apply.
+//
+// ACMEAccountKeyRollover configures rollover of the ACME account key.
+// When the private key in privateKeySecretRef no longer matches the key that
+// the account was last verified with, and the previous private key does,
+// cert-manager rolls the existing account over to the new key instead of
+// registering a new account.
+type ACMEAccountKeyRolloverApplyConfiguration struct {
+	// previousPrivateKeySecretRef is a reference to the private key that was
+	// used before the key in privateKeySecretRef.
+	// If not set, the `<key>.previous` entry of the privateKeySecretRef Secret
+	// is used, where `<key>` is the entry holding the current private key.
+	PreviousPrivateKey *metav1.SecretKeySelectorApplyConfiguration `json:"previousPrivateKeySecretRef,omitempty"`
+	// rotationPeriod is the interval at which cert-manager generates a new
+	// account private key. The current key is moved to the `<key>.previous`
+	// entry of the privateKeySecretRef Secret, the new key is stored in its
+	// place and the account is then rolled over to it.
+	// May not be set together with previousPrivateKeySecretRef or
+	// disableAccountKeyGeneration.
+	RotationPeriod *apismetav1.Duration `json:"rotationPeriod,omitempty"`
+}
+
+// ACMEAccountKeyRolloverApplyConfiguration constructs a declarative configuration of the ACMEAccountKeyRollover type for use with
+// apply.
+func ACMEAccountKeyRollover() *ACMEAccountKeyRolloverApplyConfiguration {
+	return &ACMEAccountKeyRolloverApplyConfiguration{}
+}
+
+// WithPreviousPrivateKey sets the PreviousPrivateKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreviousPrivateKey field is set to the value of the last call.
+func (b *ACMEAccountKeyRolloverApplyConfiguration) WithPreviousPrivateKey(value *metav1.SecretKeySelectorApplyConfiguration) *ACMEAccountKeyRolloverApplyConfiguration {
+	b.PreviousPrivateKey = value
+	return b
+}
+
+// WithRotationPeriod sets the RotationPeriod field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RotationPeriod field is set to the value of the last call.
+func (b *ACMEAccountKeyRolloverApplyConfiguration) WithRotationPeriod(value apismetav1.Duration) *ACMEAccountKeyRolloverApplyConfiguration {
+	b.RotationPeriod = &value
+	return b
+}
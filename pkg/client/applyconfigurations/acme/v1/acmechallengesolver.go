@@ -43,6 +43,11 @@ type ACMEChallengeSolverApplyConfiguration struct {
 	// Configures cert-manager to attempt to complete authorizations by
 	// performing the DNS01 challenge flow.
 	DNS01 *ACMEChallengeSolverDNS01ApplyConfiguration `json:"dns01,omitempty"`
+	// Configures cert-manager to attempt to complete authorizations by
+	// performing the TLS-ALPN-01 challenge flow.
+	// It is not possible to obtain certificates for wildcard domain names
+	// (e.g., `*.example.com`) using the TLS-ALPN-01 challenge mechanism.
+	TLSALPN01 *ACMEChallengeSolverTLSALPN01ApplyConfiguration `json:"tlsALPN01,omitempty"`
 	// WaitInsteadOfSelfCheck, if set, skips cert-manager's self-check and
 	// instead waits this long after presentation before asking the ACME server
 	// to validate the challenge.
@@ -91,6 +96,14 @@ func (b *ACMEChallengeSolverApplyConfiguration) WithDNS01(value *ACMEChallengeSo
 	return b
 }
 
+// WithTLSALPN01 sets the TLSALPN01 field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLSALPN01 field is set to the value of the last call.
+func (b *ACMEChallengeSolverApplyConfiguration) WithTLSALPN01(value *ACMEChallengeSolverTLSALPN01ApplyConfiguration) *ACMEChallengeSolverApplyConfiguration {
+	b.TLSALPN01 = value
+	return b
+}
+
 // WithWaitInsteadOfSelfCheck sets the WaitInsteadOfSelfCheck field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the WaitInsteadOfSelfCheck field is set to the value of the last call.
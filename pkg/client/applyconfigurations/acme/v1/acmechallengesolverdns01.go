@@ -32,14 +32,32 @@ type ACMEChallengeSolverDNS01ApplyConfiguration struct {
 	// CNAMEStrategy configures how the DNS01 provider should handle CNAME
 	// records when found in DNS zones.
 	CNAMEStrategy *acmev1.CNAMEStrategy `json:"cnameStrategy,omitempty"`
+	// ValidationZone configures the solver to use CNAME delegation to a
+	// dedicated validation zone, for example `acme.example-validation.net`.
+	// When set, every `_acme-challenge.<name>` record is expected to be a CNAME
+	// into this zone, and challenge records are only ever written into this
+	// zone. Challenges for names that are not delegated to the validation zone
+	// fail until the CNAME record has been created.
+	// May not be specified together with cnameStrategy.
+	ValidationZone *string `json:"validationZone,omitempty"`
 	// Nameservers defines a list of DNS nameservers to use for DNS01 propagation
 	// checks. Each entry must be in the format `<host>:<port>` for plain DNS,
-	// where host may be an IP address or hostname, or
-	// `https://<DoH RFC 8484 server address>` for DNS over HTTPS. If not set,
-	// the controller's configured global DNS01 recursive nameservers are used.
+	// where host may be an IP address or hostname,
+	// `https://<DoH RFC 8484 server address>` for DNS over HTTPS, or
+	// `tls://<DoT RFC 7858 server address>[:<port>]` for DNS over TLS, where
+	// the port defaults to 853. If not set, the controller's configured global
+	// DNS01 recursive nameservers are used.
 	// When specified, this overrides the global nameservers for this solver
 	// only, and disables the authoritative nameserver check.
 	Nameservers []string `json:"nameservers,omitempty"`
+	// AccountScoped configures the solver to use the dns-account-01 challenge
+	// type when the ACME server offers it. dns-account-01 places the TXT record
+	// at an `_<label>._acme-challenge` name derived from the ACME account URI,
+	// so that several ACME accounts can solve challenges for the same domain
+	// concurrently without their records colliding.
+	// If the ACME server does not offer dns-account-01 for an authorization,
+	// the dns-01 challenge type is used instead.
+	AccountScoped *bool `json:"accountScoped,omitempty"`
 	// Use the Akamai DNS zone management API to manage DNS01 challenge records.
 	Akamai *ACMEIssuerDNS01ProviderAkamaiApplyConfiguration `json:"akamai,omitempty"`
 	// Use the Google Cloud DNS API to manage DNS01 challenge records.
@@ -52,6 +70,9 @@ type ACMEChallengeSolverDNS01ApplyConfiguration struct {
 	AzureDNS *ACMEIssuerDNS01ProviderAzureDNSApplyConfiguration `json:"azureDNS,omitempty"`
 	// Use the DigitalOcean DNS API to manage DNS01 challenge records.
 	DigitalOcean *ACMEIssuerDNS01ProviderDigitalOceanApplyConfiguration `json:"digitalocean,omitempty"`
+	// Use the PowerDNS Authoritative Server HTTP API to manage DNS01 challenge
+	// records.
+	PowerDNS *ACMEIssuerDNS01ProviderPowerDNSApplyConfiguration `json:"powerDNS,omitempty"`
 	// Use the 'ACME DNS' (https://github.com/joohoi/acme-dns) API to manage
 	// DNS01 challenge records.
 	AcmeDNS *ACMEIssuerDNS01ProviderAcmeDNSApplyConfiguration `json:"acmeDNS,omitempty"`
@@ -77,6 +98,14 @@ func (b *ACMEChallengeSolverDNS01ApplyConfiguration) WithCNAMEStrategy(value acm
 	return b
 }
 
+// WithValidationZone sets the ValidationZone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ValidationZone field is set to the value of the last call.
+func (b *ACMEChallengeSolverDNS01ApplyConfiguration) WithValidationZone(value string) *ACMEChallengeSolverDNS01ApplyConfiguration {
+	b.ValidationZone = &value
+	return b
+}
+
 // WithNameservers adds the given value to the Nameservers field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Nameservers field.
@@ -87,6 +116,14 @@ func (b *ACMEChallengeSolverDNS01ApplyConfiguration) WithNameservers(values ...s
 	return b
 }
 
+// WithAccountScoped sets the AccountScoped field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AccountScoped field is set to the value of the last call.
+func (b *ACMEChallengeSolverDNS01ApplyConfiguration) WithAccountScoped(value bool) *ACMEChallengeSolverDNS01ApplyConfiguration {
+	b.AccountScoped = &value
+	return b
+}
+
 // WithAkamai sets the Akamai field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Akamai field is set to the value of the last call.
@@ -135,6 +172,14 @@ func (b *ACMEChallengeSolverDNS01ApplyConfiguration) WithDigitalOcean(value *ACM
 	return b
 }
 
+// WithPowerDNS sets the PowerDNS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PowerDNS field is set to the value of the last call.
+func (b *ACMEChallengeSolverDNS01ApplyConfiguration) WithPowerDNS(value *ACMEIssuerDNS01ProviderPowerDNSApplyConfiguration) *ACMEChallengeSolverDNS01ApplyConfiguration {
+	b.PowerDNS = value
+	return b
+}
+
 // WithAcmeDNS sets the AcmeDNS field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the AcmeDNS field is set to the value of the last call.
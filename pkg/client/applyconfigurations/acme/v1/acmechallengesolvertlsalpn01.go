@@ -0,0 +1,75 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ACMEChallengeSolverTLSALPN01ApplyConfiguration represents a declarative configuration of the ACMEChallengeSolverTLSALPN01 type for use
+// with apply.
+//
+// ACMEChallengeSolverTLSALPN01 contains configuration detailing how to solve
+// TLS-ALPN-01 challenges within a Kubernetes cluster.
+// cert-manager runs a solver pod that answers TLS connections negotiating the
+// `acme-tls/1` protocol with the challenge certificate, and exposes it through
+// a Service. The Service must be reachable on port 443 of the domain being
+// validated, either directly or through a Gateway API TLSRoute.
+type ACMEChallengeSolverTLSALPN01ApplyConfiguration struct {
+	// Optional service type for Kubernetes solver service. Supported values
+	// are NodePort, ClusterIP or LoadBalancer. If unset, defaults to NodePort.
+	ServiceType *corev1.ServiceType `json:"serviceType,omitempty"`
+	// The Gateway API TLSRoute solver will create TLSRoute objects in
+	// passthrough mode, routing connections for the domain being validated to
+	// the solver Service.
+	GatewayTLSRoute *ACMEChallengeSolverTLSALPN01GatewayTLSRouteApplyConfiguration `json:"gatewayTLSRoute,omitempty"`
+	// Optional pod template used to configure the ACME challenge solver pods
+	// used for TLS-ALPN-01 challenges.
+	PodTemplate *ACMEChallengeSolverHTTP01IngressPodTemplateApplyConfiguration `json:"podTemplate,omitempty"`
+}
+
+// ACMEChallengeSolverTLSALPN01ApplyConfiguration constructs a declarative configuration of the ACMEChallengeSolverTLSALPN01 type for use with
+// apply.
+func ACMEChallengeSolverTLSALPN01() *ACMEChallengeSolverTLSALPN01ApplyConfiguration {
+	return &ACMEChallengeSolverTLSALPN01ApplyConfiguration{}
+}
+
+// WithServiceType sets the ServiceType field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceType field is set to the value of the last call.
+func (b *ACMEChallengeSolverTLSALPN01ApplyConfiguration) WithServiceType(value corev1.ServiceType) *ACMEChallengeSolverTLSALPN01ApplyConfiguration {
+	b.ServiceType = &value
+	return b
+}
+
+// WithGatewayTLSRoute sets the GatewayTLSRoute field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GatewayTLSRoute field is set to the value of the last call.
+func (b *ACMEChallengeSolverTLSALPN01ApplyConfiguration) WithGatewayTLSRoute(value *ACMEChallengeSolverTLSALPN01GatewayTLSRouteApplyConfiguration) *ACMEChallengeSolverTLSALPN01ApplyConfiguration {
+	b.GatewayTLSRoute = value
+	return b
+}
+
+// WithPodTemplate sets the PodTemplate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodTemplate field is set to the value of the last call.
+func (b *ACMEChallengeSolverTLSALPN01ApplyConfiguration) WithPodTemplate(value *ACMEChallengeSolverHTTP01IngressPodTemplateApplyConfiguration) *ACMEChallengeSolverTLSALPN01ApplyConfiguration {
+	b.PodTemplate = value
+	return b
+}
@@ -0,0 +1,71 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// ACMEChallengeSolverTLSALPN01GatewayTLSRouteApplyConfiguration represents a declarative configuration of the ACMEChallengeSolverTLSALPN01GatewayTLSRoute type for use
+// with apply.
+//
+// The ACMEChallengeSolverTLSALPN01GatewayTLSRoute solver will create TLSRoute
+// objects for a Gateway listener in TLS passthrough mode, routing to an ACME
+// challenge solver pod.
+type ACMEChallengeSolverTLSALPN01GatewayTLSRouteApplyConfiguration struct {
+	// Custom labels that will be applied to TLSRoutes created by cert-manager
+	// while solving TLS-ALPN-01 challenges.
+	Labels map[string]string `json:"labels,omitempty"`
+	// When solving a TLS-ALPN-01 challenge, cert-manager creates a TLSRoute.
+	// cert-manager needs to know which parentRefs should be used when creating
+	// the TLSRoute. Usually, the parentRef references a Gateway listener with
+	// the TLS protocol and the Passthrough TLS mode. See:
+	// https://gateway-api.sigs.k8s.io/guides/tls/#tls-passthrough
+	ParentRefs []apisv1.ParentReference `json:"parentRefs,omitempty"`
+}
+
+// ACMEChallengeSolverTLSALPN01GatewayTLSRouteApplyConfiguration constructs a declarative configuration of the ACMEChallengeSolverTLSALPN01GatewayTLSRoute type for use with
+// apply.
+func ACMEChallengeSolverTLSALPN01GatewayTLSRoute() *ACMEChallengeSolverTLSALPN01GatewayTLSRouteApplyConfiguration {
+	return &ACMEChallengeSolverTLSALPN01GatewayTLSRouteApplyConfiguration{}
+}
+
+// WithLabels puts the entries into the Labels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Labels field,
+// overwriting an existing map entries in Labels field with the same key.
+func (b *ACMEChallengeSolverTLSALPN01GatewayTLSRouteApplyConfiguration) WithLabels(entries map[string]string) *ACMEChallengeSolverTLSALPN01GatewayTLSRouteApplyConfiguration {
+	if b.Labels == nil && len(entries) > 0 {
+		b.Labels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Labels[k] = v
+	}
+	return b
+}
+
+// WithParentRefs adds the given value to the ParentRefs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ParentRefs field.
+func (b *ACMEChallengeSolverTLSALPN01GatewayTLSRouteApplyConfiguration) WithParentRefs(values ...apisv1.ParentReference) *ACMEChallengeSolverTLSALPN01GatewayTLSRouteApplyConfiguration {
+	for i := range values {
+		b.ParentRefs = append(b.ParentRefs, values[i])
+	}
+	return b
+}
@@ -0,0 +1,87 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "github.com/cert-manager/cert-manager/pkg/client/applyconfigurations/meta/v1"
+)
+
+// ACMEExternalAccountBindingProvisionerApplyConfiguration represents a declarative configuration of the ACMEExternalAccountBindingProvisioner type for use
+// with apply.
+//
+// ACMEExternalAccountBindingProvisioner configures an endpoint from which
+// External Account Binding credentials are obtained.
+// cert-manager authenticates to the endpoint with an OAuth 2.0 access token,
+// obtained from the token endpoint using the client credentials grant, and
+// sends it a POST request. The endpoint must respond with a JSON object
+// containing the `keyID` and the un-padded, base64 URL encoded `hmacKey` of
+// the new External Account Binding.
+// Both endpoints are trusted using the caBundle of the ACME issuer.
+type ACMEExternalAccountBindingProvisionerApplyConfiguration struct {
+	// url is the URL of the provisioning endpoint.
+	URL *string `json:"url,omitempty"`
+	// tokenURL is the URL of the OAuth 2.0 token endpoint.
+	TokenURL *string `json:"tokenURL,omitempty"`
+	// scopes are the OAuth 2.0 scopes requested with the access token.
+	Scopes []string `json:"scopes,omitempty"`
+	// credentialsRef is a reference to a Secret containing the OAuth 2.0
+	// client ID and client secret under the `client-id` and `client-secret`
+	// keys.
+	CredentialsRef *metav1.LocalObjectReferenceApplyConfiguration `json:"credentialsRef,omitempty"`
+}
+
+// ACMEExternalAccountBindingProvisionerApplyConfiguration constructs a declarative configuration of the ACMEExternalAccountBindingProvisioner type for use with
+// apply.
+func ACMEExternalAccountBindingProvisioner() *ACMEExternalAccountBindingProvisionerApplyConfiguration {
+	return &ACMEExternalAccountBindingProvisionerApplyConfiguration{}
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *ACMEExternalAccountBindingProvisionerApplyConfiguration) WithURL(value string) *ACMEExternalAccountBindingProvisionerApplyConfiguration {
+	b.URL = &value
+	return b
+}
+
+// WithTokenURL sets the TokenURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TokenURL field is set to the value of the last call.
+func (b *ACMEExternalAccountBindingProvisionerApplyConfiguration) WithTokenURL(value string) *ACMEExternalAccountBindingProvisionerApplyConfiguration {
+	b.TokenURL = &value
+	return b
+}
+
+// WithScopes adds the given value to the Scopes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Scopes field.
+func (b *ACMEExternalAccountBindingProvisionerApplyConfiguration) WithScopes(values ...string) *ACMEExternalAccountBindingProvisionerApplyConfiguration {
+	for i := range values {
+		b.Scopes = append(b.Scopes, values[i])
+	}
+	return b
+}
+
+// WithCredentialsRef sets the CredentialsRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CredentialsRef field is set to the value of the last call.
+func (b *ACMEExternalAccountBindingProvisionerApplyConfiguration) WithCredentialsRef(value *metav1.LocalObjectReferenceApplyConfiguration) *ACMEExternalAccountBindingProvisionerApplyConfiguration {
+	b.CredentialsRef = value
+	return b
+}
@@ -37,6 +37,12 @@ type ACMEIssuerApplyConfiguration struct {
 	// certificates, including expiry notification emails.
 	// This field may be updated after the account is initially registered.
 	Email *string `json:"email,omitempty"`
+	// Contacts is a list of additional contact URIs to be associated with the
+	// ACME account, such as `mailto:admin@example.com`. They are registered
+	// after the address in `email`, if set.
+	// Which URI schemes are supported depends on the ACME server.
+	// This field may be updated after the account is initially registered.
+	Contacts []string `json:"contacts,omitempty"`
 	// Server is the URL used to access the ACME server's 'directory' endpoint.
 	// For example, for Let's Encrypt's staging endpoint, you would use:
 	// "https://acme-staging-v02.api.letsencrypt.org/directory".
@@ -73,12 +79,26 @@ type ACMEIssuerApplyConfiguration struct {
 	// If set, upon registration cert-manager will attempt to associate the given
 	// external account credentials with the registered ACME account.
 	ExternalAccountBinding *ACMEExternalAccountBindingApplyConfiguration `json:"externalAccountBinding,omitempty"`
+	// ExternalAccountBindingProvisioner configures an endpoint from which
+	// External Account Binding credentials are obtained whenever a new ACME
+	// account has to be registered, i.e. when the Issuer is first set up and
+	// after its account private key has been rotated.
+	// This is intended for ACME servers which issue one-time External Account
+	// Binding credentials.
+	// Mutually exclusive with ExternalAccountBinding.
+	ExternalAccountBindingProvisioner *ACMEExternalAccountBindingProvisionerApplyConfiguration `json:"externalAccountBindingProvisioner,omitempty"`
 	// PrivateKey is the name of a Kubernetes Secret resource that will be used to
 	// store the automatically generated ACME account private key.
 	// Optionally, a `key` may be specified to select a specific entry within
 	// the named Secret resource.
 	// If `key` is not specified, a default of `tls.key` will be used.
 	PrivateKey *metav1.SecretKeySelectorApplyConfiguration `json:"privateKeySecretRef,omitempty"`
+	// AccountKeyRollover configures rolling the ACME account over to a new
+	// private key, as described in RFC 8555 section 7.3.5, so that the
+	// account's history, rate limits and External Account Binding are
+	// preserved when its private key changes.
+	// If not set, changing the private key registers a new ACME account.
+	AccountKeyRollover *ACMEAccountKeyRolloverApplyConfiguration `json:"accountKeyRollover,omitempty"`
 	// Solvers is a list of challenge solvers that will be used to solve
 	// ACME challenges for the matching domains.
 	// Solver configurations must be provided in order to obtain certificates
@@ -98,6 +118,13 @@ type ACMEIssuerApplyConfiguration struct {
 	// for the Issuer.
 	// Defaults to false.
 	DisableAccountKeyGeneration *bool `json:"disableAccountKeyGeneration,omitempty"`
+	// Enables deactivating the ACME account when the Issuer resource is
+	// deleted. A deactivated account can no longer be used, not even by other
+	// Issuers referencing the same private key.
+	// If true, a finalizer is added to the Issuer so that the account is
+	// deactivated before the Issuer is removed.
+	// Defaults to false.
+	DeactivateAccountOnDeletion *bool `json:"deactivateAccountOnDeletion,omitempty"`
 	// Enables requesting a Not After date on certificates that matches the
 	// duration of the certificate. This is not supported by all ACME servers
 	// like Let's Encrypt. If set to true when the ACME server does not support
@@ -110,6 +137,13 @@ type ACMEIssuerApplyConfiguration struct {
 	// RenewalInformationSource allows fetching ACME Renewal Information from the ACME CA
 	// server. Default is `ARI`.
 	RenewalInformationSource *acmev1.ACMERenewalInformationSource `json:"renewalInformationSource,omitempty"`
+	// PreAuthorization configures pre-authorization of DNS names, as
+	// described in RFC 8555 section 7.4.1. The Issuer periodically obtains
+	// authorizations for the configured DNS names, so that orders for them
+	// can reuse the valid authorizations instead of solving a challenge for
+	// every order.
+	// Only supported by ACME servers that provide a `newAuthz` endpoint.
+	PreAuthorization *ACMEPreAuthorizationApplyConfiguration `json:"preAuthorization,omitempty"`
 }
 
 // ACMEIssuerApplyConfiguration constructs a declarative configuration of the ACMEIssuer type for use with
@@ -126,6 +160,16 @@ func (b *ACMEIssuerApplyConfiguration) WithEmail(value string) *ACMEIssuerApplyC
 	return b
 }
 
+// WithContacts adds the given value to the Contacts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Contacts field.
+func (b *ACMEIssuerApplyConfiguration) WithContacts(values ...string) *ACMEIssuerApplyConfiguration {
+	for i := range values {
+		b.Contacts = append(b.Contacts, values[i])
+	}
+	return b
+}
+
 // WithServer sets the Server field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Server field is set to the value of the last call.
@@ -168,6 +212,14 @@ func (b *ACMEIssuerApplyConfiguration) WithExternalAccountBinding(value *ACMEExt
 	return b
 }
 
+// WithExternalAccountBindingProvisioner sets the ExternalAccountBindingProvisioner field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExternalAccountBindingProvisioner field is set to the value of the last call.
+func (b *ACMEIssuerApplyConfiguration) WithExternalAccountBindingProvisioner(value *ACMEExternalAccountBindingProvisionerApplyConfiguration) *ACMEIssuerApplyConfiguration {
+	b.ExternalAccountBindingProvisioner = value
+	return b
+}
+
 // WithPrivateKey sets the PrivateKey field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the PrivateKey field is set to the value of the last call.
@@ -176,6 +228,14 @@ func (b *ACMEIssuerApplyConfiguration) WithPrivateKey(value *metav1.SecretKeySel
 	return b
 }
 
+// WithAccountKeyRollover sets the AccountKeyRollover field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AccountKeyRollover field is set to the value of the last call.
+func (b *ACMEIssuerApplyConfiguration) WithAccountKeyRollover(value *ACMEAccountKeyRolloverApplyConfiguration) *ACMEIssuerApplyConfiguration {
+	b.AccountKeyRollover = value
+	return b
+}
+
 // WithSolvers adds the given value to the Solvers field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Solvers field.
@@ -197,6 +257,14 @@ func (b *ACMEIssuerApplyConfiguration) WithDisableAccountKeyGeneration(value boo
 	return b
 }
 
+// WithDeactivateAccountOnDeletion sets the DeactivateAccountOnDeletion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeactivateAccountOnDeletion field is set to the value of the last call.
+func (b *ACMEIssuerApplyConfiguration) WithDeactivateAccountOnDeletion(value bool) *ACMEIssuerApplyConfiguration {
+	b.DeactivateAccountOnDeletion = &value
+	return b
+}
+
 // WithEnableDurationFeature sets the EnableDurationFeature field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the EnableDurationFeature field is set to the value of the last call.
@@ -220,3 +288,11 @@ func (b *ACMEIssuerApplyConfiguration) WithRenewalInformationSource(value acmev1
 	b.RenewalInformationSource = &value
 	return b
 }
+
+// WithPreAuthorization sets the PreAuthorization field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreAuthorization field is set to the value of the last call.
+func (b *ACMEIssuerApplyConfiguration) WithPreAuthorization(value *ACMEPreAuthorizationApplyConfiguration) *ACMEIssuerApplyConfiguration {
+	b.PreAuthorization = value
+	return b
+}
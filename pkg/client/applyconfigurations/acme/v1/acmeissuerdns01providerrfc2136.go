@@ -47,6 +47,13 @@ type ACMEIssuerDNS01ProviderRFC2136ApplyConfiguration struct {
 	TSIGAlgorithm *string `json:"tsigAlgorithm,omitempty"`
 	// Protocol to use for dynamic DNS update queries. Valid values are (case-sensitive) ``TCP`` and ``UDP``; ``UDP`` (default).
 	Protocol *acmev1.RFC2136UpdateProtocol `json:"protocol,omitempty"`
+	// Authenticate dynamic DNS updates using GSS-TSIG (RFC3645), as required
+	// by Active Directory integrated zones accepting only secure updates.
+	// Cannot be used together with ``tsigKeyName`` or ``sig0``.
+	GSSTSIG *ACMEIssuerDNS01ProviderRFC2136GSSTSIGApplyConfiguration `json:"gssTSIG,omitempty"`
+	// Authenticate dynamic DNS updates using a SIG(0) (RFC2931) key pair.
+	// Cannot be used together with ``tsigKeyName`` or ``gssTSIG``.
+	SIG0 *ACMEIssuerDNS01ProviderRFC2136SIG0ApplyConfiguration `json:"sig0,omitempty"`
 }
 
 // ACMEIssuerDNS01ProviderRFC2136ApplyConfiguration constructs a declarative configuration of the ACMEIssuerDNS01ProviderRFC2136 type for use with
@@ -94,3 +101,19 @@ func (b *ACMEIssuerDNS01ProviderRFC2136ApplyConfiguration) WithProtocol(value ac
 	b.Protocol = &value
 	return b
 }
+
+// WithGSSTSIG sets the GSSTSIG field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GSSTSIG field is set to the value of the last call.
+func (b *ACMEIssuerDNS01ProviderRFC2136ApplyConfiguration) WithGSSTSIG(value *ACMEIssuerDNS01ProviderRFC2136GSSTSIGApplyConfiguration) *ACMEIssuerDNS01ProviderRFC2136ApplyConfiguration {
+	b.GSSTSIG = value
+	return b
+}
+
+// WithSIG0 sets the SIG0 field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SIG0 field is set to the value of the last call.
+func (b *ACMEIssuerDNS01ProviderRFC2136ApplyConfiguration) WithSIG0(value *ACMEIssuerDNS01ProviderRFC2136SIG0ApplyConfiguration) *ACMEIssuerDNS01ProviderRFC2136ApplyConfiguration {
+	b.SIG0 = value
+	return b
+}
@@ -0,0 +1,61 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "github.com/cert-manager/cert-manager/pkg/client/applyconfigurations/meta/v1"
+)
+
+// ACMEIssuerDNS01ProviderRFC2136SIG0ApplyConfiguration represents a declarative configuration of the ACMEIssuerDNS01ProviderRFC2136SIG0 type for use
+// with apply.
+//
+// ACMEIssuerDNS01ProviderRFC2136SIG0 configures SIG(0) authentication of
+// RFC2136 dynamic DNS updates.
+type ACMEIssuerDNS01ProviderRFC2136SIG0ApplyConfiguration struct {
+	// The public KEY resource record of the key pair, as generated by
+	// ``dnssec-keygen -T KEY`` in the ``K<name>+<alg>+<id>.key`` file, for
+	// example ``update.example.com. IN KEY 512 3 13 <base64 key>``.
+	PublicKey *string `json:"publicKey,omitempty"`
+	// A reference to a key in a Secret holding the private key of the key
+	// pair, in the BIND private key format of the
+	// ``K<name>+<alg>+<id>.private`` file.
+	PrivateKeySecretRef *metav1.SecretKeySelectorApplyConfiguration `json:"privateKeySecretRef,omitempty"`
+}
+
+// ACMEIssuerDNS01ProviderRFC2136SIG0ApplyConfiguration constructs a declarative configuration of the ACMEIssuerDNS01ProviderRFC2136SIG0 type for use with
+// apply.
+func ACMEIssuerDNS01ProviderRFC2136SIG0() *ACMEIssuerDNS01ProviderRFC2136SIG0ApplyConfiguration {
+	return &ACMEIssuerDNS01ProviderRFC2136SIG0ApplyConfiguration{}
+}
+
+// WithPublicKey sets the PublicKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PublicKey field is set to the value of the last call.
+func (b *ACMEIssuerDNS01ProviderRFC2136SIG0ApplyConfiguration) WithPublicKey(value string) *ACMEIssuerDNS01ProviderRFC2136SIG0ApplyConfiguration {
+	b.PublicKey = &value
+	return b
+}
+
+// WithPrivateKeySecretRef sets the PrivateKeySecretRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PrivateKeySecretRef field is set to the value of the last call.
+func (b *ACMEIssuerDNS01ProviderRFC2136SIG0ApplyConfiguration) WithPrivateKeySecretRef(value *metav1.SecretKeySelectorApplyConfiguration) *ACMEIssuerDNS01ProviderRFC2136SIG0ApplyConfiguration {
+	b.PrivateKeySecretRef = value
+	return b
+}
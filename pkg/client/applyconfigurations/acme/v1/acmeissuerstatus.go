@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // ACMEIssuerStatusApplyConfiguration represents a declarative configuration of the ACMEIssuerStatus type for use
 // with apply.
 type ACMEIssuerStatusApplyConfiguration struct {
@@ -28,10 +32,24 @@ type ACMEIssuerStatusApplyConfiguration struct {
 	// ACME account, in order to track changes made to registered account
 	// associated with the  Issuer
 	LastRegisteredEmail *string `json:"lastRegisteredEmail,omitempty"`
+	// LastRegisteredContacts are the additional contact URIs associated with
+	// the latest registered ACME account, in order to track changes made to
+	// registered account associated with the Issuer
+	LastRegisteredContacts []string `json:"lastRegisteredContacts,omitempty"`
+	// OrdersURL is the URL from which the list of orders submitted by the
+	// ACME account can be retrieved, if the ACME server provides it.
+	OrdersURL *string `json:"ordersURL,omitempty"`
 	// LastPrivateKeyHash is a hash of the private key associated with the latest
 	// registered ACME account, in order to track changes made to registered account
 	// associated with the Issuer
 	LastPrivateKeyHash *string `json:"lastPrivateKeyHash,omitempty"`
+	// LastKeyRolloverTime is the time at which the ACME account was last
+	// rolled over to a new private key, or, if it never was, at which
+	// cert-manager started tracking the age of its private key.
+	LastKeyRolloverTime *metav1.Time `json:"lastKeyRolloverTime,omitempty"`
+	// PreAuthorizations are the authorizations obtained for the DNS names
+	// configured in preAuthorization.
+	PreAuthorizations []ACMEPreAuthorizationStatusApplyConfiguration `json:"preAuthorizations,omitempty"`
 }
 
 // ACMEIssuerStatusApplyConfiguration constructs a declarative configuration of the ACMEIssuerStatus type for use with
@@ -56,6 +74,24 @@ func (b *ACMEIssuerStatusApplyConfiguration) WithLastRegisteredEmail(value strin
 	return b
 }
 
+// WithLastRegisteredContacts adds the given value to the LastRegisteredContacts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the LastRegisteredContacts field.
+func (b *ACMEIssuerStatusApplyConfiguration) WithLastRegisteredContacts(values ...string) *ACMEIssuerStatusApplyConfiguration {
+	for i := range values {
+		b.LastRegisteredContacts = append(b.LastRegisteredContacts, values[i])
+	}
+	return b
+}
+
+// WithOrdersURL sets the OrdersURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OrdersURL field is set to the value of the last call.
+func (b *ACMEIssuerStatusApplyConfiguration) WithOrdersURL(value string) *ACMEIssuerStatusApplyConfiguration {
+	b.OrdersURL = &value
+	return b
+}
+
 // WithLastPrivateKeyHash sets the LastPrivateKeyHash field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the LastPrivateKeyHash field is set to the value of the last call.
@@ -63,3 +99,24 @@ func (b *ACMEIssuerStatusApplyConfiguration) WithLastPrivateKeyHash(value string
 	b.LastPrivateKeyHash = &value
 	return b
 }
+
+// WithLastKeyRolloverTime sets the LastKeyRolloverTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastKeyRolloverTime field is set to the value of the last call.
+func (b *ACMEIssuerStatusApplyConfiguration) WithLastKeyRolloverTime(value metav1.Time) *ACMEIssuerStatusApplyConfiguration {
+	b.LastKeyRolloverTime = &value
+	return b
+}
+
+// WithPreAuthorizations adds the given value to the PreAuthorizations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PreAuthorizations field.
+func (b *ACMEIssuerStatusApplyConfiguration) WithPreAuthorizations(values ...*ACMEPreAuthorizationStatusApplyConfiguration) *ACMEIssuerStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPreAuthorizations")
+		}
+		b.PreAuthorizations = append(b.PreAuthorizations, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,61 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ACMEPreAuthorizationApplyConfiguration represents a declarative configuration of the ACMEPreAuthorization type for use
+// with apply.
+//
+// ACMEPreAuthorization configures pre-authorization of DNS names.
+type ACMEPreAuthorizationApplyConfiguration struct {
+	// DNSNames is the list of DNS names to pre-authorize, such as zone apexes.
+	// Wildcard DNS names cannot be pre-authorized.
+	DNSNames []string `json:"dnsNames,omitempty"`
+	// RenewBefore is how long before a pre-authorization expires that a new
+	// one is obtained.
+	// Defaults to 72h.
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// ACMEPreAuthorizationApplyConfiguration constructs a declarative configuration of the ACMEPreAuthorization type for use with
+// apply.
+func ACMEPreAuthorization() *ACMEPreAuthorizationApplyConfiguration {
+	return &ACMEPreAuthorizationApplyConfiguration{}
+}
+
+// WithDNSNames adds the given value to the DNSNames field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DNSNames field.
+func (b *ACMEPreAuthorizationApplyConfiguration) WithDNSNames(values ...string) *ACMEPreAuthorizationApplyConfiguration {
+	for i := range values {
+		b.DNSNames = append(b.DNSNames, values[i])
+	}
+	return b
+}
+
+// WithRenewBefore sets the RenewBefore field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RenewBefore field is set to the value of the last call.
+func (b *ACMEPreAuthorizationApplyConfiguration) WithRenewBefore(value metav1.Duration) *ACMEPreAuthorizationApplyConfiguration {
+	b.RenewBefore = &value
+	return b
+}
@@ -22,7 +22,8 @@ package v1
 // with apply.
 //
 // CertificateDNSNameSelector selects certificates using a label selector, and
-// can optionally select individual DNS names within those certificates.
+// can optionally select individual DNS names or IP addresses within those
+// certificates.
 // If both MatchLabels and DNSNames are empty, this selector will match all
 // certificates and DNS names within them.
 type CertificateDNSNameSelectorApplyConfiguration struct {
@@ -47,6 +48,21 @@ type CertificateDNSNameSelectorApplyConfiguration struct {
 	// If neither has more matches, the solver defined earlier in the list
 	// will be selected.
 	DNSZones []string `json:"dnsZones,omitempty"`
+	// List of IP address ranges, in CIDR notation, that this solver will be
+	// used to solve IP address identifiers for. A single IP address can be
+	// selected using a /32 (IPv4) or /128 (IPv6) range.
+	// The most specific range match specified here will take precedence over
+	// other range matches, so a solver specifying 10.0.1.0/24 will be
+	// selected over one specifying 10.0.0.0/8 for the IP address 10.0.1.5.
+	// If specified and a match is found, a dnsNames selector will take
+	// precedence over an ipRanges selector.
+	// IP address identifiers never match a dnsZones selector, so ipRanges
+	// may not be combined with dnsZones.
+	// If multiple solvers match with the same ipRanges value, the solver
+	// with the most matching labels in matchLabels will be selected.
+	// If neither has more matches, the solver defined earlier in the list
+	// will be selected.
+	IPRanges []string `json:"ipRanges,omitempty"`
 }
 
 // CertificateDNSNameSelectorApplyConfiguration constructs a declarative configuration of the CertificateDNSNameSelector type for use with
@@ -88,3 +104,13 @@ func (b *CertificateDNSNameSelectorApplyConfiguration) WithDNSZones(values ...st
 	}
 	return b
 }
+
+// WithIPRanges adds the given value to the IPRanges field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the IPRanges field.
+func (b *CertificateDNSNameSelectorApplyConfiguration) WithIPRanges(values ...string) *CertificateDNSNameSelectorApplyConfiguration {
+	for i := range values {
+		b.IPRanges = append(b.IPRanges, values[i])
+	}
+	return b
+}
@@ -40,16 +40,19 @@ type ChallengeSpecApplyConfiguration struct {
 	// for example '*.example.com'.
 	Wildcard *bool `json:"wildcard,omitempty"`
 	// The type of ACME challenge this resource represents.
-	// One of "HTTP-01" or "DNS-01".
+	// One of "HTTP-01", "DNS-01", "DNS-ACCOUNT-01" or "TLS-ALPN-01".
 	Type *acmev1.ACMEChallengeType `json:"type,omitempty"`
 	// The ACME challenge token for this challenge.
 	// This is the raw value returned from the ACME server.
 	Token *string `json:"token,omitempty"`
 	// The ACME challenge key for this challenge
-	// For HTTP01 challenges, this is the value that must be responded with to
-	// complete the HTTP01 challenge in the format:
+	// For HTTP01 and TLS-ALPN-01 challenges, this is the key authorization in
+	// the format:
 	// `<private key JWK thumbprint>.<key from acme server for challenge>`.
-	// For DNS01 challenges, this is the base64 encoded SHA256 sum of the
+	// HTTP01 solvers respond with it, and TLS-ALPN-01 solvers embed its SHA256
+	// sum in the acmeIdentifier extension of the challenge certificate.
+	// For DNS01 and DNS-ACCOUNT-01 challenges, this is the base64 encoded
+	// SHA256 sum of the
 	// `<private key JWK thumbprint>.<key from acme server for challenge>`
 	// text that must be set as the TXT record content.
 	Key *string `json:"key,omitempty"`
@@ -62,6 +65,11 @@ type ChallengeSpecApplyConfiguration struct {
 	// If the Issuer is not an 'ACME' Issuer, an error will be returned and the
 	// Challenge will be marked as failed.
 	IssuerRef *metav1.IssuerReferenceApplyConfiguration `json:"issuerRef,omitempty"`
+	// accountURI is the URI of the ACME account that this challenge belongs
+	// to, as recorded in the ACME Issuer's status.
+	// It is only set for DNS-ACCOUNT-01 challenges, where it is used to
+	// compute the account-scoped validation domain name.
+	AccountURI *string `json:"accountURI,omitempty"`
 }
 
 // ChallengeSpecApplyConfiguration constructs a declarative configuration of the ChallengeSpec type for use with
@@ -141,3 +149,11 @@ func (b *ChallengeSpecApplyConfiguration) WithIssuerRef(value *metav1.IssuerRefe
 	b.IssuerRef = value
 	return b
 }
+
+// WithAccountURI sets the AccountURI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AccountURI field is set to the value of the last call.
+func (b *ChallengeSpecApplyConfiguration) WithAccountURI(value string) *ChallengeSpecApplyConfiguration {
+	b.AccountURI = &value
+	return b
+}
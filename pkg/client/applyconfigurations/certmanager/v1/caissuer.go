@@ -33,11 +33,19 @@ type CAIssuerApplyConfiguration struct {
 	// revocation status of an issued certificate. If not set, the
 	// certificate will be issued with no OCSP servers set. For example, an
 	// OCSP server URL could be "http://ocsp.int-x3.letsencrypt.org".
+	// The cert-manager controller can act as the OCSP responder for CA
+	// issuers when started with `--enable-ocsp-responder`.
 	OCSPServers []string `json:"ocspServers,omitempty"`
 	// IssuingCertificateURLs is a list of URLs which this issuer should embed into certificates
 	// it creates. See https://www.rfc-editor.org/rfc/rfc5280#section-4.2.2.1 for more details.
 	// As an example, such a URL might be "http://ca.domain.com/ca.crt".
 	IssuingCertificateURLs []string `json:"issuingCertificateURLs,omitempty"`
+	// CRL configures this issuer to periodically sign a certificate revocation
+	// list (CRL) using the CA keypair and to publish it to a Secret resource.
+	// A certificate is added to the list once the CertificateRequest it was
+	// issued for is annotated with `cert-manager.io/revocation-reason`.
+	// If not set, no CRL will be published.
+	CRL *CAIssuerCRLApplyConfiguration `json:"crl,omitempty"`
 }
 
 // CAIssuerApplyConfiguration constructs a declarative configuration of the CAIssuer type for use with
@@ -83,3 +91,11 @@ func (b *CAIssuerApplyConfiguration) WithIssuingCertificateURLs(values ...string
 	}
 	return b
 }
+
+// WithCRL sets the CRL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CRL field is set to the value of the last call.
+func (b *CAIssuerApplyConfiguration) WithCRL(value *CAIssuerCRLApplyConfiguration) *CAIssuerApplyConfiguration {
+	b.CRL = value
+	return b
+}
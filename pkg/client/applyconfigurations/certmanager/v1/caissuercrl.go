@@ -0,0 +1,67 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CAIssuerCRLApplyConfiguration represents a declarative configuration of the CAIssuerCRL type for use
+// with apply.
+//
+// CAIssuerCRL configures how a CA issuer publishes its certificate revocation
+// list.
+type CAIssuerCRLApplyConfiguration struct {
+	// SecretName is the name of the Secret resource that the DER encoded CRL
+	// will be written to, under the `ca.crl` key. The Secret is stored in the
+	// same namespace as the CA keypair Secret.
+	// A CRL does not contain any sensitive data, so this Secret can be
+	// mounted into an HTTP server that serves the URLs listed in
+	// `crlDistributionPoints`.
+	SecretName *string `json:"secretName,omitempty"`
+	// Duration is the validity period of each published CRL, i.e. the time
+	// between its `thisUpdate` and `nextUpdate` fields. The CRL is signed
+	// again once two thirds of this period have elapsed, or as soon as a new
+	// certificate is revoked.
+	// Minimum accepted duration is 1 hour.
+	// If not set, a duration of 24 hours is used.
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// CAIssuerCRLApplyConfiguration constructs a declarative configuration of the CAIssuerCRL type for use with
+// apply.
+func CAIssuerCRL() *CAIssuerCRLApplyConfiguration {
+	return &CAIssuerCRLApplyConfiguration{}
+}
+
+// WithSecretName sets the SecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretName field is set to the value of the last call.
+func (b *CAIssuerCRLApplyConfiguration) WithSecretName(value string) *CAIssuerCRLApplyConfiguration {
+	b.SecretName = &value
+	return b
+}
+
+// WithDuration sets the Duration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Duration field is set to the value of the last call.
+func (b *CAIssuerCRLApplyConfiguration) WithDuration(value metav1.Duration) *CAIssuerCRLApplyConfiguration {
+	b.Duration = &value
+	return b
+}
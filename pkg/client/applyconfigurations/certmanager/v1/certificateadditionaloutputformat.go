@@ -20,6 +20,7 @@ package v1
 
 import (
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	metav1 "github.com/cert-manager/cert-manager/pkg/client/applyconfigurations/meta/v1"
 )
 
 // CertificateAdditionalOutputFormatApplyConfiguration represents a declarative configuration of the CertificateAdditionalOutputFormat type for use
@@ -32,6 +33,12 @@ type CertificateAdditionalOutputFormatApplyConfiguration struct {
 	// Type is the name of the format type that should be written to the
 	// Certificate's target Secret.
 	Type *certmanagerv1.CertificateOutputFormatType `json:"type,omitempty"`
+	// PasswordSecretRef is a reference to a non-empty key in a Secret resource
+	// containing the password used to encrypt the private key.
+	// Required if, and only if, Type is `EncryptedPKCS8`. Changing the password
+	// in the referenced Secret causes the encrypted private key to be
+	// re-written.
+	PasswordSecretRef *metav1.SecretKeySelectorApplyConfiguration `json:"passwordSecretRef,omitempty"`
 }
 
 // CertificateAdditionalOutputFormatApplyConfiguration constructs a declarative configuration of the CertificateAdditionalOutputFormat type for use with
@@ -47,3 +54,11 @@ func (b *CertificateAdditionalOutputFormatApplyConfiguration) WithType(value cer
 	b.Type = &value
 	return b
 }
+
+// WithPasswordSecretRef sets the PasswordSecretRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PasswordSecretRef field is set to the value of the last call.
+func (b *CertificateAdditionalOutputFormatApplyConfiguration) WithPasswordSecretRef(value *metav1.SecretKeySelectorApplyConfiguration) *CertificateAdditionalOutputFormatApplyConfiguration {
+	b.PasswordSecretRef = value
+	return b
+}
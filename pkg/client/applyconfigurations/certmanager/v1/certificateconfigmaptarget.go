@@ -0,0 +1,54 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// CertificateConfigMapTargetApplyConfiguration represents a declarative configuration of the CertificateConfigMapTarget type for use
+// with apply.
+//
+// CertificateConfigMapTarget is a ConfigMap which the public parts of a
+// Certificate's signed certificate are published to.
+type CertificateConfigMapTargetApplyConfiguration struct {
+	// Name of the ConfigMap.
+	Name *string `json:"name,omitempty"`
+	// Namespace of the ConfigMap. Defaults to the namespace of the
+	// Certificate.
+	Namespace *string `json:"namespace,omitempty"`
+}
+
+// CertificateConfigMapTargetApplyConfiguration constructs a declarative configuration of the CertificateConfigMapTarget type for use with
+// apply.
+func CertificateConfigMapTarget() *CertificateConfigMapTargetApplyConfiguration {
+	return &CertificateConfigMapTargetApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *CertificateConfigMapTargetApplyConfiguration) WithName(value string) *CertificateConfigMapTargetApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *CertificateConfigMapTargetApplyConfiguration) WithNamespace(value string) *CertificateConfigMapTargetApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
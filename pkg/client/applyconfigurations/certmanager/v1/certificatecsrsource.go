@@ -0,0 +1,62 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "github.com/cert-manager/cert-manager/pkg/client/applyconfigurations/meta/v1"
+)
+
+// CertificateCSRSourceApplyConfiguration represents a declarative configuration of the CertificateCSRSource type for use
+// with apply.
+//
+// CertificateCSRSource configures where the certificate signing request for a
+// Certificate is read from. Exactly one of `request` or `secretRef` must be set.
+type CertificateCSRSourceApplyConfiguration struct {
+	// Request is an inline PEM encoded x509 certificate signing request.
+	Request []byte `json:"request,omitempty"`
+	// SecretRef references a PEM encoded x509 certificate signing request
+	// stored in a Secret in the same namespace as the Certificate.
+	// If `key` is not set, the `tls.csr` entry of the Secret is used.
+	// Updating the Secret triggers a re-issuance of the Certificate.
+	SecretRef *metav1.SecretKeySelectorApplyConfiguration `json:"secretRef,omitempty"`
+}
+
+// CertificateCSRSourceApplyConfiguration constructs a declarative configuration of the CertificateCSRSource type for use with
+// apply.
+func CertificateCSRSource() *CertificateCSRSourceApplyConfiguration {
+	return &CertificateCSRSourceApplyConfiguration{}
+}
+
+// WithRequest adds the given value to the Request field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Request field.
+func (b *CertificateCSRSourceApplyConfiguration) WithRequest(values ...byte) *CertificateCSRSourceApplyConfiguration {
+	for i := range values {
+		b.Request = append(b.Request, values[i])
+	}
+	return b
+}
+
+// WithSecretRef sets the SecretRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretRef field is set to the value of the last call.
+func (b *CertificateCSRSourceApplyConfiguration) WithSecretRef(value *metav1.SecretKeySelectorApplyConfiguration) *CertificateCSRSourceApplyConfiguration {
+	b.SecretRef = value
+	return b
+}
@@ -30,6 +30,11 @@ type CertificateKeystoresApplyConfiguration struct {
 	// PKCS12 configures options for storing a PKCS12 keystore in the
 	// `spec.secretName` Secret resource.
 	PKCS12 *PKCS12KeystoreApplyConfiguration `json:"pkcs12,omitempty"`
+	// Truststore configures writing the JKS and PKCS12 truststores to a
+	// separate Secret resource instead of the `spec.secretName` Secret
+	// resource, so that workloads which only need to trust the issuing
+	// Certificate Authority do not need access to the private key.
+	Truststore *CertificateTruststoreApplyConfiguration `json:"truststore,omitempty"`
 }
 
 // CertificateKeystoresApplyConfiguration constructs a declarative configuration of the CertificateKeystores type for use with
@@ -53,3 +58,11 @@ func (b *CertificateKeystoresApplyConfiguration) WithPKCS12(value *PKCS12Keystor
 	b.PKCS12 = value
 	return b
 }
+
+// WithTruststore sets the Truststore field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Truststore field is set to the value of the last call.
+func (b *CertificateKeystoresApplyConfiguration) WithTruststore(value *CertificateTruststoreApplyConfiguration) *CertificateKeystoresApplyConfiguration {
+	b.Truststore = value
+	return b
+}
@@ -52,11 +52,13 @@ type CertificatePrivateKeyApplyConfiguration struct {
 	// Algorithm is the private key algorithm of the corresponding private key
 	// for this certificate.
 	//
-	// If provided, allowed values are either `RSA`, `ECDSA` or `Ed25519`.
+	// If provided, allowed values are either `RSA`, `ECDSA`, `Ed25519`, `Ed448` or `MLDSA`.
 	// If `algorithm` is specified and `size` is not provided,
-	// key size of 2048 will be used for `RSA` key algorithm and
-	// key size of 256 will be used for `ECDSA` key algorithm.
-	// key size is ignored when using the `Ed25519` key algorithm.
+	// key size of 2048 will be used for `RSA` key algorithm,
+	// key size of 256 will be used for `ECDSA` key algorithm and
+	// parameter set 44 will be used for `MLDSA` key algorithm.
+	// key size is ignored when using the `Ed25519` or `Ed448` key algorithms.
+	// `Ed448` private keys cannot be used with `keystores`.
 	Algorithm *certmanagerv1.PrivateKeyAlgorithm `json:"algorithm,omitempty"`
 	// Size is the key bit size of the corresponding private key for this certificate.
 	//
@@ -64,9 +66,23 @@ type CertificatePrivateKeyApplyConfiguration struct {
 	// and will default to `2048` if not specified.
 	// If `algorithm` is set to `ECDSA`, valid values are `256`, `384` or `521`,
 	// and will default to `256` if not specified.
-	// If `algorithm` is set to `Ed25519`, Size is ignored.
+	// If `algorithm` is set to `Ed25519` or `Ed448`, Size is ignored.
+	// If `algorithm` is set to `MLDSA`, Size selects the ML-DSA parameter set:
+	// valid values are `44`, `65` or `87`, and will default to `44` if not
+	// specified.
 	// No other values are allowed.
 	Size *int `json:"size,omitempty"`
+	// Provider is the name of an external key provider which should generate
+	// and hold the private key for this certificate, for example a PKCS#11
+	// hardware security module or a KMS. The private key never leaves the
+	// provider: `tls.key` in the Secret contains only a handle referencing
+	// the key, so `keystores` and `additionalOutputFormats` cannot be used.
+	// The provider must be configured in the cert-manager controller. The only
+	// provider included in cert-manager is `local`, which is intended for
+	// testing; other providers are added by builds of the controller.
+	// If not set, the private key is generated by cert-manager and stored in
+	// the Secret.
+	Provider *string `json:"provider,omitempty"`
 }
 
 // CertificatePrivateKeyApplyConfiguration constructs a declarative configuration of the CertificatePrivateKey type for use with
@@ -106,3 +122,11 @@ func (b *CertificatePrivateKeyApplyConfiguration) WithSize(value int) *Certifica
 	b.Size = &value
 	return b
 }
+
+// WithProvider sets the Provider field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Provider field is set to the value of the last call.
+func (b *CertificatePrivateKeyApplyConfiguration) WithProvider(value string) *CertificatePrivateKeyApplyConfiguration {
+	b.Provider = &value
+	return b
+}
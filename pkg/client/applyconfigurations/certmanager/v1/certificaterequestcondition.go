@@ -30,7 +30,7 @@ import (
 // CertificateRequestCondition contains condition information for a CertificateRequest.
 type CertificateRequestConditionApplyConfiguration struct {
 	// Type of the condition, known values are (`Ready`, `InvalidRequest`,
-	// `Approved`, `Denied`).
+	// `Approved`, `Denied`, `Revoked`).
 	Type *certmanagerv1.CertificateRequestConditionType `json:"type,omitempty"`
 	// Status of the condition, one of (`True`, `False`, `Unknown`).
 	Status *metav1.ConditionStatus `json:"status,omitempty"`
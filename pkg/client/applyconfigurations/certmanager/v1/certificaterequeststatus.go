@@ -29,7 +29,8 @@ import (
 // resulting signed certificate.
 type CertificateRequestStatusApplyConfiguration struct {
 	// List of status conditions to indicate the status of a CertificateRequest.
-	// Known condition types are `Ready`, `InvalidRequest`, `Approved` and `Denied`.
+	// Known condition types are `Ready`, `InvalidRequest`, `Approved`,
+	// `Denied` and `Revoked`.
 	Conditions []CertificateRequestConditionApplyConfiguration `json:"conditions,omitempty"`
 	// The PEM encoded X.509 certificate resulting from the certificate
 	// signing request.
@@ -155,10 +155,22 @@ type CertificateSpecApplyConfiguration struct {
 	// Private key options. These include the key algorithm and size, the used
 	// encoding and the rotation policy.
 	PrivateKey *CertificatePrivateKeyApplyConfiguration `json:"privateKey,omitempty"`
+	// CSR is a PEM encoded certificate signing request supplied by the user,
+	// for private keys which are generated and held outside of the cluster.
+	// If set, cert-manager does not generate or store a private key: the CSR
+	// is used as is for every CertificateRequest, including renewals, and
+	// only `tls.crt` and `ca.crt` are written to the Secret.
+	// The subject and subject alternative names are taken from the CSR, so
+	// `commonName`, `subject`, `literalSubject`, the SAN fields,
+	// `privateKey`, `keystores` and `additionalOutputFormats` cannot be set.
+	CSR *CertificateCSRSourceApplyConfiguration `json:"csr,omitempty"`
 	// Signature algorithm to use.
 	// Allowed values for RSA keys: SHA256WithRSA, SHA384WithRSA, SHA512WithRSA.
 	// Allowed values for ECDSA keys: ECDSAWithSHA256, ECDSAWithSHA384, ECDSAWithSHA512.
 	// Allowed values for Ed25519 keys: PureEd25519.
+	// Allowed values for Ed448 keys: PureEd448.
+	// Allowed values for ML-DSA keys: MLDSA44, MLDSA65, MLDSA87, matching the
+	// key's parameter set.
 	SignatureAlgorithm *certmanagerv1.SignatureAlgorithm `json:"signatureAlgorithm,omitempty"`
 	// Whether the KeyUsage and ExtKeyUsage extensions should be set in the encoded CSR.
 	//
@@ -177,6 +189,18 @@ type CertificateSpecApplyConfiguration struct {
 	// Defines extra output formats of the private key and signed certificate chain
 	// to be written to this Certificate's target Secret.
 	AdditionalOutputFormats []CertificateAdditionalOutputFormatApplyConfiguration `json:"additionalOutputFormats,omitempty"`
+	// ConfigMaps which the public parts of the signed certificate are
+	// published to, in addition to the Secret named in `secretName`.
+	// Each ConfigMap is populated with the `tls.crt` (leaf and chain) and
+	// `ca.crt` entries of the Secret. The private key is never written to a
+	// ConfigMap.
+	// ConfigMaps in a namespace other than the Certificate's are only written
+	// if that namespace is listed for the Certificate's namespace in the
+	// controller's `--configmap-target-namespaces` flag. ConfigMaps which
+	// already exist are only written if they were created for this
+	// Certificate, and ConfigMaps which are removed from this list are
+	// deleted.
+	ConfigMapTargets []CertificateConfigMapTargetApplyConfiguration `json:"configMapTargets,omitempty"`
 	// x.509 certificate NameConstraint extension which MUST NOT be used in a non-CA certificate.
 	// More Info: https://datatracker.ietf.org/doc/html/rfc5280#section-4.2.1.10
 	//
@@ -359,6 +383,14 @@ func (b *CertificateSpecApplyConfiguration) WithPrivateKey(value *CertificatePri
 	return b
 }
 
+// WithCSR sets the CSR field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CSR field is set to the value of the last call.
+func (b *CertificateSpecApplyConfiguration) WithCSR(value *CertificateCSRSourceApplyConfiguration) *CertificateSpecApplyConfiguration {
+	b.CSR = value
+	return b
+}
+
 // WithSignatureAlgorithm sets the SignatureAlgorithm field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the SignatureAlgorithm field is set to the value of the last call.
@@ -396,6 +428,19 @@ func (b *CertificateSpecApplyConfiguration) WithAdditionalOutputFormats(values .
 	return b
 }
 
+// WithConfigMapTargets adds the given value to the ConfigMapTargets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ConfigMapTargets field.
+func (b *CertificateSpecApplyConfiguration) WithConfigMapTargets(values ...*CertificateConfigMapTargetApplyConfiguration) *CertificateSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConfigMapTargets")
+		}
+		b.ConfigMapTargets = append(b.ConfigMapTargets, *values[i])
+	}
+	return b
+}
+
 // WithNameConstraints sets the NameConstraints field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the NameConstraints field is set to the value of the last call.
@@ -76,6 +76,11 @@ type CertificateStatusApplyConfiguration struct {
 	FailedIssuanceAttempts *int `json:"failedIssuanceAttempts,omitempty"`
 	// ACME stores information that is fetched from the ACME CA server.
 	ACME *CertificateACMEStatusApplyConfiguration `json:"acme,omitempty"`
+	// Revocations records the certificates issued for this Certificate which
+	// have been requested to be revoked, and the outcome of revoking them with
+	// the issuer. Revocation of the current certificate is requested by adding
+	// the `cert-manager.io/revocation-reason` annotation to the Certificate.
+	Revocations []CertificateRevocationApplyConfiguration `json:"revocations,omitempty"`
 }
 
 // CertificateStatusApplyConfiguration constructs a declarative configuration of the CertificateStatus type for use with
@@ -160,3 +165,16 @@ func (b *CertificateStatusApplyConfiguration) WithACME(value *CertificateACMESta
 	b.ACME = value
 	return b
 }
+
+// WithRevocations adds the given value to the Revocations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Revocations field.
+func (b *CertificateStatusApplyConfiguration) WithRevocations(values ...*CertificateRevocationApplyConfiguration) *CertificateStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRevocations")
+		}
+		b.Revocations = append(b.Revocations, *values[i])
+	}
+	return b
+}
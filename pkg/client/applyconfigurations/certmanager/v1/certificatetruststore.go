@@ -0,0 +1,65 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// CertificateTruststoreApplyConfiguration represents a declarative configuration of the CertificateTruststore type for use
+// with apply.
+//
+// CertificateTruststore configures the Secret resources which the JKS and
+// PKCS12 truststores of a Certificate are written to.
+type CertificateTruststoreApplyConfiguration struct {
+	// SecretName is the name of the Secret resource, in the Certificate's
+	// namespace, which the truststores are written to. The truststores are
+	// encrypted using the same passwords as the corresponding keystores, and
+	// the Secret also contains the CA certificate as `ca.crt`. It is only
+	// written if the issuer provided a CA certificate, and an existing Secret
+	// is only written if it was created for this Certificate.
+	// Must not be the same as `spec.secretName`.
+	SecretName *string `json:"secretName,omitempty"`
+	// Namespaces is a list of additional namespaces which a copy of the
+	// truststore Secret, with the same name, is written to. Copies are only
+	// written to namespaces permitted for the Certificate's namespace by the
+	// controller's `--truststore-secret-namespaces` flag. Copies which are
+	// removed from this list are deleted.
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// CertificateTruststoreApplyConfiguration constructs a declarative configuration of the CertificateTruststore type for use with
+// apply.
+func CertificateTruststore() *CertificateTruststoreApplyConfiguration {
+	return &CertificateTruststoreApplyConfiguration{}
+}
+
+// WithSecretName sets the SecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretName field is set to the value of the last call.
+func (b *CertificateTruststoreApplyConfiguration) WithSecretName(value string) *CertificateTruststoreApplyConfiguration {
+	b.SecretName = &value
+	return b
+}
+
+// WithNamespaces adds the given value to the Namespaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Namespaces field.
+func (b *CertificateTruststoreApplyConfiguration) WithNamespaces(values ...string) *CertificateTruststoreApplyConfiguration {
+	for i := range values {
+		b.Namespaces = append(b.Namespaces, values[i])
+	}
+	return b
+}
@@ -0,0 +1,64 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// ExternalSignerIssuerApplyConfiguration represents a declarative configuration of the ExternalSignerIssuer type for use
+// with apply.
+//
+// Configures an issuer to forward certificate signing requests to an external
+// signer plugin.
+type ExternalSignerIssuerApplyConfiguration struct {
+	// Name is the name of the external signer plugin. The controller connects
+	// to the plugin on the unix socket `<name>.sock` in its external signer
+	// socket directory, see the `--external-signer-socket-directory` flag.
+	Name *string `json:"name,omitempty"`
+	// Additional configuration that should be passed to the external signer
+	// plugin with every request.
+	// This can contain arbitrary JSON data.
+	// Secret values should not be specified in this stanza.
+	// For details on the schema of this field, consult the external signer
+	// plugin's documentation.
+	Config *apiextensionsv1.JSON `json:"config,omitempty"`
+}
+
+// ExternalSignerIssuerApplyConfiguration constructs a declarative configuration of the ExternalSignerIssuer type for use with
+// apply.
+func ExternalSignerIssuer() *ExternalSignerIssuerApplyConfiguration {
+	return &ExternalSignerIssuerApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ExternalSignerIssuerApplyConfiguration) WithName(value string) *ExternalSignerIssuerApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithConfig sets the Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Config field is set to the value of the last call.
+func (b *ExternalSignerIssuerApplyConfiguration) WithConfig(value apiextensionsv1.JSON) *ExternalSignerIssuerApplyConfiguration {
+	b.Config = &value
+	return b
+}
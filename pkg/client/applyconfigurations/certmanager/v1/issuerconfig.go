@@ -44,6 +44,12 @@ type IssuerConfigApplyConfiguration struct {
 	// Venafi configures this issuer to sign certificates using a CyberArk Certificate Manager Self-Hosted
 	// or SaaS policy zone.
 	Venafi *VenafiIssuerApplyConfiguration `json:"venafi,omitempty"`
+	// ExternalSigner configures this issuer to forward certificate signing
+	// requests to an external signer plugin, which runs alongside the
+	// cert-manager controller and is reached over a unix socket. Plugins
+	// implement the versioned JSON-over-gRPC protocol defined by the
+	// github.com/cert-manager/cert-manager/pkg/externalsigner package.
+	ExternalSigner *ExternalSignerIssuerApplyConfiguration `json:"externalSigner,omitempty"`
 }
 
 // IssuerConfigApplyConfiguration constructs a declarative configuration of the IssuerConfig type for use with
@@ -91,3 +97,11 @@ func (b *IssuerConfigApplyConfiguration) WithVenafi(value *VenafiIssuerApplyConf
 	b.Venafi = value
 	return b
 }
+
+// WithExternalSigner sets the ExternalSigner field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExternalSigner field is set to the value of the last call.
+func (b *IssuerConfigApplyConfiguration) WithExternalSigner(value *ExternalSignerIssuerApplyConfiguration) *IssuerConfigApplyConfiguration {
+	b.ExternalSigner = value
+	return b
+}
@@ -76,3 +76,11 @@ func (b *IssuerSpecApplyConfiguration) WithVenafi(value *VenafiIssuerApplyConfig
 	b.IssuerConfigApplyConfiguration.Venafi = value
 	return b
 }
+
+// WithExternalSigner sets the ExternalSigner field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExternalSigner field is set to the value of the last call.
+func (b *IssuerSpecApplyConfiguration) WithExternalSigner(value *ExternalSignerIssuerApplyConfiguration) *IssuerSpecApplyConfiguration {
+	b.IssuerConfigApplyConfiguration.ExternalSigner = value
+	return b
+}
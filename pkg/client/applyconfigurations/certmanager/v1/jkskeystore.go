@@ -34,7 +34,8 @@ type JKSKeystoreApplyConfiguration struct {
 	// `passwordSecretRef` or `password`.
 	// The keystore file will be updated immediately.
 	// If the issuer provided a CA certificate, a file named `truststore.jks`
-	// will also be created in the target Secret resource, encrypted using the
+	// will also be created in the target Secret resource, or in the Secret
+	// configured by `keystores.truststore`, encrypted using the
 	// password stored in `passwordSecretRef`
 	// containing the issuing Certificate Authority
 	Create *bool `json:"create,omitempty"`
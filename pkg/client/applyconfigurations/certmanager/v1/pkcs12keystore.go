@@ -35,9 +35,9 @@ type PKCS12KeystoreApplyConfiguration struct {
 	// `passwordSecretRef` or in `password`.
 	// The keystore file will be updated immediately.
 	// If the issuer provided a CA certificate, a file named `truststore.p12` will
-	// also be created in the target Secret resource, encrypted using the
-	// password stored in `passwordSecretRef` containing the issuing Certificate
-	// Authority
+	// also be created in the target Secret resource, or in the Secret configured
+	// by `keystores.truststore`, encrypted using the password stored in
+	// `passwordSecretRef` containing the issuing Certificate Authority
 	Create *bool `json:"create,omitempty"`
 	// Profile specifies the key and certificate encryption algorithms and the HMAC algorithm
 	// used to create the PKCS12 keystore. Default value is `LegacyRC2` for backward compatibility.
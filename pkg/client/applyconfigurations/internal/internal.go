@@ -429,6 +429,15 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: numeric
       default: 0
+- name: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEAccountKeyRollover
+  map:
+    fields:
+    - name: previousPrivateKeySecretRef
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.meta.v1.SecretKeySelector
+    - name: rotationPeriod
+      type:
+        namedType: Duration.v1.meta.apis.pkg.apimachinery.k8s.io
 - name: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEAuthorization
   map:
     fields:
@@ -478,12 +487,18 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: selector
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.CertificateDNSNameSelector
+    - name: tlsALPN01
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEChallengeSolverTLSALPN01
     - name: waitInsteadOfSelfCheck
       type:
         namedType: Duration.v1.meta.apis.pkg.apimachinery.k8s.io
 - name: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEChallengeSolverDNS01
   map:
     fields:
+    - name: accountScoped
+      type:
+        scalar: boolean
     - name: acmeDNS
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEIssuerDNS01ProviderAcmeDNS
@@ -511,12 +526,18 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             scalar: string
           elementRelationship: atomic
+    - name: powerDNS
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEIssuerDNS01ProviderPowerDNS
     - name: rfc2136
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEIssuerDNS01ProviderRFC2136
     - name: route53
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEIssuerDNS01ProviderRoute53
+    - name: validationZone
+      type:
+        scalar: string
     - name: webhook
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEIssuerDNS01ProviderWebhook
@@ -700,6 +721,32 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEChallengeSolverHTTP01IngressObjectMeta
       default: {}
+- name: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEChallengeSolverTLSALPN01
+  map:
+    fields:
+    - name: gatewayTLSRoute
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEChallengeSolverTLSALPN01GatewayTLSRoute
+    - name: podTemplate
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEChallengeSolverHTTP01IngressPodTemplate
+    - name: serviceType
+      type:
+        scalar: string
+- name: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEChallengeSolverTLSALPN01GatewayTLSRoute
+  map:
+    fields:
+    - name: labels
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: parentRefs
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.gateway-api.apis.v1.ParentReference
+          elementRelationship: atomic
 - name: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEExternalAccountBinding
   map:
     fields:
@@ -714,12 +761,45 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.meta.v1.SecretKeySelector
       default: {}
+- name: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEExternalAccountBindingProvisioner
+  map:
+    fields:
+    - name: credentialsRef
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.meta.v1.LocalObjectReference
+      default: {}
+    - name: scopes
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: tokenURL
+      type:
+        scalar: string
+      default: ""
+    - name: url
+      type:
+        scalar: string
+      default: ""
 - name: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEIssuer
   map:
     fields:
+    - name: accountKeyRollover
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEAccountKeyRollover
     - name: caBundle
       type:
         scalar: string
+    - name: contacts
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: deactivateAccountOnDeletion
+      type:
+        scalar: boolean
     - name: disableAccountKeyGeneration
       type:
         scalar: boolean
@@ -732,6 +812,12 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: externalAccountBinding
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEExternalAccountBinding
+    - name: externalAccountBindingProvisioner
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEExternalAccountBindingProvisioner
+    - name: preAuthorization
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEPreAuthorization
     - name: preferredChain
       type:
         scalar: string
@@ -852,9 +938,29 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.meta.v1.SecretKeySelector
       default: {}
+- name: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEIssuerDNS01ProviderPowerDNS
+  map:
+    fields:
+    - name: apiKeySecretRef
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.meta.v1.SecretKeySelector
+      default: {}
+    - name: caBundle
+      type:
+        scalar: string
+    - name: host
+      type:
+        scalar: string
+      default: ""
+    - name: serverID
+      type:
+        scalar: string
 - name: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEIssuerDNS01ProviderRFC2136
   map:
     fields:
+    - name: gssTSIG
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEIssuerDNS01ProviderRFC2136GSSTSIG
     - name: nameserver
       type:
         scalar: string
@@ -862,6 +968,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: protocol
       type:
         scalar: string
+    - name: sig0
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEIssuerDNS01ProviderRFC2136SIG0
     - name: tsigAlgorithm
       type:
         scalar: string
@@ -872,6 +981,41 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.meta.v1.SecretKeySelector
       default: {}
+- name: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEIssuerDNS01ProviderRFC2136GSSTSIG
+  map:
+    fields:
+    - name: kdcs
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: keytabSecretRef
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.meta.v1.SecretKeySelector
+      default: {}
+    - name: realm
+      type:
+        scalar: string
+      default: ""
+    - name: servicePrincipalName
+      type:
+        scalar: string
+    - name: username
+      type:
+        scalar: string
+      default: ""
+- name: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEIssuerDNS01ProviderRFC2136SIG0
+  map:
+    fields:
+    - name: privateKeySecretRef
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.meta.v1.SecretKeySelector
+      default: {}
+    - name: publicKey
+      type:
+        scalar: string
+      default: ""
 - name: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEIssuerDNS01ProviderRoute53
   map:
     fields:
@@ -914,15 +1058,70 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEIssuerStatus
   map:
     fields:
+    - name: lastKeyRolloverTime
+      type:
+        namedType: Time.v1.meta.apis.pkg.apimachinery.k8s.io
     - name: lastPrivateKeyHash
       type:
         scalar: string
+    - name: lastRegisteredContacts
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
     - name: lastRegisteredEmail
       type:
         scalar: string
+    - name: ordersURL
+      type:
+        scalar: string
+    - name: preAuthorizations
+      type:
+        list:
+          elementType:
+            namedType: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEPreAuthorizationStatus
+          elementRelationship: associative
+          keys:
+          - dnsName
     - name: uri
       type:
         scalar: string
+- name: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEPreAuthorization
+  map:
+    fields:
+    - name: dnsNames
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: renewBefore
+      type:
+        namedType: Duration.v1.meta.apis.pkg.apimachinery.k8s.io
+- name: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ACMEPreAuthorizationStatus
+  map:
+    fields:
+    - name: dnsName
+      type:
+        scalar: string
+      default: ""
+    - name: expires
+      type:
+        namedType: Time.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: failedAttempts
+      type:
+        scalar: numeric
+    - name: lastFailureTime
+      type:
+        namedType: Time.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: state
+      type:
+        scalar: string
+    - name: url
+      type:
+        scalar: string
+      default: ""
 - name: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.AzureManagedIdentity
   map:
     fields:
@@ -950,6 +1149,12 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             scalar: string
           elementRelationship: atomic
+    - name: ipRanges
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
     - name: matchLabels
       type:
         map:
@@ -979,6 +1184,9 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.cert-manager.cert-manager.pkg.apis.acme.v1.ChallengeSpec
   map:
     fields:
+    - name: accountURI
+      type:
+        scalar: string
     - name: authorizationURL
       type:
         scalar: string
@@ -1154,6 +1362,9 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CAIssuer
   map:
     fields:
+    - name: crl
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CAIssuerCRL
     - name: crlDistributionPoints
       type:
         list:
@@ -1176,6 +1387,16 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CAIssuerCRL
+  map:
+    fields:
+    - name: duration
+      type:
+        namedType: Duration.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: secretName
+      type:
+        scalar: string
+      default: ""
 - name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.Certificate
   map:
     fields:
@@ -1224,10 +1445,22 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateAdditionalOutputFormat
   map:
     fields:
+    - name: passwordSecretRef
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.meta.v1.SecretKeySelector
     - name: type
       type:
         scalar: string
       default: ""
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateCSRSource
+  map:
+    fields:
+    - name: request
+      type:
+        scalar: string
+    - name: secretRef
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.meta.v1.SecretKeySelector
 - name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateCondition
   map:
     fields:
@@ -1251,6 +1484,16 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateConfigMapTarget
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: namespace
+      type:
+        scalar: string
 - name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateKeystores
   map:
     fields:
@@ -1260,6 +1503,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: pkcs12
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.PKCS12Keystore
+    - name: truststore
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateTruststore
 - name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificatePrivateKey
   map:
     fields:
@@ -1269,6 +1515,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: encoding
       type:
         scalar: string
+    - name: provider
+      type:
+        scalar: string
     - name: rotationPolicy
       type:
         scalar: string
@@ -1402,6 +1651,29 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: failureTime
       type:
         namedType: Time.v1.meta.apis.pkg.apimachinery.k8s.io
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRevocation
+  map:
+    fields:
+    - name: certificateRequestName
+      type:
+        scalar: string
+    - name: message
+      type:
+        scalar: string
+    - name: reason
+      type:
+        scalar: string
+    - name: revocationTime
+      type:
+        namedType: Time.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: serialNumber
+      type:
+        scalar: string
+      default: ""
+    - name: status
+      type:
+        scalar: string
+      default: ""
 - name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateSecretTemplate
   map:
     fields:
@@ -1427,6 +1699,15 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: commonName
       type:
         scalar: string
+    - name: configMapTargets
+      type:
+        list:
+          elementType:
+            namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateConfigMapTarget
+          elementRelationship: atomic
+    - name: csr
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateCSRSource
     - name: dnsNames
       type:
         list:
@@ -1548,6 +1829,27 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: revision
       type:
         scalar: numeric
+    - name: revocations
+      type:
+        list:
+          elementType:
+            namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRevocation
+          elementRelationship: associative
+          keys:
+          - serialNumber
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateTruststore
+  map:
+    fields:
+    - name: namespaces
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: secretName
+      type:
+        scalar: string
+      default: ""
 - name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.ClusterIssuer
   map:
     fields:
@@ -1569,6 +1871,16 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.IssuerStatus
       default: {}
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.ExternalSignerIssuer
+  map:
+    fields:
+    - name: config
+      type:
+        namedType: JSON.v1.apiextensions.apis.pkg.apiextensions-apiserver.k8s.io
+    - name: name
+      type:
+        scalar: string
+      default: ""
 - name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.Issuer
   map:
     fields:
@@ -1622,6 +1934,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: ca
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CAIssuer
+    - name: externalSigner
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.ExternalSignerIssuer
     - name: selfSigned
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.SelfSignedIssuer
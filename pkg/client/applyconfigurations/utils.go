@@ -36,6 +36,8 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=acme.cert-manager.io, Version=v1
+	case v1.SchemeGroupVersion.WithKind("ACMEAccountKeyRollover"):
+		return &acmev1.ACMEAccountKeyRolloverApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ACMEAuthorization"):
 		return &acmev1.ACMEAuthorizationApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ACMEChallenge"):
@@ -64,8 +66,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &acmev1.ACMEChallengeSolverHTTP01IngressPodTemplateApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ACMEChallengeSolverHTTP01IngressTemplate"):
 		return &acmev1.ACMEChallengeSolverHTTP01IngressTemplateApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ACMEChallengeSolverTLSALPN01"):
+		return &acmev1.ACMEChallengeSolverTLSALPN01ApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ACMEChallengeSolverTLSALPN01GatewayTLSRoute"):
+		return &acmev1.ACMEChallengeSolverTLSALPN01GatewayTLSRouteApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ACMEExternalAccountBinding"):
 		return &acmev1.ACMEExternalAccountBindingApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ACMEExternalAccountBindingProvisioner"):
+		return &acmev1.ACMEExternalAccountBindingProvisionerApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ACMEIssuer"):
 		return &acmev1.ACMEIssuerApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ACMEIssuerDNS01ProviderAcmeDNS"):
@@ -80,14 +88,24 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &acmev1.ACMEIssuerDNS01ProviderCloudflareApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ACMEIssuerDNS01ProviderDigitalOcean"):
 		return &acmev1.ACMEIssuerDNS01ProviderDigitalOceanApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ACMEIssuerDNS01ProviderPowerDNS"):
+		return &acmev1.ACMEIssuerDNS01ProviderPowerDNSApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ACMEIssuerDNS01ProviderRFC2136"):
 		return &acmev1.ACMEIssuerDNS01ProviderRFC2136ApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ACMEIssuerDNS01ProviderRFC2136GSSTSIG"):
+		return &acmev1.ACMEIssuerDNS01ProviderRFC2136GSSTSIGApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ACMEIssuerDNS01ProviderRFC2136SIG0"):
+		return &acmev1.ACMEIssuerDNS01ProviderRFC2136SIG0ApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ACMEIssuerDNS01ProviderRoute53"):
 		return &acmev1.ACMEIssuerDNS01ProviderRoute53ApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ACMEIssuerDNS01ProviderWebhook"):
 		return &acmev1.ACMEIssuerDNS01ProviderWebhookApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ACMEIssuerStatus"):
 		return &acmev1.ACMEIssuerStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ACMEPreAuthorization"):
+		return &acmev1.ACMEPreAuthorizationApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ACMEPreAuthorizationStatus"):
+		return &acmev1.ACMEPreAuthorizationStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AzureManagedIdentity"):
 		return &acmev1.AzureManagedIdentityApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("CertificateDNSNameSelector"):
@@ -116,6 +134,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationscertmanagerv1.ACMERenewalWindowApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CAIssuer"):
 		return &applyconfigurationscertmanagerv1.CAIssuerApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("CAIssuerCRL"):
+		return &applyconfigurationscertmanagerv1.CAIssuerCRLApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("Certificate"):
 		return &applyconfigurationscertmanagerv1.CertificateApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateACMEARIStatus"):
@@ -126,6 +146,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationscertmanagerv1.CertificateAdditionalOutputFormatApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateCondition"):
 		return &applyconfigurationscertmanagerv1.CertificateConditionApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateConfigMapTarget"):
+		return &applyconfigurationscertmanagerv1.CertificateConfigMapTargetApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateCSRSource"):
+		return &applyconfigurationscertmanagerv1.CertificateCSRSourceApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateKeystores"):
 		return &applyconfigurationscertmanagerv1.CertificateKeystoresApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificatePrivateKey"):
@@ -142,14 +166,20 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationscertmanagerv1.CertificateRequestSpecApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateRequestStatus"):
 		return &applyconfigurationscertmanagerv1.CertificateRequestStatusApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateRevocation"):
+		return &applyconfigurationscertmanagerv1.CertificateRevocationApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateSecretTemplate"):
 		return &applyconfigurationscertmanagerv1.CertificateSecretTemplateApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateSpec"):
 		return &applyconfigurationscertmanagerv1.CertificateSpecApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateStatus"):
 		return &applyconfigurationscertmanagerv1.CertificateStatusApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateTruststore"):
+		return &applyconfigurationscertmanagerv1.CertificateTruststoreApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("ClusterIssuer"):
 		return &applyconfigurationscertmanagerv1.ClusterIssuerApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("ExternalSignerIssuer"):
+		return &applyconfigurationscertmanagerv1.ExternalSignerIssuerApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("Issuer"):
 		return &applyconfigurationscertmanagerv1.IssuerApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("IssuerCondition"):
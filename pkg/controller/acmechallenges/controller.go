@@ -122,6 +122,10 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLi
 		gwAPIHTTPRouteInformer := ctx.GWShared.Gateway().V1().HTTPRoutes()
 		mustSync = append(mustSync, gwAPIHTTPRouteInformer.Informer().HasSynced)
 	}
+	if ctx.GatewayTLSRouteSolverEnabled {
+		gwAPITLSRouteInformer := ctx.GWShared.Gateway().V1().TLSRoutes()
+		mustSync = append(mustSync, gwAPITLSRouteInformer.Informer().HasSynced)
+	}
 
 	// set all the references to the listers for used by the Sync function
 	c.challengeLister = challengeInformer.Lister()
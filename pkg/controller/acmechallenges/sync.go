@@ -38,16 +38,18 @@ import (
 	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	dnsutil "github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
 )
 
 const (
-	reasonDomainVerified = "DomainVerified"
-	reasonCleanUpError   = "CleanUpError"
-	reasonPresentError   = "PresentError"
-	reasonPresented      = "Presented"
-	reasonFailed         = "Failed"
+	reasonDomainVerified    = "DomainVerified"
+	reasonCleanUpError      = "CleanUpError"
+	reasonPresentError      = "PresentError"
+	reasonPresented         = "Presented"
+	reasonFailed            = "Failed"
+	reasonMissingDelegation = "MissingDelegation"
 
 	// How long to wait for an authorization response from the ACME server in acceptChallenge()
 	// before giving up
@@ -58,12 +60,16 @@ const (
 // appropriate way given the config in the Issuer and Certificate.
 type solver interface {
 	// Present the challenge value with the given solver.
+	// A *dnsutil.BatchPendingError is returned while the record waits to be
+	// presented together with the records for other challenges.
 	Present(ctx context.Context, issuer cmapi.GenericIssuer, ch *cmacme.Challenge) error
 	// Check returns an Error if the propagation check didn't succeed.
 	Check(ctx context.Context, issuer cmapi.GenericIssuer, ch *cmacme.Challenge) error
 	// CleanUp will remove challenge records for a given solver.
 	// This may involve deleting resources in the Kubernetes API Server, or
 	// communicating with other external components (e.g., DNS providers).
+	// A *dnsutil.BatchPendingError is returned while the record waits to be
+	// cleaned up together with the records for other challenges.
 	CleanUp(ctx context.Context, ch *cmacme.Challenge) error
 }
 
@@ -105,6 +111,10 @@ func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (er
 			// The resource still has ACME finalizers, we attempt to finalize the resource
 			// by calling CleanUp and then remove the finalizers if successful
 			if err := c.finalize(ctx, ch); err != nil {
+				if pending, ok := errors.AsType[*dnsutil.BatchPendingError](err); ok {
+					c.queue.AddAfter(types.NamespacedName{Namespace: ch.Namespace, Name: ch.Name}, pending.RetryAfter)
+					return nil
+				}
 				return err
 			}
 
@@ -177,6 +187,21 @@ func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (er
 
 	if !ch.Status.Presented {
 		err := solver.Present(ctx, genericIssuer, ch)
+		if pending, ok := errors.AsType[*dnsutil.BatchPendingError](err); ok {
+			// The record is presented together with the records for other
+			// challenges in the same zone, so check back once the batch has
+			// been applied rather than blocking this worker.
+			ch.Status.Reason = "Waiting for the DNS01 record to be presented together with other records in the same zone"
+			c.queue.AddAfter(types.NamespacedName{Namespace: ch.Namespace, Name: ch.Name}, pending.RetryAfter)
+			return nil
+		}
+		if delegationErr, ok := errors.AsType[*dnsutil.DelegationError](err); ok {
+			// The challenge can't be presented until the user creates the
+			// CNAME record, so tell them exactly which record is missing.
+			c.recorder.Eventf(ch, corev1.EventTypeWarning, reasonMissingDelegation, "Challenge is not delegated to the validation zone: %v", delegationErr)
+			ch.Status.Reason = fmt.Sprintf("Waiting for CNAME delegation to the validation zone: %v", delegationErr)
+			return err
+		}
 		if err != nil {
 			c.recorder.Eventf(ch, corev1.EventTypeWarning, reasonPresentError, "Error presenting challenge: %v", err)
 			// stabilize the error message to avoid spurious updates which would
@@ -359,6 +384,10 @@ func (c *controller) finalize(ctx context.Context, ch *cmacme.Challenge) (err er
 	}
 
 	err = solver.CleanUp(ctx, ch)
+	if _, ok := errors.AsType[*dnsutil.BatchPendingError](err); ok {
+		log.V(logf.DebugLevel).Info("waiting for the challenge record to be cleaned up together with other records in the same zone")
+		return err
+	}
 	if err != nil {
 		err := fmt.Errorf("Error cleaning up challenge: %v", err)
 		c.recorder.Eventf(ch, corev1.EventTypeWarning, reasonCleanUpError, "%s", err.Error())
@@ -504,9 +533,9 @@ func (c *controller) handleAuthorizationError(ctx context.Context, ch *cmacme.Ch
 
 func (c *controller) solverFor(challengeType cmacme.ACMEChallengeType) (solver, error) {
 	switch challengeType {
-	case cmacme.ACMEChallengeTypeHTTP01:
+	case cmacme.ACMEChallengeTypeHTTP01, cmacme.ACMEChallengeTypeTLSALPN01:
 		return c.httpSolver, nil
-	case cmacme.ACMEChallengeTypeDNS01:
+	case cmacme.ACMEChallengeTypeDNS01, cmacme.ACMEChallengeTypeDNSAccount01:
 		return c.dnsSolver, nil
 	}
 	return nil, fmt.Errorf("no solver for %q implemented", challengeType)
@@ -32,10 +32,13 @@ import (
 	"github.com/aws/smithy-go"
 	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/digitalocean/godo"
+	"github.com/miekg/dns"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
 	coretesting "k8s.io/client-go/testing"
 	fakeclock "k8s.io/utils/clock/testing"
 
@@ -44,8 +47,10 @@ import (
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
 	"github.com/cert-manager/cert-manager/pkg/issuer"
+	dnsutil "github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
 	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
 )
@@ -106,6 +111,30 @@ func TestSyncHappyPath(t *testing.T) {
 	presentedNow := metav1.NewTime(time.Date(2026, 5, 15, 12, 0, 0, 0, time.UTC))
 
 	simulatedCleanupError := errors.New("simulated-cleanup-error")
+
+	// A DNS01 Challenge which must be delegated to a validation zone, but
+	// whose challenge record is not a CNAME record.
+	undelegatedChallenge := gen.ChallengeFrom(deletedChallenge,
+		gen.SetChallengeProcessing(true),
+		gen.SetChallengeURL("testurl"),
+		gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+		gen.SetChallengeDNSName("example.com"),
+		gen.SetChallengeSolverDNS01(cmacme.ACMEChallengeSolverDNS01{
+			ValidationZone: "acme.example-validation.net",
+			Nameservers:    []string{newNoCNAMENameserver(t)},
+			DigitalOcean: &cmacme.ACMEIssuerDNS01ProviderDigitalOcean{
+				Token: cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "digitalocean"},
+					Key:                  "token",
+				},
+			},
+		}),
+	)
+	digitalOceanSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "digitalocean"},
+		Data:       map[string][]byte{"token": []byte("FAKE-TOKEN")},
+	}
+
 	tests := map[string]testT{
 		"cleanup if the challenge is deleted and remove the finalizer (step1: finalizer set)": {
 			challenge: gen.ChallengeFrom(deletedChallenge,
@@ -174,6 +203,27 @@ func TestSyncHappyPath(t *testing.T) {
 				},
 			},
 		},
+		"remove the finalizer of a deleted challenge which was never delegated to the validation zone": {
+			// The DNS01 solver is not faked, so that its clean up of a
+			// challenge which could not be presented is exercised.
+			challenge: undelegatedChallenge,
+			builder: &testpkg.Builder{
+				// The DNS01 solver's providers are constructed with the
+				// RESTConfig's user agent.
+				Context:            &controllerpkg.Context{RESTConfig: new(rest.Config)},
+				KubeObjects:        []runtime.Object{digitalOceanSecret},
+				CertManagerObjects: []runtime.Object{undelegatedChallenge, testIssuerHTTP01Enabled},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewPatchActionWithOptions(cmacme.SchemeGroupVersion.WithResource("challenges"),
+						gen.DefaultTestNamespace,
+						"testchal",
+						types.ApplyPatchType,
+						[]byte(`{"kind":"Challenge","apiVersion":"acme.cert-manager.io/v1","metadata":{"name":"testchal","namespace":"default-unit-test-ns","uid":""}}`),
+						metav1.PatchOptions{Force: new(true), FieldManager: testpkg.FieldManager},
+					)),
+				},
+			},
+		},
 		"if the challenge is deleted and the cleanup fails, set the reason": {
 			challenge: gen.ChallengeFrom(deletedChallenge,
 				gen.SetChallengeProcessing(true),
@@ -374,6 +424,80 @@ func TestSyncHappyPath(t *testing.T) {
 				},
 			},
 		},
+		"report a missing CNAME delegation to the validation zone when presenting": {
+			challenge: gen.ChallengeFrom(baseChallenge,
+				gen.SetChallengeProcessing(true),
+				gen.SetChallengeURL("testurl"),
+				gen.SetChallengeState(cmacme.Pending),
+				gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+			),
+			dnsSolver: &fakeSolver{
+				fakePresent: func(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
+					return &dnsutil.DelegationError{
+						FQDN:   "_acme-challenge.example.com.",
+						Target: "_acme-challenge.example.com.",
+						Zone:   "acme.example-validation.net.",
+					}
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{gen.ChallengeFrom(baseChallenge,
+					gen.SetChallengeProcessing(true),
+					gen.SetChallengeURL("testurl"),
+					gen.SetChallengeState(cmacme.Pending),
+					gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+				), testIssuerHTTP01Enabled},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("challenges"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.ChallengeFrom(baseChallenge,
+							gen.SetChallengeProcessing(true),
+							gen.SetChallengeURL("testurl"),
+							gen.SetChallengeState(cmacme.Pending),
+							gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+							gen.SetChallengeReason("Waiting for CNAME delegation to the validation zone: expected _acme-challenge.example.com. to be a CNAME record into validation zone acme.example-validation.net., but no CNAME record was found"),
+						))),
+				},
+				ExpectedEvents: []string{
+					"Warning MissingDelegation Challenge is not delegated to the validation zone: expected _acme-challenge.example.com. to be a CNAME record into validation zone acme.example-validation.net., but no CNAME record was found",
+				},
+			},
+			expectErr: true,
+		},
+		"wait without an error while the record waits to be presented in a batch": {
+			challenge: gen.ChallengeFrom(baseChallenge,
+				gen.SetChallengeProcessing(true),
+				gen.SetChallengeURL("testurl"),
+				gen.SetChallengeState(cmacme.Pending),
+				gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+			),
+			dnsSolver: &fakeSolver{
+				fakePresent: func(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
+					return &dnsutil.BatchPendingError{RetryAfter: time.Second}
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{gen.ChallengeFrom(baseChallenge,
+					gen.SetChallengeProcessing(true),
+					gen.SetChallengeURL("testurl"),
+					gen.SetChallengeState(cmacme.Pending),
+					gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+				), testIssuerHTTP01Enabled},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("challenges"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.ChallengeFrom(baseChallenge,
+							gen.SetChallengeProcessing(true),
+							gen.SetChallengeURL("testurl"),
+							gen.SetChallengeState(cmacme.Pending),
+							gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+							gen.SetChallengeReason("Waiting for the DNS01 record to be presented together with other records in the same zone"),
+						))),
+				},
+			},
+		},
 		// PresentedAt may be missing on challenges that were already in the
 		// presented state before the controller started recording it, for example
 		// during upgrade or version-skew scenarios.
@@ -899,7 +1023,9 @@ func runTest(t *testing.T, test testT) {
 		},
 	}
 	c.httpSolver = test.httpSolver
-	c.dnsSolver = test.dnsSolver
+	if test.dnsSolver != nil {
+		c.dnsSolver = test.dnsSolver
+	}
 	test.builder.Start()
 
 	err := c.Sync(t.Context(), test.challenge)
@@ -913,6 +1039,29 @@ func runTest(t *testing.T, test testT) {
 	test.builder.CheckAndFinish(err)
 }
 
+// newNoCNAMENameserver starts a DNS server which answers every query with an
+// empty response, and returns its address.
+func newNoCNAMENameserver(t *testing.T) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	started := make(chan struct{})
+	server := &dns.Server{
+		PacketConn: conn,
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+			m := new(dns.Msg)
+			m.SetReply(r)
+			_ = w.WriteMsg(m)
+		}),
+		NotifyStartedFunc: func() { close(started) },
+	}
+	go func() { _ = server.ActivateAndServe() }()
+	<-started
+	t.Cleanup(func() { _ = server.Shutdown() })
+	return conn.LocalAddr().String()
+}
+
 func Test_StabilizeSolverErrorMessage(t *testing.T) {
 	newResponseError := func() *smithyhttp.ResponseError {
 		return &smithyhttp.ResponseError{
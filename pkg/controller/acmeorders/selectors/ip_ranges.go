@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selectors
+
+import (
+	"net/netip"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+)
+
+func IPRanges(sel cmacme.CertificateDNSNameSelector) Selector {
+	return &ipRangesSelector{
+		allowedIPRanges: sel.IPRanges,
+	}
+}
+
+type ipRangesSelector struct {
+	allowedIPRanges []string
+}
+
+// Matches returns true if the identifier is an IP address within one of the
+// allowed ranges. The number of matches is one more than the prefix length of
+// the most specific matching range, so that a match on 0.0.0.0/0 still counts.
+func (s *ipRangesSelector) Matches(meta metav1.ObjectMeta, dnsName string) (bool, int) {
+	if len(s.allowedIPRanges) == 0 {
+		return true, 0
+	}
+
+	ip, err := netip.ParseAddr(dnsName)
+	if err != nil {
+		return false, 0
+	}
+	ip = ip.Unmap()
+
+	maxMatchingBits := 0
+	for _, r := range s.allowedIPRanges {
+		prefix, err := netip.ParsePrefix(r)
+		if err != nil || !prefix.Contains(ip) {
+			continue
+		}
+
+		if prefix.Bits()+1 > maxMatchingBits {
+			maxMatchingBits = prefix.Bits() + 1
+		}
+	}
+
+	return maxMatchingBits > 0, maxMatchingBits
+}
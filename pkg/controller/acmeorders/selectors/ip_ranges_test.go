@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selectors
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+)
+
+func TestIPRanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector cmacme.CertificateDNSNameSelector
+		meta     metav1.ObjectMeta
+		dnsName  string
+		matches  bool
+		score    int
+	}{
+		{
+			name:     "matching an IP address with an empty selector",
+			selector: cmacme.CertificateDNSNameSelector{},
+			dnsName:  "10.0.0.1",
+			matches:  true,
+			score:    0,
+		},
+		{
+			name: "matching an IP address in a range",
+			selector: cmacme.CertificateDNSNameSelector{
+				IPRanges: []string{"10.0.0.0/8"},
+			},
+			dnsName: "10.0.0.1",
+			matches: true,
+			score:   9,
+		},
+		{
+			name: "matching the most specific range",
+			selector: cmacme.CertificateDNSNameSelector{
+				IPRanges: []string{"10.0.0.0/8", "10.0.0.0/24", "192.0.2.0/24"},
+			},
+			dnsName: "10.0.0.1",
+			matches: true,
+			score:   25,
+		},
+		{
+			name: "matching a range containing all addresses",
+			selector: cmacme.CertificateDNSNameSelector{
+				IPRanges: []string{"0.0.0.0/0"},
+			},
+			dnsName: "10.0.0.1",
+			matches: true,
+			score:   1,
+		},
+		{
+			name: "matching an IPv6 address in a range",
+			selector: cmacme.CertificateDNSNameSelector{
+				IPRanges: []string{"2001:db8::/32"},
+			},
+			dnsName: "2001:db8::1",
+			matches: true,
+			score:   33,
+		},
+		{
+			name: "not matching an IP address outside of the ranges",
+			selector: cmacme.CertificateDNSNameSelector{
+				IPRanges: []string{"192.0.2.0/24"},
+			},
+			dnsName: "10.0.0.1",
+			matches: false,
+			score:   0,
+		},
+		{
+			name: "not matching a domain",
+			selector: cmacme.CertificateDNSNameSelector{
+				IPRanges: []string{"0.0.0.0/0"},
+			},
+			dnsName: "www.example.com",
+			matches: false,
+			score:   0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			testSelector(t, IPRanges(test.selector), test.meta, test.dnsName, test.matches, test.score)
+		})
+	}
+}
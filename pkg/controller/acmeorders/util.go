@@ -50,6 +50,10 @@ func buildPartialRequiredChallenges(ctx context.Context, issuer cmapi.GenericIss
 			logf.FromContext(ctx).V(logf.DebugLevel).Info("Authorization already valid, not creating Challenge resource", "identifier", a.Identifier, "is_wildcard", wc)
 			continue
 		}
+		if isPreAuthorization(issuer, a.URL) {
+			logf.FromContext(ctx).V(logf.DebugLevel).Info("Authorization is being solved as a pre-authorization of the issuer, not creating Challenge resource", "identifier", a.Identifier)
+			continue
+		}
 		ch, err := buildPartialChallenge(ctx, issuer, o, a)
 		if err != nil {
 			return nil, err
@@ -59,6 +63,19 @@ func buildPartialRequiredChallenges(ctx context.Context, issuer cmapi.GenericIss
 	return chs, nil
 }
 
+// isPreAuthorization returns true if the authorization with the given URL is
+// a pre-authorization of the issuer. The issuer manages the Challenge
+// resources for these itself.
+func isPreAuthorization(issuer cmapi.GenericIssuer, url string) bool {
+	acmeStatus := issuer.GetStatus().ACMEStatus()
+	for _, preAuthz := range acmeStatus.PreAuthorizations {
+		if preAuthz.URL == url {
+			return true
+		}
+	}
+	return false
+}
+
 // buildPartialChallenge builds a challenge for the required ACME Authorization.
 // The spec will be populated with fields that can be determined by looking at
 // the ACME Authorization object returned in Order.
@@ -109,9 +126,9 @@ func partialChallengeSpecForAuthorization(ctx context.Context, issuer cmapi.Gene
 	}
 
 	// It should never be possible for this case to be hit as earlier in this
-	// method we already assert that the challenge type is one of 'http-01'
-	// or 'dns-01'.
-	chType, err := challengeType(selectedChallenge.Type)
+	// method we already assert that the challenge type is one of 'http-01',
+	// 'dns-01', 'dns-account-01' or 'tls-alpn-01'.
+	chType, err := acme.ChallengeType(selectedChallenge.Type)
 	if err != nil {
 		return nil, err
 	}
@@ -128,7 +145,19 @@ func partialChallengeSpecForAuthorization(ctx context.Context, issuer cmapi.Gene
 		return nil, err
 	}
 
-	// 6. construct Challenge resource with spec.solver field set
+	// 6. dns-account-01 challenges are scoped to the ACME account, so record
+	//    the account URI that the validation domain name is derived from
+	var accountURI string
+	if chType == cmacme.ACMEChallengeTypeDNSAccount01 {
+		if status := issuer.GetStatus(); status != nil && status.ACME != nil {
+			accountURI = status.ACME.URI
+		}
+		if accountURI == "" {
+			return nil, fmt.Errorf("issuer has no registered ACME account URI, which is required for dns-account-01 challenges")
+		}
+	}
+
+	// 7. construct Challenge resource with spec.solver field set
 	return &cmacme.ChallengeSpec{
 		AuthorizationURL: authz.URL,
 		Type:             chType,
@@ -136,23 +165,13 @@ func partialChallengeSpecForAuthorization(ctx context.Context, issuer cmapi.Gene
 		DNSName:          authz.Identifier,
 		Token:            selectedChallenge.Token,
 		// selectedSolver cannot be nil due to the check above.
-		Solver:    *selectedSolver,
-		Wildcard:  wc,
-		IssuerRef: o.Spec.IssuerRef,
+		Solver:     *selectedSolver,
+		Wildcard:   wc,
+		IssuerRef:  o.Spec.IssuerRef,
+		AccountURI: accountURI,
 	}, nil
 }
 
-func challengeType(t string) (cmacme.ACMEChallengeType, error) {
-	switch t {
-	case "http-01":
-		return cmacme.ACMEChallengeTypeHTTP01, nil
-	case "dns-01":
-		return cmacme.ACMEChallengeTypeDNS01, nil
-	default:
-		return "", fmt.Errorf("unsupported challenge type: %v", t)
-	}
-}
-
 func applyIngressParameterAnnotationOverrides(o *cmacme.Order, s *cmacme.ACMEChallengeSolver) error {
 	if s.HTTP01 == nil || s.HTTP01.Ingress == nil || o.Annotations == nil {
 		return nil
@@ -256,18 +275,7 @@ func applyGatewayAPIAnnotationParentRefOverride(o *cmacme.Order, s *cmacme.ACMEC
 
 func ensureKeysForChallenges(cl acmecl.Interface, challenges []*cmacme.Challenge) ([]*cmacme.Challenge, error) {
 	for _, ch := range challenges {
-		var (
-			key string
-			err error
-		)
-		switch ch.Spec.Type {
-		case cmacme.ACMEChallengeTypeHTTP01:
-			key, err = cl.HTTP01ChallengeResponse(ch.Spec.Token)
-		case cmacme.ACMEChallengeTypeDNS01:
-			key, err = cl.DNS01ChallengeRecord(ch.Spec.Token)
-		default:
-			return nil, fmt.Errorf("challenge %s has unsupported challenge type: %s", ch.Name, ch.Spec.Type)
-		}
+		key, err := acme.ChallengeKey(cl, ch.Spec.Type, ch.Spec.Token)
 		if err != nil {
 			return nil, err
 		}
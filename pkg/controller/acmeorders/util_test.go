@@ -52,6 +52,9 @@ func TestChallengeSpecForAuthorization(t *testing.T) {
 			},
 		},
 	}
+	emptySelectorSolverTLSALPN01 := cmacme.ACMEChallengeSolver{
+		TLSALPN01: &cmacme.ACMEChallengeSolverTLSALPN01{},
+	}
 	emptySelectorSolverHTTP01HTTPRoute := cmacme.ACMEChallengeSolver{
 		HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
 			GatewayHTTPRoute: &cmacme.ACMEChallengeSolverHTTP01GatewayHTTPRoute{},
@@ -83,6 +86,14 @@ func TestChallengeSpecForAuthorization(t *testing.T) {
 			},
 		},
 	}
+	accountScopedSolverDNS01 := cmacme.ACMEChallengeSolver{
+		DNS01: &cmacme.ACMEChallengeSolverDNS01{
+			AccountScoped: true,
+			Cloudflare: &cmacme.ACMEIssuerDNS01ProviderCloudflare{
+				Email: "test-cloudflare-email",
+			},
+		},
+	}
 	// define ACME challenges that are used during tests
 	acmeChallengeHTTP01 := &cmacme.ACMEChallenge{
 		Type:  "http-01",
@@ -92,6 +103,14 @@ func TestChallengeSpecForAuthorization(t *testing.T) {
 		Type:  "dns-01",
 		Token: "dns-01-token",
 	}
+	acmeChallengeDNSAccount01 := &cmacme.ACMEChallenge{
+		Type:  "dns-account-01",
+		Token: "dns-account-01-token",
+	}
+	acmeChallengeTLSALPN01 := &cmacme.ACMEChallenge{
+		Type:  "tls-alpn-01",
+		Token: "tls-alpn-01-token",
+	}
 
 	tests := map[string]struct {
 		acmeClient acmecl.Interface
@@ -361,6 +380,86 @@ func TestChallengeSpecForAuthorization(t *testing.T) {
 				Solver:  emptySelectorSolverDNS01,
 			},
 		},
+		"should use the TLS-ALPN-01 challenge for a TLSALPN01 solver": {
+			acmeClient: basicACMEClient,
+			issuer: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{emptySelectorSolverTLSALPN01},
+						},
+					},
+				},
+			},
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"example.com"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeHTTP01, *acmeChallengeTLSALPN01},
+			},
+			expectedChallengeSpec: &cmacme.ChallengeSpec{
+				Type:    cmacme.ACMEChallengeTypeTLSALPN01,
+				DNSName: "example.com",
+				Token:   acmeChallengeTLSALPN01.Token,
+				Solver:  emptySelectorSolverTLSALPN01,
+			},
+		},
+		"should set the account URI for an account scoped DNS01 solver": {
+			acmeClient: basicACMEClient,
+			issuer: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{accountScopedSolverDNS01},
+						},
+					},
+				},
+				Status: cmapi.IssuerStatus{
+					ACME: &cmacme.ACMEIssuerStatus{URI: "https://example.com/acme/acct/1"},
+				},
+			},
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"example.com"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeDNS01, *acmeChallengeDNSAccount01},
+			},
+			expectedChallengeSpec: &cmacme.ChallengeSpec{
+				Type:       cmacme.ACMEChallengeTypeDNSAccount01,
+				DNSName:    "example.com",
+				Token:      acmeChallengeDNSAccount01.Token,
+				Solver:     accountScopedSolverDNS01,
+				AccountURI: "https://example.com/acme/acct/1",
+			},
+		},
+		"should error for an account scoped DNS01 solver if the issuer has no account URI": {
+			acmeClient: basicACMEClient,
+			issuer: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{accountScopedSolverDNS01},
+						},
+					},
+				},
+			},
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"example.com"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeDNSAccount01},
+			},
+			expectedError: true,
+		},
 		"should set parentRef on Challenge based on annotations": {
 			acmeClient: basicACMEClient,
 			issuer: &cmapi.Issuer{
@@ -562,6 +661,22 @@ func Test_ensureKeysForChallenges(t *testing.T) {
 				gen.ChallengeFrom(barChallenge, gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
 					gen.SetChallengeKey("barKeyDNS01"))},
 		},
+		"happy path with some dns-account-01 challenges": {
+			acmeClient: basicACMEClient,
+			partialChallenges: []*cmacme.Challenge{
+				gen.ChallengeFrom(fooChallenge, gen.SetChallengeType(cmacme.ACMEChallengeTypeDNSAccount01))},
+			want: []*cmacme.Challenge{
+				gen.ChallengeFrom(fooChallenge, gen.SetChallengeType(cmacme.ACMEChallengeTypeDNSAccount01),
+					gen.SetChallengeKey("fooKeyDNS01"))},
+		},
+		"happy path with some tls-alpn-01 challenges": {
+			acmeClient: basicACMEClient,
+			partialChallenges: []*cmacme.Challenge{
+				gen.ChallengeFrom(fooChallenge, gen.SetChallengeType(cmacme.ACMEChallengeTypeTLSALPN01))},
+			want: []*cmacme.Challenge{
+				gen.ChallengeFrom(fooChallenge, gen.SetChallengeType(cmacme.ACMEChallengeTypeTLSALPN01),
+					gen.SetChallengeKey("fooKeyHTTP01"))},
+		},
 		"unhappy path with an unknown challenge type": {
 			acmeClient:        basicACMEClient,
 			partialChallenges: []*cmacme.Challenge{gen.ChallengeFrom(fooChallenge, gen.SetChallengeType(cmacme.ACMEChallengeType("foo")))},
@@ -715,6 +830,42 @@ func TestBuildChallengeSpecFromOrder_ParentRefAnnotations(t *testing.T) {
 	}
 }
 
+func TestBuildPartialRequiredChallenges_PreAuthorizations(t *testing.T) {
+	issuer := ingressIssuer()
+	issuer.Status.ACME = &cmacme.ACMEIssuerStatus{
+		PreAuthorizations: []cmacme.ACMEPreAuthorizationStatus{
+			{DNSName: "preauthorized.example.com", URL: "https://acme/authz/1", State: cmacme.Pending},
+		},
+	}
+	order := &cmacme.Order{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-order", Namespace: "default"},
+		Spec: cmacme.OrderSpec{
+			DNSNames: []string{"preauthorized.example.com", "example.com"},
+		},
+		Status: cmacme.OrderStatus{
+			Authorizations: []cmacme.ACMEAuthorization{
+				{
+					URL:          "https://acme/authz/1",
+					Identifier:   "preauthorized.example.com",
+					InitialState: cmacme.Pending,
+					Challenges:   []cmacme.ACMEChallenge{acmeChallengeHTTP01()},
+				},
+				{
+					URL:          "https://acme/authz/2",
+					Identifier:   "example.com",
+					InitialState: cmacme.Pending,
+					Challenges:   []cmacme.ACMEChallenge{acmeChallengeHTTP01()},
+				},
+			},
+		},
+	}
+
+	chs, err := buildPartialRequiredChallenges(t.Context(), issuer, order)
+	require.NoError(t, err)
+	require.Len(t, chs, 1)
+	assert.Equal(t, "https://acme/authz/2", chs[0].Spec.AuthorizationURL)
+}
+
 func gatewayIssuer(parentRefs []gwapi.ParentReference) *cmapi.Issuer {
 	return gen.Issuer("test-gw-issuer",
 		gen.SetIssuerACME(cmacme.ACMEIssuer{
@@ -0,0 +1,268 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacrl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	ControllerName = "ca-crl"
+)
+
+// controller publishes a certificate revocation list for each CA Issuer and
+// ClusterIssuer that has `spec.ca.crl` configured.
+// Items in the queue are keyed by the Issuer's namespace and name. An empty
+// namespace denotes a ClusterIssuer.
+type controller struct {
+	issuerLister             cmlisters.IssuerLister
+	clusterIssuerLister      cmlisters.ClusterIssuerLister
+	certificateRequestLister cmlisters.CertificateRequestLister
+	secretLister             internalinformers.SecretLister
+
+	// maintain a reference to the workqueue for this controller
+	// so the event handlers and ProcessItem can enqueue resources
+	queue workqueue.TypedRateLimitingInterface[types.NamespacedName]
+
+	// logger to be used by this controller
+	log logr.Logger
+
+	// client used to apply CRL Secret resources
+	kubeClient kubernetes.Interface
+
+	// used to record Events about resources to the API
+	recorder record.EventRecorder
+
+	issuerOptions controllerpkg.IssuerOptions
+
+	// fieldManager is the manager name used for the Apply operations.
+	fieldManager string
+
+	clock clock.Clock
+}
+
+// Register registers and constructs the controller using the provided context.
+// It returns the workqueue to be used to enqueue items, a list of
+// InformerSynced functions that must be synced, or an error.
+func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLimitingInterface[types.NamespacedName], []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	c.log = logf.FromContext(ctx.RootContext, ControllerName)
+
+	// create a queue used to queue up items to be processed
+	c.queue = workqueue.NewTypedRateLimitingQueueWithConfig(
+		controllerpkg.DefaultItemBasedRateLimiter(),
+		workqueue.TypedRateLimitingQueueConfig[types.NamespacedName]{
+			Name: ControllerName,
+		},
+	)
+
+	// obtain references to all the informers used by this controller
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
+	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
+	secretInformer := ctx.KubeSharedInformerFactory.Secrets()
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		issuerInformer.Informer().HasSynced,
+		certificateRequestInformer.Informer().HasSynced,
+		secretInformer.Informer().HasSynced,
+	}
+
+	// if we are running in non-namespaced mode (i.e. --namespace=""), we also
+	// publish CRLs for ClusterIssuers.
+	if ctx.Namespace == "" {
+		clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+		c.clusterIssuerLister = clusterIssuerInformer.Lister()
+		if _, err := clusterIssuerInformer.Informer().AddEventHandler(controllerpkg.QueuingEventHandler(c.queue)); err != nil {
+			return nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+		}
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+	}
+
+	// set all the references to the listers for used by the Sync function
+	c.issuerLister = issuerInformer.Lister()
+	c.certificateRequestLister = certificateRequestInformer.Lister()
+	c.secretLister = secretInformer.Lister()
+
+	// register handler functions
+	if _, err := issuerInformer.Informer().AddEventHandler(controllerpkg.QueuingEventHandler(c.queue)); err != nil {
+		return nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+	if _, err := certificateRequestInformer.Informer().AddEventHandler(controllerpkg.BlockingEventHandler(c.certificateRequestEvent)); err != nil {
+		return nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+	if _, err := secretInformer.Informer().AddEventHandler(controllerpkg.BlockingEventHandler(c.secretEvent)); err != nil {
+		return nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+
+	c.kubeClient = ctx.Client
+	c.recorder = ctx.Recorder
+	c.issuerOptions = ctx.IssuerOptions
+	c.fieldManager = ctx.FieldManager
+	c.clock = ctx.Clock
+
+	return c.queue, mustSync, nil
+}
+
+// certificateRequestEvent queues the issuer referenced by a
+// CertificateRequest that has been marked as revoked.
+func (c *controller) certificateRequestEvent(obj metav1.Object) {
+	cr, ok := obj.(*cmapi.CertificateRequest)
+	if !ok {
+		c.log.Error(nil, "object is not a CertificateRequest", "object", obj)
+		return
+	}
+
+	if _, revoked := cr.Annotations[cmapi.CertificateRequestRevocationReasonAnnotationKey]; !revoked {
+		return
+	}
+
+	key, ok := issuerKeyForRequest(cr)
+	if !ok {
+		return
+	}
+
+	c.queue.Add(key)
+}
+
+// secretEvent queues the issuers that reference the given Secret as either
+// their CA keypair or CRL Secret, so that a rotated CA or a modified CRL
+// Secret results in a newly signed CRL.
+func (c *controller) secretEvent(obj metav1.Object) {
+	log := c.log.WithName("secretEvent")
+	secret, ok := controllerpkg.ToSecret(obj)
+	if !ok {
+		log.Error(nil, "object is not a secret", "object", obj)
+		return
+	}
+
+	issuers, err := c.issuerLister.Issuers(secret.Namespace).List(labels.Everything())
+	if err != nil {
+		log.Error(err, "error listing issuers")
+		return
+	}
+	for _, iss := range issuers {
+		if referencesSecret(iss, secret.Name) {
+			c.queue.Add(types.NamespacedName{Namespace: iss.Namespace, Name: iss.Name})
+		}
+	}
+
+	if c.clusterIssuerLister == nil || secret.Namespace != c.issuerOptions.ClusterResourceNamespace {
+		return
+	}
+
+	clusterIssuers, err := c.clusterIssuerLister.List(labels.Everything())
+	if err != nil {
+		log.Error(err, "error listing clusterissuers")
+		return
+	}
+	for _, iss := range clusterIssuers {
+		if referencesSecret(iss, secret.Name) {
+			c.queue.Add(types.NamespacedName{Name: iss.Name})
+		}
+	}
+}
+
+// ProcessItem is the worker function that will be called with a new key from
+// the workqueue. A key corresponds to an Issuer, or to a ClusterIssuer if the
+// namespace is empty.
+func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName) error {
+	log := logf.FromContext(ctx)
+
+	var (
+		iss cmapi.GenericIssuer
+		err error
+	)
+	if key.Namespace == "" {
+		if c.clusterIssuerLister == nil {
+			return nil
+		}
+		iss, err = c.clusterIssuerLister.Get(key.Name)
+	} else {
+		iss, err = c.issuerLister.Issuers(key.Namespace).Get(key.Name)
+	}
+	if k8sErrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if iss.GetDeletionTimestamp() != nil || iss.GetSpec().CA == nil || iss.GetSpec().CA.CRL == nil {
+		return nil
+	}
+
+	ctx = logf.NewContext(ctx, logf.WithResource(log, iss))
+	return c.Sync(ctx, key, iss)
+}
+
+// issuerKeyForRequest returns the queue key of the issuer referenced by the
+// given CertificateRequest, or false if it does not reference a cert-manager
+// Issuer or ClusterIssuer.
+func issuerKeyForRequest(cr *cmapi.CertificateRequest) (types.NamespacedName, bool) {
+	ref := cr.Spec.IssuerRef
+	if ref.Group != "" && ref.Group != cmapi.SchemeGroupVersion.Group {
+		return types.NamespacedName{}, false
+	}
+
+	switch ref.Kind {
+	case "", cmapi.IssuerKind:
+		return types.NamespacedName{Namespace: cr.Namespace, Name: ref.Name}, true
+	case cmapi.ClusterIssuerKind:
+		return types.NamespacedName{Name: ref.Name}, true
+	default:
+		return types.NamespacedName{}, false
+	}
+}
+
+// referencesSecret returns true if the given CA issuer publishes a CRL and
+// uses the named Secret for either its keypair or its CRL.
+func referencesSecret(iss cmapi.GenericIssuer, secretName string) bool {
+	ca := iss.GetSpec().CA
+	if ca == nil || ca.CRL == nil {
+		return false
+	}
+
+	return ca.SecretName == secretName || ca.CRL.SecretName == secretName
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controller{}).
+			Complete()
+	})
+}
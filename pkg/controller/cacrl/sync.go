@@ -0,0 +1,279 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacrl
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"math/big"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
+	"github.com/cert-manager/cert-manager/pkg/util/kube"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	reasonCRLPublished = "CRLPublished"
+	reasonCRLError     = "CRLError"
+)
+
+// Sync ensures that the CRL Secret of the given CA issuer contains an up to
+// date CRL, signed by the issuer's current CA keypair, which lists every
+// revoked certificate. The item is re-queued for when the CRL needs to be
+// signed again.
+func (c *controller) Sync(ctx context.Context, key types.NamespacedName, iss cmapi.GenericIssuer) error {
+	log := logf.FromContext(ctx)
+
+	ca := iss.GetSpec().CA
+	resourceNamespace := c.issuerOptions.ResourceNamespace(iss)
+
+	caCerts, caKey, err := kube.SecretTLSKeyPairAndCA(ctx, c.secretLister, resourceNamespace, ca.SecretName)
+	if k8sErrors.IsNotFound(err) || cmerrors.IsInvalidData(err) {
+		// The issuers controller reports this on the Issuer's Ready condition.
+		// We will be re-queued once the Secret is updated.
+		log.V(logf.DebugLevel).Info("not publishing CRL as the CA keypair is not available", "reason", err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	caCert := caCerts[0]
+
+	duration := cmapi.DefaultCRLDuration
+	if ca.CRL.Duration != nil {
+		duration = ca.CRL.Duration.Duration
+	}
+
+	now := c.clock.Now()
+
+	published, err := c.publishedCRL(resourceNamespace, ca.CRL.SecretName, caCert)
+	if err != nil {
+		// The published CRL will be replaced by a CRL signed by the current CA.
+		log.V(logf.InfoLevel).Info("ignoring existing CRL", "reason", err.Error())
+	}
+
+	revoked, err := c.revokedCertificates(iss, caCert, now)
+	if err != nil {
+		return err
+	}
+
+	var publishedEntries []x509.RevocationListEntry
+	if published != nil {
+		publishedEntries = published.RevokedCertificateEntries
+	}
+	entries, added := mergeRevocationEntries(publishedEntries, revoked)
+
+	if refreshAt := crlRefreshTime(published, duration); !added && now.Before(refreshAt) {
+		log.V(logf.DebugLevel).Info("published CRL is up to date", "refreshAt", refreshAt)
+		c.queue.AddAfter(key, refreshAt.Sub(now))
+		return nil
+	}
+
+	number := big.NewInt(1)
+	if published != nil && published.Number != nil {
+		number.Add(published.Number, big.NewInt(1))
+	}
+
+	thisUpdate := now.Truncate(time.Second)
+	crl, err := pki.SignCRL(caCert, caKey, entries, number, thisUpdate, thisUpdate.Add(duration))
+	if err != nil {
+		c.recorder.Eventf(iss, corev1.EventTypeWarning, reasonCRLError, "Failed to sign CRL: %v", err)
+		return err
+	}
+
+	if err := c.applyCRLSecret(ctx, iss, resourceNamespace, ca.CRL.SecretName, crl); err != nil {
+		c.recorder.Eventf(iss, corev1.EventTypeWarning, reasonCRLError, "Failed to publish CRL: %v", err)
+		return err
+	}
+
+	log.V(logf.InfoLevel).Info("published CRL", "number", number, "revoked", len(entries))
+	c.recorder.Eventf(iss, corev1.EventTypeNormal, reasonCRLPublished,
+		"Published CRL number %s listing %d revoked certificate(s) to Secret %q", number, len(entries), ca.CRL.SecretName)
+
+	c.queue.AddAfter(key, duration*2/3)
+
+	return nil
+}
+
+// publishedCRL returns the CRL currently stored in the named Secret, if it
+// was signed by the given CA certificate. A nil CRL is returned if the Secret
+// does not exist or does not contain a CRL.
+func (c *controller) publishedCRL(namespace, name string, caCert *x509.Certificate) (*x509.RevocationList, error) {
+	secret, err := c.secretLister.Secrets(namespace).Get(name)
+	if k8sErrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data := secret.Data[cmapi.CRLSecretKey]
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	return pki.ParseCRLSignedBy(data, caCert)
+}
+
+// revokedCertificates returns a revocation list entry for every certificate
+// signed by caCert that was issued for a CertificateRequest which references
+// the given issuer and is marked as revoked. Entries are given a revocation
+// time of now.
+func (c *controller) revokedCertificates(iss cmapi.GenericIssuer, caCert *x509.Certificate, now time.Time) ([]x509.RevocationListEntry, error) {
+	var (
+		requests []*cmapi.CertificateRequest
+		err      error
+	)
+	if iss.GetNamespace() == "" {
+		requests, err = c.certificateRequestLister.List(labels.Everything())
+	} else {
+		requests, err = c.certificateRequestLister.CertificateRequests(iss.GetNamespace()).List(labels.Everything())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	issuerKey := types.NamespacedName{Namespace: iss.GetNamespace(), Name: iss.GetName()}
+
+	var entries []x509.RevocationListEntry
+	for _, cr := range requests {
+		reason, revoked := cr.Annotations[cmapi.CertificateRequestRevocationReasonAnnotationKey]
+		if !revoked || len(cr.Status.Certificate) == 0 {
+			continue
+		}
+
+		if key, ok := issuerKeyForRequest(cr); !ok || key != issuerKey {
+			continue
+		}
+
+		log := logf.WithRelatedResource(c.log, cr)
+
+		reasonCode, err := pki.ParseRevocationReason(reason)
+		if err != nil {
+			log.Error(err, "skipping CertificateRequest with invalid revocation reason")
+			continue
+		}
+
+		cert, err := pki.DecodeX509CertificateBytes(cr.Status.Certificate)
+		if err != nil {
+			log.Error(err, "skipping CertificateRequest with invalid certificate")
+			continue
+		}
+
+		// Certificates signed by a previous CA keypair cannot be listed in
+		// the current CA's CRL.
+		if err := cert.CheckSignatureFrom(caCert); err != nil {
+			continue
+		}
+
+		entries = append(entries, x509.RevocationListEntry{
+			SerialNumber:   cert.SerialNumber,
+			RevocationTime: now,
+			ReasonCode:     reasonCode,
+		})
+	}
+
+	return entries, nil
+}
+
+// applyCRLSecret writes the given DER encoded CRL to the named Secret,
+// creating it if it does not exist.
+func (c *controller) applyCRLSecret(ctx context.Context, iss cmapi.GenericIssuer, namespace, name string, crl []byte) error {
+	kind := cmapi.IssuerKind
+	if iss.GetNamespace() == "" {
+		kind = cmapi.ClusterIssuerKind
+	}
+
+	applyCnf := applycorev1.Secret(name, namespace).
+		WithLabels(map[string]string{
+			cmapi.PartOfCertManagerControllerLabelKey: "true",
+		}).
+		WithAnnotations(map[string]string{
+			cmapi.IssuerNameAnnotationKey:  iss.GetName(),
+			cmapi.IssuerKindAnnotationKey:  kind,
+			cmapi.IssuerGroupAnnotationKey: cmapi.SchemeGroupVersion.Group,
+		}).
+		WithData(map[string][]byte{
+			cmapi.CRLSecretKey: crl,
+		})
+
+	_, err := c.kubeClient.CoreV1().Secrets(namespace).Apply(ctx, applyCnf, metav1.ApplyOptions{FieldManager: c.fieldManager, Force: true})
+	if err != nil {
+		return fmt.Errorf("failed to apply secret %s/%s: %w", namespace, name, err)
+	}
+
+	return nil
+}
+
+// mergeRevocationEntries returns the union of the entries of the published
+// CRL and the currently revoked certificates. Entries that were already
+// published keep their original revocation time and reason. It also reports
+// whether any certificate was not yet listed in the published CRL.
+func mergeRevocationEntries(published, revoked []x509.RevocationListEntry) ([]x509.RevocationListEntry, bool) {
+	entries := make([]x509.RevocationListEntry, 0, len(published)+len(revoked))
+	seen := make(map[string]struct{}, len(published)+len(revoked))
+
+	for _, entry := range published {
+		seen[entry.SerialNumber.String()] = struct{}{}
+		entries = append(entries, x509.RevocationListEntry{
+			SerialNumber:   entry.SerialNumber,
+			RevocationTime: entry.RevocationTime,
+			ReasonCode:     entry.ReasonCode,
+		})
+	}
+
+	added := false
+	for _, entry := range revoked {
+		if _, ok := seen[entry.SerialNumber.String()]; ok {
+			continue
+		}
+		seen[entry.SerialNumber.String()] = struct{}{}
+		entries = append(entries, entry)
+		added = true
+	}
+
+	return entries, added
+}
+
+// crlRefreshTime returns the time at which a new CRL should be signed to
+// replace the given one. This is two thirds of the way through the configured
+// duration, so that clients are able to fetch the new CRL before the previous
+// one expires. A nil CRL needs to be signed immediately.
+func crlRefreshTime(crl *x509.RevocationList, duration time.Duration) time.Time {
+	if crl == nil {
+		return time.Time{}
+	}
+
+	// Sign a new CRL straight away if the configured duration has changed.
+	// Times in a CRL are encoded with a precision of one second.
+	if crl.NextUpdate.Sub(crl.ThisUpdate) != duration.Truncate(time.Second) {
+		return crl.ThisUpdate
+	}
+
+	return crl.ThisUpdate.Add(duration * 2 / 3)
+}
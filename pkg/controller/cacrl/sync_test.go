@@ -0,0 +1,352 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacrl
+
+import (
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+var (
+	fixedClockStart = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func mustCreateCA(t *testing.T) (*x509.Certificate, crypto.Signer, *corev1.Secret) {
+	key, err := pki.GenerateECPrivateKey(256)
+	require.NoError(t, err)
+	keyPEM, err := pki.EncodeECPrivateKey(key)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		BasicConstraintsValid: true,
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             fixedClockStart,
+		NotAfter:              fixedClockStart.Add(time.Hour * 24 * 365),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		PublicKey:             key.Public(),
+		IsCA:                  true,
+	}
+	certPEM, cert, err := pki.SignCertificate(tmpl, tmpl, key.Public(), key)
+	require.NoError(t, err)
+
+	secret := gen.Secret("ca-secret",
+		gen.SetSecretNamespace("default"),
+		gen.SetSecretData(map[string][]byte{
+			corev1.TLSCertKey:       certPEM,
+			corev1.TLSPrivateKeyKey: keyPEM,
+		}),
+	)
+
+	return cert, key, secret
+}
+
+func mustSignLeaf(t *testing.T, caCert *x509.Certificate, caKey crypto.Signer, serial int64) []byte {
+	key, err := pki.GenerateECPrivateKey(256)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: fmt.Sprintf("leaf-%d", serial)},
+		NotBefore:    fixedClockStart,
+		NotAfter:     fixedClockStart.Add(time.Hour * 24),
+		PublicKey:    key.Public(),
+	}
+	certPEM, _, err := pki.SignCertificate(tmpl, caCert, key.Public(), caKey)
+	require.NoError(t, err)
+
+	return certPEM
+}
+
+// appliedCRL returns the CRL contained in an apply patch to a Secret.
+func appliedCRL(t *testing.T, action coretesting.Action) *x509.RevocationList {
+	patch, ok := action.(coretesting.PatchAction)
+	require.True(t, ok, "expected a patch action")
+
+	var secret corev1.Secret
+	require.NoError(t, json.Unmarshal(patch.GetPatch(), &secret))
+
+	crl, err := x509.ParseRevocationList(secret.Data[cmapi.CRLSecretKey])
+	require.NoError(t, err)
+
+	return crl
+}
+
+func TestProcessItem(t *testing.T) {
+	caCert, caKey, caSecret := mustCreateCA(t)
+	otherCACert, otherCAKey, _ := mustCreateCA(t)
+
+	issuer := gen.Issuer("ca-issuer",
+		gen.SetIssuerNamespace("default"),
+		gen.SetIssuerCA(cmapi.CAIssuer{
+			SecretName: caSecret.Name,
+			CRL:        &cmapi.CAIssuerCRL{SecretName: "ca-crl"},
+		}),
+	)
+
+	issuerRef := cmmeta.IssuerReference{Name: issuer.Name, Kind: cmapi.IssuerKind}
+	revokedCR := gen.CertificateRequest("revoked",
+		gen.SetCertificateRequestNamespace("default"),
+		gen.SetCertificateRequestIssuer(issuerRef),
+		gen.SetCertificateRequestCertificate(mustSignLeaf(t, caCert, caKey, 10)),
+		gen.AddCertificateRequestAnnotations(map[string]string{
+			cmapi.CertificateRequestRevocationReasonAnnotationKey: "keyCompromise",
+		}),
+	)
+	validCR := gen.CertificateRequest("valid",
+		gen.SetCertificateRequestNamespace("default"),
+		gen.SetCertificateRequestIssuer(issuerRef),
+		gen.SetCertificateRequestCertificate(mustSignLeaf(t, caCert, caKey, 11)),
+	)
+	otherIssuerCR := gen.CertificateRequestFrom(revokedCR,
+		gen.SetCertificateRequestName("other-issuer"),
+		gen.SetCertificateRequestIssuer(cmmeta.IssuerReference{Name: "other", Kind: cmapi.IssuerKind}),
+		gen.SetCertificateRequestCertificate(mustSignLeaf(t, caCert, caKey, 12)),
+	)
+	otherCACR := gen.CertificateRequestFrom(revokedCR,
+		gen.SetCertificateRequestName("other-ca"),
+		gen.SetCertificateRequestCertificate(mustSignLeaf(t, otherCACert, otherCAKey, 13)),
+	)
+
+	publishedCRL, err := pki.SignCRL(caCert, caKey, []x509.RevocationListEntry{
+		{SerialNumber: big.NewInt(10), RevocationTime: fixedClockStart.Add(-time.Hour), ReasonCode: 1},
+	}, big.NewInt(4), fixedClockStart.Add(-time.Hour), fixedClockStart.Add(23*time.Hour))
+	require.NoError(t, err)
+	publishedSecret := gen.Secret("ca-crl",
+		gen.SetSecretNamespace("default"),
+		gen.SetSecretData(map[string][]byte{cmapi.CRLSecretKey: publishedCRL}),
+	)
+
+	tests := map[string]struct {
+		issuer     runtime.Object
+		kubeObjs   []runtime.Object
+		requests   []runtime.Object
+		clockShift time.Duration
+
+		// expectedSerials is the list of serial numbers expected in the
+		// published CRL. If nil, no CRL is expected to be published.
+		expectedSerials []int64
+		expectedNumber  int64
+		expectedEvents  []string
+	}{
+		"do nothing if the issuer does not configure a CRL": {
+			issuer:   gen.IssuerFrom(issuer, gen.SetIssuerCA(cmapi.CAIssuer{SecretName: caSecret.Name})),
+			kubeObjs: []runtime.Object{caSecret},
+			requests: []runtime.Object{revokedCR},
+		},
+		"do nothing if the CA secret does not exist": {
+			issuer:   issuer,
+			requests: []runtime.Object{revokedCR},
+		},
+		"publish an empty CRL if no certificates are revoked": {
+			issuer:          issuer,
+			kubeObjs:        []runtime.Object{caSecret},
+			requests:        []runtime.Object{validCR},
+			expectedSerials: []int64{},
+			expectedNumber:  1,
+			expectedEvents:  []string{`Normal CRLPublished Published CRL number 1 listing 0 revoked certificate(s) to Secret "ca-crl"`},
+		},
+		"publish only certificates issued by this issuer and CA": {
+			issuer:          issuer,
+			kubeObjs:        []runtime.Object{caSecret},
+			requests:        []runtime.Object{revokedCR, validCR, otherIssuerCR, otherCACR},
+			expectedSerials: []int64{10},
+			expectedNumber:  1,
+			expectedEvents:  []string{`Normal CRLPublished Published CRL number 1 listing 1 revoked certificate(s) to Secret "ca-crl"`},
+		},
+		"do nothing if the published CRL is up to date": {
+			issuer:   issuer,
+			kubeObjs: []runtime.Object{caSecret, publishedSecret},
+			requests: []runtime.Object{revokedCR, validCR},
+		},
+		"re-sign the published CRL once two thirds of its duration have elapsed": {
+			issuer:          issuer,
+			kubeObjs:        []runtime.Object{caSecret, publishedSecret},
+			requests:        []runtime.Object{validCR},
+			clockShift:      16 * time.Hour,
+			expectedSerials: []int64{10},
+			expectedNumber:  5,
+			expectedEvents:  []string{`Normal CRLPublished Published CRL number 5 listing 1 revoked certificate(s) to Secret "ca-crl"`},
+		},
+		"add newly revoked certificates to the published CRL": {
+			issuer:   issuer,
+			kubeObjs: []runtime.Object{caSecret, publishedSecret},
+			requests: []runtime.Object{revokedCR, gen.CertificateRequestFrom(validCR,
+				gen.AddCertificateRequestAnnotations(map[string]string{
+					cmapi.CertificateRequestRevocationReasonAnnotationKey: "superseded",
+				}),
+			)},
+			expectedSerials: []int64{10, 11},
+			expectedNumber:  5,
+			expectedEvents:  []string{`Normal CRLPublished Published CRL number 5 listing 2 revoked certificate(s) to Secret "ca-crl"`},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(fixedClockStart.Add(test.clockShift)),
+				KubeObjects:        test.kubeObjs,
+				CertManagerObjects: append([]runtime.Object{test.issuer}, test.requests...),
+				ExpectedEvents:     test.expectedEvents,
+			}
+			if test.expectedSerials != nil {
+				builder.ExpectedActions = []testpkg.Action{
+					testpkg.NewCustomMatch(coretesting.NewPatchAction(corev1.SchemeGroupVersion.WithResource("secrets"), "default", "ca-crl", types.ApplyPatchType, nil),
+						func(_ coretesting.Action, action coretesting.Action) error {
+							crl := appliedCRL(t, action)
+							if err := crl.CheckSignatureFrom(caCert); err != nil {
+								return err
+							}
+
+							var serials []int64
+							for _, entry := range crl.RevokedCertificateEntries {
+								serials = append(serials, entry.SerialNumber.Int64())
+							}
+							if !assert.ElementsMatch(t, test.expectedSerials, serials) {
+								return fmt.Errorf("unexpected serials in CRL")
+							}
+							if crl.Number.Int64() != test.expectedNumber {
+								return fmt.Errorf("expected CRL number %d, got %s", test.expectedNumber, crl.Number)
+							}
+							return nil
+						}),
+				}
+			}
+			builder.Init()
+
+			c := &controller{}
+			_, _, err := c.Register(builder.Context)
+			require.NoError(t, err)
+			builder.Start()
+			defer builder.Stop()
+
+			err = c.ProcessItem(t.Context(), types.NamespacedName{Namespace: "default", Name: "ca-issuer"})
+			require.NoError(t, err)
+
+			if err := builder.AllEventsCalled(); err != nil {
+				t.Error(err)
+			}
+			if err := builder.AllActionsExecuted(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMergeRevocationEntries(t *testing.T) {
+	published := []x509.RevocationListEntry{
+		{SerialNumber: big.NewInt(1), RevocationTime: fixedClockStart.Add(-time.Hour), ReasonCode: 1},
+	}
+
+	entries, added := mergeRevocationEntries(published, []x509.RevocationListEntry{
+		{SerialNumber: big.NewInt(1), RevocationTime: fixedClockStart, ReasonCode: 4},
+	})
+	assert.False(t, added)
+	assert.Equal(t, published, entries, "published entries should keep their original revocation time and reason")
+
+	entries, added = mergeRevocationEntries(published, []x509.RevocationListEntry{
+		{SerialNumber: big.NewInt(2), RevocationTime: fixedClockStart, ReasonCode: 4},
+	})
+	assert.True(t, added)
+	assert.Len(t, entries, 2)
+}
+
+func TestCRLRefreshTime(t *testing.T) {
+	tests := map[string]struct {
+		crl      *x509.RevocationList
+		duration time.Duration
+		expected time.Time
+	}{
+		"a missing CRL should be signed immediately": {
+			duration: time.Hour * 24,
+		},
+		"a CRL should be signed again after two thirds of its duration": {
+			crl:      &x509.RevocationList{ThisUpdate: fixedClockStart, NextUpdate: fixedClockStart.Add(time.Hour * 24)},
+			duration: time.Hour * 24,
+			expected: fixedClockStart.Add(time.Hour * 16),
+		},
+		"a CRL should be signed immediately if the configured duration changed": {
+			crl:      &x509.RevocationList{ThisUpdate: fixedClockStart, NextUpdate: fixedClockStart.Add(time.Hour * 24)},
+			duration: time.Hour * 12,
+			expected: fixedClockStart,
+		},
+		"sub-second durations should be ignored": {
+			crl:      &x509.RevocationList{ThisUpdate: fixedClockStart, NextUpdate: fixedClockStart.Add(time.Hour * 3)},
+			duration: time.Hour*3 + time.Millisecond*300,
+			expected: fixedClockStart.Add((time.Hour*3 + time.Millisecond*300) * 2 / 3),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, crlRefreshTime(test.crl, test.duration))
+		})
+	}
+}
+
+func TestIssuerKeyForRequest(t *testing.T) {
+	tests := map[string]struct {
+		ref         cmmeta.IssuerReference
+		expectedKey types.NamespacedName
+		expectedOK  bool
+	}{
+		"issuer with default kind": {
+			ref:         cmmeta.IssuerReference{Name: "issuer"},
+			expectedKey: types.NamespacedName{Namespace: "ns", Name: "issuer"},
+			expectedOK:  true,
+		},
+		"cluster issuer": {
+			ref:         cmmeta.IssuerReference{Name: "issuer", Kind: cmapi.ClusterIssuerKind, Group: "cert-manager.io"},
+			expectedKey: types.NamespacedName{Name: "issuer"},
+			expectedOK:  true,
+		},
+		"external issuer": {
+			ref: cmmeta.IssuerReference{Name: "issuer", Kind: cmapi.IssuerKind, Group: "example.com"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cr := gen.CertificateRequest("cr", gen.SetCertificateRequestNamespace("ns"), gen.SetCertificateRequestIssuer(test.ref))
+			key, ok := issuerKeyForRequest(cr)
+			assert.Equal(t, test.expectedOK, ok)
+			assert.Equal(t, test.expectedKey, key)
+		})
+	}
+}
@@ -247,7 +247,9 @@ func translateAnnotations(crt *cmapi.Certificate, ingLikeAnnotations map[string]
 		switch algorithm {
 		case cmapi.RSAKeyAlgorithm,
 			cmapi.ECDSAKeyAlgorithm,
-			cmapi.Ed25519KeyAlgorithm:
+			cmapi.Ed25519KeyAlgorithm,
+			cmapi.Ed448KeyAlgorithm,
+			cmapi.MLDSAKeyAlgorithm:
 			// ok
 		default:
 			return fmt.Errorf("%w %q: invalid private key algorithm %q", errInvalidIngressAnnotation, cmapi.PrivateKeyAlgorithmAnnotationKey, privateKeyAlgorithm)
@@ -298,6 +300,13 @@ func translateAnnotations(crt *cmapi.Certificate, ingLikeAnnotations map[string]
 			default:
 				return fmt.Errorf("%w %q: invalid private key size for ECDSA algorithm %q", errInvalidIngressAnnotation, cmapi.PrivateKeySizeAnnotationKey, privateKeySize)
 			}
+		case cmapi.MLDSAKeyAlgorithm:
+			switch size {
+			case pki.MLDSA44, pki.MLDSA65, pki.MLDSA87:
+				// ok
+			default:
+				return fmt.Errorf("%w %q: invalid private key size for MLDSA algorithm %q", errInvalidIngressAnnotation, cmapi.PrivateKeySizeAnnotationKey, privateKeySize)
+			}
 		default:
 			// ok
 		}
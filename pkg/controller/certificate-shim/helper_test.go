@@ -148,6 +148,21 @@ func Test_translateAnnotations(t *testing.T) {
 				a.Equal(cmapi.RotationPolicyAlways, crt.Spec.PrivateKey.RotationPolicy)
 			},
 		},
+		"success mldsa private key algorithm": {
+			crt: gen.Certificate("example-cert"),
+			annotations: map[string]string{
+				cmapi.CommonNameAnnotationKey:               "www.example.com",
+				cmapi.PrivateKeyAlgorithmAnnotationKey:      "MLDSA",
+				cmapi.PrivateKeySizeAnnotationKey:           "65",
+				cmapi.PrivateKeyRotationPolicyAnnotationKey: "Always",
+			},
+			check: func(a *assert.Assertions, crt *cmapi.Certificate) {
+				a.Equal("www.example.com", crt.Spec.CommonName)
+				a.Equal(cmapi.MLDSAKeyAlgorithm, crt.Spec.PrivateKey.Algorithm)
+				a.Equal(65, crt.Spec.PrivateKey.Size)
+				a.Equal(cmapi.RotationPolicyAlways, crt.Spec.PrivateKey.RotationPolicy)
+			},
+		},
 		"success ed25519 private key algorithm": {
 			crt: gen.Certificate("example-cert"),
 			annotations: map[string]string{
@@ -169,6 +184,16 @@ func Test_translateAnnotations(t *testing.T) {
 				a.Equal(cmapi.RotationPolicyAlways, crt.Spec.PrivateKey.RotationPolicy)
 			},
 		},
+		"success ed448 private key algorithm": {
+			crt: gen.Certificate("example-cert"),
+			annotations: map[string]string{
+				cmapi.CommonNameAnnotationKey:          "www.example.com",
+				cmapi.PrivateKeyAlgorithmAnnotationKey: "Ed448",
+			},
+			check: func(a *assert.Assertions, crt *cmapi.Certificate) {
+				a.Equal(cmapi.Ed448KeyAlgorithm, crt.Spec.PrivateKey.Algorithm)
+			},
+		},
 		"nil annotations": {
 			crt:         gen.Certificate("example-cert"),
 			annotations: nil,
@@ -296,6 +321,15 @@ func Test_translateAnnotations(t *testing.T) {
 			},
 			expectedError: errInvalidIngressAnnotation,
 		},
+		"bad private key size for mldsa": {
+			crt:         gen.Certificate("example-cert"),
+			annotations: validAnnotations(),
+			mutate: func(tc *testCase) {
+				tc.annotations[cmapi.PrivateKeyAlgorithmAnnotationKey] = "MLDSA"
+				tc.annotations[cmapi.PrivateKeySizeAnnotationKey] = "256"
+			},
+			expectedError: errInvalidIngressAnnotation,
+		},
 		"bad street addresses": {
 			crt:         gen.Certificate("example-cert"),
 			annotations: validAnnotations(),
@@ -33,6 +33,7 @@ import (
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	"github.com/cert-manager/cert-manager/pkg/acme"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -65,6 +66,7 @@ type ACME struct {
 	certificateLister cmlisters.CertificateLister
 	secretsLister     internalinformers.SecretLister
 	acmeClientV       cmacmeclientset.AcmeV1Interface
+	accountRegistry   accounts.Getter
 
 	reporter *crutil.Reporter
 
@@ -112,6 +114,7 @@ func NewACME(ctx *controllerpkg.Context) certificaterequests.Issuer {
 		certificateLister: ctx.SharedInformerFactory.Certmanager().V1().Certificates().Lister(),
 		secretsLister:     ctx.KubeSharedInformerFactory.Secrets().Lister(),
 		acmeClientV:       ctx.CMClient.AcmeV1(),
+		accountRegistry:   ctx.ACMEAccountRegistry,
 		reporter:          crutil.NewReporter(ctx.Clock, ctx.Recorder),
 		fieldManager:      ctx.FieldManager,
 	}
@@ -259,6 +262,23 @@ func (a *ACME) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuer cm
 	}, nil
 }
 
+// Revoke revokes the certificate of the CertificateRequest with the ACME
+// server. The revocation request is signed with the ACME account key of the
+// issuer, which must be the account that ordered the certificate.
+func (a *ACME) Revoke(ctx context.Context, cr *cmapi.CertificateRequest, issuer cmapi.GenericIssuer, reason int) error {
+	cl, err := a.accountRegistry.GetClient(string(issuer.GetUID()))
+	if err != nil {
+		return err
+	}
+
+	cert, err := pki.DecodeX509CertificateBytes(cr.Status.Certificate)
+	if err != nil {
+		return fmt.Errorf("failed to decode certificate: %w", err)
+	}
+
+	return cl.RevokeCert(ctx, nil, cert.Raw, acmeapi.CRLReasonCode(reason))
+}
+
 // Build order. If we error here it is a terminating failure.
 func buildOrder(cr *cmapi.CertificateRequest, csr *x509.CertificateRequest, enableDurationFeature bool, profile string, replaces string) (*cmacme.Order, error) {
 	var ipAddresses []string
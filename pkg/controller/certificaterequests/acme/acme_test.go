@@ -17,6 +17,7 @@ limitations under the License.
 package acme
 
 import (
+	"context"
 	"crypto"
 	"crypto/x509"
 	"crypto/x509/pkix"
@@ -32,6 +33,8 @@ import (
 	coretesting "k8s.io/client-go/testing"
 	fakeclock "k8s.io/utils/clock/testing"
 
+	accountstest "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
+	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
@@ -982,3 +985,69 @@ func Test_resolveReplacesCertID(t *testing.T) {
 		})
 	}
 }
+
+func TestRevoke(t *testing.T) {
+	sk, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(10),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    fixedClockStart,
+		NotAfter:     fixedClockStart.Add(time.Hour),
+		PublicKey:    sk.Public(),
+	}
+	certPEM, cert, err := pki.SignCertificate(tmpl, tmpl, sk.Public(), sk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issuer := gen.Issuer("acme-issuer", gen.SetIssuerACME(cmacme.ACMEIssuer{}))
+	issuer.UID = "issuer-uid"
+	cr := gen.CertificateRequest("test-cr", gen.SetCertificateRequestCertificate(certPEM))
+
+	tests := map[string]struct {
+		getClientErr error
+		revokeErr    error
+		expectedErr  bool
+	}{
+		"should revoke the certificate using the issuer's ACME account": {},
+		"should return an error if the ACME client is not registered": {
+			getClientErr: errors.New("client not found"),
+			expectedErr:  true,
+		},
+		"should return an error if the ACME server fails to revoke the certificate": {
+			revokeErr:   errors.New("this is an error"),
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var revoked bool
+			a := &ACME{
+				accountRegistry: &accountstest.FakeRegistry{
+					GetClientFunc: func(uid string) (acmecl.Interface, error) {
+						assert.Equal(t, "issuer-uid", uid)
+						return &acmecl.FakeACME{
+							FakeRevokeCert: func(_ context.Context, key crypto.Signer, der []byte, reason acmeapi.CRLReasonCode) error {
+								assert.Nil(t, key)
+								assert.Equal(t, cert.Raw, der)
+								assert.Equal(t, acmeapi.CRLReasonKeyCompromise, reason)
+								revoked = true
+								return test.revokeErr
+							},
+						}, test.getClientErr
+					},
+				},
+			}
+
+			err := a.Revoke(t.Context(), cr, issuer, int(acmeapi.CRLReasonKeyCompromise))
+			if test.expectedErr != (err != nil) {
+				t.Errorf("unexpected error, exp=%t got=%v", test.expectedErr, err)
+			}
+			assert.Equal(t, test.getClientErr == nil, revoked)
+		})
+	}
+}
@@ -141,3 +141,50 @@ func init() {
 			Complete()
 	})
 }
+
+// Revoke reports the certificate of the CertificateRequest as revoked once
+// it is listed in the issuer's published CRL. The CA issuer keeps no
+// revocation state of its own: the certificates of CertificateRequests with
+// the revocation reason annotation are published in the issuer's CRL by the
+// CRL controller, and reported as revoked by the OCSP responder. An error is
+// returned until the CRL controller has published the certificate, so that
+// revocation is retried.
+func (c *CA) Revoke(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer, _ int) error {
+	ca := issuerObj.GetSpec().CA
+	if ca.CRL == nil {
+		return fmt.Errorf("%w: the issuer does not publish a CRL, set spec.ca.crl to revoke certificates", certificaterequests.ErrRevocationNotSupported)
+	}
+
+	cert, err := pki.DecodeX509CertificateBytes(cr.Status.Certificate)
+	if err != nil {
+		return fmt.Errorf("%w: %w", certificaterequests.ErrRevocationNotSupported, err)
+	}
+
+	resourceNamespace := c.issuerOptions.ResourceNamespace(issuerObj)
+	caCerts, _, err := kube.SecretTLSKeyPairAndCA(ctx, c.secretsLister, resourceNamespace, ca.SecretName)
+	if err != nil {
+		return fmt.Errorf("failed to get CA key pair from secret %s/%s: %w", resourceNamespace, ca.SecretName, err)
+	}
+	caCert := caCerts[0]
+
+	// The CRL controller only lists certificates signed by the current CA.
+	if err := cert.CheckSignatureFrom(caCert); err != nil {
+		return fmt.Errorf("%w: the certificate was not signed by the current CA certificate, so cannot be listed in its CRL", certificaterequests.ErrRevocationNotSupported)
+	}
+
+	secret, err := c.secretsLister.Secrets(resourceNamespace).Get(ca.CRL.SecretName)
+	if err != nil {
+		return fmt.Errorf("failed to get published CRL from secret %s/%s: %w", resourceNamespace, ca.CRL.SecretName, err)
+	}
+	crl, err := pki.ParseCRLSignedBy(secret.Data[cmapi.CRLSecretKey], caCert)
+	if err != nil {
+		return fmt.Errorf("failed to parse published CRL from secret %s/%s: %w", resourceNamespace, ca.CRL.SecretName, err)
+	}
+	for _, entry := range crl.RevokedCertificateEntries {
+		if entry.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("certificate with serial number %s is not yet listed in the published CRL", cert.SerialNumber.Text(16))
+}
@@ -18,7 +18,6 @@ package ca
 
 import (
 	"crypto"
-	"crypto/ecdsa"
 	"crypto/rand"
 	"crypto/x509"
 	"crypto/x509/pkix"
@@ -31,6 +30,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	clientcorev1 "k8s.io/client-go/listers/core/v1"
@@ -46,6 +46,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/pkg/util/pki/localkeyprovider"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
 	testlisters "github.com/cert-manager/cert-manager/test/unit/listers"
 )
@@ -461,6 +462,24 @@ func TestCA_Sign(t *testing.T) {
 	}
 	rootCert, _ := generateSelfSignedCACert(t, rootPK, "root")
 
+	mldsaRootPK, err := pki.GenerateMLDSAPrivateKey(pki.MLDSA65)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mldsaRootCert, _ := generateSelfSignedCACert(t, mldsaRootPK, "mldsa-root")
+
+	pki.RegisterKeyProvider(localkeyprovider.Name, localkeyprovider.New(t.TempDir()))
+	externalRootPK, err := pki.GenerateExternalPrivateKey(t.Context(), localkeyprovider.Name, &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ca-certificate"},
+		Spec: cmapi.CertificateSpec{
+			PrivateKey: &cmapi.CertificatePrivateKey{Algorithm: cmapi.ECDSAKeyAlgorithm},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	externalRootCert, _ := generateSelfSignedCACert(t, externalRootPK, "external-root")
+
 	// Build test CSR
 	testpk, err := pki.GenerateECPrivateKey(256)
 	if err != nil {
@@ -468,6 +487,12 @@ func TestCA_Sign(t *testing.T) {
 	}
 	testCSR := generateCSR(t, testpk)
 
+	mldsaTestPK, err := pki.GenerateMLDSAPrivateKey(pki.MLDSA44)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mldsaTestCSR := generateCSR(t, mldsaTestPK)
+
 	tests := map[string]struct {
 		givenCASecret    *corev1.Secret
 		givenCAIssuer    cmapi.GenericIssuer
@@ -589,6 +614,46 @@ func TestCA_Sign(t *testing.T) {
 				assert.Equal(t, []string{"http://www.example.com/crl/test.crl"}, gotCA.CRLDistributionPoints)
 			},
 		},
+		"when the CA key is an ML-DSA key, it should sign an ML-DSA CSR with ML-DSA": {
+			givenCASecret: gen.SecretFrom(gen.Secret("secret-1"), gen.SetSecretNamespace("default"), gen.SetSecretData(secretDataFor(t, mldsaRootPK, mldsaRootCert))),
+			givenCAIssuer: gen.Issuer("issuer-1", gen.SetIssuerCA(cmapi.CAIssuer{
+				SecretName: "secret-1",
+			})),
+			givenCR: gen.CertificateRequest("cr-1",
+				gen.SetCertificateRequestCSR(mldsaTestCSR),
+				gen.SetCertificateRequestIssuer(cmmeta.IssuerReference{
+					Name:  "issuer-1",
+					Group: certmanager.GroupName,
+					Kind:  "Issuer",
+				}),
+			),
+			assertSignedCert: func(t *testing.T, got *x509.Certificate) {
+				assert.Equal(t, x509.MLDSA65, got.SignatureAlgorithm)
+				assert.Equal(t, x509.MLDSA, got.PublicKeyAlgorithm)
+				assert.NoError(t, got.CheckSignatureFrom(mldsaRootCert))
+			},
+		},
+		"when the CA key is held by a key provider, it should sign with the key provider": {
+			// Key provider keys can only be used by the Certificate they were
+			// generated for, which is read from the Secret's annotations.
+			givenCASecret: gen.SecretFrom(gen.Secret("secret-1"), gen.SetSecretNamespace("default"),
+				gen.SetSecretAnnotations(map[string]string{cmapi.CertificateNameKey: "ca-certificate"}),
+				gen.SetSecretData(secretDataFor(t, externalRootPK, externalRootCert))),
+			givenCAIssuer: gen.Issuer("issuer-1", gen.SetIssuerCA(cmapi.CAIssuer{
+				SecretName: "secret-1",
+			})),
+			givenCR: gen.CertificateRequest("cr-1",
+				gen.SetCertificateRequestCSR(testCSR),
+				gen.SetCertificateRequestIssuer(cmmeta.IssuerReference{
+					Name:  "issuer-1",
+					Group: certmanager.GroupName,
+					Kind:  "Issuer",
+				}),
+			),
+			assertSignedCert: func(t *testing.T, got *x509.Certificate) {
+				assert.NoError(t, got.CheckSignatureFrom(externalRootCert))
+			},
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -626,12 +691,12 @@ func TestCA_Sign(t *testing.T) {
 
 // Returns a map that is meant to be used for creating a certificate Secret
 // that contains the fields "tls.crt" and "tls.key".
-func secretDataFor(t *testing.T, caKey *ecdsa.PrivateKey, caCrt *x509.Certificate) (secretData map[string][]byte) {
+func secretDataFor(t *testing.T, caKey crypto.Signer, caCrt *x509.Certificate) (secretData map[string][]byte) {
 	rootCADER, err := x509.CreateCertificate(rand.Reader, caCrt, caCrt, caKey.Public(), caKey)
 	require.NoError(t, err)
 	caCrt, err = x509.ParseCertificate(rootCADER)
 	require.NoError(t, err)
-	caKeyPEM, err := pki.EncodeECPrivateKey(caKey)
+	caKeyPEM, err := pki.EncodePrivateKey(caKey, cmapi.PKCS1)
 	require.NoError(t, err)
 	caCrtPEM, err := pki.EncodeX509(caCrt)
 	require.NoError(t, err)
@@ -641,3 +706,154 @@ func secretDataFor(t *testing.T, caKey *ecdsa.PrivateKey, caCrt *x509.Certificat
 		"tls.crt": caCrtPEM,
 	}
 }
+
+func TestCA_Revoke(t *testing.T) {
+	newCA := func(t *testing.T, name string) (*x509.Certificate, crypto.Signer, map[string][]byte) {
+		key, err := pki.GenerateECPrivateKey(256)
+		require.NoError(t, err)
+		keyPEM, err := pki.EncodeECPrivateKey(key)
+		require.NoError(t, err)
+
+		tmpl := &x509.Certificate{
+			BasicConstraintsValid: true,
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: name},
+			NotBefore:             time.Now(),
+			NotAfter:              time.Now().Add(time.Hour),
+			KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+			PublicKey:             key.Public(),
+			IsCA:                  true,
+		}
+		certPEM, cert, err := pki.SignCertificate(tmpl, tmpl, key.Public(), key)
+		require.NoError(t, err)
+
+		return cert, key, map[string][]byte{
+			corev1.TLSCertKey:       certPEM,
+			corev1.TLSPrivateKeyKey: keyPEM,
+		}
+	}
+	signLeaf := func(t *testing.T, caCert *x509.Certificate, caKey crypto.Signer, serial int64) []byte {
+		key, err := pki.GenerateECPrivateKey(256)
+		require.NoError(t, err)
+
+		tmpl := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: "leaf"},
+			NotBefore:    time.Now(),
+			NotAfter:     time.Now().Add(time.Hour),
+			PublicKey:    key.Public(),
+		}
+		certPEM, _, err := pki.SignCertificate(tmpl, caCert, key.Public(), caKey)
+		require.NoError(t, err)
+
+		return certPEM
+	}
+	crlSecretData := func(t *testing.T, caCert *x509.Certificate, caKey crypto.Signer, serials ...int64) map[string][]byte {
+		var entries []x509.RevocationListEntry
+		for _, serial := range serials {
+			entries = append(entries, x509.RevocationListEntry{
+				SerialNumber:   big.NewInt(serial),
+				RevocationTime: time.Now(),
+			})
+		}
+		crl, err := pki.SignCRL(caCert, caKey, entries, big.NewInt(1), time.Now(), time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		return map[string][]byte{cmapi.CRLSecretKey: crl}
+	}
+
+	caCert, caKey, caSecretData := newCA(t, "root")
+	otherCACert, otherCAKey, _ := newCA(t, "other-root")
+
+	issuerWithCRL := gen.Issuer("issuer-1",
+		gen.SetIssuerNamespace("default"),
+		gen.SetIssuerCA(cmapi.CAIssuer{
+			SecretName: "ca-secret",
+			CRL:        &cmapi.CAIssuerCRL{SecretName: "crl-secret"},
+		}),
+	)
+
+	tests := map[string]struct {
+		givenSecrets     []*corev1.Secret
+		givenIssuer      cmapi.GenericIssuer
+		givenCert        []byte
+		wantErr          string
+		wantNotSupported bool
+	}{
+		"when the issuer does not publish a CRL, revocation is not supported": {
+			givenIssuer:      gen.Issuer("issuer-1", gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "ca-secret"})),
+			givenCert:        signLeaf(t, caCert, caKey, 10),
+			wantErr:          "issuer does not support certificate revocation: the issuer does not publish a CRL, set spec.ca.crl to revoke certificates",
+			wantNotSupported: true,
+		},
+		"when the certificate was signed by another CA, revocation is not supported": {
+			givenSecrets: []*corev1.Secret{
+				gen.Secret("ca-secret", gen.SetSecretNamespace("default"), gen.SetSecretData(caSecretData)),
+				gen.Secret("crl-secret", gen.SetSecretNamespace("default"), gen.SetSecretData(crlSecretData(t, caCert, caKey, 10))),
+			},
+			givenIssuer:      issuerWithCRL,
+			givenCert:        signLeaf(t, otherCACert, otherCAKey, 10),
+			wantErr:          "issuer does not support certificate revocation: the certificate was not signed by the current CA certificate, so cannot be listed in its CRL",
+			wantNotSupported: true,
+		},
+		"when the CRL has not been published yet, an error is returned so revocation is retried": {
+			givenSecrets: []*corev1.Secret{
+				gen.Secret("ca-secret", gen.SetSecretNamespace("default"), gen.SetSecretData(caSecretData)),
+			},
+			givenIssuer: issuerWithCRL,
+			givenCert:   signLeaf(t, caCert, caKey, 10),
+			wantErr:     `failed to get published CRL from secret default/crl-secret: secrets "crl-secret" not found`,
+		},
+		"when the serial is not yet listed in the published CRL, an error is returned so revocation is retried": {
+			givenSecrets: []*corev1.Secret{
+				gen.Secret("ca-secret", gen.SetSecretNamespace("default"), gen.SetSecretData(caSecretData)),
+				gen.Secret("crl-secret", gen.SetSecretNamespace("default"), gen.SetSecretData(crlSecretData(t, caCert, caKey, 11))),
+			},
+			givenIssuer: issuerWithCRL,
+			givenCert:   signLeaf(t, caCert, caKey, 10),
+			wantErr:     "certificate with serial number a is not yet listed in the published CRL",
+		},
+		"when the serial is listed in the published CRL, the certificate is revoked": {
+			givenSecrets: []*corev1.Secret{
+				gen.Secret("ca-secret", gen.SetSecretNamespace("default"), gen.SetSecretData(caSecretData)),
+				gen.Secret("crl-secret", gen.SetSecretNamespace("default"), gen.SetSecretData(crlSecretData(t, caCert, caKey, 10, 11))),
+			},
+			givenIssuer: issuerWithCRL,
+			givenCert:   signLeaf(t, caCert, caKey, 10),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := &CA{
+				issuerOptions: controller.IssuerOptions{},
+				secretsLister: testlisters.FakeSecretListerFrom(testlisters.NewFakeSecretLister(),
+					testlisters.SetFakeSecretListerSecret(func(namespace string) clientcorev1.SecretNamespaceLister {
+						return testlisters.NewFakeSecretNamespaceLister(func(f *testlisters.FakeSecretNamespaceLister) {
+							f.GetFn = func(name string) (*corev1.Secret, error) {
+								for _, secret := range test.givenSecrets {
+									if secret.Namespace == namespace && secret.Name == name {
+										return secret, nil
+									}
+								}
+								return nil, apierrors.NewNotFound(corev1.Resource("secrets"), name)
+							}
+						})
+					}),
+				),
+			}
+
+			cr := gen.CertificateRequest("cr-1",
+				gen.SetCertificateRequestNamespace("default"),
+				gen.SetCertificateRequestCertificate(test.givenCert),
+			)
+
+			gotErr := c.Revoke(t.Context(), cr, test.givenIssuer, 0)
+			if test.wantErr != "" {
+				require.EqualError(t, gotErr, test.wantErr)
+				assert.Equal(t, test.wantNotSupported, errors.Is(gotErr, certificaterequests.ErrRevocationNotSupported))
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
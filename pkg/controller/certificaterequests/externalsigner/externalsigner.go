@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsigner
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	crutil "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
+	"github.com/cert-manager/cert-manager/pkg/externalsigner"
+	issuerpkg "github.com/cert-manager/cert-manager/pkg/issuer"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	CRControllerName = "certificaterequests-issuer-externalsigner"
+)
+
+// ExternalSigner is a CertificateRequest Issuer which forwards requests to an
+// external signer plugin over gRPC. The shared CertificateRequest controller
+// takes care of approval checks and conditions, so that plugins only have to
+// sign certificates.
+type ExternalSigner struct {
+	issuerOptions controllerpkg.IssuerOptions
+
+	reporter *crutil.Reporter
+}
+
+func init() {
+	// create certificate request controller for external signer issuer
+	controllerpkg.Register(CRControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, CRControllerName).
+			For(certificaterequests.New(apiutil.IssuerExternalSigner, NewExternalSigner)).
+			Complete()
+	})
+}
+
+func NewExternalSigner(ctx *controllerpkg.Context) certificaterequests.Issuer {
+	return &ExternalSigner{
+		issuerOptions: ctx.IssuerOptions,
+		reporter:      crutil.NewReporter(ctx.Clock, ctx.Recorder),
+	}
+}
+
+// Sign forwards the CertificateRequest to the issuer's external signer plugin.
+// Returns a nil certificate and no error when the plugin returned a permanent
+// error, and the error otherwise so that the request is retried.
+func (e *ExternalSigner) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer) (*issuerpkg.IssueResponse, error) {
+	log := logf.FromContext(ctx, "sign")
+
+	pluginName := issuerObj.GetSpec().ExternalSigner.Name
+	client, err := externalsigner.NewClient(externalsigner.SocketPath(e.issuerOptions.ExternalSignerSocketDirectory, pluginName))
+	if err != nil {
+		message := fmt.Sprintf("Failed to create client for external signer %q", pluginName)
+		e.reporter.Failed(cr, err, "SigningError", message)
+		log.Error(err, message)
+		return nil, nil
+	}
+	defer client.Close()
+
+	resp, err := client.Sign(ctx, &externalsigner.SignRequest{
+		Issuer:             externalsigner.IssuerFor(issuerObj),
+		CertificateRequest: externalsigner.CertificateRequestFor(cr),
+	})
+	if err != nil {
+		message := fmt.Sprintf("External signer %q failed to sign certificate", pluginName)
+		if externalsigner.IsPermanentError(err) {
+			e.reporter.Failed(cr, err, "SigningError", message)
+			log.Error(err, message)
+			return nil, nil
+		}
+
+		e.reporter.Pending(cr, err, "SigningPending", message)
+		log.Error(err, message)
+		return nil, err
+	}
+
+	if err := verifyCertificate(cr, resp.Certificate); err != nil {
+		message := fmt.Sprintf("External signer %q returned an invalid certificate", pluginName)
+		e.reporter.Failed(cr, err, "SigningError", message)
+		log.Error(err, message)
+		return nil, nil
+	}
+
+	log.V(logf.DebugLevel).Info("certificate issued")
+
+	return &issuerpkg.IssueResponse{
+		Certificate: resp.Certificate,
+		CA:          resp.CA,
+	}, nil
+}
+
+// Revoke forwards the revocation of the certificate of the CertificateRequest
+// to the issuer's external signer plugin.
+func (e *ExternalSigner) Revoke(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer, reason int) error {
+	pluginName := issuerObj.GetSpec().ExternalSigner.Name
+	client, err := externalsigner.NewClient(externalsigner.SocketPath(e.issuerOptions.ExternalSignerSocketDirectory, pluginName))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	_, err = client.Revoke(ctx, &externalsigner.RevokeRequest{
+		Issuer:             externalsigner.IssuerFor(issuerObj),
+		CertificateRequest: externalsigner.CertificateRequestFor(cr),
+		Certificate:        cr.Status.Certificate,
+		Reason:             reason,
+	})
+	if status.Code(err) == codes.Unimplemented {
+		return fmt.Errorf("%w: %w", certificaterequests.ErrRevocationNotSupported, err)
+	}
+	return err
+}
+
+// verifyCertificate checks that the PEM encoded certificate chain returned by
+// a plugin is for the public key of the CertificateRequest.
+func verifyCertificate(cr *cmapi.CertificateRequest, certPEM []byte) error {
+	csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.Request)
+	if err != nil {
+		return err
+	}
+
+	chain, err := pki.DecodeX509CertificateChainBytes(certPEM)
+	if err != nil {
+		return err
+	}
+
+	ok, err := pki.PublicKeyMatchesCertificate(csr.PublicKey, chain[0])
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("the public key of the certificate does not match the public key of the request")
+	}
+	return nil
+}
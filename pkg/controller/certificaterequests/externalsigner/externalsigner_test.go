@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsigner
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/tools/record"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	crutil "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
+	"github.com/cert-manager/cert-manager/pkg/externalsigner"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+type fakePlugin struct {
+	externalsigner.UnimplementedServer
+
+	sign func(*externalsigner.SignRequest) (*externalsigner.SignResponse, error)
+}
+
+func (f *fakePlugin) Sign(_ context.Context, req *externalsigner.SignRequest) (*externalsigner.SignResponse, error) {
+	return f.sign(req)
+}
+
+// selfSign returns a certificate for the public key of the given request,
+// self-signed by the given key.
+func selfSign(t *testing.T, request []byte, key any) []byte {
+	template, err := pki.CertificateTemplateFromCSRPEM(request)
+	require.NoError(t, err)
+	certPEM, _, err := pki.SignCertificate(template, template, template.PublicKey, key)
+	require.NoError(t, err)
+	return certPEM
+}
+
+func TestSign(t *testing.T) {
+	csrPEM, sk, err := gen.CSR(x509.ECDSA, gen.SetCSRCommonName("example.com"))
+	require.NoError(t, err)
+	otherKey, err := pki.GenerateECPrivateKey(256)
+	require.NoError(t, err)
+
+	baseIssuer := gen.Issuer("test-issuer",
+		gen.SetIssuerNamespace("test-ns"),
+		func(iss cmapi.GenericIssuer) {
+			iss.GetSpec().ExternalSigner = &cmapi.ExternalSignerIssuer{Name: "test"}
+		},
+	)
+	baseCR := gen.CertificateRequest("test-cr",
+		gen.SetCertificateRequestNamespace("test-ns"),
+		gen.SetCertificateRequestCSR(csrPEM),
+	)
+
+	tests := map[string]struct {
+		sign func(*externalsigner.SignRequest) (*externalsigner.SignResponse, error)
+
+		expCertificate bool
+		expErr         bool
+		expReason      string
+	}{
+		"a certificate signed by the plugin should be returned": {
+			sign: func(req *externalsigner.SignRequest) (*externalsigner.SignResponse, error) {
+				return &externalsigner.SignResponse{Certificate: selfSign(t, req.CertificateRequest.Request, sk)}, nil
+			},
+			expCertificate: true,
+		},
+		"a permanent error from the plugin should fail the request": {
+			sign: func(*externalsigner.SignRequest) (*externalsigner.SignResponse, error) {
+				return nil, status.Error(codes.PermissionDenied, "not allowed")
+			},
+			expReason: cmapi.CertificateRequestReasonFailed,
+		},
+		"a transient error from the plugin should be retried": {
+			sign: func(*externalsigner.SignRequest) (*externalsigner.SignResponse, error) {
+				return nil, status.Error(codes.Unavailable, "try again later")
+			},
+			expErr:    true,
+			expReason: cmapi.CertificateRequestReasonPending,
+		},
+		"a certificate for a different key should fail the request": {
+			sign: func(req *externalsigner.SignRequest) (*externalsigner.SignResponse, error) {
+				template, err := pki.CertificateTemplateFromCSRPEM(req.CertificateRequest.Request)
+				if err != nil {
+					return nil, err
+				}
+				template.PublicKey = otherKey.Public()
+				certPEM, _, err := pki.SignCertificate(template, template, otherKey.Public(), otherKey)
+				return &externalsigner.SignResponse{Certificate: certPEM}, err
+			},
+			expReason: cmapi.CertificateRequestReasonFailed,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// t.TempDir may exceed the maximum length of a unix socket path.
+			dir, err := os.MkdirTemp("", "externalsigner")
+			require.NoError(t, err)
+			t.Cleanup(func() { os.RemoveAll(dir) })
+
+			lis, err := net.Listen("unix", filepath.Join(dir, "test.sock"))
+			require.NoError(t, err)
+			s := grpc.NewServer()
+			externalsigner.RegisterServer(s, &fakePlugin{sign: test.sign})
+			go func() { _ = s.Serve(lis) }()
+			t.Cleanup(s.Stop)
+
+			e := &ExternalSigner{
+				issuerOptions: controllerpkg.IssuerOptions{ExternalSignerSocketDirectory: dir},
+				reporter:      crutil.NewReporter(fakeclock.NewFakeClock(time.Now()), record.NewFakeRecorder(10)),
+			}
+
+			cr := baseCR.DeepCopy()
+			resp, err := e.Sign(t.Context(), cr, baseIssuer)
+			assert.Equal(t, test.expErr, err != nil, "unexpected error: %v", err)
+			assert.Equal(t, test.expCertificate, resp != nil && len(resp.Certificate) > 0)
+
+			cond := apiutil.GetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionReady)
+			if test.expReason == "" {
+				assert.Nil(t, cond)
+				return
+			}
+			require.NotNil(t, cond)
+			assert.Equal(t, cmmeta.ConditionFalse, cond.Status)
+			assert.Equal(t, test.expReason, cond.Reason)
+		})
+	}
+}
+
+func TestRevoke(t *testing.T) {
+	dir, err := os.MkdirTemp("", "externalsigner")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	lis, err := net.Listen("unix", filepath.Join(dir, "test.sock"))
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	externalsigner.RegisterServer(s, &fakePlugin{})
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	e := &ExternalSigner{
+		issuerOptions: controllerpkg.IssuerOptions{ExternalSignerSocketDirectory: dir},
+	}
+
+	err = e.Revoke(t.Context(), gen.CertificateRequest("test-cr"), gen.Issuer("test-issuer", func(iss cmapi.GenericIssuer) {
+		iss.GetSpec().ExternalSigner = &cmapi.ExternalSignerIssuer{Name: "test"}
+	}), 0)
+	assert.True(t, errors.Is(err, certificaterequests.ErrRevocationNotSupported), "expected ErrRevocationNotSupported, got: %v", err)
+}
@@ -33,3 +33,16 @@ type Issuer struct {
 func (i *Issuer) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
 	return i.FakeSign(ctx, cr, issuerObj)
 }
+
+// Revoker is a mock implementation of an Issuer which is also able to revoke
+// certificates.
+type Revoker struct {
+	Issuer
+	FakeRevoke func(context.Context, *cmapi.CertificateRequest, cmapi.GenericIssuer, int) error
+}
+
+// Revoke attempts to revoke the certificate of the CertificateRequest
+// resource given
+func (r *Revoker) Revoke(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer, reason int) error {
+	return r.FakeRevoke(ctx, cr, issuerObj, reason)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificaterequests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// ErrRevocationNotSupported is returned by a Revoker when the configured
+// issuer is not able to revoke certificates. Revocation will not be retried.
+var ErrRevocationNotSupported = errors.New("issuer does not support certificate revocation")
+
+// Revoker is implemented by Issuers which are able to revoke the certificates
+// they have issued. The Revoked condition of CertificateRequests for Issuers
+// which do not implement Revoker is set to False with the reason
+// RevocationNotSupported.
+type Revoker interface {
+	// Revoke revokes the certificate in the status of the given
+	// CertificateRequest, using the given RFC 5280 CRLReason code. Returned
+	// errors are retried, unless they wrap ErrRevocationNotSupported.
+	Revoke(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer, reason int) error
+}
+
+// revocationRequested returns true if the given issued CertificateRequest has
+// been marked for revocation, and its revocation has not yet completed.
+func revocationRequested(cr *cmapi.CertificateRequest) bool {
+	if _, ok := cr.Annotations[cmapi.CertificateRequestRevocationReasonAnnotationKey]; !ok {
+		return false
+	}
+
+	cond := apiutil.GetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionRevoked)
+	if cond == nil {
+		return true
+	}
+
+	return cond.Status != cmmeta.ConditionTrue && cond.Reason == cmapi.CertificateRequestReasonPending
+}
+
+// syncRevocation revokes the certificate of an issued CertificateRequest
+// which has been marked for revocation, and records the outcome in the
+// Revoked condition.
+func (c *Controller) syncRevocation(ctx context.Context, cr *cmapi.CertificateRequest) error {
+	log := logf.FromContext(ctx, "revoke")
+
+	issuerObj, err := c.helper.GetGenericIssuer(cr.Spec.IssuerRef, cr.Namespace)
+	if k8sErrors.IsNotFound(err) {
+		c.reporter.RevocationFailed(cr, err, cmapi.CertificateRequestReasonPending,
+			fmt.Sprintf("Referenced %q not found", apiutil.IssuerKind(cr.Spec.IssuerRef)))
+		return nil
+	}
+
+	if err != nil {
+		log.Error(err, "failed to get issuer")
+		return err
+	}
+
+	log = logf.WithRelatedResource(log, issuerObj)
+
+	issuerType, err := apiutil.NameForIssuer(issuerObj)
+	if err != nil || issuerType != c.issuerType {
+		// This CertificateRequest is not meant for us, ignore
+		return nil
+	}
+
+	reason, err := pki.ParseRevocationReason(cr.Annotations[cmapi.CertificateRequestRevocationReasonAnnotationKey])
+	if err != nil {
+		c.reporter.RevocationFailed(cr, err, cmapi.CertificateRequestReasonFailed, "Invalid revocation reason")
+		return nil
+	}
+
+	revoker, ok := c.issuer.(Revoker)
+	if !ok {
+		c.reporter.RevocationFailed(cr, ErrRevocationNotSupported, cmapi.CertificateRequestReasonRevocationNotSupported,
+			fmt.Sprintf("Failed to revoke certificate with %s issuer", issuerType))
+		return nil
+	}
+
+	if err := revoker.Revoke(ctx, cr, issuerObj, reason); err != nil {
+		if errors.Is(err, ErrRevocationNotSupported) {
+			c.reporter.RevocationFailed(cr, err, cmapi.CertificateRequestReasonRevocationNotSupported,
+				fmt.Sprintf("Failed to revoke certificate with %s issuer", issuerType))
+			return nil
+		}
+
+		c.reporter.RevocationFailed(cr, err, cmapi.CertificateRequestReasonPending,
+			fmt.Sprintf("Failed to revoke certificate with %s issuer", issuerType))
+		log.Error(err, "error revoking certificate")
+		return err
+	}
+
+	log.V(logf.DebugLevel).Info("certificate revoked")
+	c.reporter.Revoked(cr)
+
+	return nil
+}
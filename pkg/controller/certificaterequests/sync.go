@@ -82,6 +82,11 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 		return nil
 
 	case cmapi.CertificateRequestReasonIssued:
+		if revocationRequested(cr) {
+			dbg.Info("certificate request has been marked for revocation")
+			return c.syncRevocation(ctx, crCopy)
+		}
+
 		dbg.Info("certificate request Ready condition true so skipping processing")
 		return nil
 	}
@@ -24,6 +24,7 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -157,6 +158,21 @@ func TestSync(t *testing.T) {
 	certECPEM := generateSelfSignedCert(t, baseCREC, skEC, fixedClockStart, fixedClockStart.Add(time.Hour*12))
 	certECPEMExpired := generateSelfSignedCert(t, baseCREC, skEC, fixedClockStart.Add(-time.Hour*13), fixedClockStart.Add(-time.Hour*12))
 
+	issuedCondition := cmapi.CertificateRequestCondition{
+		Type:               cmapi.CertificateRequestConditionReady,
+		Status:             cmmeta.ConditionTrue,
+		Reason:             "Issued",
+		Message:            "Certificate fetched from issuer successfully",
+		LastTransitionTime: &nowMetaTime,
+	}
+	revokeCR := gen.CertificateRequestFrom(baseCR,
+		gen.SetCertificateRequestCertificate(certRSAPEM),
+		gen.SetCertificateRequestStatusCondition(issuedCondition),
+		gen.AddCertificateRequestAnnotations(map[string]string{
+			cmapi.CertificateRequestRevocationReasonAnnotationKey: "keyCompromise",
+		}),
+	)
+
 	tests := map[string]testT{
 		"should return nil (no action) if group name if not 'cert-manager.io' or ''": {
 			certificateRequest: gen.CertificateRequestFrom(baseCR,
@@ -738,6 +754,116 @@ func TestSync(t *testing.T) {
 				},
 			},
 		},
+		"if an issued certificate request is marked for revocation then revoke and set condition Revoked": {
+			certificateRequest: revokeCR.DeepCopy(),
+			issuerImpl: &fake.Revoker{
+				FakeRevoke: func(_ context.Context, cr *cmapi.CertificateRequest, _ cmapi.GenericIssuer, reason int) error {
+					if reason != 1 {
+						return fmt.Errorf("unexpected revocation reason %d", reason)
+					}
+					return nil
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseIssuer, revokeCR.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateRevoked Certificate revoked by issuer successfully",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(revokeCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionRevoked,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             "Revoked",
+								Message:            "Certificate revoked by issuer successfully",
+								LastTransitionTime: &nowMetaTime,
+							}),
+						),
+					)),
+				},
+			},
+		},
+		"if the issuer does not support revocation then set condition Revoked to RevocationNotSupported": {
+			certificateRequest: revokeCR.DeepCopy(),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseIssuer, revokeCR.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning RevocationFailed Failed to revoke certificate with selfsigned issuer: issuer does not support certificate revocation",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(revokeCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionRevoked,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             "RevocationNotSupported",
+								Message:            "Failed to revoke certificate with selfsigned issuer: issuer does not support certificate revocation",
+								LastTransitionTime: &nowMetaTime,
+							}),
+						),
+					)),
+				},
+			},
+		},
+		"if revoking the certificate fails then set condition Revoked to Pending and return error": {
+			certificateRequest: revokeCR.DeepCopy(),
+			issuerImpl: &fake.Revoker{
+				FakeRevoke: func(context.Context, *cmapi.CertificateRequest, cmapi.GenericIssuer, int) error {
+					return errors.New("this is a network error")
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseIssuer, revokeCR.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning RevocationFailed Failed to revoke certificate with selfsigned issuer: this is a network error",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(revokeCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionRevoked,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             "Pending",
+								Message:            "Failed to revoke certificate with selfsigned issuer: this is a network error",
+								LastTransitionTime: &nowMetaTime,
+							}),
+						),
+					)),
+				},
+			},
+			expectedErr: true,
+		},
+		"if the certificate has already been revoked then do nothing": {
+			certificateRequest: gen.CertificateRequestFrom(revokeCR,
+				gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+					Type:               cmapi.CertificateRequestConditionRevoked,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "Revoked",
+					Message:            "Certificate revoked by issuer successfully",
+					LastTransitionTime: &nowMetaTime,
+				}),
+			),
+			issuerImpl: &fake.Revoker{
+				FakeRevoke: func(context.Context, *cmapi.CertificateRequest, cmapi.GenericIssuer, int) error {
+					return errors.New("unexpected revoke call")
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseIssuer, revokeCR.DeepCopy()},
+				ExpectedEvents:     []string{},
+				ExpectedActions:    []testpkg.Action{},
+			},
+		},
 	}
 
 	for n, test := range tests {
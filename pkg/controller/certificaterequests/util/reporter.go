@@ -30,7 +30,8 @@ import (
 )
 
 const (
-	readyMessage = "Certificate fetched from issuer successfully"
+	readyMessage   = "Certificate fetched from issuer successfully"
+	revokedMessage = "Certificate revoked by issuer successfully"
 )
 
 // A Reporter updates the Status of a CertificateRequest and sends an event
@@ -109,3 +110,24 @@ func (r *Reporter) Ready(cr *cmapi.CertificateRequest) {
 	apiutil.SetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionReady,
 		cmmeta.ConditionTrue, cmapi.CertificateRequestReasonIssued, readyMessage)
 }
+
+// Revoked marks the certificate of a CertificateRequest as revoked and sends
+// a corresponding event.
+func (r *Reporter) Revoked(cr *cmapi.CertificateRequest) {
+	r.recorder.Event(cr, corev1.EventTypeNormal, "CertificateRevoked", revokedMessage)
+	apiutil.SetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionRevoked,
+		cmmeta.ConditionTrue, cmapi.CertificateRequestReasonRevoked, revokedMessage)
+}
+
+// RevocationFailed marks the revocation of the certificate of a
+// CertificateRequest as failed with the given condition reason, and sends a
+// corresponding event. Revocation is only retried if the reason is Pending.
+func (r *Reporter) RevocationFailed(cr *cmapi.CertificateRequest, err error, reason, message string) {
+	if err != nil {
+		message = fmt.Sprintf("%s: %v", message, err)
+	}
+
+	r.recorder.Event(cr, corev1.EventTypeWarning, "RevocationFailed", message)
+	apiutil.SetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionRevoked,
+		cmmeta.ConditionFalse, reason, message)
+}
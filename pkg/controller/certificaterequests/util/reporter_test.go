@@ -108,6 +108,22 @@ func TestReporter(t *testing.T) {
 		LastTransitionTime: &nowMetaTime,
 	}
 
+	revokedCondition := cmapi.CertificateRequestCondition{
+		Type:               cmapi.CertificateRequestConditionRevoked,
+		Reason:             "Revoked",
+		Message:            "Certificate revoked by issuer successfully",
+		Status:             "True",
+		LastTransitionTime: &nowMetaTime,
+	}
+
+	revocationPendingCondition := cmapi.CertificateRequestCondition{
+		Type:               cmapi.CertificateRequestConditionRevoked,
+		Reason:             "Pending",
+		Message:            exampleMessage + ": " + exampleErr.Error(),
+		Status:             "False",
+		LastTransitionTime: &nowMetaTime,
+	}
+
 	tests := map[string]reporterT{
 		"a failed report should update the conditions and set FailureTime as it is nil": {
 			certificateRequest: gen.CertificateRequestFrom(baseCR),
@@ -233,6 +249,36 @@ func TestReporter(t *testing.T) {
 
 			call: "denied",
 		},
+
+		"a revoked report should add the Revoked condition and send an event": {
+			certificateRequest: gen.CertificateRequestFrom(baseCR,
+				gen.SetCertificateRequestStatusCondition(readyCondition),
+			),
+			expectedEvents: []string{
+				"Normal CertificateRevoked Certificate revoked by issuer successfully",
+			},
+			expectedConditions:  []cmapi.CertificateRequestCondition{readyCondition, revokedCondition},
+			expectedFailureTime: nil,
+
+			call: "revoked",
+		},
+
+		"a revocation failed report should set the Revoked condition to False with the reason and send an event": {
+			certificateRequest: gen.CertificateRequestFrom(baseCR,
+				gen.SetCertificateRequestStatusCondition(readyCondition),
+			),
+			err:     exampleErr,
+			message: exampleMessage,
+			reason:  "Pending",
+
+			expectedEvents: []string{
+				"Warning RevocationFailed this is a message: this is an error",
+			},
+			expectedConditions:  []cmapi.CertificateRequestCondition{readyCondition, revocationPendingCondition},
+			expectedFailureTime: nil,
+
+			call: "revocation-failed",
+		},
 	}
 
 	for name, test := range tests {
@@ -259,6 +305,11 @@ func (tt *reporterT) runTest(t *testing.T) {
 			tt.reason, tt.message)
 	case "denied":
 		reporter.Denied(tt.certificateRequest)
+	case "revoked":
+		reporter.Revoked(tt.certificateRequest)
+	case "revocation-failed":
+		reporter.RevocationFailed(tt.certificateRequest, tt.err,
+			tt.reason, tt.message)
 	default:
 		reporter.Ready(tt.certificateRequest)
 	}
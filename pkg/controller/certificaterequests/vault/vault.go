@@ -18,6 +18,7 @@ package vault
 
 import (
 	"context"
+	"fmt"
 
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 
@@ -118,3 +119,17 @@ func (v *Vault) Sign(ctx context.Context, cr *v1.CertificateRequest, issuerObj v
 		CA:          caPem,
 	}, nil
 }
+
+// Revoke will connect to Vault server associated with the provided issuer to
+// revoke the X.509 certificate of the Certificate Request. Vault does not
+// record a reason for revocations, so the given reason is ignored.
+func (v *Vault) Revoke(ctx context.Context, cr *v1.CertificateRequest, issuerObj v1.GenericIssuer, _ int) error {
+	resourceNamespace := v.issuerOptions.ResourceNamespace(issuerObj)
+
+	client, err := v.vaultClientBuilder(ctx, resourceNamespace, v.createTokenFn, v.secretsLister, issuerObj, v.issuerOptions.CanUseAmbientCredentials(issuerObj))
+	if err != nil {
+		return fmt.Errorf("failed to initialise vault client for revocation: %w", err)
+	}
+
+	return client.Revoke(cr.Status.Certificate)
+}
@@ -139,6 +139,20 @@ func TestSign(t *testing.T) {
 		t.FailNow()
 	}
 
+	revokeCR := gen.CertificateRequestFrom(baseCR,
+		gen.SetCertificateRequestCertificate(rsaPEMCert),
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:               cmapi.CertificateRequestConditionReady,
+			Status:             cmmeta.ConditionTrue,
+			Reason:             cmapi.CertificateRequestReasonIssued,
+			Message:            "Certificate fetched from issuer successfully",
+			LastTransitionTime: &metaFixedClockStart,
+		}),
+		gen.AddCertificateRequestAnnotations(map[string]string{
+			cmapi.CertificateRequestRevocationReasonAnnotationKey: "keyCompromise",
+		}),
+	)
+
 	tokenSecret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: gen.DefaultTestNamespace,
@@ -534,6 +548,61 @@ func TestSign(t *testing.T) {
 			},
 			fakeVault: fakevault.New().WithSign(rsaPEMCert, rsaPEMCert, nil),
 		},
+		"an issued request marked for revocation should be revoked with vault": {
+			certificateRequest: revokeCR.DeepCopy(),
+			builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{tokenSecret},
+				CertManagerObjects: []runtime.Object{revokeCR.DeepCopy(), baseIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateRevoked Certificate revoked by issuer successfully",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(revokeCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionRevoked,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             cmapi.CertificateRequestReasonRevoked,
+								Message:            "Certificate revoked by issuer successfully",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+			fakeVault: fakevault.New().WithRevoke(nil),
+		},
+		"a failed vault revocation should set the Revoked condition to Pending and retry": {
+			certificateRequest: revokeCR.DeepCopy(),
+			builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{tokenSecret},
+				CertManagerObjects: []runtime.Object{revokeCR.DeepCopy(), baseIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning RevocationFailed Failed to revoke certificate with vault issuer: permission denied",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(revokeCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionRevoked,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            "Failed to revoke certificate with vault issuer: permission denied",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+			fakeVault:   fakevault.New().WithRevoke(errors.New("permission denied")),
+			expectedErr: true,
+		},
 	}
 
 	for name, test := range tests {
@@ -193,3 +193,33 @@ func (v *Venafi) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerO
 		CA:          bundle.CAPEM,
 	}, nil
 }
+
+// venafiRevocationReasons maps RFC 5280 CRLReason codes to the revocation
+// reasons understood by vcert. Other reasons cannot be revoked with Venafi.
+var venafiRevocationReasons = map[int]string{
+	0: "",
+	1: "key-compromise",
+	2: "ca-compromise",
+	3: "affiliation-changed",
+	4: "superseded",
+	5: "cessation-of-operation",
+}
+
+// Revoke revokes the certificate of the CertificateRequest with Venafi.
+func (v *Venafi) Revoke(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer, reason int) error {
+	log := logf.FromContext(ctx, "revoke")
+	log = logf.WithRelatedResource(log, issuerObj)
+
+	venafiReason, ok := venafiRevocationReasons[reason]
+	if !ok {
+		return fmt.Errorf("%w: the revocation reason %q is not supported by Venafi",
+			certificaterequests.ErrRevocationNotSupported, utilpki.RevocationReasonName(reason))
+	}
+
+	client, err := v.clientBuilder(v.issuerOptions.ResourceNamespace(issuerObj), v.secretsLister, issuerObj, v.metrics, log, v.userAgent)
+	if err != nil {
+		return fmt.Errorf("failed to initialise Certificate Manager client for revocation: %w", err)
+	}
+
+	return client.RevokeCertificate(cr.Status.Certificate, venafiReason)
+}
@@ -21,6 +21,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -981,3 +982,75 @@ func runTest(t *testing.T, test testT) {
 
 	test.builder.CheckAndFinish(err)
 }
+
+func TestRevoke(t *testing.T) {
+	issuer := gen.Issuer("test-issuer", gen.SetIssuerVenafi(cmapi.VenafiIssuer{}))
+	cr := gen.CertificateRequest("test-cr", gen.SetCertificateRequestCertificate([]byte("cert")))
+
+	tests := map[string]struct {
+		reason         int
+		clientErr      error
+		revokeErr      error
+		expectedReason string
+		expectedErr    error
+	}{
+		"should revoke the certificate with the matching Venafi reason": {
+			reason:         1,
+			expectedReason: "key-compromise",
+		},
+		"should revoke the certificate without a reason if unspecified": {
+			reason:         0,
+			expectedReason: "",
+		},
+		"should not revoke the certificate if the reason is not supported by Venafi": {
+			reason:      9,
+			expectedErr: certificaterequests.ErrRevocationNotSupported,
+		},
+		"should return an error if the client cannot be initialised": {
+			reason:      1,
+			clientErr:   errors.New("this is an error"),
+			expectedErr: errors.New("this is an error"),
+		},
+		"should return an error if Venafi fails to revoke the certificate": {
+			reason:         1,
+			revokeErr:      errors.New("this is an error"),
+			expectedReason: "key-compromise",
+			expectedErr:    errors.New("this is an error"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotReason *string
+			v := &Venafi{
+				clientBuilder: func(string, internalinformers.SecretLister, cmapi.GenericIssuer, *metrics.Metrics, logr.Logger, string) (client.Interface, error) {
+					if test.clientErr != nil {
+						return nil, test.clientErr
+					}
+					return &internalvenafifake.Venafi{
+						RevokeCertificateFn: func(certPEM []byte, reason string) error {
+							gotReason = &reason
+							return test.revokeErr
+						},
+					}, nil
+				},
+			}
+
+			err := v.Revoke(t.Context(), cr, issuer, test.reason)
+			switch {
+			case test.expectedErr == nil && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case test.expectedErr != nil && err == nil:
+				t.Errorf("expected error %v, got nil", test.expectedErr)
+			case test.expectedErr != nil && !errors.Is(err, test.expectedErr) && !strings.Contains(err.Error(), test.expectedErr.Error()):
+				t.Errorf("unexpected error, exp=%v got=%v", test.expectedErr, err)
+			}
+
+			if test.expectedReason != "" || (test.expectedErr == nil && gotReason != nil) {
+				if gotReason == nil || *gotReason != test.expectedReason {
+					t.Errorf("unexpected revocation reason, exp=%q got=%v", test.expectedReason, gotReason)
+				}
+			}
+		})
+	}
+}
@@ -66,3 +66,43 @@ func EnqueueCertificatesForResourceUsingPredicates[U metav1.Object](
 		}
 	}
 }
+
+// EnqueueCertificatesInAllNamespacesUsingPredicates behaves like
+// EnqueueCertificatesForResourceUsingPredicates, except that Certificate
+// resources in all namespaces are considered rather than only those in the
+// namespace of the object being processed.
+// It should be used as a handler for resources that may be referenced by
+// Certificates in other namespaces, such as ConfigMap targets.
+func EnqueueCertificatesInAllNamespacesUsingPredicates[U metav1.Object](
+	log logr.Logger, queue workqueue.TypedInterface[types.NamespacedName],
+	lister cmlisters.CertificateLister,
+	predicateBuilders ...predicate.ExtractorFunc[*cmapi.Certificate, U],
+) func(metav1.Object) {
+	return func(s metav1.Object) {
+		u, ok := s.(U)
+		if !ok {
+			return
+		}
+
+		predicates := make(predicate.Funcs[*cmapi.Certificate], len(predicateBuilders))
+		for i, b := range predicateBuilders {
+			predicates[i] = b(u)
+		}
+
+		certs, err := lister.List(labels.Everything())
+		if err != nil {
+			log.Error(err, "Failed listing Certificate resources")
+			return
+		}
+
+		for _, cert := range certs {
+			if !predicates.Evaluate(cert) {
+				continue
+			}
+			queue.Add(types.NamespacedName{
+				Name:      cert.Name,
+				Namespace: cert.Namespace,
+			})
+		}
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internal
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+
+	"github.com/cert-manager/cert-manager/internal/controller/certificates"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// ConfigMapTargets returns the ConfigMaps named in the Certificate's
+// spec.configMapTargets. Targets in the Certificate's own namespace, or in one
+// of the namespaces allowedNamespaces lists for the Certificate's namespace,
+// are returned in permitted. All other targets are returned in denied, and
+// must not be written to.
+func ConfigMapTargets(crt *cmapi.Certificate, allowedNamespaces map[string][]string) (permitted, denied []types.NamespacedName) {
+	for _, target := range crt.Spec.ConfigMapTargets {
+		key := types.NamespacedName{Namespace: target.Namespace, Name: target.Name}
+		if key.Namespace == "" {
+			key.Namespace = crt.Namespace
+		}
+
+		if namespacePermitted(allowedNamespaces, crt.Namespace, key.Namespace) {
+			permitted = append(permitted, key)
+		} else {
+			denied = append(denied, key)
+		}
+	}
+	return permitted, denied
+}
+
+// RemovedConfigMapTargets returns the ConfigMaps which were written for the
+// Certificate, but are no longer among its permitted ConfigMap targets.
+func RemovedConfigMapTargets(lister corev1listers.ConfigMapLister, crt *cmapi.Certificate, permitted []types.NamespacedName) ([]types.NamespacedName, error) {
+	configMaps, err := lister.List(partOfCertManagerSelector)
+	if err != nil {
+		return nil, err
+	}
+	return removedTargets(configMaps, crt, permitted), nil
+}
+
+// updateConfigMapTargets applies the public parts of the given secret data to
+// each of the Certificate's permitted ConfigMap targets, and deletes the
+// ConfigMaps which are no longer targets. The private key is never written to
+// a ConfigMap.
+func (s *SecretsManager) updateConfigMapTargets(ctx context.Context, crt *cmapi.Certificate, data SecretData) error {
+	log := logf.FromContext(ctx).WithName("secrets_manager")
+
+	permitted, denied := ConfigMapTargets(crt, s.configMapTargetNamespaces)
+	for _, key := range denied {
+		s.recorder.Eventf(crt, corev1.EventTypeWarning, "ConfigMapTargetNotPermitted",
+			"Not writing ConfigMap target %s: namespace %q is not permitted for Certificates in namespace %q by the controller's --configmap-target-namespaces flag", key, key.Namespace, crt.Namespace)
+	}
+
+	removed, err := RemovedConfigMapTargets(s.configMapLister, crt, permitted)
+	if err != nil {
+		return err
+	}
+	for _, key := range removed {
+		log.V(logf.DebugLevel).Info("deleting configmap which is no longer a target", "configmap", key)
+
+		if err := s.configMapClient.ConfigMaps(key.Namespace).Delete(ctx, key.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete configmap %s: %w", key, err)
+		}
+	}
+
+	if len(permitted) == 0 {
+		return nil
+	}
+
+	var certificate *x509.Certificate
+	if len(data.Certificate) > 0 {
+		certificate, err = utilpki.DecodeX509CertificateBytes(data.Certificate)
+		if err != nil {
+			return err
+		}
+	}
+	annotations, err := certificates.AnnotationsForCertificate(certificate)
+	if err != nil {
+		return err
+	}
+	maps.Copy(annotations, targetAnnotations(crt))
+
+	configMapData := map[string]string{corev1.TLSCertKey: string(data.Certificate)}
+	if len(data.CA) > 0 {
+		configMapData[cmmeta.TLSCAKey] = string(data.CA)
+	}
+
+	applyOpts := metav1.ApplyOptions{FieldManager: s.fieldManager, Force: true}
+	for _, key := range permitted {
+		// The lister only holds ConfigMaps written by the controller, so any
+		// other existing ConfigMap is only found by querying the API server.
+		existing, err := s.configMapClient.ConfigMaps(key.Namespace).Get(ctx, key.Name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+		case err != nil:
+			return fmt.Errorf("failed to get configmap %s: %w", key, err)
+		case !CreatedForCertificate(existing, crt):
+			s.recorder.Eventf(crt, corev1.EventTypeWarning, "ConfigMapTargetNotOwned",
+				"Not writing ConfigMap target %s: the ConfigMap already exists and was not created for this Certificate", key)
+			continue
+		}
+
+		applyCnf := applycorev1.ConfigMap(key.Name, key.Namespace).
+			WithAnnotations(annotations).
+			WithLabels(map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"}).
+			WithData(configMapData)
+		if ref := s.targetOwnerReference(crt, key.Namespace); ref != nil {
+			applyCnf = applyCnf.WithOwnerReferences(ref)
+		}
+
+		log.V(logf.DebugLevel).Info("applying configmap target", "configmap", key)
+
+		if _, err := s.configMapClient.ConfigMaps(key.Namespace).Apply(ctx, applyCnf, applyOpts); err != nil {
+			return fmt.Errorf("failed to apply configmap %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
@@ -28,6 +28,7 @@ import (
 	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
 	applymetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/record"
 
 	"github.com/cert-manager/cert-manager/internal/controller/certificates"
@@ -45,20 +46,38 @@ var (
 	certificateGvk = cmapi.SchemeGroupVersion.WithKind("Certificate")
 )
 
-// SecretsManager creates and updates secrets with certificate and key data.
+// SecretsManager creates and updates secrets with certificate and key data,
+// the truststore Secrets of Certificates, and the ConfigMap targets of
+// Certificates with the public certificate data.
 type SecretsManager struct {
-	secretClient coreclient.SecretsGetter
-	secretLister internalinformers.SecretLister
-	recorder     record.EventRecorder
+	secretClient    coreclient.SecretsGetter
+	secretLister    internalinformers.SecretLister
+	configMapClient coreclient.ConfigMapsGetter
+	configMapLister corev1listers.ConfigMapLister
+	recorder        record.EventRecorder
 
 	// fieldManager is the manager name used for the Apply operations on Secrets.
 	fieldManager string
 
+	// truststoreFieldManager is the manager name used for the Apply
+	// operations on truststore Secrets.
+	truststoreFieldManager string
+
 	// if true, Secret resources created by the controller will have an
 	// 'owner reference' set, meaning when the Certificate is deleted, the
 	// Secret resource will be automatically deleted.
 	// This option is disabled by default.
 	enableSecretOwnerReferences bool
+
+	// configMapTargetNamespaces are the namespaces, other than the
+	// Certificate's own, which ConfigMap targets may be written to, keyed by
+	// the namespace of the Certificate.
+	configMapTargetNamespaces map[string][]string
+
+	// truststoreSecretNamespaces are the namespaces, other than the
+	// Certificate's own, which copies of truststore Secrets may be written to,
+	// keyed by the namespace of the Certificate.
+	truststoreSecretNamespaces map[string][]string
 }
 
 // SecretData is a structure wrapping private key, Certificate and CA data
@@ -70,27 +89,42 @@ type SecretData struct {
 
 // NewSecretsManager returns a new SecretsManager. Setting
 // enableSecretOwnerReferences to true will mean that secrets will be deleted
-// when the corresponding Certificate is deleted.
+// when the corresponding Certificate is deleted. ConfigMap targets outside of
+// a Certificate's namespace are only written if their namespace is listed for
+// the Certificate's namespace in configMapTargetNamespaces, and likewise
+// copies of truststore Secrets for truststoreSecretNamespaces.
 func NewSecretsManager(
 	secretClient coreclient.SecretsGetter,
 	secretLister internalinformers.SecretLister,
+	configMapClient coreclient.ConfigMapsGetter,
+	configMapLister corev1listers.ConfigMapLister,
 	recorder record.EventRecorder,
 	fieldManager string,
 	enableSecretOwnerReferences bool,
+	configMapTargetNamespaces map[string][]string,
+	truststoreSecretNamespaces map[string][]string,
 ) *SecretsManager {
 	return &SecretsManager{
 		secretClient:                secretClient,
 		secretLister:                secretLister,
+		configMapClient:             configMapClient,
+		configMapLister:             configMapLister,
 		recorder:                    recorder,
 		fieldManager:                fieldManager,
+		truststoreFieldManager:      fieldManager + "-truststore",
 		enableSecretOwnerReferences: enableSecretOwnerReferences,
+		configMapTargetNamespaces:   configMapTargetNamespaces,
+		truststoreSecretNamespaces:  truststoreSecretNamespaces,
 	}
 }
 
 // UpdateData will ensure the Secret resource contains the given secret data as
 // well as appropriate metadata using an Apply call.
 // If the Secret resource does not exist, it will be created on Apply.
-// UpdateData will also update deprecated annotations if they exist.
+// UpdateData will also update deprecated annotations if they exist, apply the
+// Certificate's truststore Secrets if configured, and apply the public
+// certificate data to the Certificate's ConfigMap targets, deleting the
+// ConfigMaps and truststore Secrets which are no longer configured.
 func (s *SecretsManager) UpdateData(ctx context.Context, crt *cmapi.Certificate, data SecretData) error {
 	secret, err := s.getCertificateSecret(crt)
 	if err != nil {
@@ -100,7 +134,14 @@ func (s *SecretsManager) UpdateData(ctx context.Context, crt *cmapi.Certificate,
 	log := logf.FromContext(ctx).WithName("secrets_manager")
 	log = logf.WithResource(log, secret)
 
-	if err := s.setValues(crt, secret, data); err != nil {
+	// Truststores are written to the Certificate's Secret, unless a separate
+	// truststore Secret has been configured.
+	truststore := secret.Data
+	if crt.Spec.Keystores != nil && crt.Spec.Keystores.Truststore != nil {
+		truststore = make(map[string][]byte)
+	}
+
+	if err := s.setValues(crt, secret, truststore, data); err != nil {
 		return err
 	}
 
@@ -129,24 +170,32 @@ func (s *SecretsManager) UpdateData(ctx context.Context, crt *cmapi.Certificate,
 		return fmt.Errorf("failed to apply secret %s/%s: %w", secret.Namespace, secret.Name, err)
 	}
 
-	return nil
+	if err := s.updateTruststoreSecrets(ctx, crt, truststore, data); err != nil {
+		return err
+	}
+
+	return s.updateConfigMapTargets(ctx, crt, data)
 }
 
 // setValues will update the Secret resource 'secret' with the data contained
-// in the given secretData.
+// in the given secretData, and write any keystore truststores to truststore.
 // It will update labels and annotations on the Secret resource appropriately.
 // The Secret resource 's' must be non-nil, although may be a resource that does
 // not exist in the Kubernetes apiserver yet.
 // setValues will NOT actually update the resource in the apiserver.
 // It will also update depreciated issuer name and kind annotations if they
 // exist.
-func (s *SecretsManager) setValues(crt *cmapi.Certificate, secret *corev1.Secret, data SecretData) error {
-	if err := s.setKeystores(crt, secret, data); err != nil {
+func (s *SecretsManager) setValues(crt *cmapi.Certificate, secret *corev1.Secret, truststore map[string][]byte, data SecretData) error {
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string)
+	}
+
+	if err := s.setKeystores(crt, secret, truststore, data); err != nil {
 		return fmt.Errorf("failed to add keystores to Secret: %w", err)
 	}
 
 	// Add additional output formats if enabled.
-	if err := setAdditionalOutputFormats(crt, secret, data); err != nil {
+	if err := s.setAdditionalOutputFormats(crt, secret, data); err != nil {
 		return fmt.Errorf("failed to add additional output formats to Secret: %w", err)
 	}
 
@@ -156,10 +205,6 @@ func (s *SecretsManager) setValues(crt *cmapi.Certificate, secret *corev1.Secret
 		secret.Data[cmmeta.TLSCAKey] = data.CA
 	}
 
-	if secret.Annotations == nil {
-		secret.Annotations = make(map[string]string)
-	}
-
 	if secret.Labels == nil {
 		secret.Labels = make(map[string]string)
 	}
@@ -243,8 +288,9 @@ func (s *SecretsManager) getCertificateSecret(crt *cmapi.Certificate) (*corev1.S
 }
 
 // setKeystores will set extra Secret Data keys according to any Keystores
-// which have been configured.
-func (s *SecretsManager) setKeystores(crt *cmapi.Certificate, secret *corev1.Secret, data SecretData) error {
+// which have been configured. Truststores are set in truststore, which is
+// either the Secret's Data or the data of a separate truststore Secret.
+func (s *SecretsManager) setKeystores(crt *cmapi.Certificate, secret *corev1.Secret, truststore map[string][]byte, data SecretData) error {
 	if crt.Spec.Keystores == nil {
 		return nil
 	}
@@ -300,7 +346,7 @@ func (s *SecretsManager) setKeystores(crt *cmapi.Certificate, secret *corev1.Sec
 				return fmt.Errorf("error encoding PKCS12 trust store bundle: %w", err)
 			}
 			// always overwrite the truststore entry
-			secret.Data[cmapi.PKCS12TruststoreKey] = truststoreData
+			truststore[cmapi.PKCS12TruststoreKey] = truststoreData
 		}
 	}
 
@@ -358,7 +404,7 @@ func (s *SecretsManager) setKeystores(crt *cmapi.Certificate, secret *corev1.Sec
 				return fmt.Errorf("error encoding JKS trust store bundle: %w", err)
 			}
 			// always overwrite the keystore entry
-			secret.Data[cmapi.JKSTruststoreKey] = truststoreData
+			truststore[cmapi.JKSTruststoreKey] = truststoreData
 		}
 	}
 
@@ -367,7 +413,7 @@ func (s *SecretsManager) setKeystores(crt *cmapi.Certificate, secret *corev1.Sec
 
 // setAdditionalOutputFormat will set extra Secret Data keys with additional
 // output formats according to any OutputFormats which have been configured.
-func setAdditionalOutputFormats(crt *cmapi.Certificate, secret *corev1.Secret, data SecretData) error {
+func (s *SecretsManager) setAdditionalOutputFormats(crt *cmapi.Certificate, secret *corev1.Secret, data SecretData) error {
 	for _, format := range crt.Spec.AdditionalOutputFormats {
 		switch format.Type {
 		case cmapi.CertificateOutputFormatDER:
@@ -376,6 +422,35 @@ func setAdditionalOutputFormats(crt *cmapi.Certificate, secret *corev1.Secret, d
 		case cmapi.CertificateOutputFormatCombinedPEM:
 			// Combine tls.key and tls.crt
 			secret.Data[cmapi.CertificateOutputFormatCombinedPEMKey] = certificates.OutputFormatCombinedPEM(data.PrivateKey, data.Certificate)
+		case cmapi.CertificateOutputFormatEncryptedPKCS8:
+			pw, err := EncryptedPKCS8Password(s.secretLister, crt)
+			if apierrors.IsNotFound(err) {
+				s.recorder.Eventf(crt, corev1.EventTypeWarning, "EncryptedPKCS8PasswordSecretNotFound", "EncryptedPKCS8 password Secret %q not found", format.PasswordSecretRef.Name)
+			}
+			if err != nil {
+				return err
+			}
+
+			encrypted, err := certificates.OutputFormatEncryptedPKCS8(data.PrivateKey, pw)
+			if err != nil {
+				return fmt.Errorf("error encrypting PKCS#8 private key: %w", err)
+			}
+			secret.Data[cmapi.CertificateOutputFormatEncryptedPKCS8Key] = encrypted
+			secret.Annotations[cmapi.EncryptedPKCS8ChecksumAnnotationKey] = certificates.EncryptedPKCS8Checksum(encrypted, data.PrivateKey, pw)
+		case cmapi.CertificateOutputFormatPKCS7:
+			// Bundle tls.crt and ca.crt, without the private key
+			bundle, err := certificates.OutputFormatPKCS7(data.Certificate, data.CA)
+			if err != nil {
+				return fmt.Errorf("error encoding PKCS#7 certificate bundle: %w", err)
+			}
+			secret.Data[cmapi.CertificateOutputFormatPKCS7Key] = bundle
+		case cmapi.CertificateOutputFormatFullChainPEM:
+			// Concatenate tls.crt and ca.crt, without the private key
+			fullChain, err := certificates.OutputFormatFullChainPEM(data.Certificate, data.CA)
+			if err != nil {
+				return fmt.Errorf("error encoding full certificate chain: %w", err)
+			}
+			secret.Data[cmapi.CertificateOutputFormatFullChainPEMKey] = fullChain
 		default:
 			return fmt.Errorf("unknown additional output format %s", format.Type)
 		}
@@ -383,3 +458,28 @@ func setAdditionalOutputFormats(crt *cmapi.Certificate, secret *corev1.Secret, d
 
 	return nil
 }
+
+// EncryptedPKCS8Password returns the password referenced by the Certificate's
+// EncryptedPKCS8 additional output format. It returns nil if the Certificate
+// has no EncryptedPKCS8 additional output format.
+func EncryptedPKCS8Password(secretLister internalinformers.SecretLister, crt *cmapi.Certificate) ([]byte, error) {
+	for _, format := range crt.Spec.AdditionalOutputFormats {
+		if format.Type != cmapi.CertificateOutputFormatEncryptedPKCS8 {
+			continue
+		}
+		if format.PasswordSecretRef == nil {
+			return nil, fmt.Errorf("passwordSecretRef must be set for the EncryptedPKCS8 additional output format")
+		}
+
+		ref := format.PasswordSecretRef
+		pwSecret, err := secretLister.Secrets(crt.Namespace).Get(ref.Name)
+		if err != nil {
+			return nil, fmt.Errorf("fetching EncryptedPKCS8 password from Secret: %w", err)
+		}
+		if len(pwSecret.Data[ref.Key]) == 0 {
+			return nil, fmt.Errorf("EncryptedPKCS8 password Secret contains no data for key %q", ref.Key)
+		}
+		return pwSecret.Data[ref.Key], nil
+	}
+	return nil, nil
+}
@@ -17,8 +17,13 @@ limitations under the License.
 package internal
 
 import (
+	"bytes"
 	"context"
+	"crypto"
+	"crypto/x509"
 	"errors"
+	"maps"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -27,12 +32,17 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	apitypes "k8s.io/apimachinery/pkg/types"
 	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
 	applymetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	fakeclock "k8s.io/utils/clock/testing"
 
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/pem"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -98,6 +108,23 @@ func Test_SecretsManager(t *testing.T) {
 		gen.SetCertificateKeystore(&cmapi.CertificateKeystores{PKCS12: &cmapi.PKCS12Keystore{Create: true, Password: &keystorePassword}}),
 	)
 
+	baseCertWithAdditionalOutputFormatEncryptedPKCS8 := gen.CertificateFrom(baseCertBundle.Certificate,
+		gen.SetCertificateAdditionalOutputFormats(cmapi.CertificateAdditionalOutputFormat{
+			Type: "EncryptedPKCS8",
+			PasswordSecretRef: &cmmeta.SecretKeySelector{
+				LocalObjectReference: cmmeta.LocalObjectReference{Name: "key-password"}, Key: "password",
+			},
+		}),
+	)
+
+	baseCertWithAdditionalOutputFormatChains := gen.CertificateFrom(baseCertBundle.Certificate,
+		gen.SetCertificateAdditionalOutputFormats(
+			cmapi.CertificateAdditionalOutputFormat{Type: "PKCS7"},
+			cmapi.CertificateAdditionalOutputFormat{Type: "FullChainPEM"},
+		),
+	)
+	caCertBytes := testcrypto.MustCreateCert(t, testcrypto.MustCreatePEMPrivateKey(t), &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "ca"}})
+
 	block, _, _ := pem.SafeDecodePrivateKey(baseCertBundle.PrivateKeyBytes)
 	tlsDerContent := block.Bytes
 
@@ -768,6 +795,75 @@ func Test_SecretsManager(t *testing.T) {
 			expectedErr: true,
 		},
 
+		"if secret does not exist, create new Secret with EncryptedPKCS8 output format using the referenced password": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate:        baseCertWithAdditionalOutputFormatEncryptedPKCS8,
+			// The fake lister returns this Secret for every name, so it is
+			// used both as the password Secret and the existing target Secret.
+			existingSecret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "key-password"},
+				Data:       map[string][]byte{"password": []byte("test-password")},
+				Type:       corev1.SecretTypeOpaque,
+			},
+			secretData: SecretData{
+				Certificate: baseCertBundle.CertBytes, PrivateKey: baseCertBundle.PrivateKeyBytes,
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					encrypted := gotCnf.Data[cmapi.CertificateOutputFormatEncryptedPKCS8Key]
+					der, err := testcrypto.DecryptPKCS8PrivateKey(encrypted, []byte("test-password"))
+					assert.NoError(t, err, "expected tls-encrypted.key to be encrypted with the referenced password")
+					got, err := x509.ParsePKCS8PrivateKey(der)
+					assert.NoError(t, err)
+					assert.True(t, baseCertBundle.PrivateKey.Public().(interface{ Equal(crypto.PublicKey) bool }).Equal(got.(crypto.Signer).Public()),
+						"expected tls-encrypted.key to contain the private key")
+					assert.Equal(t, internalcertificates.EncryptedPKCS8Checksum(encrypted, baseCertBundle.PrivateKeyBytes, []byte("test-password")),
+						gotCnf.Annotations[cmapi.EncryptedPKCS8ChecksumAnnotationKey])
+					return nil, nil
+				}
+			},
+			expectedErr: false,
+		},
+
+		"if the EncryptedPKCS8 password Secret does not exist, then error": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate:        baseCertWithAdditionalOutputFormatEncryptedPKCS8,
+			existingSecret:     nil,
+			secretData: SecretData{
+				Certificate: baseCertBundle.CertBytes, PrivateKey: baseCertBundle.PrivateKeyBytes,
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					t.Error("expected the Secret not to be applied")
+					return nil, nil
+				}
+			},
+			expectedErr: true,
+		},
+
+		"if secret does not exist, create new Secret with PKCS7 and FullChainPEM output formats without the private key": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate:        baseCertWithAdditionalOutputFormatChains,
+			existingSecret:     nil,
+			secretData: SecretData{
+				Certificate: baseCertBundle.CertBytes, CA: caCertBytes, PrivateKey: baseCertBundle.PrivateKeyBytes,
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					assert.Equal(t, append(bytes.Clone(baseCertBundle.CertBytes), caCertBytes...), gotCnf.Data[cmapi.CertificateOutputFormatFullChainPEMKey])
+
+					expPKCS7, err := internalcertificates.OutputFormatPKCS7(baseCertBundle.CertBytes, caCertBytes)
+					assert.NoError(t, err)
+					assert.Equal(t, expPKCS7, gotCnf.Data[cmapi.CertificateOutputFormatPKCS7Key])
+					return nil, nil
+				}
+			},
+			expectedErr: false,
+		},
+
 		"if secret does not exist, create new Secret with JKS keystore": {
 			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
 			certificate:        baseCertWithJKSKeystore,
@@ -816,10 +912,12 @@ func Test_SecretsManager(t *testing.T) {
 			secretLister := testcorelisters.NewFakeSecretLister(mod)
 
 			testManager := NewSecretsManager(
-				secretClient, secretLister,
+				secretClient, secretLister, nil, newConfigMapLister(t),
 				record.NewFakeRecorder(10),
 				testpkg.FieldManager,
 				test.certificateOptions.EnableOwnerRef,
+				test.certificateOptions.ConfigMapTargetNamespaces,
+				test.certificateOptions.TruststoreSecretNamespaces,
 			)
 
 			err := testManager.UpdateData(t.Context(), test.certificate, test.secretData)
@@ -833,6 +931,241 @@ func Test_SecretsManager(t *testing.T) {
 	}
 }
 
+// newConfigMapLister returns a ConfigMap lister which holds the given
+// ConfigMaps.
+func newConfigMapLister(t *testing.T, configMaps ...*corev1.ConfigMap) corev1listers.ConfigMapLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, configMap := range configMaps {
+		if err := indexer.Add(configMap); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return corev1listers.NewConfigMapLister(indexer)
+}
+
+func Test_SecretsManager_ConfigMapTargets(t *testing.T) {
+	baseCert := gen.Certificate("test",
+		gen.SetCertificateNamespace("default"),
+		gen.SetCertificateIssuer(cmmeta.IssuerReference{Name: "ca-issuer", Kind: "Issuer", Group: "foo.io"}),
+		gen.SetCertificateSecretName("output"),
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateUID(apitypes.UID("test-uid")),
+	)
+	baseCertBundle := testcrypto.MustCreateCryptoBundle(t, baseCert, fixedClock)
+	crt := gen.CertificateFrom(baseCertBundle.Certificate,
+		gen.SetCertificateConfigMapTargets(
+			cmapi.CertificateConfigMapTarget{Name: "local"},
+			cmapi.CertificateConfigMapTarget{Name: "allowed", Namespace: "frontend"},
+			cmapi.CertificateConfigMapTarget{Name: "denied", Namespace: "other"},
+			cmapi.CertificateConfigMapTarget{Name: "kube-root-ca.crt", Namespace: "frontend"},
+		),
+	)
+	data := SecretData{
+		Certificate: baseCertBundle.CertBytes, CA: []byte("test-ca"), PrivateKey: baseCertBundle.PrivateKeyBytes,
+		CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+	}
+
+	// A ConfigMap which was not created for the Certificate, and a ConfigMap
+	// which was but is no longer a target.
+	notOwned := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "frontend", Name: "kube-root-ca.crt"},
+		Data:       map[string]string{"ca.crt": "cluster-ca"},
+	}
+	removed := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "frontend",
+			Name:        "removed",
+			Labels:      map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"},
+			Annotations: map[string]string{cmapi.CertificateNameKey: "test", cmapi.CertificateNamespaceKey: "default"},
+		},
+	}
+	otherCertificate := removed.DeepCopy()
+	otherCertificate.Name = "other-certificate"
+	otherCertificate.Annotations[cmapi.CertificateNamespaceKey] = "backend"
+
+	secretClient := testcoreclients.NewFakeSecretsGetter(testcoreclients.SetFakeSecretsGetterApplyFn(
+		func(context.Context, *applycorev1.SecretApplyConfiguration, metav1.ApplyOptions) (*corev1.Secret, error) {
+			return nil, nil
+		},
+	))
+	secretLister := testcorelisters.NewFakeSecretLister(testcorelisters.SetFakeSecretNamespaceListerGet(nil, apierrors.NewNotFound(corev1.Resource("secret"), "not found")))
+	kubeClient := kubefake.NewClientset(notOwned, removed, otherCertificate)
+	recorder := record.NewFakeRecorder(10)
+
+	testManager := NewSecretsManager(
+		secretClient, secretLister, kubeClient.CoreV1(), newConfigMapLister(t, removed, otherCertificate),
+		recorder,
+		testpkg.FieldManager,
+		true,
+		map[string][]string{"default": {"frontend"}, "backend": {"other"}},
+		nil,
+	)
+
+	if err := testManager.UpdateData(t.Context(), crt, data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	local, err := kubeClient.CoreV1().ConfigMaps("default").Get(t.Context(), "local", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected ConfigMap default/local to be created: %v", err)
+	}
+	assert.Equal(t, map[string]string{"tls.crt": string(baseCertBundle.CertBytes), "ca.crt": "test-ca"}, local.Data)
+	assert.Equal(t, "true", local.Labels[cmapi.PartOfCertManagerControllerLabelKey])
+	assert.Equal(t, "test", local.Annotations[cmapi.CertificateNameKey])
+	assert.Equal(t, "default", local.Annotations[cmapi.CertificateNamespaceKey])
+	assert.Equal(t, []metav1.OwnerReference{*metav1.NewControllerRef(crt, certificateGvk)}, local.OwnerReferences)
+
+	allowed, err := kubeClient.CoreV1().ConfigMaps("frontend").Get(t.Context(), "allowed", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected ConfigMap frontend/allowed to be created: %v", err)
+	}
+	assert.Equal(t, map[string]string{"tls.crt": string(baseCertBundle.CertBytes), "ca.crt": "test-ca"}, allowed.Data)
+	assert.Empty(t, allowed.OwnerReferences, "owner references cannot cross namespaces")
+
+	// Only the namespaces allowed for the Certificate's own namespace may be
+	// written to.
+	_, err = kubeClient.CoreV1().ConfigMaps("other").Get(t.Context(), "denied", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err), "expected ConfigMap other/denied not to be created, got: %v", err)
+
+	existing, err := kubeClient.CoreV1().ConfigMaps("frontend").Get(t.Context(), "kube-root-ca.crt", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected ConfigMap frontend/kube-root-ca.crt to exist: %v", err)
+	}
+	assert.Equal(t, notOwned.Data, existing.Data, "ConfigMaps not created for the Certificate must not be written to")
+
+	_, err = kubeClient.CoreV1().ConfigMaps("frontend").Get(t.Context(), "removed", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err), "expected ConfigMap frontend/removed to be deleted, got: %v", err)
+	_, err = kubeClient.CoreV1().ConfigMaps("frontend").Get(t.Context(), "other-certificate", metav1.GetOptions{})
+	assert.NoError(t, err, "ConfigMaps written for other Certificates must not be deleted")
+
+	close(recorder.Events)
+	var reasons []string
+	for event := range recorder.Events {
+		reasons = append(reasons, strings.Fields(event)[1])
+	}
+	assert.Equal(t, []string{"ConfigMapTargetNotPermitted", "ConfigMapTargetNotOwned"}, reasons)
+}
+
+func Test_SecretsManager_TruststoreSecrets(t *testing.T) {
+	baseCert := gen.Certificate("test",
+		gen.SetCertificateNamespace("default"),
+		gen.SetCertificateIssuer(cmmeta.IssuerReference{Name: "ca-issuer", Kind: "Issuer", Group: "foo.io"}),
+		gen.SetCertificateSecretName("output"),
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateUID(apitypes.UID("test-uid")),
+	)
+	baseCertBundle := testcrypto.MustCreateCryptoBundle(t, baseCert, fixedClock)
+	keystorePassword := "changeit"
+	crt := gen.CertificateFrom(baseCertBundle.Certificate,
+		gen.SetCertificateKeystore(&cmapi.CertificateKeystores{
+			JKS: &cmapi.JKSKeystore{Create: true, Password: &keystorePassword},
+			Truststore: &cmapi.CertificateTruststore{
+				SecretName: "output-truststore",
+				Namespaces: []string{"frontend", "other", "backend"},
+			},
+		}),
+	)
+	caCertBytes := testcrypto.MustCreateCert(t, testcrypto.MustCreatePEMPrivateKey(t), &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "ca"}})
+	data := SecretData{
+		Certificate: baseCertBundle.CertBytes, CA: caCertBytes, PrivateKey: baseCertBundle.PrivateKeyBytes,
+		CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+	}
+
+	// A Secret which was not created for the Certificate, and a truststore
+	// Secret which was but is no longer configured.
+	notOwned := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "backend", Name: "output-truststore"},
+		Data:       map[string][]byte{"password": []byte("hunter2")},
+	}
+	removed := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "stale",
+			Name:        "output-truststore",
+			Labels:      map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"},
+			Annotations: map[string]string{cmapi.CertificateNameKey: "test", cmapi.CertificateNamespaceKey: "default"},
+		},
+	}
+
+	secretLister := testcorelisters.NewFakeSecretLister(func(f *testcorelisters.FakeSecretLister) {
+		f.SecretsFn = func(string) corev1listers.SecretNamespaceLister {
+			return &testcorelisters.FakeSecretNamespaceLister{
+				GetFn: func(name string) (*corev1.Secret, error) {
+					return nil, apierrors.NewNotFound(corev1.Resource("secret"), name)
+				},
+				ListFn: func(labels.Selector) ([]*corev1.Secret, error) {
+					return []*corev1.Secret{removed}, nil
+				},
+			}
+		}
+	})
+	kubeClient := kubefake.NewClientset(notOwned, removed)
+	recorder := record.NewFakeRecorder(10)
+
+	testManager := NewSecretsManager(
+		kubeClient.CoreV1(), secretLister, kubeClient.CoreV1(), newConfigMapLister(t),
+		recorder,
+		testpkg.FieldManager,
+		true,
+		nil,
+		map[string][]string{"default": {"frontend", "backend", "stale"}, "other": {"other"}},
+	)
+
+	if err := testManager.UpdateData(t.Context(), crt, data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output, err := kubeClient.CoreV1().Secrets("default").Get(t.Context(), "output", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected Secret default/output to be created: %v", err)
+	}
+	assert.NotEmpty(t, output.Data[cmapi.JKSSecretKey])
+	assert.NotContains(t, output.Data, cmapi.JKSTruststoreKey, "truststore should only be written to the truststore Secret")
+
+	local, err := kubeClient.CoreV1().Secrets("default").Get(t.Context(), "output-truststore", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected Secret default/output-truststore to be created: %v", err)
+	}
+	assert.ElementsMatch(t, []string{cmapi.JKSTruststoreKey, cmmeta.TLSCAKey}, slices.Collect(maps.Keys(local.Data)))
+	assert.Equal(t, caCertBytes, local.Data[cmmeta.TLSCAKey])
+	assert.Equal(t, "true", local.Labels[cmapi.PartOfCertManagerControllerLabelKey])
+	assert.Equal(t, "test", local.Annotations[cmapi.CertificateNameKey])
+	assert.Equal(t, "default", local.Annotations[cmapi.CertificateNamespaceKey])
+	assert.Equal(t, []metav1.OwnerReference{*metav1.NewControllerRef(crt, certificateGvk)}, local.OwnerReferences)
+	var managers []string
+	for _, entry := range local.ManagedFields {
+		managers = append(managers, entry.Manager)
+	}
+	assert.Equal(t, []string{testpkg.FieldManager + "-truststore"}, managers, "truststore Secrets must not be applied with the Secret's field manager")
+
+	allowed, err := kubeClient.CoreV1().Secrets("frontend").Get(t.Context(), "output-truststore", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected Secret frontend/output-truststore to be created: %v", err)
+	}
+	assert.Equal(t, local.Data, allowed.Data)
+	assert.Empty(t, allowed.OwnerReferences, "owner references cannot cross namespaces")
+
+	// Only the namespaces allowed for the Certificate's own namespace may be
+	// written to.
+	_, err = kubeClient.CoreV1().Secrets("other").Get(t.Context(), "output-truststore", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err), "expected Secret other/output-truststore not to be created, got: %v", err)
+
+	existing, err := kubeClient.CoreV1().Secrets("backend").Get(t.Context(), "output-truststore", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected Secret backend/output-truststore to exist: %v", err)
+	}
+	assert.Equal(t, notOwned.Data, existing.Data, "Secrets not created for the Certificate must not be written to")
+
+	_, err = kubeClient.CoreV1().Secrets("stale").Get(t.Context(), "output-truststore", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err), "expected Secret stale/output-truststore to be deleted, got: %v", err)
+
+	close(recorder.Events)
+	var reasons []string
+	for event := range recorder.Events {
+		reasons = append(reasons, strings.Fields(event)[1])
+	}
+	assert.Equal(t, []string{"TruststoreSecretNotPermitted", "TruststoreSecretNotOwned"}, reasons)
+}
+
 func Test_getCertificateSecret(t *testing.T) {
 	crt := &cmapi.Certificate{
 		ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-certificate"},
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internal
+
+import (
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	applymetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// This file contains the functionality shared by the resources which are
+// written for a Certificate in addition to its Secret, and which may be in
+// other namespaces than the Certificate.
+
+// partOfCertManagerSelector selects the resources written by the controller.
+var partOfCertManagerSelector = labels.SelectorFromSet(labels.Set{cmapi.PartOfCertManagerControllerLabelKey: "true"})
+
+// namespacePermitted returns true if a Certificate in the source namespace may
+// write to the target namespace. Certificates may always write to their own
+// namespace, and to the namespaces listed for their namespace in
+// allowedNamespaces.
+func namespacePermitted(allowedNamespaces map[string][]string, source, target string) bool {
+	return target == source || slices.Contains(allowedNamespaces[source], target)
+}
+
+// CreatedForCertificate returns true if obj was written by the controller for
+// crt. Existing resources which were not are never written to or deleted, so
+// that a Certificate cannot take over resources it does not own.
+func CreatedForCertificate(obj metav1.Object, crt *cmapi.Certificate) bool {
+	annotations := obj.GetAnnotations()
+	return annotations[cmapi.CertificateNameKey] == crt.Name &&
+		annotations[cmapi.CertificateNamespaceKey] == crt.Namespace
+}
+
+// targetAnnotations returns the annotations which mark a resource as written
+// for crt.
+func targetAnnotations(crt *cmapi.Certificate) map[string]string {
+	return map[string]string{
+		cmapi.CertificateNameKey:      crt.Name,
+		cmapi.CertificateNamespaceKey: crt.Namespace,
+	}
+}
+
+// targetOwnerReference returns the owner reference to set on a resource
+// written for crt in the given namespace, or nil if none should be set.
+// Owner references cannot cross namespaces, so resources in other namespaces
+// are instead deleted once they are removed from the Certificate's spec, and
+// are left in place when the Certificate is deleted.
+func (s *SecretsManager) targetOwnerReference(crt *cmapi.Certificate, namespace string) *applymetav1.OwnerReferenceApplyConfiguration {
+	if !s.enableSecretOwnerReferences || namespace != crt.Namespace {
+		return nil
+	}
+	ref := *metav1.NewControllerRef(crt, certificateGvk)
+	return &applymetav1.OwnerReferenceApplyConfiguration{
+		APIVersion: &ref.APIVersion, Kind: &ref.Kind,
+		Name: &ref.Name, UID: &ref.UID,
+		Controller: ref.Controller, BlockOwnerDeletion: ref.BlockOwnerDeletion,
+	}
+}
+
+// removedTargets returns the keys of the resources in objs which were written
+// for crt, but are not in permitted. These are no longer configured, or no
+// longer permitted, and should be deleted.
+func removedTargets[T metav1.Object](objs []T, crt *cmapi.Certificate, permitted []types.NamespacedName) []types.NamespacedName {
+	var removed []types.NamespacedName
+	for _, obj := range objs {
+		key := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+		if CreatedForCertificate(obj, crt) && !slices.Contains(permitted, key) {
+			removed = append(removed, key)
+		}
+	}
+	slices.SortFunc(removed, func(a, b types.NamespacedName) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	return removed
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internal
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// TruststoreSecrets returns the Secrets configured by the Certificate's
+// spec.keystores.truststore. The Secret in the Certificate's own namespace,
+// and copies in one of the namespaces allowedNamespaces lists for the
+// Certificate's namespace, are returned in permitted. All other copies are
+// returned in denied, and must not be written to.
+func TruststoreSecrets(crt *cmapi.Certificate, allowedNamespaces map[string][]string) (permitted, denied []types.NamespacedName) {
+	if crt.Spec.Keystores == nil || crt.Spec.Keystores.Truststore == nil {
+		return nil, nil
+	}
+
+	truststore := crt.Spec.Keystores.Truststore
+	permitted = append(permitted, types.NamespacedName{Namespace: crt.Namespace, Name: truststore.SecretName})
+	for _, namespace := range truststore.Namespaces {
+		key := types.NamespacedName{Namespace: namespace, Name: truststore.SecretName}
+		switch {
+		case namespace == crt.Namespace:
+			continue
+		case namespacePermitted(allowedNamespaces, crt.Namespace, namespace):
+			permitted = append(permitted, key)
+		default:
+			denied = append(denied, key)
+		}
+	}
+	return permitted, denied
+}
+
+// RemovedTruststoreSecrets returns the truststore Secrets which were written
+// for the Certificate, but are no longer among its permitted truststore
+// Secrets.
+func RemovedTruststoreSecrets(lister internalinformers.SecretLister, crt *cmapi.Certificate, permitted []types.NamespacedName) ([]types.NamespacedName, error) {
+	secrets, err := lister.Secrets(metav1.NamespaceAll).List(partOfCertManagerSelector)
+	if err != nil {
+		return nil, err
+	}
+	return removedTargets(secrets, crt, permitted), nil
+}
+
+// updateTruststoreSecrets applies the given truststore data, together with
+// the CA certificate, to each of the Certificate's permitted truststore
+// Secrets, and deletes the truststore Secrets which are no longer configured.
+// Nothing is written if the issuer did not provide a CA certificate.
+func (s *SecretsManager) updateTruststoreSecrets(ctx context.Context, crt *cmapi.Certificate, truststore map[string][]byte, data SecretData) error {
+	log := logf.FromContext(ctx).WithName("secrets_manager")
+
+	permitted, denied := TruststoreSecrets(crt, s.truststoreSecretNamespaces)
+	for _, key := range denied {
+		s.recorder.Eventf(crt, corev1.EventTypeWarning, "TruststoreSecretNotPermitted",
+			"Not writing truststore Secret %s: namespace %q is not permitted for Certificates in namespace %q by the controller's --truststore-secret-namespaces flag", key, key.Namespace, crt.Namespace)
+	}
+
+	removed, err := RemovedTruststoreSecrets(s.secretLister, crt, permitted)
+	if err != nil {
+		return err
+	}
+	for _, key := range removed {
+		log.V(logf.DebugLevel).Info("deleting truststore secret which is no longer configured", "secret", key)
+
+		if err := s.secretClient.Secrets(key.Namespace).Delete(ctx, key.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete truststore secret %s: %w", key, err)
+		}
+	}
+
+	if len(permitted) == 0 || len(data.CA) == 0 {
+		return nil
+	}
+
+	secretData := maps.Clone(truststore)
+	secretData[cmmeta.TLSCAKey] = data.CA
+
+	// Truststore Secrets are applied with their own field manager, so that
+	// they can never take over the fields of a Certificate's Secret.
+	applyOpts := metav1.ApplyOptions{FieldManager: s.truststoreFieldManager, Force: true}
+	for _, key := range permitted {
+		existing, err := s.secretClient.Secrets(key.Namespace).Get(ctx, key.Name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+		case err != nil:
+			return fmt.Errorf("failed to get truststore secret %s: %w", key, err)
+		case !CreatedForCertificate(existing, crt):
+			s.recorder.Eventf(crt, corev1.EventTypeWarning, "TruststoreSecretNotOwned",
+				"Not writing truststore Secret %s: the Secret already exists and was not created for this Certificate", key)
+			continue
+		}
+
+		applyCnf := applycorev1.Secret(key.Name, key.Namespace).
+			WithAnnotations(targetAnnotations(crt)).
+			WithLabels(map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"}).
+			WithData(secretData).
+			WithType(corev1.SecretTypeOpaque)
+		if ref := s.targetOwnerReference(crt, key.Namespace); ref != nil {
+			applyCnf = applyCnf.WithOwnerReferences(ref)
+		}
+
+		log.V(logf.DebugLevel).Info("applying truststore secret", "secret", key)
+
+		if _, err := s.secretClient.Secrets(key.Namespace).Apply(ctx, applyCnf, applyOpts); err != nil {
+			return fmt.Errorf("failed to apply truststore secret %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
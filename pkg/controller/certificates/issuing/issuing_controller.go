@@ -17,8 +17,10 @@ limitations under the License.
 package issuing
 
 import (
+	"bytes"
 	"context"
 	"crypto"
+	"crypto/x509"
 	"fmt"
 	"time"
 
@@ -27,6 +29,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
@@ -45,6 +48,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/issuing/internal"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	utilkube "github.com/cert-manager/cert-manager/pkg/util/kube"
 	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
@@ -55,7 +59,7 @@ const (
 	ControllerName = "certificates-issuing"
 )
 
-type localTemporarySignerFn func(crt *cmapi.Certificate, pk []byte) ([]byte, error)
+type localTemporarySignerFn func(ctx context.Context, crt *cmapi.Certificate, pk []byte) ([]byte, error)
 
 // This controller observes the state of the certificate's 'Issuing' condition,
 // which will then copy the signed certificates and private key to the target
@@ -64,6 +68,7 @@ type controller struct {
 	certificateLister        cmlisters.CertificateLister
 	certificateRequestLister cmlisters.CertificateRequestLister
 	secretLister             internalinformers.SecretLister
+	configMapLister          corelisters.ConfigMapLister
 	recorder                 record.EventRecorder
 	clock                    clock.Clock
 
@@ -83,6 +88,16 @@ type controller struct {
 	// Apply API calls.
 	fieldManager string
 
+	// configMapTargetNamespaces are the namespaces, other than the
+	// Certificate's own, which ConfigMap targets may be written to, keyed by
+	// the namespace of the Certificate.
+	configMapTargetNamespaces map[string][]string
+
+	// truststoreSecretNamespaces are the namespaces, other than the
+	// Certificate's own, which copies of truststore Secrets may be written to,
+	// keyed by the namespace of the Certificate.
+	truststoreSecretNamespaces map[string][]string
+
 	// localTemporarySigner signs a certificate that is stored temporarily
 	localTemporarySigner localTemporarySignerFn
 }
@@ -104,6 +119,7 @@ func NewController(
 	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
 	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
 	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
+	configMapsInformer := ctx.KubeSharedInformerFactory.ConfigMaps()
 
 	if _, err := certificateInformer.Informer().AddEventHandler(controllerpkg.QueuingEventHandler(queue)); err != nil {
 		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
@@ -141,24 +157,81 @@ func NewController(
 	); err != nil {
 		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
 	}
+	if _, err := secretsInformer.Informer().AddEventHandler(
+		controllerpkg.BlockingEventHandler(
+			// Issuer reconciles on changes to the Secret named `spec.csr.secretRef.name`
+			certificates.EnqueueCertificatesForResourceUsingPredicates(
+				log, queue, certificateInformer.Lister(),
+				predicate.ExtractResourceName[*corev1.Secret](predicate.CertificateCSRSecretName),
+			),
+		),
+	); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+
+	if _, err := secretsInformer.Informer().AddEventHandler(
+		controllerpkg.BlockingEventHandler(
+			// Issuer reconciles on changes to the password Secret of the
+			// EncryptedPKCS8 additional output format, so that password
+			// rotations are written promptly
+			certificates.EnqueueCertificatesForResourceUsingPredicates(
+				log, queue, certificateInformer.Lister(),
+				predicate.ExtractResourceName[*corev1.Secret](predicate.CertificateEncryptedPKCS8PasswordSecretName),
+			),
+		),
+	); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+	if _, err := secretsInformer.Informer().AddEventHandler(
+		controllerpkg.BlockingEventHandler(
+			// Issuer reconciles on changes to the truststore Secrets named by
+			// `spec.keystores.truststore`, which may be in any namespace
+			certificates.EnqueueCertificatesInAllNamespacesUsingPredicates(
+				log, queue, certificateInformer.Lister(),
+				func(secret *corev1.Secret) predicate.Func[*cmapi.Certificate] {
+					return predicate.CertificateTruststoreSecret(secret.Namespace, secret.Name)
+				},
+			),
+		),
+	); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+	if _, err := configMapsInformer.Informer().AddEventHandler(
+		controllerpkg.BlockingEventHandler(
+			// Issuer reconciles on changes to the ConfigMaps named in
+			// `spec.configMapTargets`, which may be in any namespace
+			certificates.EnqueueCertificatesInAllNamespacesUsingPredicates(
+				log, queue, certificateInformer.Lister(),
+				func(cm *corev1.ConfigMap) predicate.Func[*cmapi.Certificate] {
+					return predicate.CertificateConfigMapTarget(cm.Namespace, cm.Name)
+				},
+			),
+		),
+	); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
 
 	// build a list of InformerSynced functions that will be returned by the Register method.
 	// the controller will only begin processing items once all of these informers have synced.
 	mustSync := []cache.InformerSynced{
 		certificateRequestInformer.Informer().HasSynced,
 		secretsInformer.Informer().HasSynced,
+		configMapsInformer.Informer().HasSynced,
 		certificateInformer.Informer().HasSynced,
 	}
 
 	secretsManager := internal.NewSecretsManager(
-		ctx.Client.CoreV1(), secretsInformer.Lister(),
+		ctx.Client.CoreV1(), secretsInformer.Lister(), ctx.Client.CoreV1(), configMapsInformer.Lister(),
 		ctx.Recorder, ctx.FieldManager, ctx.CertificateOptions.EnableOwnerRef,
+		ctx.CertificateOptions.ConfigMapTargetNamespaces,
+		ctx.CertificateOptions.TruststoreSecretNamespaces,
 	)
 
 	return &controller{
 		certificateLister:        certificateInformer.Lister(),
 		certificateRequestLister: certificateRequestInformer.Lister(),
 		secretLister:             secretsInformer.Lister(),
+		configMapLister:          configMapsInformer.Lister(),
 		client:                   ctx.CMClient,
 		recorder:                 ctx.Recorder,
 		clock:                    ctx.Clock,
@@ -167,8 +240,10 @@ func NewController(
 			ctx.CertificateOptions.EnableOwnerRef,
 			ctx.FieldManager,
 		),
-		fieldManager:         ctx.FieldManager,
-		localTemporarySigner: utilpki.GenerateLocallySignedTemporaryCertificate,
+		fieldManager:               ctx.FieldManager,
+		configMapTargetNamespaces:  ctx.CertificateOptions.ConfigMapTargetNamespaces,
+		truststoreSecretNamespaces: ctx.CertificateOptions.TruststoreSecretNamespaces,
+		localTemporarySigner:       utilpki.GenerateLocallySignedTemporaryCertificate,
 	}, queue, mustSync, nil
 }
 
@@ -205,36 +280,28 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 		return c.ensureSecretData(ctx, log, crt)
 	}
 
-	if crt.Status.NextPrivateKeySecretName == nil ||
-		len(*crt.Status.NextPrivateKeySecretName) == 0 {
-		// Do nothing if the next private key secret name is not set
-		return nil
-	}
-
-	// Fetch and parse the 'next private key secret'
-	nextPrivateKeySecret, err := c.secretLister.Secrets(crt.Namespace).Get(*crt.Status.NextPrivateKeySecretName)
-	if apierrors.IsNotFound(err) {
-		log.V(logf.DebugLevel).Info("Next private key secret does not exist, waiting for keymanager controller")
-		// If secret does not exist, do nothing (keymanager will handle this).
-		return nil
-	}
-	if err != nil {
-		return err
-	}
-	if nextPrivateKeySecret.Data == nil || len(nextPrivateKeySecret.Data[corev1.TLSPrivateKeyKey]) == 0 {
-		logf.WithResource(log, nextPrivateKeySecret).Info("Next private key secret does not contain any private key data, waiting for keymanager controller")
-		return nil
-	}
-	pk, _, err := utilkube.ParseTLSKeyFromSecret(nextPrivateKeySecret, corev1.TLSPrivateKeyKey)
-	if err != nil {
-		// If the private key cannot be parsed here, do nothing as the key manager will handle this.
-		logf.WithResource(log, nextPrivateKeySecret).Error(err, "failed to parse next private key, waiting for keymanager controller")
-		return nil
-	}
-	pkViolations := utilpki.PrivateKeyMatchesSpec(pk, crt.Spec)
-	if len(pkViolations) > 0 {
-		logf.WithResource(log, nextPrivateKeySecret).Info("stored next private key does not match requirements on Certificate resource, waiting for keymanager controller", "violations", pkViolations)
-		return nil
+	// A Certificate either uses a user supplied CSR, or a private key
+	// generated by the keymanager controller.
+	var (
+		userCSR *x509.CertificateRequest
+		pk      crypto.Signer
+	)
+	if crt.Spec.CSR != nil {
+		userCSR, err = internalcertificates.CSRForCertificate(c.secretLister, crt)
+		if apierrors.IsNotFound(err) || cmerrors.IsInvalidData(err) {
+			// If the CSR is not available, do nothing (requestmanager will
+			// report this).
+			log.V(logf.DebugLevel).Info("spec.csr is not available, waiting for requestmanager controller", "error", err.Error())
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	} else {
+		pk, err = c.nextPrivateKey(ctx, crt)
+		if err != nil || pk == nil {
+			return err
+		}
 	}
 
 	// CertificateRequest revisions begin from 1. If no revision is set on the
@@ -279,13 +346,20 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	if err != nil {
 		return err
 	}
-	publicKeyMatchesCSR, err := utilpki.PublicKeyMatchesCSR(pk.Public(), csr)
-	if err != nil {
-		return err
-	}
-	if !publicKeyMatchesCSR {
-		logf.WithResource(log, nextPrivateKeySecret).Info("next private key does not match CSR public key, waiting for requestmanager controller")
-		return nil
+	if userCSR != nil {
+		if !bytes.Equal(csr.Raw, userCSR.Raw) {
+			log.Info("CertificateRequest does not contain spec.csr, waiting for requestmanager controller")
+			return nil
+		}
+	} else {
+		publicKeyMatchesCSR, err := utilpki.PublicKeyMatchesCSR(pk.Public(), csr)
+		if err != nil {
+			return err
+		}
+		if !publicKeyMatchesCSR {
+			log.Info("next private key does not match CSR public key, waiting for requestmanager controller")
+			return nil
+		}
 	}
 
 	certIssuingCond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing)
@@ -398,9 +472,12 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 
 	// Issue temporary certificate if needed. If a certificate was issued, then
 	// return early - we will sync again since the target Secret has been
-	// updated.
-	if issued, err := c.ensureTemporaryCertificate(ctx, crt, pk); err != nil || issued {
-		return err
+	// updated. Without a private key, no temporary certificate can be issued
+	// for a user supplied CSR.
+	if pk != nil {
+		if issued, err := c.ensureTemporaryCertificate(ctx, crt, pk); err != nil || issued {
+			return err
+		}
 	}
 
 	// CertificateRequest is not in a final state so do nothing.
@@ -408,6 +485,52 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	return nil
 }
 
+// nextPrivateKey returns the private key stored in the Certificate's
+// 'status.nextPrivateKeySecretName' Secret. If the key is not available or
+// does not match the Certificate's spec, nil is returned and the keymanager
+// controller is left to handle it.
+func (c *controller) nextPrivateKey(ctx context.Context, crt *cmapi.Certificate) (crypto.Signer, error) {
+	log := logf.FromContext(ctx)
+
+	if crt.Status.NextPrivateKeySecretName == nil ||
+		len(*crt.Status.NextPrivateKeySecretName) == 0 {
+		// Do nothing if the next private key secret name is not set
+		return nil, nil
+	}
+
+	// Fetch and parse the 'next private key secret'
+	nextPrivateKeySecret, err := c.secretLister.Secrets(crt.Namespace).Get(*crt.Status.NextPrivateKeySecretName)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("Next private key secret does not exist, waiting for keymanager controller")
+		// If secret does not exist, do nothing (keymanager will handle this).
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if nextPrivateKeySecret.Data == nil || len(nextPrivateKeySecret.Data[corev1.TLSPrivateKeyKey]) == 0 {
+		logf.WithResource(log, nextPrivateKeySecret).Info("Next private key secret does not contain any private key data, waiting for keymanager controller")
+		return nil, nil
+	}
+	pk, _, err := utilkube.ParseTLSKeyFromSecret(ctx, nextPrivateKeySecret, corev1.TLSPrivateKeyKey)
+	if err != nil && !cmerrors.IsInvalidData(err) {
+		// The private key is held by a KeyProvider which could not be reached.
+		return nil, err
+	}
+	if err != nil {
+		// If the private key cannot be parsed here, do nothing as the key manager will handle this.
+		logf.WithResource(log, nextPrivateKeySecret).Error(err, "failed to parse next private key, waiting for keymanager controller")
+		return nil, nil
+	}
+	pkViolations := utilpki.PrivateKeyMatchesSpec(pk, crt.Spec)
+	if len(pkViolations) > 0 {
+		logf.WithResource(log, nextPrivateKeySecret).Info("stored next private key does not match requirements on Certificate resource, waiting for keymanager controller", "violations", pkViolations)
+		return nil, nil
+	}
+
+	return pk, nil
+}
+
 // failIssueCertificate will mark the Issuing condition of this Certificate as
 // false, set the Certificate's last failure time and issuance attempts, and log
 // an appropriate event. The reason and message of the Issuing condition will be that of
@@ -450,9 +573,15 @@ func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt
 		crt.Spec.PrivateKey = &cmapi.CertificatePrivateKey{}
 	}
 
-	pkData, err := utilpki.EncodePrivateKey(pk, crt.Spec.PrivateKey.Encoding)
-	if err != nil {
-		return err
+	// The private key of a user supplied CSR is not held by cert-manager, but
+	// the tls.key entry is still required for kubernetes.io/tls Secrets.
+	pkData := []byte{}
+	if pk != nil {
+		var err error
+		pkData, err = utilpki.EncodePrivateKey(pk, crt.Spec.PrivateKey.Encoding)
+		if err != nil {
+			return err
+		}
 	}
 	secretData := internal.SecretData{
 		PrivateKey:      pkData,
@@ -464,10 +593,27 @@ func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt
 		IssuerGroup:     req.Spec.IssuerRef.Group,
 	}
 
+	// Keys held by a KeyProvider can only be used by this Certificate, so
+	// they are deleted from the KeyProvider once replaced in the Secret.
+	var replacedPKData []byte
+	existingSecret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if existingSecret != nil {
+		replacedPKData = existingSecret.Data[corev1.TLSPrivateKeyKey]
+	}
+
 	if err := c.secretsUpdateData(ctx, crt, secretData); err != nil {
 		return err
 	}
 
+	if err := utilpki.DeleteReplacedExternalPrivateKey(ctx, replacedPKData, pkData); err != nil {
+		// Issuance is complete, failing to delete the replaced key only
+		// leaves it unused in the KeyProvider.
+		logf.FromContext(ctx).Error(err, "failed to delete replaced private key")
+	}
+
 	// Set status.revision to revision of the CertificateRequest
 	crt.Status.Revision = &nextRevision
 
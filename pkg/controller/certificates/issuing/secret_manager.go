@@ -23,6 +23,7 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/cert-manager/cert-manager/internal/controller/certificates/policies"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -33,8 +34,8 @@ import (
 
 // ensureSecretData ensures that the Certificate's Secret is up to date with
 // non-issuing condition related data.
-// Reconciles over the Certificate's SecretTemplate, and
-// AdditionalOutputFormats.
+// Reconciles over the Certificate's SecretTemplate, AdditionalOutputFormats,
+// truststore Secrets and ConfigMapTargets.
 func (c *controller) ensureSecretData(ctx context.Context, log logr.Logger, crt *cmapi.Certificate) error {
 	// Retrieve the Secret which is associated with this Certificate.
 	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
@@ -57,9 +58,10 @@ func (c *controller) ensureSecretData(ctx context.Context, log logr.Logger, crt
 	// If there is no certificate or private key data available at the target
 	// Secret then exit early. The absence of these keys should cause an issuance
 	// of the Certificate, so there is no need to run post issuance checks.
+	// Certificates using a user supplied CSR never have private key data.
 	if secret.Data == nil ||
 		len(secret.Data[corev1.TLSCertKey]) == 0 ||
-		len(secret.Data[corev1.TLSPrivateKeyKey]) == 0 {
+		(crt.Spec.CSR == nil && len(secret.Data[corev1.TLSPrivateKeyKey]) == 0) {
 		log.V(logf.DebugLevel).Info("secret doesn't contain both certificate and private key data",
 			"cert_data_len", len(secret.Data[corev1.TLSCertKey]), "key_data_len", len(secret.Data[corev1.TLSPrivateKeyKey]))
 		return nil
@@ -75,11 +77,32 @@ func (c *controller) ensureSecretData(ctx context.Context, log logr.Logger, crt
 		IssuerGroup:     secret.Annotations[cmapi.IssuerGroupAnnotationKey],
 	}
 
+	configMapTargets, removedConfigMapTargets, err := c.getConfigMapTargets(crt)
+	if err != nil {
+		return err
+	}
+
+	truststoreSecrets, removedTruststoreSecrets, err := c.getTruststoreSecrets(crt)
+	if err != nil {
+		return err
+	}
+
+	encryptedPKCS8Password, err := internal.EncryptedPKCS8Password(c.secretLister, crt)
+	if err != nil {
+		return err
+	}
+
 	// Check whether the Certificate's Secret has correct output format and
-	// metadata.
+	// metadata, and whether its truststore Secrets and ConfigMap targets are up
+	// to date.
 	reason, message, isViolation := c.postIssuancePolicyChain.Evaluate(policies.Input{
-		Certificate: crt,
-		Secret:      secret,
+		Certificate:              crt,
+		Secret:                   secret,
+		EncryptedPKCS8Password:   encryptedPKCS8Password,
+		TruststoreSecrets:        truststoreSecrets,
+		RemovedTruststoreSecrets: removedTruststoreSecrets,
+		ConfigMapTargets:         configMapTargets,
+		RemovedConfigMapTargets:  removedConfigMapTargets,
 	})
 
 	if isViolation {
@@ -104,3 +127,63 @@ func (c *controller) ensureSecretData(ctx context.Context, log logr.Logger, crt
 
 	return nil
 }
+
+// getConfigMapTargets returns the Certificate's permitted ConfigMap targets
+// from the lister, keyed by namespace and name. ConfigMaps which do not exist
+// yet are present with a nil value, and ConfigMaps which exist but were not
+// created for the Certificate are left out, as they are never written to.
+// It also returns the ConfigMaps which were written for the Certificate, but
+// are no longer among its permitted targets.
+func (c *controller) getConfigMapTargets(crt *cmapi.Certificate) (map[types.NamespacedName]*corev1.ConfigMap, []types.NamespacedName, error) {
+	permitted, _ := internal.ConfigMapTargets(crt, c.configMapTargetNamespaces)
+	removed, err := internal.RemovedConfigMapTargets(c.configMapLister, crt, permitted)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(permitted) == 0 {
+		return nil, removed, nil
+	}
+
+	configMaps := make(map[types.NamespacedName]*corev1.ConfigMap, len(permitted))
+	for _, key := range permitted {
+		configMap, err := c.configMapLister.ConfigMaps(key.Namespace).Get(key.Name)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, nil, err
+		}
+		if configMap != nil && !internal.CreatedForCertificate(configMap, crt) {
+			continue
+		}
+		configMaps[key] = configMap
+	}
+	return configMaps, removed, nil
+}
+
+// getTruststoreSecrets returns the Certificate's permitted truststore Secrets
+// from the lister, keyed by namespace and name. Secrets which do not exist yet
+// are present with a nil value, and Secrets which exist but were not created
+// for the Certificate are left out, as they are never written to.
+// It also returns the truststore Secrets which were written for the
+// Certificate, but are no longer configured.
+func (c *controller) getTruststoreSecrets(crt *cmapi.Certificate) (map[types.NamespacedName]*corev1.Secret, []types.NamespacedName, error) {
+	permitted, _ := internal.TruststoreSecrets(crt, c.truststoreSecretNamespaces)
+	removed, err := internal.RemovedTruststoreSecrets(c.secretLister, crt, permitted)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(permitted) == 0 {
+		return nil, removed, nil
+	}
+
+	secrets := make(map[types.NamespacedName]*corev1.Secret, len(permitted))
+	for _, key := range permitted {
+		secret, err := c.secretLister.Secrets(key.Namespace).Get(key.Name)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, nil, err
+		}
+		if secret != nil && !internal.CreatedForCertificate(secret, crt) {
+			continue
+		}
+		secrets[key] = secret
+	}
+	return secrets, removed, nil
+}
@@ -51,7 +51,7 @@ func (c *controller) ensureTemporaryCertificate(ctx context.Context, crt *cmapi.
 	if err != nil && !apierrors.IsNotFound(err) {
 		return false, err
 	}
-	input := policies.Input{Secret: secret}
+	input := policies.Input{Certificate: crt, Secret: secret}
 	// If the target Secret exists with a signed certificate and matching private
 	// key, do not issue.
 	if _, _, invalid := policies.NewTemporaryCertificatePolicyChain().Evaluate(input); !invalid {
@@ -63,7 +63,7 @@ func (c *controller) ensureTemporaryCertificate(ctx context.Context, crt *cmapi.
 	if err != nil {
 		return false, err
 	}
-	certData, err := c.localTemporarySigner(crt, pkData)
+	certData, err := c.localTemporarySigner(ctx, crt, pkData)
 	if err != nil {
 		return false, err
 	}
@@ -47,6 +47,7 @@ import (
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 	"github.com/cert-manager/cert-manager/pkg/util/predicate"
@@ -175,6 +176,16 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 		return err
 	}
 
+	// Certificates using a user supplied CSR do not have a private key in the
+	// cluster, so there is nothing for the keymanager to generate.
+	if crt.Spec.CSR != nil {
+		log.V(logf.DebugLevel).Info("Cleaning up Secret resources and unsetting nextPrivateKeySecretName as the Certificate uses spec.csr")
+		if err := c.deleteSecretResources(ctx, secrets); err != nil {
+			return err
+		}
+		return c.setNextPrivateKeySecretName(ctx, crt, nil)
+	}
+
 	if !apiutil.CertificateHasCondition(crt, cmapi.CertificateCondition{
 		Type:   cmapi.CertificateConditionIssuing,
 		Status: cmmeta.ConditionTrue,
@@ -229,7 +240,12 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 		return c.deleteSecretResources(ctx, secrets)
 	}
 	pkData := secret.Data[corev1.TLSPrivateKeyKey]
-	pk, err := pki.DecodePrivateKeyBytes(pkData)
+	pk, err := pki.DecodeCertificatePrivateKeyBytes(ctx, types.NamespacedName{Namespace: crt.Namespace, Name: crt.Name}, pkData)
+	if err != nil && !cmerrors.IsInvalidData(err) {
+		// The key is held by a KeyProvider which could not be reached, so
+		// retry rather than discarding the key.
+		return err
+	}
 	if err != nil {
 		log.Error(err, "Deleting existing private key secret due to error decoding data")
 		return c.deleteSecretResources(ctx, secrets)
@@ -260,7 +276,10 @@ func (c *controller) createNextPrivateKeyRotationPolicyNever(ctx context.Context
 		return c.createAndSetNextPrivateKey(ctx, crt)
 	}
 	existingPKData := s.Data[corev1.TLSPrivateKeyKey]
-	pk, err := pki.DecodePrivateKeyBytes(existingPKData)
+	pk, err := pki.DecodeCertificatePrivateKeyBytes(ctx, types.NamespacedName{Namespace: crt.Namespace, Name: crt.Name}, existingPKData)
+	if err != nil && !cmerrors.IsInvalidData(err) {
+		return err
+	}
 	if err != nil {
 		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonDecodeFailed, "Failed to decode private key stored in Secret %q - generating new key", crt.Spec.SecretName)
 		return c.createAndSetNextPrivateKey(ctx, crt)
@@ -282,7 +301,15 @@ func (c *controller) createNextPrivateKeyRotationPolicyNever(ctx context.Context
 }
 
 func (c *controller) createAndSetNextPrivateKey(ctx context.Context, crt *cmapi.Certificate) error {
-	pk, err := pki.GeneratePrivateKeyForCertificate(crt)
+	var pk crypto.Signer
+	var err error
+	if crt.Spec.PrivateKey != nil && crt.Spec.PrivateKey.Provider != "" {
+		// The private key is held by the KeyProvider, and the Secret only
+		// stores a handle referencing it.
+		pk, err = pki.GenerateExternalPrivateKey(ctx, crt.Spec.PrivateKey.Provider, crt)
+	} else {
+		pk, err = pki.GeneratePrivateKeyForCertificate(crt)
+	}
 	if err != nil {
 		return err
 	}
@@ -17,6 +17,8 @@ limitations under the License.
 package keymanager
 
 import (
+	"context"
+	"crypto"
 	"fmt"
 	"reflect"
 	"testing"
@@ -34,6 +36,21 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
+// unavailableKeyProvider is a pki.KeyProvider which can never be reached.
+type unavailableKeyProvider struct{}
+
+func (unavailableKeyProvider) GenerateKey(context.Context, *cmapi.Certificate) ([]byte, error) {
+	return nil, pki.ErrKeyProviderUnavailable
+}
+
+func (unavailableKeyProvider) Signer(context.Context, types.NamespacedName, []byte) (crypto.Signer, error) {
+	return nil, fmt.Errorf("%w: connection refused", pki.ErrKeyProviderUnavailable)
+}
+
+func (unavailableKeyProvider) Delete(context.Context, []byte) error {
+	return fmt.Errorf("%w: connection refused", pki.ErrKeyProviderUnavailable)
+}
+
 func mustGenerateRSA(t *testing.T, keySize int) []byte {
 	pk, err := pki.GenerateRSAPrivateKey(keySize)
 	if err != nil {
@@ -74,6 +91,8 @@ func relaxedSecretMatcher(l coretesting.Action, r coretesting.Action) error {
 }
 
 func TestProcessItem(t *testing.T) {
+	pki.RegisterKeyProvider("unavailable", unavailableKeyProvider{})
+
 	ownedSecretWithName := func(namespace, name, owner string, data map[string][]byte) *corev1.Secret {
 		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
 			Namespace: namespace,
@@ -305,6 +324,49 @@ func TestProcessItem(t *testing.T) {
 				)),
 			},
 		},
+		"if the Certificate uses spec.csr, delete owned secrets and unset nextPrivateKeySecretName": {
+			certificate: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test", UID: types.UID("test")},
+				Spec: cmapi.CertificateSpec{
+					CSR: &cmapi.CertificateCSRSource{Request: []byte("csr")},
+				},
+				Status: cmapi.CertificateStatus{
+					NextPrivateKeySecretName: new("fixed-name"),
+					Conditions: []cmapi.CertificateCondition{
+						{
+							Type:   cmapi.CertificateConditionIssuing,
+							Status: cmmeta.ConditionTrue,
+						},
+					},
+				},
+			},
+			secrets: []runtime.Object{
+				ownedSecretWithName("testns", "fixed-name", "test", nil),
+			},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewDeleteAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					"testns",
+					"fixed-name",
+				)),
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"),
+					"status",
+					"testns",
+					&cmapi.Certificate{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test", UID: types.UID("test")},
+						Status: cmapi.CertificateStatus{
+							Conditions: []cmapi.CertificateCondition{
+								{
+									Type:   cmapi.CertificateConditionIssuing,
+									Status: cmmeta.ConditionTrue,
+								},
+							},
+						},
+					},
+				)),
+			},
+		},
 		"if multiple owned secrets exist with one matching nextPrivateKeySecretName, preserve the matching one and delete others": {
 			certificate: &cmapi.Certificate{
 				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test", UID: types.UID("test")},
@@ -498,6 +560,86 @@ func TestProcessItem(t *testing.T) {
 				)),
 			},
 		},
+		"if an owned secret exists but contains a key not held by the requested key provider, delete it'": {
+			certificate: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test", UID: types.UID("test")},
+				Spec: cmapi.CertificateSpec{
+					PrivateKey: &cmapi.CertificatePrivateKey{Provider: "test-provider"},
+				},
+				Status: cmapi.CertificateStatus{
+					NextPrivateKeySecretName: new("fixed-name"),
+					Conditions: []cmapi.CertificateCondition{
+						{
+							Type:   cmapi.CertificateConditionIssuing,
+							Status: cmmeta.ConditionTrue,
+						},
+					},
+				},
+			},
+			secrets: []runtime.Object{
+				ownedSecretWithName("testns", "fixed-name", "test", map[string][]byte{"tls.key": mustGenerateRSA(t, 2048)}),
+			},
+			expectedEvents: []string{"Normal Deleted Regenerating private key due to change in fields: [spec.privateKey.provider]"},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewDeleteAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					"testns",
+					"fixed-name",
+				)),
+			},
+		},
+		"if an owned secret exists but its key provider is unavailable, retry without deleting it'": {
+			certificate: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test", UID: types.UID("test")},
+				Spec: cmapi.CertificateSpec{
+					PrivateKey: &cmapi.CertificatePrivateKey{Provider: "unavailable"},
+				},
+				Status: cmapi.CertificateStatus{
+					NextPrivateKeySecretName: new("fixed-name"),
+					Conditions: []cmapi.CertificateCondition{
+						{
+							Type:   cmapi.CertificateConditionIssuing,
+							Status: cmmeta.ConditionTrue,
+						},
+					},
+				},
+			},
+			secrets: []runtime.Object{
+				ownedSecretWithName("testns", "fixed-name", "test", map[string][]byte{
+					"tls.key": []byte("-----BEGIN CERT-MANAGER KEY HANDLE-----\nProvider: unavailable\n\nMDEyMw==\n-----END CERT-MANAGER KEY HANDLE-----\n"),
+				}),
+			},
+			err: `error loading private key from key provider "unavailable": key provider unavailable: connection refused`,
+		},
+		"if an owned secret exists but its key provider is not registered, delete it'": {
+			certificate: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test", UID: types.UID("test")},
+				Spec: cmapi.CertificateSpec{
+					PrivateKey: &cmapi.CertificatePrivateKey{Provider: "unregistered"},
+				},
+				Status: cmapi.CertificateStatus{
+					NextPrivateKeySecretName: new("fixed-name"),
+					Conditions: []cmapi.CertificateCondition{
+						{
+							Type:   cmapi.CertificateConditionIssuing,
+							Status: cmmeta.ConditionTrue,
+						},
+					},
+				},
+			},
+			secrets: []runtime.Object{
+				ownedSecretWithName("testns", "fixed-name", "test", map[string][]byte{
+					"tls.key": []byte("-----BEGIN CERT-MANAGER KEY HANDLE-----\nProvider: unregistered\n\nMDEyMw==\n-----END CERT-MANAGER KEY HANDLE-----\n"),
+				}),
+			},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewDeleteAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					"testns",
+					"fixed-name",
+				)),
+			},
+		},
 		"if an owned secret exists and contains data valid for the spec, do nothing'": {
 			certificate: &cmapi.Certificate{
 				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test", UID: types.UID("test")},
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"crypto"
+	"crypto/x509"
 	"encoding/pem"
 	"fmt"
 	"strconv"
@@ -36,6 +37,7 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/clock"
 
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
@@ -46,6 +48,7 @@ import (
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 	"github.com/cert-manager/cert-manager/pkg/util/predicate"
@@ -116,6 +119,17 @@ func NewController(
 	); err != nil {
 		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
 	}
+	if _, err := secretsInformer.Informer().AddEventHandler(
+		controllerpkg.BlockingEventHandler(
+			// Requestmanager reconciles on changes to the Secret named `spec.csr.secretRef.name`
+			certificates.EnqueueCertificatesForResourceUsingPredicates(
+				log, queue, certificateInformer.Lister(),
+				predicate.ExtractResourceName[*corev1.Secret](predicate.CertificateCSRSecretName),
+			),
+		),
+	); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
 
 	// build a list of InformerSynced functions that will be returned by the Register method.
 	// the controller will only begin processing items once all of these informers have synced.
@@ -160,27 +174,25 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 		return nil
 	}
 
-	// Check for and fetch the 'status.nextPrivateKeySecretName' secret
-	if crt.Status.NextPrivateKeySecretName == nil {
-		log.V(logf.DebugLevel).Info("status.nextPrivateKeySecretName not yet set, waiting for keymanager before processing certificate")
-		return nil
-	}
-	nextPrivateKeySecret, err := c.secretLister.Secrets(crt.Namespace).Get(*crt.Status.NextPrivateKeySecretName)
-	if apierrors.IsNotFound(err) {
-		log.V(logf.DebugLevel).Info("nextPrivateKeySecretName Secret resource does not exist, waiting for keymanager to create it before continuing")
-		return nil
-	}
-	if err != nil {
-		return err
-	}
-	if nextPrivateKeySecret.Data == nil || len(nextPrivateKeySecret.Data[corev1.TLSPrivateKeyKey]) == 0 {
-		log.V(logf.DebugLevel).Info("Next private key secret does not contain any valid data, waiting for keymanager before processing certificate")
-		return nil
-	}
-	pk, err := pki.DecodePrivateKeyBytes(nextPrivateKeySecret.Data[corev1.TLSPrivateKeyKey])
-	if err != nil {
-		log.Error(err, "Failed to decode next private key secret data, waiting for keymanager before processing certificate")
-		return nil
+	// A Certificate either uses a user supplied CSR, or a private key
+	// generated by the keymanager controller which is used to build the CSR.
+	var (
+		csr       *x509.CertificateRequest
+		pk        crypto.Signer
+		publicKey crypto.PublicKey
+	)
+	if crt.Spec.CSR != nil {
+		csr, err = c.userSuppliedCSR(ctx, crt)
+		if err != nil || csr == nil {
+			return err
+		}
+		publicKey = csr.PublicKey
+	} else {
+		pk, err = c.nextPrivateKey(ctx, crt)
+		if err != nil || pk == nil {
+			return err
+		}
+		publicKey = pk.Public()
 	}
 
 	// Discover all 'owned' CertificateRequests
@@ -209,7 +221,7 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 		return err
 	}
 
-	requests, err = c.deleteRequestsNotMatchingSpec(ctx, crt, pk.Public(), requests...)
+	requests, err = c.deleteRequestsNotMatchingSpec(ctx, crt, publicKey, csr, requests...)
 	if err != nil {
 		return err
 	}
@@ -233,7 +245,66 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 		return nil
 	}
 
-	return c.createNewCertificateRequest(ctx, crt, pk, nextRevision, nextPrivateKeySecret.Name)
+	return c.createNewCertificateRequest(ctx, crt, pk, csr, nextRevision)
+}
+
+// nextPrivateKey returns the private key stored in the Certificate's
+// 'status.nextPrivateKeySecretName' Secret. If the key is not yet available,
+// nil is returned and the Certificate will be resynced once the keymanager
+// has stored it.
+func (c *controller) nextPrivateKey(ctx context.Context, crt *cmapi.Certificate) (crypto.Signer, error) {
+	log := logf.FromContext(ctx)
+
+	// Check for and fetch the 'status.nextPrivateKeySecretName' secret
+	if crt.Status.NextPrivateKeySecretName == nil {
+		log.V(logf.DebugLevel).Info("status.nextPrivateKeySecretName not yet set, waiting for keymanager before processing certificate")
+		return nil, nil
+	}
+	nextPrivateKeySecret, err := c.secretLister.Secrets(crt.Namespace).Get(*crt.Status.NextPrivateKeySecretName)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("nextPrivateKeySecretName Secret resource does not exist, waiting for keymanager to create it before continuing")
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if nextPrivateKeySecret.Data == nil || len(nextPrivateKeySecret.Data[corev1.TLSPrivateKeyKey]) == 0 {
+		log.V(logf.DebugLevel).Info("Next private key secret does not contain any valid data, waiting for keymanager before processing certificate")
+		return nil, nil
+	}
+	pk, err := pki.DecodeCertificatePrivateKeyBytes(ctx, types.NamespacedName{Namespace: crt.Namespace, Name: crt.Name}, nextPrivateKeySecret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil && !cmerrors.IsInvalidData(err) {
+		// The private key is held by a KeyProvider which could not be reached.
+		return nil, err
+	}
+	if err != nil {
+		log.Error(err, "Failed to decode next private key secret data, waiting for keymanager before processing certificate")
+		return nil, nil
+	}
+
+	return pk, nil
+}
+
+// userSuppliedCSR returns the CSR set in the Certificate's spec.csr. If the
+// CSR is not available or invalid, nil is returned and the Certificate will
+// be resynced once the referenced Secret is updated.
+func (c *controller) userSuppliedCSR(ctx context.Context, crt *cmapi.Certificate) (*x509.CertificateRequest, error) {
+	log := logf.FromContext(ctx)
+
+	csr, err := internalcertificates.CSRForCertificate(c.secretLister, crt)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("spec.csr.secretRef Secret resource does not exist, waiting for it to be created before continuing")
+		return nil, nil
+	}
+	if cmerrors.IsInvalidData(err) {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonRequestFailed, "Invalid CSR in spec.csr: %s", err.Error())
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return csr, nil
 }
 
 func (c *controller) deleteCurrentFailedRequests(ctx context.Context, crt *cmapi.Certificate, reqs ...*cmapi.CertificateRequest) ([]*cmapi.CertificateRequest, error) {
@@ -323,7 +394,11 @@ func requestsWithRevision(reqs []*cmapi.CertificateRequest, revision int) ([]*cm
 	return remaining, nil
 }
 
-func (c *controller) deleteRequestsNotMatchingSpec(ctx context.Context, crt *cmapi.Certificate, publicKey crypto.PublicKey, reqs ...*cmapi.CertificateRequest) ([]*cmapi.CertificateRequest, error) {
+// deleteRequestsNotMatchingSpec deletes CertificateRequests which do not match
+// the Certificate's spec, or whose CSR does not use the given public key. If
+// the Certificate uses a user supplied CSR, requests which do not contain
+// exactly that CSR are deleted too.
+func (c *controller) deleteRequestsNotMatchingSpec(ctx context.Context, crt *cmapi.Certificate, publicKey crypto.PublicKey, csr *x509.CertificateRequest, reqs ...*cmapi.CertificateRequest) ([]*cmapi.CertificateRequest, error) {
 	log := logf.FromContext(ctx)
 	var remaining []*cmapi.CertificateRequest
 	for _, req := range reqs {
@@ -359,40 +434,60 @@ func (c *controller) deleteRequestsNotMatchingSpec(ctx context.Context, crt *cma
 			}
 			continue
 		}
+		if csr != nil && !bytes.Equal(x509Req.Raw, csr.Raw) {
+			log.V(logf.DebugLevel).Info("CertificateRequest contains a CSR that differs from spec.csr, deleting CertificateRequest")
+			if err := c.client.CertmanagerV1().CertificateRequests(req.Namespace).Delete(ctx, req.Name, metav1.DeleteOptions{}); err != nil {
+				return nil, err
+			}
+			continue
+		}
 		remaining = append(remaining, req)
 	}
 	return remaining, nil
 }
 
-func (c *controller) createNewCertificateRequest(ctx context.Context, crt *cmapi.Certificate, pk crypto.Signer, nextRevision int, nextPrivateKeySecretName string) error {
+// createNewCertificateRequest creates the CertificateRequest for the next
+// revision of the Certificate. If csr is set, it is submitted as is.
+// Otherwise a CSR is generated from the Certificate's spec and signed with pk.
+func (c *controller) createNewCertificateRequest(ctx context.Context, crt *cmapi.Certificate, pk crypto.Signer, csr *x509.CertificateRequest, nextRevision int) error {
 	log := logf.FromContext(ctx)
 
-	x509CSR, err := pki.GenerateCSR(
-		crt,
-		pki.WithUseLiteralSubject(utilfeature.DefaultMutableFeatureGate.Enabled(feature.LiteralCertificateSubject)),
-		pki.WithEncodeBasicConstraintsInRequest(utilfeature.DefaultMutableFeatureGate.Enabled(feature.UseCertificateRequestBasicConstraints)),
-		pki.WithNameConstraints(utilfeature.DefaultMutableFeatureGate.Enabled(feature.NameConstraints)),
-		pki.WithOtherNames(utilfeature.DefaultMutableFeatureGate.Enabled(feature.OtherNames)),
-	)
-	if err != nil {
-		log.Error(err, "Failed to generate CSR - will not retry")
-		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonRequestFailed, "Failed to generate CSR: %s - will not retry", err.Error())
-		return nil
-	}
-	csrDER, err := pki.EncodeCSR(x509CSR, pk)
-	if err != nil {
-		return err
+	var csrDER []byte
+	if csr != nil {
+		csrDER = csr.Raw
+	} else {
+		x509CSR, err := pki.GenerateCSR(
+			crt,
+			pki.WithUseLiteralSubject(utilfeature.DefaultMutableFeatureGate.Enabled(feature.LiteralCertificateSubject)),
+			pki.WithEncodeBasicConstraintsInRequest(utilfeature.DefaultMutableFeatureGate.Enabled(feature.UseCertificateRequestBasicConstraints)),
+			pki.WithNameConstraints(utilfeature.DefaultMutableFeatureGate.Enabled(feature.NameConstraints)),
+			pki.WithOtherNames(utilfeature.DefaultMutableFeatureGate.Enabled(feature.OtherNames)),
+		)
+		if err != nil {
+			log.Error(err, "Failed to generate CSR - will not retry")
+			c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonRequestFailed, "Failed to generate CSR: %s - will not retry", err.Error())
+			return nil
+		}
+		csrDER, err = pki.EncodeCSR(x509CSR, pk)
+		if err != nil {
+			return err
+		}
 	}
 
 	csrPEM := bytes.NewBuffer([]byte{})
-	err = pem.Encode(csrPEM, &pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+	err := pem.Encode(csrPEM, &pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
 	if err != nil {
 		return err
 	}
 
 	annotations := controllerpkg.BuildAnnotationsToCopy(crt.Annotations, c.copiedAnnotationPrefixes)
+	// Revocation of a Certificate applies to its current certificate only,
+	// and must never be inherited by the request for the next one.
+	delete(annotations, cmapi.CertificateRequestRevocationReasonAnnotationKey)
 	annotations[cmapi.CertificateRequestRevisionAnnotationKey] = strconv.Itoa(nextRevision)
-	annotations[cmapi.CertificateRequestPrivateKeyAnnotationKey] = nextPrivateKeySecretName
+	if csr == nil {
+		annotations[cmapi.CertificateRequestPrivateKeyAnnotationKey] = *crt.Status.NextPrivateKeySecretName
+	}
 	annotations[cmapi.CertificateNameKey] = crt.Name
 
 	cr := &cmapi.CertificateRequest{
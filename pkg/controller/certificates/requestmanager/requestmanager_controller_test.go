@@ -65,6 +65,17 @@ func relaxedCertificateRequestMatcher(l coretesting.Action, r coretesting.Action
 	return nil
 }
 
+// certificateRequestMatcher compares the created CertificateRequest objects,
+// including the encoded request, but ignores the create options.
+func certificateRequestMatcher(l coretesting.Action, r coretesting.Action) error {
+	objL := l.(coretesting.CreateAction).GetObject().(*cmapi.CertificateRequest)
+	objR := r.(coretesting.CreateAction).GetObject().(*cmapi.CertificateRequest)
+	if !reflect.DeepEqual(objL, objR) {
+		return fmt.Errorf("unexpected difference between actions (-want +got):\n%s", cmp.Diff(objL, objR))
+	}
+	return nil
+}
+
 func TestProcessItem(t *testing.T) {
 	bundle1 := mustCreateCryptoBundle(t, &cmapi.Certificate{
 		ObjectMeta: metav1.ObjectMeta{
@@ -242,6 +253,113 @@ func TestProcessItem(t *testing.T) {
 					)), relaxedCertificateRequestMatcher),
 			},
 		},
+		"create a CertificateRequest containing spec.csr if none exists": {
+			certificate: gen.CertificateFrom(bundle1.certificate,
+				gen.SetCertificateCSR(cmapi.CertificateCSRSource{Request: bundle1.csrBytes}),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+			),
+			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-1"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
+					gen.CertificateRequestFrom(bundle1.certificateRequest,
+						gen.SetCertificateRequestName("test-1"),
+						gen.DeleteCertificateRequestAnnotation(cmapi.CertificateRequestPrivateKeyAnnotationKey),
+						gen.SetCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "1",
+						}),
+					)), certificateRequestMatcher),
+			},
+		},
+		"create a CertificateRequest containing the CSR from the spec.csr Secret": {
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "csr"},
+					Data:       map[string][]byte{cmapi.CertificateCSRDefaultSecretKey: bundle1.csrBytes},
+				},
+			},
+			certificate: gen.CertificateFrom(bundle1.certificate,
+				gen.SetCertificateCSR(cmapi.CertificateCSRSource{SecretRef: &cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "csr"},
+				}}),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+			),
+			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-1"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
+					gen.CertificateRequestFrom(bundle1.certificateRequest,
+						gen.SetCertificateRequestName("test-1"),
+						gen.DeleteCertificateRequestAnnotation(cmapi.CertificateRequestPrivateKeyAnnotationKey),
+						gen.SetCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "1",
+						}),
+					)), certificateRequestMatcher),
+			},
+		},
+		"do nothing if the spec.csr Secret does not exist": {
+			certificate: gen.CertificateFrom(bundle1.certificate,
+				gen.SetCertificateCSR(cmapi.CertificateCSRSource{SecretRef: &cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "csr"},
+				}}),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+			),
+		},
+		"fire an event and do nothing if the spec.csr Secret does not contain a CSR": {
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "csr"},
+					Data:       map[string][]byte{"other": bundle1.csrBytes},
+				},
+			},
+			certificate: gen.CertificateFrom(bundle1.certificate,
+				gen.SetCertificateCSR(cmapi.CertificateCSRSource{SecretRef: &cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "csr"},
+				}}),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+			),
+			expectedEvents: []string{`Warning RequestFailed Invalid CSR in spec.csr: secret "csr" does not contain key "tls.csr"`},
+		},
+		"delete the owned CertificateRequest and create a new one if it does not contain spec.csr": {
+			certificate: gen.CertificateFrom(bundle1.certificate,
+				gen.SetCertificateCSR(cmapi.CertificateCSRSource{Request: bundle1.csrBytes}),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+			),
+			requests: []runtime.Object{
+				gen.CertificateRequestFrom(bundle2.certificateRequest,
+					gen.SetCertificateRequestName("test-1"),
+					gen.DeleteCertificateRequestAnnotation(cmapi.CertificateRequestPrivateKeyAnnotationKey),
+					gen.SetCertificateRequestAnnotations(map[string]string{
+						cmapi.CertificateRequestRevisionAnnotationKey: "1",
+					}),
+				),
+			},
+			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-1"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewDeleteAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns", "test-1")),
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
+					gen.CertificateRequestFrom(bundle1.certificateRequest,
+						gen.SetCertificateRequestName("test-1"),
+						gen.DeleteCertificateRequestAnnotation(cmapi.CertificateRequestPrivateKeyAnnotationKey),
+						gen.SetCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "1",
+						}),
+					)), certificateRequestMatcher),
+			},
+		},
+		"do nothing if an owned CertificateRequest already contains spec.csr": {
+			certificate: gen.CertificateFrom(bundle1.certificate,
+				gen.SetCertificateCSR(cmapi.CertificateCSRSource{Request: bundle1.csrBytes}),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+			),
+			requests: []runtime.Object{
+				gen.CertificateRequestFrom(bundle1.certificateRequest,
+					gen.SetCertificateRequestName("test-1"),
+					gen.DeleteCertificateRequestAnnotation(cmapi.CertificateRequestPrivateKeyAnnotationKey),
+					gen.SetCertificateRequestAnnotations(map[string]string{
+						cmapi.CertificateRequestRevisionAnnotationKey: "1",
+					}),
+				),
+			},
+		},
 		"create a CertificateRequest if none exists (with long name)": {
 			secrets: []runtime.Object{
 				&corev1.Secret{
@@ -0,0 +1,332 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revocation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/pkg/util/predicate"
+)
+
+const (
+	ControllerName = "certificates-revocation"
+
+	reasonRevocationRequested = "RevocationRequested"
+	reasonNothingToRevoke     = "NothingToRevoke"
+	reasonInvalidRevocation   = "InvalidRevocationReason"
+)
+
+// controller forwards revocation requests made on Certificate resources to
+// the CertificateRequest of the current certificate revision, and records the
+// outcome of revoking certificates in the Certificate's status.
+type controller struct {
+	certificateLister        cmlisters.CertificateLister
+	certificateRequestLister cmlisters.CertificateRequestLister
+	client                   cmclient.Interface
+	recorder                 record.EventRecorder
+
+	// fieldManager is the string which will be used as the Field Manager on
+	// fields created or edited by the cert-manager Kubernetes client during
+	// Apply API calls.
+	fieldManager string
+}
+
+func NewController(log logr.Logger, ctx *controllerpkg.Context) (*controller, workqueue.TypedRateLimitingInterface[types.NamespacedName], []cache.InformerSynced, error) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewTypedRateLimitingQueueWithConfig(
+		controllerpkg.DefaultCertificateRateLimiter(),
+		workqueue.TypedRateLimitingQueueConfig[types.NamespacedName]{
+			Name: ControllerName,
+		},
+	)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
+	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
+
+	if _, err := certificateInformer.Informer().AddEventHandler(controllerpkg.QueuingEventHandler(queue)); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+	if _, err := certificateRequestInformer.Informer().AddEventHandler(
+		controllerpkg.BlockingEventHandler(
+			// Trigger reconciles on changes to any 'owned' CertificateRequest resources
+			certificates.EnqueueCertificatesForResourceUsingPredicates[*cmapi.CertificateRequest](
+				log, queue, certificateInformer.Lister(),
+				predicate.ResourceOwnerOf,
+			),
+		),
+	); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		certificateRequestInformer.Informer().HasSynced,
+		certificateInformer.Informer().HasSynced,
+	}
+
+	return &controller{
+		certificateLister:        certificateInformer.Lister(),
+		certificateRequestLister: certificateRequestInformer.Lister(),
+		client:                   ctx.CMClient,
+		recorder:                 ctx.Recorder,
+		fieldManager:             ctx.FieldManager,
+	}, queue, mustSync, nil
+}
+
+// ProcessItem forwards the revocation reason annotation of a Certificate to
+// the CertificateRequest of its current revision, and updates the
+// Certificate's status.revocations from the CertificateRequests it owns which
+// have been marked for revocation.
+func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	namespace, name := key.Namespace, key.Name
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if crt == nil || crt.DeletionTimestamp != nil {
+		return nil
+	}
+
+	log = logf.WithResource(log, crt)
+	ctx = logf.NewContext(ctx, log)
+
+	// Get all CertificateRequests that are owned by this Certificate
+	requests, err := certificates.ListCertificateRequestsMatchingPredicates(
+		c.certificateRequestLister.CertificateRequests(crt.Namespace),
+		predicate.ResourceOwnedBy[*cmapi.CertificateRequest](crt))
+	if err != nil {
+		return err
+	}
+
+	if reason, ok := crt.Annotations[cmapi.CertificateRequestRevocationReasonAnnotationKey]; ok {
+		return c.requestRevocation(ctx, crt, requests, reason)
+	}
+
+	revocations := buildRevocations(log, crt.Status.Revocations, requests)
+	if apiequality.Semantic.DeepEqual(crt.Status.Revocations, revocations) {
+		return nil
+	}
+
+	crt = crt.DeepCopy()
+	crt.Status.Revocations = revocations
+	return c.updateOrApplyStatus(ctx, crt)
+}
+
+// requestRevocation marks the CertificateRequest of the current revision of
+// the Certificate for revocation, and removes the revocation reason
+// annotation from the Certificate so that it is only acted upon once.
+func (c *controller) requestRevocation(ctx context.Context, crt *cmapi.Certificate, requests []*cmapi.CertificateRequest, reason string) error {
+	log := logf.FromContext(ctx)
+
+	if _, err := pki.ParseRevocationReason(reason); err != nil {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonInvalidRevocation, "Not revoking certificate: %v", err)
+		return c.removeRevocationAnnotation(ctx, crt)
+	}
+
+	req := currentRevisionRequest(crt, requests)
+	switch {
+	case req == nil:
+		c.recorder.Event(crt, corev1.EventTypeWarning, reasonNothingToRevoke, "Not revoking certificate: no CertificateRequest found for the current revision")
+
+	case len(req.Status.Certificate) == 0:
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonNothingToRevoke, "Not revoking certificate: CertificateRequest %q has not been issued", req.Name)
+
+	default:
+		if _, revoked := req.Annotations[cmapi.CertificateRequestRevocationReasonAnnotationKey]; !revoked {
+			logf.WithRelatedResource(log, req).Info("requesting revocation of certificate", "reason", reason)
+			patch, err := revocationAnnotationPatch(&reason)
+			if err != nil {
+				return err
+			}
+			if _, err := c.client.CertmanagerV1().CertificateRequests(req.Namespace).Patch(ctx, req.Name, types.MergePatchType, patch, metav1.PatchOptions{FieldManager: c.fieldManager}); err != nil {
+				return err
+			}
+		}
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonRevocationRequested, "Requested revocation of the certificate issued for CertificateRequest %q", req.Name)
+	}
+
+	return c.removeRevocationAnnotation(ctx, crt)
+}
+
+func (c *controller) removeRevocationAnnotation(ctx context.Context, crt *cmapi.Certificate) error {
+	patch, err := revocationAnnotationPatch(nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.client.CertmanagerV1().Certificates(crt.Namespace).Patch(ctx, crt.Name, types.MergePatchType, patch, metav1.PatchOptions{FieldManager: c.fieldManager})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// revocationAnnotationPatch returns a JSON merge patch which sets the
+// revocation reason annotation to the given value, or removes it if the value
+// is nil.
+func revocationAnnotationPatch(value *string) ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]*string{
+				cmapi.CertificateRequestRevocationReasonAnnotationKey: value,
+			},
+		},
+	})
+}
+
+// currentRevisionRequest returns the CertificateRequest for the current
+// revision of the Certificate, or nil if there is none.
+func currentRevisionRequest(crt *cmapi.Certificate, requests []*cmapi.CertificateRequest) *cmapi.CertificateRequest {
+	if crt.Status.Revision == nil {
+		return nil
+	}
+	revision := strconv.Itoa(*crt.Status.Revision)
+	for _, req := range requests {
+		if req.Annotations[cmapi.CertificateRequestRevisionAnnotationKey] == revision {
+			return req
+		}
+	}
+	return nil
+}
+
+// buildRevocations returns the revocations of the given Certificate status
+// updated with the revocation state of the given CertificateRequests.
+// Revocations of CertificateRequests which no longer exist are kept so that
+// the history survives garbage collection of old revisions.
+func buildRevocations(log logr.Logger, existing []cmapi.CertificateRevocation, requests []*cmapi.CertificateRequest) []cmapi.CertificateRevocation {
+	bySerial := make(map[string]cmapi.CertificateRevocation, len(existing))
+	for _, rev := range existing {
+		bySerial[rev.SerialNumber] = rev
+	}
+
+	for _, req := range requests {
+		reason, ok := req.Annotations[cmapi.CertificateRequestRevocationReasonAnnotationKey]
+		if !ok || len(req.Status.Certificate) == 0 {
+			continue
+		}
+
+		cert, err := pki.DecodeX509CertificateBytes(req.Status.Certificate)
+		if err != nil {
+			logf.WithRelatedResource(log, req).Error(err, "failed to decode certificate of revoked request")
+			continue
+		}
+
+		rev := cmapi.CertificateRevocation{
+			SerialNumber:           cert.SerialNumber.Text(16),
+			CertificateRequestName: req.Name,
+			Reason:                 reason,
+			Status:                 cmmeta.ConditionUnknown,
+		}
+		if code, err := pki.ParseRevocationReason(reason); err == nil {
+			rev.Reason = pki.RevocationReasonName(code)
+		}
+
+		if cond := apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionRevoked); cond != nil {
+			rev.Status = cond.Status
+			rev.Message = cond.Message
+			if cond.Status == cmmeta.ConditionTrue {
+				rev.RevocationTime = cond.LastTransitionTime
+			}
+		}
+
+		bySerial[rev.SerialNumber] = rev
+	}
+
+	if len(bySerial) == 0 {
+		return nil
+	}
+
+	revocations := make([]cmapi.CertificateRevocation, 0, len(bySerial))
+	for _, rev := range bySerial {
+		revocations = append(revocations, rev)
+	}
+	sort.Slice(revocations, func(i, j int) bool {
+		return revocations[i].SerialNumber < revocations[j].SerialNumber
+	})
+
+	return revocations
+}
+
+// updateOrApplyStatus will update the controller status. If the
+// ServerSideApply feature is enabled, the managed fields will instead get
+// applied using the relevant Patch API call.
+func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certificate) error {
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
+		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
+			Status:     cmapi.CertificateStatus{Revocations: crt.Status.Revocations},
+		})
+	} else {
+		_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
+		return err
+	}
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLimitingInterface[types.NamespacedName], []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync, err := NewController(log, ctx)
+	c.controller = ctrl
+
+	return queue, mustSync, err
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revocation
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	coretesting "k8s.io/client-go/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	revokedAt := metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+
+	baseCrt := gen.Certificate("test-cert",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateUID("uid-1"),
+		gen.SetCertificateCommonName("example.com"),
+	)
+
+	certPEM := testcrypto.MustCreateCert(t, testcrypto.MustCreatePEMPrivateKey(t), baseCrt)
+	cert, err := pki.DecodeX509CertificateBytes(certPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serialNumber := cert.SerialNumber.Text(16)
+
+	baseCR := gen.CertificateRequest("test-cr-1",
+		gen.SetCertificateRequestNamespace("testns"),
+		gen.SetCertificateRequestRevision("1"),
+		gen.SetCertificateRequestCertificate(certPEM),
+		gen.AddCertificateRequestOwnerReferences(*metav1.NewControllerRef(
+			baseCrt, cmapi.SchemeGroupVersion.WithKind("Certificate")),
+		),
+	)
+	revokedCR := gen.CertificateRequestFrom(baseCR,
+		gen.AddCertificateRequestAnnotations(map[string]string{
+			cmapi.CertificateRequestRevocationReasonAnnotationKey: "keyCompromise",
+		}),
+	)
+
+	removeAnnotationPatch := []byte(`{"metadata":{"annotations":{"cert-manager.io/revocation-reason":null}}}`)
+
+	tests := map[string]struct {
+		// Certificate to be synced for the test.
+		certificate *cmapi.Certificate
+
+		// Requests, if set, will exist in the apiserver before the test is run.
+		requests []runtime.Object
+
+		expectedEvents  []string
+		expectedActions []testpkg.Action
+	}{
+		"do nothing if the Certificate has not been marked for revocation": {
+			certificate: gen.CertificateFrom(baseCrt, gen.SetCertificateRevision(1)),
+			requests:    []runtime.Object{baseCR},
+		},
+		"mark the CertificateRequest of the current revision for revocation": {
+			certificate: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateRevision(1),
+				gen.AddCertificateAnnotations(map[string]string{
+					cmapi.CertificateRequestRevocationReasonAnnotationKey: "keyCompromise",
+				}),
+			),
+			requests: []runtime.Object{
+				baseCR,
+				gen.CertificateRequestFrom(baseCR,
+					gen.SetCertificateRequestName("test-cr-2"),
+					gen.SetCertificateRequestRevision("2"),
+				),
+			},
+			expectedEvents: []string{
+				`Normal RevocationRequested Requested revocation of the certificate issued for CertificateRequest "test-cr-1"`,
+			},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewPatchActionWithOptions(
+					cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns", "test-cr-1", types.MergePatchType,
+					[]byte(`{"metadata":{"annotations":{"cert-manager.io/revocation-reason":"keyCompromise"}}}`),
+					metav1.PatchOptions{FieldManager: testpkg.FieldManager},
+				)),
+				testpkg.NewAction(coretesting.NewPatchActionWithOptions(
+					cmapi.SchemeGroupVersion.WithResource("certificates"), "testns", "test-cert", types.MergePatchType,
+					removeAnnotationPatch, metav1.PatchOptions{FieldManager: testpkg.FieldManager},
+				)),
+			},
+		},
+		"remove the annotation if there is no issued certificate to revoke": {
+			certificate: gen.CertificateFrom(baseCrt,
+				gen.AddCertificateAnnotations(map[string]string{
+					cmapi.CertificateRequestRevocationReasonAnnotationKey: "",
+				}),
+			),
+			requests: []runtime.Object{baseCR},
+			expectedEvents: []string{
+				"Warning NothingToRevoke Not revoking certificate: no CertificateRequest found for the current revision",
+			},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewPatchActionWithOptions(
+					cmapi.SchemeGroupVersion.WithResource("certificates"), "testns", "test-cert", types.MergePatchType,
+					removeAnnotationPatch, metav1.PatchOptions{FieldManager: testpkg.FieldManager},
+				)),
+			},
+		},
+		"record a pending revocation in the Certificate status": {
+			certificate: gen.CertificateFrom(baseCrt, gen.SetCertificateRevision(1)),
+			requests:    []runtime.Object{revokedCR},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"), "status", "testns",
+					gen.CertificateFrom(baseCrt,
+						gen.SetCertificateRevision(1),
+						func(crt *cmapi.Certificate) {
+							crt.Status.Revocations = []cmapi.CertificateRevocation{{
+								SerialNumber:           serialNumber,
+								CertificateRequestName: "test-cr-1",
+								Reason:                 "keyCompromise",
+								Status:                 cmmeta.ConditionUnknown,
+							}}
+						},
+					),
+				)),
+			},
+		},
+		"record a completed revocation in the Certificate status": {
+			certificate: gen.CertificateFrom(baseCrt, gen.SetCertificateRevision(1)),
+			requests: []runtime.Object{
+				gen.CertificateRequestFrom(revokedCR,
+					gen.AddCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+						Type:               cmapi.CertificateRequestConditionRevoked,
+						Status:             cmmeta.ConditionTrue,
+						Reason:             cmapi.CertificateRequestReasonRevoked,
+						Message:            "Certificate has been revoked",
+						LastTransitionTime: &revokedAt,
+					}),
+				),
+			},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"), "status", "testns",
+					gen.CertificateFrom(baseCrt,
+						gen.SetCertificateRevision(1),
+						func(crt *cmapi.Certificate) {
+							crt.Status.Revocations = []cmapi.CertificateRevocation{{
+								SerialNumber:           serialNumber,
+								CertificateRequestName: "test-cr-1",
+								Reason:                 "keyCompromise",
+								Status:                 cmmeta.ConditionTrue,
+								Message:                "Certificate has been revoked",
+								RevocationTime:         &revokedAt,
+							}}
+						},
+					),
+				)),
+			},
+		},
+		"keep revocations of CertificateRequests which have been garbage collected": {
+			certificate: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateRevision(2),
+				func(crt *cmapi.Certificate) {
+					crt.Status.Revocations = []cmapi.CertificateRevocation{{
+						SerialNumber:           "abc",
+						CertificateRequestName: "test-cr-0",
+						Reason:                 "superseded",
+						Status:                 cmmeta.ConditionTrue,
+					}}
+				},
+			),
+			requests: []runtime.Object{baseCR},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Create and initialise a new unit test builder
+			builder := &testpkg.Builder{
+				T:                  t,
+				ExpectedEvents:     test.expectedEvents,
+				ExpectedActions:    test.expectedActions,
+				StringGenerator:    func(i int) string { return "notrandom" },
+				CertManagerObjects: append([]runtime.Object{test.certificate}, test.requests...),
+			}
+			builder.Init()
+
+			// Register informers used by the controller using the registration wrapper
+			w := &controllerWrapper{}
+			_, _, err := w.Register(builder.Context)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Start the informers and begin processing updates
+			builder.Start()
+			defer builder.Stop()
+
+			key := types.NamespacedName{
+				Name:      test.certificate.Name,
+				Namespace: test.certificate.Namespace,
+			}
+
+			if err := w.controller.ProcessItem(t.Context(), key); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if err := builder.AllEventsCalled(); err != nil {
+				builder.T.Error(err)
+			}
+			if err := builder.AllActionsExecuted(); err != nil {
+				builder.T.Error(err)
+			}
+		})
+	}
+}
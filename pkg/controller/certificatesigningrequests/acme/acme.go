@@ -19,9 +19,11 @@ package acme
 import (
 	"context"
 	"crypto/x509"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
 
 	"github.com/go-logr/logr"
 	certificatesv1 "k8s.io/api/certificates/v1"
@@ -39,6 +41,7 @@ import (
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	experimentalapi "github.com/cert-manager/cert-manager/pkg/apis/experimental/v1alpha1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	cmacmeclientset "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/typed/acme/v1"
 	cmacmelisters "github.com/cert-manager/cert-manager/pkg/client/listers/acme/v1"
@@ -276,6 +279,26 @@ func (a *ACME) buildOrder(csr *certificatesv1.CertificateSigningRequest, req *x5
 		return nil, errors.New("failed to construct issuer kind from signer name")
 	}
 
+	// The Issuer's profile may be overridden per request, in the same way as
+	// the duration.
+	profile := iss.GetSpec().ACME.Profile
+	if requestedProfile, ok := csr.Annotations[experimentalapi.CertificateSigningRequestACMEProfileAnnotationKey]; ok {
+		profile = requestedProfile
+	}
+
+	// CertificateSigningRequests have no parent Certificate to derive the ARI
+	// CertID from, so the requester must tell us which certificate is being
+	// replaced.
+	var replaces string
+	if acme.ARIEnabledForIssuer(iss) {
+		if certID, ok := csr.Annotations[experimentalapi.CertificateSigningRequestACMEReplacesAnnotationKey]; ok {
+			if !isARICertID(certID) {
+				return nil, fmt.Errorf("invalid ARI CertID in %q annotation: %q", experimentalapi.CertificateSigningRequestACMEReplacesAnnotationKey, certID)
+			}
+			replaces = certID
+		}
+	}
+
 	spec := cmacme.OrderSpec{
 		Request: csr.Spec.Request,
 		IssuerRef: cmmeta.IssuerReference{
@@ -286,6 +309,8 @@ func (a *ACME) buildOrder(csr *certificatesv1.CertificateSigningRequest, req *x5
 		CommonName:  req.Subject.CommonName,
 		DNSNames:    dnsNames,
 		IPAddresses: ipAddresses,
+		Profile:     profile,
+		Replaces:    replaces,
 	}
 
 	if iss.GetSpec().ACME.EnableDurationFeature {
@@ -341,3 +366,18 @@ func (a *ACME) buildOrder(csr *certificatesv1.CertificateSigningRequest, req *x5
 		Spec: spec,
 	}, nil
 }
+
+// isARICertID returns true if the given value has the form of an ARI CertID,
+// "base64url(AKI).base64url(serial)", as defined in RFC 9773 §4.1.
+func isARICertID(certID string) bool {
+	aki, serial, ok := strings.Cut(certID, ".")
+	if !ok || aki == "" || serial == "" {
+		return false
+	}
+	for _, part := range []string{aki, serial} {
+		if _, err := base64.RawURLEncoding.DecodeString(part); err != nil {
+			return false
+		}
+	}
+	return true
+}
@@ -33,15 +33,19 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	coretesting "k8s.io/client-go/testing"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
 	fakeclock "k8s.io/utils/clock/testing"
 
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	experimentalapi "github.com/cert-manager/cert-manager/pkg/apis/experimental/v1alpha1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/util"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
 )
@@ -961,6 +965,9 @@ func Test_buildOrder(t *testing.T) {
 
 	tests := map[string]struct {
 		enableDurationFeature bool
+		enableARI             bool
+		profile               string
+		annotations           map[string]string
 
 		want    *cmacme.Order
 		wantErr bool
@@ -998,15 +1005,103 @@ func Test_buildOrder(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		"Building with the Issuer's profile": {
+			profile: "tlsserver",
+			want: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					Request:    csrPEM,
+					CommonName: "example.com",
+					DNSNames:   []string{"example.com"},
+					Profile:    "tlsserver",
+					IssuerRef: cmmeta.IssuerReference{
+						Name:  "test-name",
+						Kind:  "Issuer",
+						Group: "cert-manager.io",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"Building with a profile annotation should override the Issuer's profile": {
+			profile: "tlsserver",
+			annotations: map[string]string{
+				experimentalapi.CertificateSigningRequestACMEProfileAnnotationKey: "shortlived",
+			},
+			want: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					Request:    csrPEM,
+					CommonName: "example.com",
+					DNSNames:   []string{"example.com"},
+					Profile:    "shortlived",
+					IssuerRef: cmmeta.IssuerReference{
+						Name:  "test-name",
+						Kind:  "Issuer",
+						Group: "cert-manager.io",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"Building with a replaces annotation and ARI enabled should set replaces": {
+			enableARI: true,
+			annotations: map[string]string{
+				experimentalapi.CertificateSigningRequestACMEReplacesAnnotationKey: "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE",
+			},
+			want: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					Request:    csrPEM,
+					CommonName: "example.com",
+					DNSNames:   []string{"example.com"},
+					Replaces:   "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE",
+					IssuerRef: cmmeta.IssuerReference{
+						Name:  "test-name",
+						Kind:  "Issuer",
+						Group: "cert-manager.io",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"Building with a replaces annotation and ARI disabled should not set replaces": {
+			enableARI: false,
+			annotations: map[string]string{
+				experimentalapi.CertificateSigningRequestACMEReplacesAnnotationKey: "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE",
+			},
+			want: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					Request:    csrPEM,
+					CommonName: "example.com",
+					DNSNames:   []string{"example.com"},
+					IssuerRef: cmmeta.IssuerReference{
+						Name:  "test-name",
+						Kind:  "Issuer",
+						Group: "cert-manager.io",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"Building with an invalid replaces annotation and ARI enabled should error": {
+			enableARI: true,
+			annotations: map[string]string{
+				experimentalapi.CertificateSigningRequestACMEReplacesAnnotationKey: "not-a-cert-id",
+			},
+			wantErr: true,
+		},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			got, err := new(ACME).buildOrder(csr, req, &cmapi.Issuer{
+			featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultMutableFeatureGate, feature.ACMEUseARI, test.enableARI)
+
+			got, err := new(ACME).buildOrder(gen.CertificateSigningRequestFrom(csr,
+				gen.AddCertificateSigningRequestAnnotations(test.annotations),
+			), req, &cmapi.Issuer{
 				Spec: cmapi.IssuerSpec{
 					IssuerConfig: cmapi.IssuerConfig{
 						ACME: &cmacme.ACMEIssuer{
 							EnableDurationFeature: test.enableDurationFeature,
+							Profile:               test.profile,
 						},
 					},
 				},
@@ -1015,6 +1110,9 @@ func Test_buildOrder(t *testing.T) {
 				t.Errorf("buildOrder() error = %v, wantErr %v", err, test.wantErr)
 				return
 			}
+			if test.wantErr {
+				return
+			}
 
 			// for the current purpose we only test the spec
 			if !reflect.DeepEqual(got.Spec, test.want.Spec) {
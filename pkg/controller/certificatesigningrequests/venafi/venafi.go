@@ -20,6 +20,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
 	"github.com/Venafi/vcert/v5/pkg/endpoint"
 	certificatesv1 "k8s.io/api/certificates/v1"
@@ -117,9 +120,22 @@ func (v *Venafi) Sign(ctx context.Context, csr *certificatesv1.CertificateSignin
 		return err
 	}
 
-	var customFields []venafiapi.CustomField
+	// Custom fields set on the Issuer apply to every request, and are
+	// overridden by the fields of the same name set on the request.
+	var issuerCustomFields []venafiapi.CustomField
+	if annotation, exists := issuerObj.GetAnnotations()[cmapi.VenafiCustomFieldsAnnotationKey]; exists && annotation != "" {
+		if err := json.Unmarshal([]byte(annotation), &issuerCustomFields); err != nil {
+			message := fmt.Sprintf("Failed to parse %q annotation of issuer %s: %s", cmapi.VenafiCustomFieldsAnnotationKey, issuerObj.GetName(), err)
+			v.recorder.Event(csr, corev1.EventTypeWarning, "ErrorCustomFields", message)
+			util.CertificateSigningRequestSetFailed(csr, "ErrorCustomFields", message)
+			_, userr := util.UpdateOrApplyStatus(ctx, v.certClient, csr, certificatesv1.CertificateFailed, v.fieldManager)
+			return userr
+		}
+	}
+
+	var requestCustomFields []venafiapi.CustomField
 	if annotation, exists := csr.GetAnnotations()[experimentalapi.CertificateSigningRequestVenafiCustomFieldsAnnotationKey]; exists && annotation != "" {
-		err := json.Unmarshal([]byte(annotation), &customFields)
+		err := json.Unmarshal([]byte(annotation), &requestCustomFields)
 		if err != nil {
 			message := fmt.Sprintf("Failed to parse %q annotation: %s", experimentalapi.CertificateSigningRequestVenafiCustomFieldsAnnotationKey, err)
 			v.recorder.Event(csr, corev1.EventTypeWarning, "ErrorCustomFields", message)
@@ -128,6 +144,7 @@ func (v *Venafi) Sign(ctx context.Context, csr *certificatesv1.CertificateSignin
 			return userr
 		}
 	}
+	customFields := mergeCustomFields(issuerCustomFields, requestCustomFields)
 
 	duration, err := utilpki.DurationFromCertificateSigningRequest(csr)
 	if err != nil {
@@ -222,3 +239,22 @@ func (v *Venafi) Sign(ctx context.Context, csr *certificatesv1.CertificateSignin
 
 	return nil
 }
+
+// mergeCustomFields returns the given custom fields sorted by name, with the
+// fields of override replacing those of global which have the same name.
+func mergeCustomFields(global, override []venafiapi.CustomField) []venafiapi.CustomField {
+	merged := make(map[string]venafiapi.CustomField, len(global)+len(override))
+	for _, field := range global {
+		merged[field.Name] = field
+	}
+	for _, field := range override {
+		merged[field.Name] = field
+	}
+	if len(merged) == 0 {
+		return nil
+	}
+
+	return slices.SortedFunc(maps.Values(merged), func(a, b venafiapi.CustomField) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
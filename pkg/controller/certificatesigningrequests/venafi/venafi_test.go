@@ -20,6 +20,7 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
@@ -926,3 +927,35 @@ func TestProcessItem(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeCustomFields(t *testing.T) {
+	tests := map[string]struct {
+		global, override []venafiapi.CustomField
+		exp              []venafiapi.CustomField
+	}{
+		"no custom fields": {
+			exp: nil,
+		},
+		"only issuer custom fields": {
+			global: []venafiapi.CustomField{{Name: "b", Value: "issuer"}, {Name: "a", Value: "issuer"}},
+			exp:    []venafiapi.CustomField{{Name: "a", Value: "issuer"}, {Name: "b", Value: "issuer"}},
+		},
+		"request custom fields override issuer custom fields of the same name": {
+			global:   []venafiapi.CustomField{{Name: "a", Value: "issuer"}, {Name: "b", Value: "issuer"}},
+			override: []venafiapi.CustomField{{Name: "b", Value: "request", Type: venafiapi.CustomFieldTypePlain}, {Name: "c", Value: "request"}},
+			exp: []venafiapi.CustomField{
+				{Name: "a", Value: "issuer"},
+				{Name: "b", Value: "request", Type: venafiapi.CustomFieldTypePlain},
+				{Name: "c", Value: "request"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := mergeCustomFields(test.global, test.override); !reflect.DeepEqual(got, test.exp) {
+				t.Errorf("unexpected custom fields, exp=%v got=%v", test.exp, got)
+			}
+		})
+	}
+}
@@ -55,6 +55,9 @@ func TestIssuersForSecret_ACMEDNS01Solver(t *testing.T) {
 	eabIssuer := gen.ClusterIssuer("eab-issuer",
 		gen.SetIssuerACMEEAB("kid", "eab-creds"),
 	)
+	eabProvisionerIssuer := gen.ClusterIssuer("eab-provisioner-issuer",
+		gen.SetIssuerACMEEABProvisioner("https://eab", "https://token", "eab-provisioner-creds"),
+	)
 	privateKeyIssuer := gen.ClusterIssuer("privatekey-issuer",
 		gen.SetIssuerACMEPrivKeyRef("privatekey-creds"),
 	)
@@ -63,7 +66,7 @@ func TestIssuersForSecret_ACMEDNS01Solver(t *testing.T) {
 	)
 
 	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
-	for _, iss := range []*v1.ClusterIssuer{route53Issuer, eabIssuer, privateKeyIssuer, caIssuer} {
+	for _, iss := range []*v1.ClusterIssuer{route53Issuer, eabIssuer, eabProvisionerIssuer, privateKeyIssuer, caIssuer} {
 		require.NoError(t, indexer.Add(iss))
 	}
 
@@ -84,6 +87,10 @@ func TestIssuersForSecret_ACMEDNS01Solver(t *testing.T) {
 			secret:        secretNamed(clusterResourceNamespace, "eab-creds"),
 			expectIssuers: []string{"eab-issuer"},
 		},
+		"a Secret referenced by an ExternalAccountBindingProvisioner requeues its ClusterIssuer": {
+			secret:        secretNamed(clusterResourceNamespace, "eab-provisioner-creds"),
+			expectIssuers: []string{"eab-provisioner-issuer"},
+		},
 		"a Secret referenced by the ACME PrivateKey still requeues its ClusterIssuer": {
 			secret:        secretNamed(clusterResourceNamespace, "privatekey-creds"),
 			expectIssuers: []string{"privatekey-issuer"},
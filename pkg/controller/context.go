@@ -23,6 +23,7 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"slices"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -131,6 +132,12 @@ type Context struct {
 	GWShared             gwinformers.SharedInformerFactory
 	GatewaySolverEnabled bool
 
+	// GatewayTLSRouteSolverEnabled is true if the Gateway API TLSRoute CRD
+	// is installed, so that tls-alpn-01 challenges can be solved using
+	// TLSRoutes. Gateway API support may be enabled without it, as the
+	// TLSRoute CRD is not part of the standard channel of every release.
+	GatewayTLSRouteSolverEnabled bool
+
 	// DNSResolver is used to resolve ACME DNS challenges
 	DNSResolver *utildns.CachingResolver
 
@@ -185,6 +192,10 @@ type IssuerOptions struct {
 	// IssuerAmbientCredentials controls whether an issuer should pick up ambient
 	// credentials, such as those from metadata services, to construct clients.
 	IssuerAmbientCredentials bool
+
+	// ExternalSignerSocketDirectory is the directory containing the unix
+	// sockets of external signer plugins.
+	ExternalSignerSocketDirectory string
 }
 
 type ACMEOptions struct {
@@ -249,6 +260,14 @@ type CertificateOptions struct {
 	// CopiedAnnotationPrefixes defines which annotations should be copied
 	// Certificate -> CertificateRequest, CertificateRequest -> Order.
 	CopiedAnnotationPrefixes []string
+	// ConfigMapTargetNamespaces are the namespaces, other than the
+	// Certificate's own, which ConfigMap targets may be written to, keyed by
+	// the namespace of the Certificate.
+	ConfigMapTargetNamespaces map[string][]string
+	// TruststoreSecretNamespaces are the namespaces, other than the
+	// Certificate's own, which copies of truststore Secrets may be written to,
+	// keyed by the namespace of the Certificate.
+	TruststoreSecretNamespaces map[string][]string
 	// CertificateRequestMinimumBackoffDuration defines the minimum backoff duration
 	// when a certificate request fails (default 1h). The backoff delay starts at
 	// this duration and is exponentially increased with each consecutive failure,
@@ -368,6 +387,7 @@ func NewContextFactory(ctx context.Context, opts ContextOptions) (*ContextFactor
 			SharedInformerFactory:                  sharedInformerFactory,
 			GWShared:                               gwSharedInformerFactory,
 			GatewaySolverEnabled:                   clients.gatewayAvailable,
+			GatewayTLSRouteSolverEnabled:           clients.gatewayTLSRouteAvailable,
 			HTTP01ResourceMetadataInformersFactory: http01ResourceMetadataInformerFactory,
 			ContextOptions:                         opts,
 			Clock:                                  clock,
@@ -424,6 +444,8 @@ type contextClients struct {
 	gwClient           gwclient.Interface
 	metadataOnlyClient metadata.Interface
 	gatewayAvailable   bool
+
+	gatewayTLSRouteAvailable bool
 }
 
 // buildClients builds all required clients for the context using the given
@@ -452,7 +474,7 @@ func buildClients(restConfig *rest.Config, opts ContextOptions) (contextClients,
 		return contextClients{}, fmt.Errorf("error creating metadata-only client: %w", err)
 	}
 
-	var gatewayAvailable bool
+	var gatewayAvailable, gatewayTLSRouteAvailable bool
 	// Check if the Gateway API feature gate was enabled
 	if utilfeature.DefaultFeatureGate.Enabled(feature.ExperimentalGatewayAPISupport) && opts.EnableGatewayAPI {
 		// Check if the gateway API CRDs are available. If they are not found
@@ -472,19 +494,14 @@ func buildClients(restConfig *rest.Config, opts ContextOptions) (contextClients,
 			return contextClients{}, fmt.Errorf("%s (found %d APIResources in %s)", GatewayAPINotAvailable, len(resources.APIResources), gwapi.GroupVersion.String())
 		default:
 			if utilfeature.DefaultFeatureGate.Enabled(feature.ListenerSets) && opts.EnableGatewayAPIListenerSet {
-				var listenerSetsAvailable bool
-				for _, res := range resources.APIResources {
-					if res.Kind == "ListenerSet" {
-						listenerSetsAvailable = true
-						break
-					}
-				}
-
-				if !listenerSetsAvailable {
+				if !hasAPIResourceKind(resources, "ListenerSet") {
 					return contextClients{}, fmt.Errorf("found GatewayAPI CRDs; however %s", GatewayAPIListenerSetsNotAvailable)
 				}
 			}
 			gatewayAvailable = true
+			// The TLSRoute CRD is optional. Without it, only the tls-alpn-01
+			// Gateway solver is unavailable.
+			gatewayTLSRouteAvailable = hasAPIResourceKind(resources, "TLSRoute")
 		}
 	}
 
@@ -494,7 +511,15 @@ func buildClients(restConfig *rest.Config, opts ContextOptions) (contextClients,
 		return contextClients{}, fmt.Errorf("error creating kubernetes client: %w", err)
 	}
 
-	return contextClients{kubeClient, cmClient, gwClient, metadataOnlyClient, gatewayAvailable}, nil
+	return contextClients{kubeClient, cmClient, gwClient, metadataOnlyClient, gatewayAvailable, gatewayTLSRouteAvailable}, nil
+}
+
+// hasAPIResourceKind returns true if resources contains a resource of the
+// given kind.
+func hasAPIResourceKind(resources *metav1.APIResourceList, kind string) bool {
+	return slices.ContainsFunc(resources.APIResources, func(res metav1.APIResource) bool {
+		return res.Kind == kind
+	})
 }
 
 // serverUrl returns the base URL for the cluster based on the supplied config.
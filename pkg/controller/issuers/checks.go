@@ -59,6 +59,10 @@ func (c *controller) issuersForSecret(secret *corev1.Secret) ([]*v1.Issuer, erro
 				affected = append(affected, iss)
 				continue
 			}
+			if iss.Spec.ACME.ExternalAccountBindingProvisioner != nil && iss.Spec.ACME.ExternalAccountBindingProvisioner.CredentialsRef.Name == secret.Name {
+				affected = append(affected, iss)
+				continue
+			}
 			// Match DNS-01 solver secrets so creating a missing solver Secret
 			// re-queues the Issuer (see #9036).
 			solverSecrets, err := acme.RequiredDNS01SolverSecrets(iss)
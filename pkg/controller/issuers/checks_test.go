@@ -57,6 +57,10 @@ func TestIssuersForSecret_ACMEDNS01Solver(t *testing.T) {
 		gen.SetIssuerNamespace(ns),
 		gen.SetIssuerACMEEAB("kid", "eab-creds"),
 	)
+	eabProvisionerIssuer := gen.Issuer("eab-provisioner-issuer",
+		gen.SetIssuerNamespace(ns),
+		gen.SetIssuerACMEEABProvisioner("https://eab", "https://token", "eab-provisioner-creds"),
+	)
 	privateKeyIssuer := gen.Issuer("privatekey-issuer",
 		gen.SetIssuerNamespace(ns),
 		gen.SetIssuerACMEPrivKeyRef("privatekey-creds"),
@@ -80,7 +84,7 @@ func TestIssuersForSecret_ACMEDNS01Solver(t *testing.T) {
 	)
 
 	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
-	for _, iss := range []*v1.Issuer{route53Issuer, eabIssuer, privateKeyIssuer, caIssuer, otherNamespaceIssuer} {
+	for _, iss := range []*v1.Issuer{route53Issuer, eabIssuer, eabProvisionerIssuer, privateKeyIssuer, caIssuer, otherNamespaceIssuer} {
 		require.NoError(t, indexer.Add(iss))
 	}
 
@@ -98,6 +102,10 @@ func TestIssuersForSecret_ACMEDNS01Solver(t *testing.T) {
 			secret:        secretNamed(ns, "eab-creds"),
 			expectIssuers: []string{"eab-issuer"},
 		},
+		"a Secret referenced by an ExternalAccountBindingProvisioner requeues its Issuer": {
+			secret:        secretNamed(ns, "eab-provisioner-creds"),
+			expectIssuers: []string{"eab-provisioner-issuer"},
+		},
 		"a Secret referenced by the ACME PrivateKey still requeues its Issuer": {
 			secret:        secretNamed(ns, "privatekey-creds"),
 			expectIssuers: []string{"privatekey-issuer"},
@@ -29,11 +29,13 @@ import (
 	"k8s.io/client-go/util/workqueue"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/scheduler"
 )
 
 type controller struct {
@@ -44,6 +46,9 @@ type controller struct {
 	// so the handleOwnedResource method can enqueue resources
 	queue workqueue.TypedRateLimitingInterface[types.NamespacedName]
 
+	// scheduledWorkQueue holds items to be re-queued after a period of time.
+	scheduledWorkQueue scheduler.ScheduledWorkQueue[types.NamespacedName]
+
 	// logger to be used by this controller
 	log logr.Logger
 
@@ -75,15 +80,19 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLi
 			Name: ControllerName,
 		},
 	)
+	// create a scheduled work queue to set up issuers again at a later time
+	c.scheduledWorkQueue = scheduler.NewScheduledWorkQueue(ctx.Clock, c.queue.Add)
 
 	// obtain references to all the informers used by this controller
 	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
 	secretInformer := ctx.KubeSharedInformerFactory.Secrets()
+	challengeInformer := ctx.SharedInformerFactory.Acme().V1().Challenges()
 	// build a list of InformerSynced functions that will be returned by the Register method.
 	// the controller will only begin processing items once all of these informers have synced.
 	mustSync := []cache.InformerSynced{
 		issuerInformer.Informer().HasSynced,
 		secretInformer.Informer().HasSynced,
+		challengeInformer.Informer().HasSynced,
 	}
 
 	// set all the references to the listers for used by the Sync function
@@ -97,6 +106,14 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLi
 	if _, err := secretInformer.Informer().AddEventHandler(controllerpkg.BlockingEventHandler(c.secretEvent)); err != nil {
 		return nil, nil, fmt.Errorf("error setting up event handler: %v", err)
 	}
+	// Challenges owned by the Issuer are used to solve pre-authorizations.
+	if _, err := challengeInformer.Informer().AddEventHandler(controllerpkg.BlockingEventHandler(
+		controllerpkg.HandleOwnedResourceNamespacedFunc(c.log, c.queue, cmapi.SchemeGroupVersion.WithKind(cmapi.IssuerKind), func(namespace, name string) (*cmapi.Issuer, error) {
+			return c.issuerLister.Issuers(namespace).Get(name)
+		}),
+	)); err != nil {
+		return nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
 
 	// instantiate additional helpers used by this controller
 	c.issuerFactory = issuer.NewFactory(ctx)
@@ -138,12 +155,16 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	if err != nil && !k8sErrors.IsNotFound(err) {
 		return err
 	}
-	if issuer == nil || issuer.DeletionTimestamp != nil {
-		// If the Issuer object was/ is being deleted, we don't want to update its status.
+	if issuer == nil {
 		return nil
 	}
 
 	ctx = logf.NewContext(ctx, logf.WithResource(log, issuer))
+	if issuer.DeletionTimestamp != nil {
+		// If the Issuer object is being deleted, we don't want to update its
+		// status, only release its resources and remove our finalizers.
+		return c.finalize(ctx, issuer)
+	}
 	return c.Sync(ctx, issuer)
 }
 
@@ -22,12 +22,14 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/errors"
 
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	internalissuers "github.com/cert-manager/cert-manager/internal/controller/issuers"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller/globals"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 )
@@ -44,6 +46,13 @@ func (c *controller) Sync(ctx context.Context, iss *cmapi.Issuer) (err error) {
 	ctx, cancel := context.WithTimeout(ctx, globals.DefaultControllerContextTimeout)
 	defer cancel()
 
+	// Add or remove the cert-manager finalizers first, so that the resources
+	// held by the issuer are released when it is deleted. The update of the
+	// Issuer triggers another sync.
+	if !internalissuers.FinalizersUpToDate(iss) {
+		return internalissuers.ApplyIssuerFinalizers(ctx, c.cmClient, c.fieldManager, iss, internalissuers.Finalizers(iss))
+	}
+
 	issuerCopy := iss.DeepCopy()
 	defer func() {
 		if saveErr := c.updateIssuerStatus(ctx, iss, issuerCopy); saveErr != nil {
@@ -64,9 +73,40 @@ func (c *controller) Sync(ctx context.Context, iss *cmapi.Issuer) (err error) {
 		return err
 	}
 
+	if r, ok := i.(issuer.Rescheduler); ok {
+		if after, ok := r.NextSetup(issuerCopy); ok {
+			log.V(logf.DebugLevel).Info("scheduling issuer to be set up again", "after", after)
+			c.scheduledWorkQueue.Add(types.NamespacedName{Namespace: iss.Namespace, Name: iss.Name}, after)
+		}
+	}
+
 	return nil
 }
 
+// finalize releases the resources held by a deleted Issuer and removes the
+// cert-manager finalizers from it.
+func (c *controller) finalize(ctx context.Context, iss *cmapi.Issuer) error {
+	if !internalissuers.HasFinalizers(iss) {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, globals.DefaultControllerContextTimeout)
+	defer cancel()
+
+	i, err := c.issuerFactory.IssuerFor(iss)
+	if err != nil {
+		return err
+	}
+
+	if f, ok := i.(issuer.Finalizer); ok {
+		if err := f.Finalize(ctx, iss.DeepCopy()); err != nil {
+			return err
+		}
+	}
+
+	return internalissuers.ApplyIssuerFinalizers(ctx, c.cmClient, c.fieldManager, iss, nil)
+}
+
 func (c *controller) updateIssuerStatus(ctx context.Context, oldIssuer, newIssuer *cmapi.Issuer) error {
 	if apiequality.Semantic.DeepEqual(oldIssuer.Status, newIssuer.Status) {
 		return nil
@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package externalsigner implements the protocol spoken between the
+// cert-manager controller and external signer plugins: a JSON protocol
+// carried over gRPC.
+//
+// An external signer plugin serves the ExternalSigner service on a unix
+// socket in the controller's external signer socket directory. The
+// controller forwards the CertificateRequests of Issuers with
+// spec.externalSigner set to the plugin, so that the plugin only has to sign
+// certificates and not reconcile CertificateRequests itself.
+//
+// # Wire format
+//
+// There is no protobuf definition of the service. gRPC is only used as the
+// transport: requests and responses are JSON objects, sent with the
+// "application/grpc+json" content type, so that plugins can be written in any
+// language with a gRPC implementation and without generated code. The JSON
+// encoding of the message types in this package, as given by their field
+// tags, is the contract. The service has the following unary methods:
+//
+//	/certmanager.externalsigner.v1alpha1.ExternalSigner/Check   CheckRequest  -> CheckResponse
+//	/certmanager.externalsigner.v1alpha1.ExternalSigner/Sign    SignRequest   -> SignResponse
+//	/certmanager.externalsigner.v1alpha1.ExternalSigner/Revoke  RevokeRequest -> RevokeResponse
+//
+// For example, a SignRequest is encoded as:
+//
+//	{
+//	  "issuer": {"kind": "Issuer", "name": "my-issuer", "namespace": "default", "config": {...}},
+//	  "certificateRequest": {
+//	    "name": "my-cert-1", "namespace": "default", "uid": "...",
+//	    "request": "<base64 encoded PEM CSR>", "duration": "2160h0m0s",
+//	    "usages": ["digital signature", "key encipherment"]
+//	  }
+//	}
+//
+// Byte fields are base64 encoded strings and durations are Go duration
+// strings, as in the Kubernetes API.
+//
+// # Versioning
+//
+// The protocol version, currently ProtocolVersion, is part of the service
+// name. Within a version, fields may only be added to messages, and both
+// sides must ignore fields they do not know. Incompatible changes are made
+// under a new service name, so that a plugin which does not serve the
+// version used by the controller fails with the Unimplemented code.
+//
+// # Errors
+//
+// Errors returned by plugins should carry a gRPC status code:
+// InvalidArgument, FailedPrecondition, PermissionDenied and OutOfRange fail
+// the request permanently, while all other codes are retried.
+package externalsigner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// ProtocolVersion is the version of the external signer protocol
+	// implemented by this package.
+	ProtocolVersion = "v1alpha1"
+
+	// ServiceName is the fully qualified name of the ExternalSigner service,
+	// which includes the protocol version.
+	ServiceName = "certmanager.externalsigner." + ProtocolVersion + ".ExternalSigner"
+
+	// CodecName is the gRPC content-subtype used to encode messages as JSON.
+	CodecName = "json"
+
+	checkMethod  = "/" + ServiceName + "/Check"
+	signMethod   = "/" + ServiceName + "/Sign"
+	revokeMethod = "/" + ServiceName + "/Revoke"
+)
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+// jsonCodec encodes gRPC messages as JSON.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return CodecName
+}
+
+// SocketPath returns the path of the unix socket of the external signer
+// plugin with the given name, in the given socket directory.
+func SocketPath(directory, name string) string {
+	return filepath.Join(directory, name+".sock")
+}
+
+// Server is implemented by external signer plugins.
+type Server interface {
+	// Check returns an error if the plugin is not able to sign certificates
+	// for the given issuer, for example because its configuration is invalid.
+	Check(context.Context, *CheckRequest) (*CheckResponse, error)
+
+	// Sign signs the given CertificateRequest.
+	Sign(context.Context, *SignRequest) (*SignResponse, error)
+
+	// Revoke revokes the certificate of the given CertificateRequest.
+	// Plugins which are not able to revoke certificates should return an
+	// error with the Unimplemented code.
+	Revoke(context.Context, *RevokeRequest) (*RevokeResponse, error)
+}
+
+// UnimplementedServer may be embedded by plugins which do not implement all
+// methods of Server.
+type UnimplementedServer struct{}
+
+// Check reports the plugin as ready for every issuer.
+func (UnimplementedServer) Check(context.Context, *CheckRequest) (*CheckResponse, error) {
+	return &CheckResponse{}, nil
+}
+
+func (UnimplementedServer) Sign(context.Context, *SignRequest) (*SignResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Sign not implemented")
+}
+
+func (UnimplementedServer) Revoke(context.Context, *RevokeRequest) (*RevokeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Revoke not implemented")
+}
+
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Check", Handler: unaryHandler(checkMethod, Server.Check)},
+		{MethodName: "Sign", Handler: unaryHandler(signMethod, Server.Sign)},
+		{MethodName: "Revoke", Handler: unaryHandler(revokeMethod, Server.Revoke)},
+	},
+}
+
+// RegisterServer registers the given external signer implementation with a
+// gRPC server.
+func RegisterServer(s grpc.ServiceRegistrar, srv Server) {
+	s.RegisterService(&serviceDesc, srv)
+}
+
+func unaryHandler[Req, Resp any](fullMethod string, call func(Server, context.Context, *Req) (*Resp, error)) grpc.MethodHandler {
+	return func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+		in := new(Req)
+		if err := dec(in); err != nil {
+			return nil, err
+		}
+		if interceptor == nil {
+			return call(srv.(Server), ctx, in)
+		}
+		info := &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod}
+		return interceptor(ctx, in, info, func(ctx context.Context, req any) (any, error) {
+			return call(srv.(Server), ctx, req.(*Req))
+		})
+	}
+}
+
+// Client is a client of an external signer plugin.
+type Client struct {
+	conn *grpc.ClientConn
+}
+
+// NewClient returns a client of the external signer plugin listening on the
+// unix socket at the given path. The connection is established lazily, on
+// the first call.
+func NewClient(socketPath string) (*Client, error) {
+	conn, err := grpc.NewClient("unix://"+socketPath,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.CallContentSubtype(CodecName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create external signer client for %q: %w", socketPath, err)
+	}
+	return &Client{conn: conn}, nil
+}
+
+func (c *Client) Check(ctx context.Context, req *CheckRequest) (*CheckResponse, error) {
+	out := new(CheckResponse)
+	if err := c.conn.Invoke(ctx, checkMethod, req, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *Client) Sign(ctx context.Context, req *SignRequest) (*SignResponse, error) {
+	out := new(SignResponse)
+	if err := c.conn.Invoke(ctx, signMethod, req, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *Client) Revoke(ctx context.Context, req *RevokeRequest) (*RevokeResponse, error) {
+	out := new(RevokeResponse)
+	if err := c.conn.Invoke(ctx, revokeMethod, req, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Close closes the connection to the plugin.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// IsPermanentError returns true if the given error returned by a plugin
+// means that retrying the request will not succeed.
+func IsPermanentError(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.PermissionDenied, codes.OutOfRange:
+		return true
+	default:
+		return false
+	}
+}
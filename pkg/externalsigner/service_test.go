@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsigner
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+type fakeServer struct {
+	UnimplementedServer
+
+	sign func(*SignRequest) (*SignResponse, error)
+}
+
+func (f *fakeServer) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	return f.sign(req)
+}
+
+// startServer serves the given implementation on a unix socket, and returns
+// the path of the socket.
+func startServer(t *testing.T, srv Server) string {
+	// t.TempDir may exceed the maximum length of a unix socket path.
+	dir, err := os.MkdirTemp("", "externalsigner")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	socketPath := SocketPath(dir, "test")
+	lis, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+
+	s := grpc.NewServer()
+	RegisterServer(s, srv)
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	return socketPath
+}
+
+func TestClient(t *testing.T) {
+	issuer := gen.Issuer("test-issuer",
+		gen.SetIssuerNamespace("test-ns"),
+		func(iss cmapi.GenericIssuer) {
+			iss.GetSpec().ExternalSigner = &cmapi.ExternalSignerIssuer{
+				Name:   "test",
+				Config: &apiextensionsv1.JSON{Raw: []byte(`{"profile":"server"}`)},
+			}
+		},
+	)
+	cr := gen.CertificateRequest("test-cr",
+		gen.SetCertificateRequestNamespace("test-ns"),
+		gen.SetCertificateRequestCSR([]byte("csr")),
+		gen.SetCertificateRequestIsCA(true),
+		gen.SetCertificateRequestKeyUsages(cmapi.UsageDigitalSignature),
+	)
+
+	var got *SignRequest
+	client, err := NewClient(startServer(t, &fakeServer{
+		sign: func(req *SignRequest) (*SignResponse, error) {
+			got = req
+			return &SignResponse{Certificate: []byte("cert"), CA: []byte("ca")}, nil
+		},
+	}))
+	require.NoError(t, err)
+	defer client.Close()
+
+	_, err = client.Check(t.Context(), &CheckRequest{Issuer: IssuerFor(issuer)})
+	require.NoError(t, err)
+
+	resp, err := client.Sign(t.Context(), &SignRequest{
+		Issuer:             IssuerFor(issuer),
+		CertificateRequest: CertificateRequestFor(cr),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &SignResponse{Certificate: []byte("cert"), CA: []byte("ca")}, resp)
+	assert.Equal(t, &SignRequest{
+		Issuer: Issuer{
+			Kind:      cmapi.IssuerKind,
+			Name:      "test-issuer",
+			Namespace: "test-ns",
+			Config:    json.RawMessage(`{"profile":"server"}`),
+		},
+		CertificateRequest: CertificateRequest{
+			Name:      "test-cr",
+			Namespace: "test-ns",
+			Request:   []byte("csr"),
+			IsCA:      true,
+			Usages:    []string{"digital signature"},
+		},
+	}, got)
+
+	_, err = client.Revoke(t.Context(), &RevokeRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+// TestWireFormat guards the JSON encoding of the messages, which plugins
+// depend on as there is no protobuf definition of the service.
+func TestWireFormat(t *testing.T) {
+	codec := jsonCodec{}
+
+	data, err := codec.Marshal(&SignRequest{
+		Issuer: Issuer{Kind: cmapi.IssuerKind, Name: "test-issuer", Namespace: "test-ns", Config: json.RawMessage(`{"profile":"server"}`)},
+		CertificateRequest: CertificateRequest{
+			Name: "test-cr", Namespace: "test-ns", UID: "test-uid",
+			Request:  []byte("csr"),
+			Duration: &metav1.Duration{Duration: time.Hour},
+			Usages:   []string{"digital signature"},
+		},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"issuer": {"kind": "Issuer", "name": "test-issuer", "namespace": "test-ns", "config": {"profile": "server"}},
+		"certificateRequest": {
+			"name": "test-cr", "namespace": "test-ns", "uid": "test-uid",
+			"request": "Y3Ny", "duration": "1h0m0s", "usages": ["digital signature"]
+		}
+	}`, string(data))
+
+	// Fields added in a later revision of the protocol are ignored.
+	var resp SignResponse
+	require.NoError(t, codec.Unmarshal([]byte(`{"certificate": "Y2VydA==", "ca": "Y2E=", "unknown": true}`), &resp))
+	assert.Equal(t, SignResponse{Certificate: []byte("cert"), CA: []byte("ca")}, resp)
+
+	assert.Equal(t, "/certmanager.externalsigner.v1alpha1.ExternalSigner/Sign", signMethod)
+}
+
+func TestIsPermanentError(t *testing.T) {
+	tests := map[string]struct {
+		err error
+		exp bool
+	}{
+		"invalid argument is permanent": {
+			err: status.Error(codes.InvalidArgument, "bad request"),
+			exp: true,
+		},
+		"permission denied is permanent": {
+			err: status.Error(codes.PermissionDenied, "denied"),
+			exp: true,
+		},
+		"unavailable is retried": {
+			err: status.Error(codes.Unavailable, "not ready"),
+			exp: false,
+		},
+		"errors without a status are retried": {
+			err: net.ErrClosed,
+			exp: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.exp, IsPermanentError(test.err))
+		})
+	}
+}
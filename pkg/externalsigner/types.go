@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsigner
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// Issuer identifies the Issuer or ClusterIssuer a request is made for.
+type Issuer struct {
+	// Kind is either "Issuer" or "ClusterIssuer".
+	Kind string `json:"kind"`
+
+	// Name is the name of the Issuer or ClusterIssuer.
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the Issuer. It is empty for ClusterIssuers.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Config is the issuer's spec.externalSigner.config, passed through
+	// verbatim.
+	Config json.RawMessage `json:"config,omitempty"`
+}
+
+// CertificateRequest holds the fields of a CertificateRequest that an
+// external signer needs to sign it.
+type CertificateRequest struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	UID       string `json:"uid"`
+
+	// Annotations are the annotations of the CertificateRequest.
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Request is the PEM encoded x509 certificate signing request.
+	Request []byte `json:"request"`
+
+	// Duration is the requested duration of the certificate.
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// IsCA is true if the requested certificate is a CA certificate.
+	IsCA bool `json:"isCA,omitempty"`
+
+	// Usages are the requested key usages of the certificate.
+	Usages []string `json:"usages,omitempty"`
+
+	// Username and Groups identify the user that created the
+	// CertificateRequest.
+	Username string   `json:"username,omitempty"`
+	Groups   []string `json:"groups,omitempty"`
+}
+
+// CheckRequest asks an external signer whether it is able to sign
+// certificates for the given issuer.
+type CheckRequest struct {
+	Issuer Issuer `json:"issuer"`
+}
+
+// CheckResponse is returned by an external signer that is ready to sign
+// certificates for the issuer of a CheckRequest.
+type CheckResponse struct{}
+
+// SignRequest asks an external signer to sign a CertificateRequest.
+type SignRequest struct {
+	Issuer             Issuer             `json:"issuer"`
+	CertificateRequest CertificateRequest `json:"certificateRequest"`
+}
+
+// SignResponse holds the certificate signed by an external signer.
+type SignResponse struct {
+	// Certificate is the PEM encoded signed certificate, optionally followed
+	// by its intermediate certificates.
+	Certificate []byte `json:"certificate"`
+
+	// CA is the PEM encoded CA certificate of the signer, if known.
+	CA []byte `json:"ca,omitempty"`
+}
+
+// RevokeRequest asks an external signer to revoke the certificate of a
+// CertificateRequest it has signed.
+type RevokeRequest struct {
+	Issuer             Issuer             `json:"issuer"`
+	CertificateRequest CertificateRequest `json:"certificateRequest"`
+
+	// Certificate is the PEM encoded certificate to revoke.
+	Certificate []byte `json:"certificate"`
+
+	// Reason is the RFC 5280 CRLReason code of the revocation.
+	Reason int `json:"reason"`
+}
+
+// RevokeResponse is returned by an external signer once a certificate has
+// been revoked.
+type RevokeResponse struct{}
+
+// IssuerFor returns the Issuer message for the given Issuer or ClusterIssuer.
+func IssuerFor(iss cmapi.GenericIssuer) Issuer {
+	out := Issuer{
+		Kind:      cmapi.IssuerKind,
+		Name:      iss.GetName(),
+		Namespace: iss.GetNamespace(),
+	}
+	if _, ok := iss.(*cmapi.ClusterIssuer); ok {
+		out.Kind = cmapi.ClusterIssuerKind
+	}
+	if cfg := iss.GetSpec().ExternalSigner; cfg != nil && cfg.Config != nil {
+		out.Config = cfg.Config.Raw
+	}
+	return out
+}
+
+// CertificateRequestFor returns the CertificateRequest message for the given
+// CertificateRequest.
+func CertificateRequestFor(cr *cmapi.CertificateRequest) CertificateRequest {
+	out := CertificateRequest{
+		Name:        cr.Name,
+		Namespace:   cr.Namespace,
+		UID:         string(cr.UID),
+		Annotations: cr.Annotations,
+		Request:     cr.Spec.Request,
+		Duration:    cr.Spec.Duration,
+		IsCA:        cr.Spec.IsCA,
+		Username:    cr.Spec.Username,
+		Groups:      cr.Spec.Groups,
+	}
+	for _, usage := range cr.Spec.Usages {
+		out.Usages = append(out.Usages, string(usage))
+	}
+	return out
+}
@@ -22,11 +22,14 @@ import (
 
 	core "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cmacmelisters "github.com/cert-manager/cert-manager/pkg/client/listers/acme/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/issuer"
 	"github.com/cert-manager/cert-manager/pkg/util/kube"
@@ -39,6 +42,11 @@ type Acme struct {
 	secretsClient core.SecretsGetter
 	recorder      record.EventRecorder
 
+	// cmClient and challengeLister are used to manage the Challenge resources
+	// that solve pre-authorizations.
+	cmClient        cmclient.Interface
+	challengeLister cmacmelisters.ChallengeLister
+
 	// keyFromSecret returns a decoded account key from a Kubernetes secret.
 	// It can be stubbed in unit tests.
 	keyFromSecret keyFromSecretFunc
@@ -46,10 +54,16 @@ type Acme struct {
 	// clientBuilder builds a new ACME client.
 	clientBuilder accounts.NewClientFunc
 
+	// provisionEAB obtains new External Account Binding credentials from a
+	// provisioning endpoint.
+	provisionEAB accounts.ProvisionEABFunc
+
 	// namespace of referenced resources when the given issuer is a ClusterIssuer
 	resourceNamespace func(iss cmapi.GenericIssuer) string
 	// used as a cache for ACME clients
 	accountRegistry accounts.Registry
+
+	clock clock.Clock
 }
 
 // New returns a new ACME issuer interface for the given issuer.
@@ -59,10 +73,14 @@ func New(ctx *controller.Context) (issuer.Interface, error) {
 	a := &Acme{
 		keyFromSecret:     newKeyFromSecret(secretsLister),
 		clientBuilder:     accounts.NewClient(ctx.Metrics, ctx.RESTConfig.UserAgent),
+		provisionEAB:      accounts.NewEABProvisioner(ctx.Metrics, ctx.RESTConfig.UserAgent),
 		secretsClient:     ctx.Client.CoreV1(),
 		recorder:          ctx.Recorder,
+		cmClient:          ctx.CMClient,
+		challengeLister:   ctx.SharedInformerFactory.Acme().V1().Challenges().Lister(),
 		resourceNamespace: ctx.IssuerOptions.ResourceNamespace,
 		accountRegistry:   ctx.ACMEAccountRegistry,
+		clock:             ctx.Clock,
 	}
 
 	return a, nil
@@ -0,0 +1,380 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	whapi "github.com/cert-manager/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	// defaultBatchWindow is how long the first change to the records in a
+	// zone waits for changes for other challenges in the same zone to join
+	// its batch.
+	defaultBatchWindow = 2 * time.Second
+
+	// maxBatchSize is the maximum number of records changed in a single
+	// batch. It is well within the limits of the provider APIs.
+	maxBatchSize = 100
+
+	// minBatchRetryAfter is the shortest time after which a change is added
+	// again while its batch is being applied.
+	minBatchRetryAfter = 500 * time.Millisecond
+
+	// staleBatchAge is how long the result of applying a batch is kept for
+	// the changes in it which have not been added again.
+	staleBatchAge = 10 * time.Minute
+)
+
+// batchSolver is implemented by solvers that can present and clean up the
+// records for several challenges in the same zone with a single change.
+type batchSolver interface {
+	PresentBatch(ctx context.Context, records []util.ChallengeRecord) error
+	CleanUpBatch(ctx context.Context, records []util.ChallengeRecord) error
+}
+
+// batchWebhookSolver is implemented by webhook solvers that can present and
+// clean up the records for several challenge requests with the same config
+// and zone with a single change.
+type batchWebhookSolver interface {
+	PresentBatch(chs []*whapi.ChallengeRequest) error
+	CleanUpBatch(chs []*whapi.ChallengeRequest) error
+}
+
+// batchedChange is a change to the record for a single challenge, which is
+// applied together with the changes for other challenges in the same zone.
+type batchedChange struct {
+	// checkFQDN and value identify the record when checking propagation.
+	checkFQDN string
+	value     string
+
+	record util.ChallengeRecord
+	// request is only set for changes applied using a batchWebhookSolver.
+	request *whapi.ChallengeRequest
+}
+
+// changeBatch is a set of changes which are applied together.
+type changeBatch struct {
+	changes []batchedChange
+	// applyAt is the time at which the batch window ends.
+	applyAt time.Time
+	// full is closed once no more changes can be added to the batch.
+	full chan struct{}
+	// done is closed once the batch has been applied, after setting err and
+	// appliedAt.
+	done      chan struct{}
+	err       error
+	appliedAt time.Time
+}
+
+// batcher coalesces changes to the records for challenges that are solved
+// using the same provider and zone, so that they are applied with a single
+// call to the provider API.
+// Adding a change does not block until its batch has been applied, so that
+// a batch is not limited to the changes of the challenges being synced at
+// the same time.
+// The zero value is ready to use, and applies each change straight away
+// without waiting for other changes to join its batch.
+type batcher struct {
+	window time.Duration
+
+	mu      sync.Mutex
+	pending map[string]*changeBatch
+	// added holds the batch that each change was added to, until the result
+	// of applying the batch has been returned for the change.
+	added map[string]*changeBatch
+}
+
+// add adds change to the pending batch for key, unless it has already been
+// added to a batch. The first change for a key starts a new batch, which is
+// applied using its apply function once the batch window has passed or the
+// batch is full.
+// A *util.BatchPendingError is returned while the batch of the change has not
+// been applied yet, and add should be called again for the change after the
+// returned duration. Once the batch has been applied, add returns the result
+// of applying it.
+func (b *batcher) add(ctx context.Context, key string, change batchedChange, apply func(context.Context, []batchedChange) error) error {
+	if b.window == 0 {
+		return apply(ctx, []batchedChange{change})
+	}
+
+	id := key + " " + change.checkFQDN + " " + change.value
+	now := time.Now()
+
+	b.mu.Lock()
+	b.forgetStaleLocked(now)
+	batch, ok := b.added[id]
+	if !ok {
+		batch, ok = b.pending[key]
+		if !ok {
+			batch = &changeBatch{applyAt: now.Add(b.window), full: make(chan struct{}), done: make(chan struct{})}
+			if b.pending == nil {
+				b.pending = make(map[string]*changeBatch)
+			}
+			b.pending[key] = batch
+			// The batch is applied for every change in it, so it must not be
+			// cancelled if the context of the change that started it is.
+			go b.apply(context.WithoutCancel(ctx), key, batch, apply)
+		}
+		batch.changes = append(batch.changes, change)
+		if len(batch.changes) >= maxBatchSize {
+			delete(b.pending, key)
+			close(batch.full)
+		}
+		if b.added == nil {
+			b.added = make(map[string]*changeBatch)
+		}
+		b.added[id] = batch
+	}
+	b.mu.Unlock()
+
+	select {
+	case <-batch.done:
+		b.mu.Lock()
+		if b.added[id] == batch {
+			delete(b.added, id)
+		}
+		b.mu.Unlock()
+		return batch.err
+	default:
+		return &util.BatchPendingError{RetryAfter: max(time.Until(batch.applyAt), minBatchRetryAfter)}
+	}
+}
+
+// forgetStaleLocked forgets the changes whose batch was applied too long ago
+// for the change to still be added again, for example because its challenge
+// was deleted in the meantime. b.mu must be held.
+func (b *batcher) forgetStaleLocked(now time.Time) {
+	for id, batch := range b.added {
+		select {
+		case <-batch.done:
+			if now.Sub(batch.appliedAt) > staleBatchAge {
+				delete(b.added, id)
+			}
+		default:
+		}
+	}
+}
+
+func (b *batcher) apply(ctx context.Context, key string, batch *changeBatch, apply func(context.Context, []batchedChange) error) {
+	select {
+	case <-time.After(b.window):
+	case <-batch.full:
+	}
+
+	b.mu.Lock()
+	if b.pending[key] == batch {
+		delete(b.pending, key)
+	}
+	// No more changes are added once the batch is no longer pending.
+	changes := batch.changes
+	b.mu.Unlock()
+
+	logf.FromContext(ctx).V(logf.DebugLevel).Info("applying batch of DNS01 challenge record changes", "changes", len(changes))
+	batch.err = apply(ctx, changes)
+	batch.appliedAt = time.Now()
+	close(batch.done)
+}
+
+// batchKey identifies the batch that changes for ch are added to. Changes
+// are only batched together if they are for the same zone, and use the same
+// solver configuration and credentials.
+func (s *Solver) batchKey(action string, ch *cmacme.Challenge, zone string) (string, error) {
+	cfg, err := json.Marshal(ch.Spec.Solver.DNS01)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join([]string{
+		action,
+		ch.Spec.IssuerRef.Kind,
+		ch.Spec.IssuerRef.Name,
+		s.ResourceNamespaceRef(ch.Spec.IssuerRef, ch.Namespace),
+		util.ToFqdn(zone),
+		string(cfg),
+	}, "/"), nil
+}
+
+// presentBatched presents the record for a challenge together with the records
+// for other challenges in the same zone.
+func (s *Solver) presentBatched(ctx context.Context, key string, change batchedChange, apply func(context.Context, []batchedChange) error) error {
+	return s.batches.add(ctx, key, change, func(ctx context.Context, changes []batchedChange) error {
+		if err := apply(ctx, dedupeChanges(changes)); err != nil {
+			return err
+		}
+		s.propagation.add(changes)
+		return nil
+	})
+}
+
+// cleanUpBatched cleans up the record for a challenge together with the
+// records for other challenges in the same zone.
+func (s *Solver) cleanUpBatched(ctx context.Context, key string, change batchedChange, apply func(context.Context, []batchedChange) error) error {
+	s.propagation.remove(change)
+	return s.batches.add(ctx, key, change, func(ctx context.Context, changes []batchedChange) error {
+		return apply(ctx, dedupeChanges(changes))
+	})
+}
+
+// dedupeChanges removes changes for the same record, which can happen if
+// several challenges for the same domain are solved at the same time.
+func dedupeChanges(changes []batchedChange) []batchedChange {
+	seen := make(map[string]bool, len(changes))
+	deduped := make([]batchedChange, 0, len(changes))
+	for _, c := range changes {
+		if seen[c.propagationKey()] {
+			continue
+		}
+		seen[c.propagationKey()] = true
+		deduped = append(deduped, c)
+	}
+	return deduped
+}
+
+func challengeRecords(changes []batchedChange) []util.ChallengeRecord {
+	records := make([]util.ChallengeRecord, 0, len(changes))
+	for _, c := range changes {
+		records = append(records, c.record)
+	}
+	return records
+}
+
+func challengeRequests(changes []batchedChange) []*whapi.ChallengeRequest {
+	requests := make([]*whapi.ChallengeRequest, 0, len(changes))
+	for _, c := range changes {
+		requests = append(requests, c.request)
+	}
+	return requests
+}
+
+// propagatedBatch is a set of records that were presented together, so that
+// waiting for them to propagate only needs to be done once.
+type propagatedBatch struct {
+	records []batchedChange
+
+	mu         sync.Mutex
+	propagated bool
+}
+
+// propagationTracker records which records were presented in the same batch.
+// The zero value is ready to use.
+type propagationTracker struct {
+	mu       sync.Mutex
+	byRecord map[string]*propagatedBatch
+}
+
+func (p *propagationTracker) add(changes []batchedChange) {
+	batch := &propagatedBatch{records: dedupeChanges(changes)}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.byRecord == nil {
+		p.byRecord = make(map[string]*propagatedBatch)
+	}
+	for _, c := range changes {
+		p.byRecord[c.propagationKey()] = batch
+	}
+}
+
+// remove forgets the record of a cleaned up change. The record is also
+// removed from the batch it was presented in, so that the other records in
+// the batch no longer wait for it to propagate.
+func (p *propagationTracker) remove(change batchedChange) {
+	key := change.propagationKey()
+
+	p.mu.Lock()
+	batch := p.byRecord[key]
+	delete(p.byRecord, key)
+	p.mu.Unlock()
+
+	if batch != nil {
+		batch.remove(key)
+	}
+}
+
+// get returns the batch that the record with the given name and value was
+// presented in, or nil if it was not presented in a batch.
+func (p *propagationTracker) get(checkFQDN, value string) *propagatedBatch {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.byRecord[checkFQDN+" "+value]
+}
+
+// propagationKey identifies the record of the change when checking
+// propagation.
+func (c batchedChange) propagationKey() string {
+	return c.checkFQDN + " " + c.value
+}
+
+func (b *propagatedBatch) remove(key string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.records = slices.DeleteFunc(b.records, func(c batchedChange) bool {
+		return c.propagationKey() == key
+	})
+}
+
+// waitForPropagation checks that all of the records in the batch have
+// propagated, and then calls wait once for the whole batch. Checks for
+// records in a batch that has already propagated return immediately.
+func (b *propagatedBatch) waitForPropagation(ctx context.Context, check func(ctx context.Context, fqdn, value string) (bool, error), wait func()) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.propagated {
+		return nil
+	}
+
+	for _, c := range b.records {
+		ok, err := check(ctx, c.checkFQDN, c.value)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("DNS record for %q not yet propagated", c.record.Domain)
+		}
+	}
+	wait()
+	b.propagated = true
+	return nil
+}
+
+// newBatchedChange returns the change to the record for ch, which is
+// presented at fqdn. req is the request for webhook solvers, and is nil
+// otherwise.
+func newBatchedChange(ctx context.Context, ch *cmacme.Challenge, fqdn string, req *whapi.ChallengeRequest) (batchedChange, error) {
+	// Propagation is checked at the challenge name, without following
+	// CNAME records.
+	checkFQDN, err := challengeFQDN(ctx, ch, false)
+	if err != nil {
+		return batchedChange{}, err
+	}
+	return batchedChange{
+		checkFQDN: checkFQDN,
+		value:     ch.Spec.Key,
+		record:    util.ChallengeRecord{Domain: ch.Spec.DNSName, FQDN: fqdn, Value: ch.Spec.Key},
+		request:   req,
+	}, nil
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+func testChange(i int) batchedChange {
+	domain := fmt.Sprintf("www%d.example.com", i)
+	return batchedChange{
+		checkFQDN: "_acme-challenge." + domain + ".",
+		value:     "value",
+		record:    util.ChallengeRecord{Domain: domain, FQDN: "_acme-challenge." + domain + ".", Value: "value"},
+	}
+}
+
+// addUntilApplied adds change to the batcher until its batch has been
+// applied, and returns the result of applying it.
+func addUntilApplied(t *testing.T, b *batcher, ctx context.Context, key string, change batchedChange, apply func(context.Context, []batchedChange) error) error {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		err := b.add(ctx, key, change, apply)
+		pending, ok := errors.AsType[*util.BatchPendingError](err)
+		if !ok {
+			return err
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("batch was not applied")
+		}
+		assert.Positive(t, pending.RetryAfter)
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestBatcher(t *testing.T) {
+	tests := map[string]struct {
+		changes  int
+		keys     int
+		applyErr error
+		// wantBatches is the number of changes in each batch applied.
+		wantBatches []int
+	}{
+		"changes for the same key are applied together": {
+			changes:     10,
+			keys:        1,
+			wantBatches: []int{10},
+		},
+		"changes for different keys are applied separately": {
+			changes:     10,
+			keys:        2,
+			wantBatches: []int{5, 5},
+		},
+		"full batches are split": {
+			changes:     maxBatchSize + 1,
+			keys:        1,
+			wantBatches: []int{maxBatchSize, 1},
+		},
+		"errors are returned for every change in the batch": {
+			changes:     3,
+			keys:        1,
+			applyErr:    errors.New("simulated error"),
+			wantBatches: []int{3},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			b := &batcher{window: 200 * time.Millisecond}
+
+			var mu sync.Mutex
+			var batches []int
+			apply := func(_ context.Context, changes []batchedChange) error {
+				mu.Lock()
+				defer mu.Unlock()
+				batches = append(batches, len(changes))
+				return test.applyErr
+			}
+
+			// Adding a change does not wait for its batch to be applied.
+			for i := range test.changes {
+				err := b.add(t.Context(), fmt.Sprintf("key%d", i%test.keys), testChange(i), apply)
+				_, pending := errors.AsType[*util.BatchPendingError](err)
+				assert.True(t, pending, "expected change %d to be pending, got: %v", i, err)
+			}
+
+			for i := range test.changes {
+				err := addUntilApplied(t, b, t.Context(), fmt.Sprintf("key%d", i%test.keys), testChange(i), apply)
+				assert.Equal(t, test.applyErr, err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			assert.ElementsMatch(t, test.wantBatches, batches)
+		})
+	}
+}
+
+func TestBatcherAddAgain(t *testing.T) {
+	b := &batcher{window: 100 * time.Millisecond}
+
+	applied := 0
+	apply := func(_ context.Context, changes []batchedChange) error {
+		applied++
+		return nil
+	}
+
+	// Once the result of its batch has been returned, adding a change again
+	// starts a new batch.
+	require.NoError(t, addUntilApplied(t, b, t.Context(), "key", testChange(0), apply))
+	require.NoError(t, addUntilApplied(t, b, t.Context(), "key", testChange(0), apply))
+	assert.Equal(t, 2, applied)
+	assert.Empty(t, b.added)
+}
+
+func TestBatcherZeroWindow(t *testing.T) {
+	var b batcher
+
+	applyErr := errors.New("simulated error")
+	err := b.add(t.Context(), "key", testChange(0), func(_ context.Context, changes []batchedChange) error {
+		assert.Len(t, changes, 1)
+		return applyErr
+	})
+	assert.Equal(t, applyErr, err)
+}
+
+func TestBatcherContextCancelled(t *testing.T) {
+	b := &batcher{window: 100 * time.Millisecond}
+
+	applied := make(chan []batchedChange, 1)
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	err := b.add(ctx, "key", testChange(0), func(_ context.Context, changes []batchedChange) error {
+		applied <- changes
+		return nil
+	})
+	_, pending := errors.AsType[*util.BatchPendingError](err)
+	assert.True(t, pending, "expected change to be pending, got: %v", err)
+
+	// The batch is still applied, as other changes may have joined it.
+	select {
+	case changes := <-applied:
+		assert.Len(t, changes, 1)
+	case <-time.After(5 * time.Second):
+		t.Fatal("batch was not applied")
+	}
+}
+
+func TestPropagatedBatch(t *testing.T) {
+	var tracker propagationTracker
+	tracker.add([]batchedChange{testChange(0), testChange(1), testChange(0)})
+
+	batch := tracker.get("_acme-challenge.www1.example.com.", "value")
+	require.NotNil(t, batch)
+	assert.Len(t, batch.records, 2)
+	assert.Same(t, batch, tracker.get("_acme-challenge.www0.example.com.", "value"))
+	assert.Nil(t, tracker.get("_acme-challenge.www0.example.com.", "other"))
+
+	var checked []string
+	propagated := map[string]bool{"_acme-challenge.www0.example.com.": true}
+	check := func(_ context.Context, fqdn, value string) (bool, error) {
+		checked = append(checked, fqdn)
+		return propagated[fqdn], nil
+	}
+	waits := 0
+	wait := func() { waits++ }
+
+	err := batch.waitForPropagation(t.Context(), check, wait)
+	assert.EqualError(t, err, `DNS record for "www1.example.com" not yet propagated`)
+	assert.Equal(t, 0, waits)
+
+	propagated["_acme-challenge.www1.example.com."] = true
+	checked = nil
+	require.NoError(t, batch.waitForPropagation(t.Context(), check, wait))
+	assert.Equal(t, []string{"_acme-challenge.www0.example.com.", "_acme-challenge.www1.example.com."}, checked)
+	assert.Equal(t, 1, waits)
+
+	// Once the batch has propagated, no more checks or waits are needed.
+	checked = nil
+	require.NoError(t, batch.waitForPropagation(t.Context(), check, wait))
+	assert.Empty(t, checked)
+	assert.Equal(t, 1, waits)
+
+	tracker.remove(testChange(0))
+	assert.Nil(t, tracker.get("_acme-challenge.www0.example.com.", "value"))
+	assert.Same(t, batch, tracker.get("_acme-challenge.www1.example.com.", "value"))
+}
+
+func TestPropagatedBatchCleanedUpBeforePropagation(t *testing.T) {
+	var tracker propagationTracker
+	tracker.add([]batchedChange{testChange(0), testChange(1), testChange(2)})
+
+	// The record of www0 is cleaned up, and so never propagates.
+	propagated := map[string]bool{
+		"_acme-challenge.www1.example.com.": true,
+		"_acme-challenge.www2.example.com.": true,
+	}
+	var checked []string
+	check := func(_ context.Context, fqdn, value string) (bool, error) {
+		checked = append(checked, fqdn)
+		return propagated[fqdn], nil
+	}
+	waits := 0
+	wait := func() { waits++ }
+
+	batch := tracker.get("_acme-challenge.www1.example.com.", "value")
+	require.NotNil(t, batch)
+	err := batch.waitForPropagation(t.Context(), check, wait)
+	assert.EqualError(t, err, `DNS record for "www0.example.com" not yet propagated`)
+
+	tracker.remove(testChange(0))
+
+	for _, fqdn := range []string{"_acme-challenge.www1.example.com.", "_acme-challenge.www2.example.com."} {
+		checked = nil
+		require.NoError(t, tracker.get(fqdn, "value").waitForPropagation(t.Context(), check, wait))
+		assert.NotContains(t, checked, "_acme-challenge.www0.example.com.")
+	}
+	assert.Equal(t, 1, waits)
+}
@@ -223,6 +223,125 @@ func (c *DNSProvider) CleanUp(ctx context.Context, domain, fqdn, value string) e
 	return nil
 }
 
+// PresentBatch creates the TXT records for several challenges in the same
+// zone using a single request to the batch DNS records API.
+func (c *DNSProvider) PresentBatch(ctx context.Context, records []util.ChallengeRecord) error {
+	return presentBatch(ctx, c, records)
+}
+
+// CleanUpBatch removes the TXT records for several challenges in the same
+// zone using a single request to the batch DNS records API.
+func (c *DNSProvider) CleanUpBatch(ctx context.Context, records []util.ChallengeRecord) error {
+	return cleanUpBatch(ctx, c, records)
+}
+
+func presentBatch(ctx context.Context, c DNSProviderType, records []util.ChallengeRecord) error {
+	zoneID, existing, err := listTxtRecords(ctx, c, records)
+	if err != nil {
+		return err
+	}
+
+	var batch cloudFlareBatch
+	for _, record := range records {
+		if findRecord(existing, record.FQDN, record.Value) != nil {
+			continue
+		}
+		batch.Posts = append(batch.Posts, cloudFlareRecord{
+			Type:    "TXT",
+			Name:    util.UnFqdn(record.FQDN),
+			Content: record.Value,
+			TTL:     60,
+		})
+		// Don't create the same record twice if it is in the batch twice.
+		existing = append(existing, batch.Posts[len(batch.Posts)-1])
+	}
+	if len(batch.Posts) == 0 {
+		return nil
+	}
+
+	return applyBatch(ctx, c, zoneID, batch)
+}
+
+func cleanUpBatch(ctx context.Context, c DNSProviderType, records []util.ChallengeRecord) error {
+	zoneID, existing, err := listTxtRecords(ctx, c, records)
+	if err != nil {
+		return err
+	}
+
+	var batch cloudFlareBatch
+	deleted := make(map[string]bool)
+	for _, record := range records {
+		rec := findRecord(existing, record.FQDN, record.Value)
+		// Nothing to cleanup
+		if rec == nil || deleted[rec.ID] {
+			continue
+		}
+		deleted[rec.ID] = true
+		batch.Deletes = append(batch.Deletes, cloudFlareRecordID{ID: rec.ID})
+	}
+	if len(batch.Deletes) == 0 {
+		return nil
+	}
+
+	return applyBatch(ctx, c, zoneID, batch)
+}
+
+func applyBatch(ctx context.Context, c DNSProviderType, zoneID string, batch cloudFlareBatch) error {
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.makeRequest(ctx, "POST", fmt.Sprintf("/zones/%s/dns_records/batch", zoneID), bytes.NewReader(body))
+	return err
+}
+
+// listTxtRecords returns the ID of the zone containing the records, and the
+// existing TXT records with the names of the records. All of the records must
+// be in the same zone.
+func listTxtRecords(ctx context.Context, c DNSProviderType, records []util.ChallengeRecord) (string, []cloudFlareRecord, error) {
+	zone, err := FindNearestZoneForFQDN(ctx, c, records[0].FQDN)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var existing []cloudFlareRecord
+	listed := make(map[string]bool)
+	for _, record := range records {
+		name := util.UnFqdn(record.FQDN)
+		if listed[name] {
+			continue
+		}
+		listed[name] = true
+
+		result, err := c.makeRequest(
+			ctx,
+			"GET",
+			fmt.Sprintf("/zones/%s/dns_records?per_page=100&type=TXT&name=%s", zone.ID, name),
+			nil,
+		)
+		if err != nil {
+			return "", nil, err
+		}
+
+		var nameRecords []cloudFlareRecord
+		if err := json.Unmarshal(result, &nameRecords); err != nil {
+			return "", nil, err
+		}
+		existing = append(existing, nameRecords...)
+	}
+	return zone.ID, existing, nil
+}
+
+func findRecord(records []cloudFlareRecord, fqdn, content string) *cloudFlareRecord {
+	for i, rec := range records {
+		if rec.Name == util.UnFqdn(fqdn) && rec.Content == content {
+			return &records[i]
+		}
+	}
+	return nil
+}
+
 func (c *DNSProvider) getHostedZoneID(ctx context.Context, fqdn string) (string, error) {
 	hostedZone, err := FindNearestZoneForFQDN(ctx, c, fqdn)
 	if err != nil {
@@ -342,6 +461,18 @@ type cloudFlareRecord struct {
 	ZoneID  string `json:"zone_id,omitempty"`
 }
 
+// cloudFlareBatch is a request to the batch DNS records API, which applies
+// all of its changes in a single transaction.
+type cloudFlareBatch struct {
+	Deletes []cloudFlareRecordID `json:"deletes,omitempty"`
+	Posts   []cloudFlareRecord   `json:"posts,omitempty"`
+}
+
+// cloudFlareRecordID identifies a CloudFlare DNS record in a batch request
+type cloudFlareRecordID struct {
+	ID string `json:"id"`
+}
+
 // following functions are copy-pasted from go's internal
 // http server
 func validHeaderFieldValue(v string) bool {
@@ -14,6 +14,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -149,6 +150,97 @@ func TestCloudFlareCleanUp(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// batchDNSProviderFake serves a zone containing a single existing TXT record
+// and records the TXT record queries and the body of batch requests.
+type batchDNSProviderFake struct {
+	queries []string
+	batches []string
+}
+
+func (c *batchDNSProviderFake) makeRequest(_ context.Context, method, uri string, body io.Reader) (json.RawMessage, error) {
+	switch {
+	case method == "GET" && uri == "/zones?name=example.com":
+		return []byte(`[{"id":"zone-id","name":"example.com"}]`), nil
+	case method == "GET" && strings.HasPrefix(uri, "/zones?name="):
+		return []byte(`[]`), nil
+	case method == "GET" && strings.HasPrefix(uri, "/zones/zone-id/dns_records?per_page=100&type=TXT&name="):
+		name := strings.TrimPrefix(uri, "/zones/zone-id/dns_records?per_page=100&type=TXT&name=")
+		c.queries = append(c.queries, name)
+		if name == "_acme-challenge.example.com" {
+			return []byte(`[{"id":"record-id","type":"TXT","name":"_acme-challenge.example.com","content":"existing"}]`), nil
+		}
+		return []byte(`[]`), nil
+	case method == "POST" && uri == "/zones/zone-id/dns_records/batch":
+		b, err := io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		c.batches = append(c.batches, string(b))
+		return []byte(`{}`), nil
+	}
+	return nil, fmt.Errorf("unexpected request %s %s", method, uri)
+}
+
+func TestCloudFlareBatch(t *testing.T) {
+	tests := map[string]struct {
+		cleanUp     bool
+		records     []util.ChallengeRecord
+		wantQueries []string
+		wantBatches []string
+	}{
+		"present creates missing records in a single batch": {
+			records: []util.ChallengeRecord{
+				{FQDN: "_acme-challenge.example.com.", Value: "existing"},
+				{FQDN: "_acme-challenge.example.com.", Value: "new"},
+				{FQDN: "_acme-challenge.www.example.com.", Value: "new"},
+				{FQDN: "_acme-challenge.www.example.com.", Value: "new"},
+			},
+			wantQueries: []string{"_acme-challenge.example.com", "_acme-challenge.www.example.com"},
+			wantBatches: []string{
+				`{"posts":[{"name":"_acme-challenge.example.com","type":"TXT","content":"new","ttl":60},{"name":"_acme-challenge.www.example.com","type":"TXT","content":"new","ttl":60}]}`,
+			},
+		},
+		"present does nothing if all records exist": {
+			records: []util.ChallengeRecord{
+				{FQDN: "_acme-challenge.example.com.", Value: "existing"},
+			},
+			wantQueries: []string{"_acme-challenge.example.com"},
+		},
+		"clean up deletes existing records in a single batch": {
+			cleanUp: true,
+			records: []util.ChallengeRecord{
+				{FQDN: "_acme-challenge.example.com.", Value: "existing"},
+				{FQDN: "_acme-challenge.www.example.com.", Value: "deleted"},
+			},
+			wantQueries: []string{"_acme-challenge.example.com", "_acme-challenge.www.example.com"},
+			wantBatches: []string{
+				`{"deletes":[{"id":"record-id"}]}`,
+			},
+		},
+		"clean up does nothing if no records exist": {
+			cleanUp: true,
+			records: []util.ChallengeRecord{
+				{FQDN: "_acme-challenge.www.example.com.", Value: "deleted"},
+			},
+			wantQueries: []string{"_acme-challenge.www.example.com"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := &batchDNSProviderFake{}
+			var err error
+			if test.cleanUp {
+				err = cleanUpBatch(t.Context(), c, test.records)
+			} else {
+				err = presentBatch(t.Context(), c, test.records)
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.wantQueries, c.queries)
+			assert.Equal(t, test.wantBatches, c.batches)
+		})
+	}
+}
+
 func TestNewDNSProviderFromOptions(t *testing.T) {
 	tests := []struct {
 		name    string
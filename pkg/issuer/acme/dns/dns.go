@@ -41,6 +41,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/clouddns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/cloudflare"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/digitalocean"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/powerdns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/rfc2136"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/route53"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
@@ -66,6 +67,7 @@ type dnsProviderConstructors struct {
 	azureDNS     func(context.Context, ...azuredns.DNSProviderOption) (*azuredns.DNSProvider, error)
 	acmeDNS      func(context.Context, ...acmedns.DNSProviderOption) (*acmedns.DNSProvider, error)
 	digitalOcean func(context.Context, ...digitalocean.DNSProviderOption) (*digitalocean.DNSProvider, error)
+	powerDNS     func(context.Context, ...powerdns.DNSProviderOption) (*powerdns.DNSProvider, error)
 }
 
 // Solver is a solver for the acme dns01 challenge.
@@ -76,6 +78,12 @@ type Solver struct {
 	secretLister            internalinformers.SecretLister
 	dnsProviderConstructors dnsProviderConstructors
 	webhookSolvers          map[string]webhook.Solver
+
+	// batches coalesces record changes for challenges in the same zone,
+	// for solvers that support it.
+	batches batcher
+	// propagation records which records were presented in the same batch.
+	propagation propagationTracker
 }
 
 // Present performs the work to configure DNS to resolve a DNS01 challenge.
@@ -89,6 +97,15 @@ func (s *Solver) Present(ctx context.Context, _ v1.GenericIssuer, ch *cmacme.Cha
 	}
 	if err == nil {
 		log.V(logf.InfoLevel).Info("presenting DNS01 challenge for domain")
+		if bs, ok := webhookSolver.(batchWebhookSolver); ok {
+			key, change, err := s.webhookBatchedChange(ctx, "present", ch, req)
+			if err != nil {
+				return err
+			}
+			return s.presentBatched(ctx, key, change, func(_ context.Context, changes []batchedChange) error {
+				return bs.PresentBatch(challengeRequests(changes))
+			})
+		}
 		return webhookSolver.Present(req)
 	}
 
@@ -99,13 +116,23 @@ func (s *Solver) Present(ctx context.Context, _ v1.GenericIssuer, ch *cmacme.Cha
 
 	nameservers, _ := s.nameserversForProviderConfig(providerConfig)
 
-	fqdn, err := util.DNS01LookupFQDN(ctx, ch.Spec.DNSName, followCNAME(providerConfig.CNAMEStrategy), nameservers...)
+	fqdn, err := presentationFQDN(ctx, ch, providerConfig, nameservers...)
 	if err != nil {
 		return err
 	}
 
 	log.V(logf.DebugLevel).Info("presenting DNS01 challenge for domain")
 
+	if bs, ok := slv.(batchSolver); ok {
+		key, change, err := s.solverBatchedChange(ctx, "present", ch, providerConfig, fqdn, nameservers)
+		if err != nil {
+			return err
+		}
+		return s.presentBatched(ctx, key, change, func(ctx context.Context, changes []batchedChange) error {
+			return bs.PresentBatch(ctx, challengeRecords(changes))
+		})
+	}
+
 	return slv.Present(ctx, ch.Spec.DNSName, fqdn, ch.Spec.Key)
 }
 
@@ -120,15 +147,31 @@ func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.
 
 	nameservers, checkAuthoritative := s.nameserversForProviderConfig(providerConfig)
 
-	fqdn, err := util.DNS01LookupFQDN(ctx, ch.Spec.DNSName, false, nameservers...)
+	fqdn, err := challengeFQDN(ctx, ch, false, nameservers...)
 	if err != nil {
 		return err
 	}
 
 	log.V(logf.DebugLevel).Info("checking DNS propagation", "nameservers", nameservers)
 
-	ok, err := s.DNSResolver.CheckTXTRecordPropagation(ctx, fqdn, ch.Spec.Key, nameservers,
-		util.UseAuthoritative(checkAuthoritative))
+	check := func(ctx context.Context, fqdn, value string) (bool, error) {
+		return s.DNSResolver.CheckTXTRecordPropagation(ctx, fqdn, value, nameservers,
+			util.UseAuthoritative(checkAuthoritative))
+	}
+	wait := func() {
+		ttl := 60
+		log.V(logf.DebugLevel).Info("waiting DNS record TTL to allow the DNS01 record to propagate for domain", "ttl", ttl, "fqdn", fqdn)
+		time.Sleep(time.Second * time.Duration(ttl))
+		log.V(logf.DebugLevel).Info("ACME DNS01 validation record propagated", "fqdn", fqdn)
+	}
+
+	// Records presented in the same batch are checked together, so that
+	// the TTL only needs to be waited for once for the whole batch.
+	if batch := s.propagation.get(fqdn, ch.Spec.Key); batch != nil {
+		return batch.waitForPropagation(ctx, check, wait)
+	}
+
+	ok, err := check(ctx, fqdn, ch.Spec.Key)
 	if err != nil {
 		return err
 	}
@@ -136,10 +179,7 @@ func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.
 		return fmt.Errorf("DNS record for %q not yet propagated", ch.Spec.DNSName)
 	}
 
-	ttl := 60
-	log.V(logf.DebugLevel).Info("waiting DNS record TTL to allow the DNS01 record to propagate for domain", "ttl", ttl, "fqdn", fqdn)
-	time.Sleep(time.Second * time.Duration(ttl))
-	log.V(logf.DebugLevel).Info("ACME DNS01 validation record propagated", "fqdn", fqdn)
+	wait()
 
 	return nil
 }
@@ -156,6 +196,15 @@ func (s *Solver) CleanUp(ctx context.Context, ch *cmacme.Challenge) error {
 	}
 	if err == nil {
 		log.V(logf.DebugLevel).Info("cleaning up DNS01 challenge")
+		if bs, ok := webhookSolver.(batchWebhookSolver); ok {
+			key, change, err := s.webhookBatchedChange(ctx, "cleanup", ch, req)
+			if err != nil {
+				return err
+			}
+			return s.cleanUpBatched(ctx, key, change, func(_ context.Context, changes []batchedChange) error {
+				return bs.CleanUpBatch(challengeRequests(changes))
+			})
+		}
 		return webhookSolver.CleanUp(req)
 	}
 
@@ -166,11 +215,28 @@ func (s *Solver) CleanUp(ctx context.Context, ch *cmacme.Challenge) error {
 
 	nameservers, _ := s.nameserversForProviderConfig(providerConfig)
 
-	fqdn, err := util.DNS01LookupFQDN(ctx, ch.Spec.DNSName, followCNAME(providerConfig.CNAMEStrategy), nameservers...)
+	fqdn, err := presentationFQDN(ctx, ch, providerConfig, nameservers...)
+	if _, ok := errors.AsType[*util.DelegationError](err); ok {
+		// A challenge which is not delegated to the validation zone can
+		// never have been presented, or its delegation has since been
+		// removed, so there is no record in the validation zone to clean up.
+		log.V(logf.DebugLevel).Info("not cleaning up DNS01 challenge which is not delegated to the validation zone", "error", err)
+		return nil
+	}
 	if err != nil {
 		return err
 	}
 
+	if bs, ok := slv.(batchSolver); ok {
+		key, change, err := s.solverBatchedChange(ctx, "cleanup", ch, providerConfig, fqdn, nameservers)
+		if err != nil {
+			return err
+		}
+		return s.cleanUpBatched(ctx, key, change, func(ctx context.Context, changes []batchedChange) error {
+			return bs.CleanUpBatch(ctx, challengeRecords(changes))
+		})
+	}
+
 	return slv.CleanUp(ctx, ch.Spec.DNSName, fqdn, ch.Spec.Key)
 }
 
@@ -178,6 +244,68 @@ func followCNAME(strategy cmacme.CNAMEStrategy) bool {
 	return strategy == cmacme.FollowStrategy
 }
 
+// challengeFQDN returns the DNS name that the TXT record for the challenge
+// must be presented at. dns-account-01 challenges use an account-scoped name
+// so that multiple ACME accounts can solve challenges for the same domain.
+func challengeFQDN(ctx context.Context, ch *cmacme.Challenge, followCNAME bool, nameservers ...string) (string, error) {
+	if ch.Spec.Type == cmacme.ACMEChallengeTypeDNSAccount01 {
+		return util.DNSAccount01LookupFQDN(ctx, ch.Spec.DNSName, ch.Spec.AccountURI, followCNAME, nameservers...)
+	}
+	return util.DNS01LookupFQDN(ctx, ch.Spec.DNSName, followCNAME, nameservers...)
+}
+
+// presentationFQDN returns the DNS name that the TXT record for the challenge
+// is written to. If the solver has a validation zone, the challenge record must
+// be delegated to it using a CNAME record, and the name it resolves to is
+// returned.
+func presentationFQDN(ctx context.Context, ch *cmacme.Challenge, providerConfig *cmacme.ACMEChallengeSolverDNS01, nameservers ...string) (string, error) {
+	if providerConfig.ValidationZone == "" {
+		return challengeFQDN(ctx, ch, followCNAME(providerConfig.CNAMEStrategy), nameservers...)
+	}
+
+	fqdn, err := challengeFQDN(ctx, ch, false, nameservers...)
+	if err != nil {
+		return "", err
+	}
+	return util.DelegatedFQDN(ctx, fqdn, providerConfig.ValidationZone, nameservers...)
+}
+
+// zoneForFQDN returns the zone containing the challenge record at fqdn.
+func (s *Solver) zoneForFQDN(ctx context.Context, providerConfig *cmacme.ACMEChallengeSolverDNS01, fqdn string, nameservers []string) (string, error) {
+	// Records are only ever written into the validation zone when one is
+	// configured, so there is no need to look up the zone.
+	if providerConfig.ValidationZone != "" {
+		return util.ToFqdn(providerConfig.ValidationZone), nil
+	}
+	return s.DNSResolver.FindZoneByFQDN(ctx, fqdn, nameservers)
+}
+
+// solverBatchedChange returns the change to the record at fqdn for ch, and
+// the key of the batch it is added to, for solvers implementing batchSolver.
+func (s *Solver) solverBatchedChange(ctx context.Context, action string, ch *cmacme.Challenge, providerConfig *cmacme.ACMEChallengeSolverDNS01, fqdn string, nameservers []string) (string, batchedChange, error) {
+	zone, err := s.zoneForFQDN(ctx, providerConfig, fqdn, nameservers)
+	if err != nil {
+		return "", batchedChange{}, err
+	}
+	key, err := s.batchKey(action, ch, zone)
+	if err != nil {
+		return "", batchedChange{}, err
+	}
+	change, err := newBatchedChange(ctx, ch, fqdn, nil)
+	return key, change, err
+}
+
+// webhookBatchedChange returns the change to the record for req, and the key
+// of the batch it is added to, for solvers implementing batchWebhookSolver.
+func (s *Solver) webhookBatchedChange(ctx context.Context, action string, ch *cmacme.Challenge, req *whapi.ChallengeRequest) (string, batchedChange, error) {
+	key, err := s.batchKey(action, ch, req.ResolvedZone)
+	if err != nil {
+		return "", batchedChange{}, err
+	}
+	change, err := newBatchedChange(ctx, ch, req.ResolvedFQDN, req)
+	return key, change, err
+}
+
 func extractChallengeSolverConfig(ch *cmacme.Challenge) (*cmacme.ACMEChallengeSolverDNS01, error) {
 	if ch.Spec.Solver.DNS01 == nil {
 		return nil, fmt.Errorf("no dns01 challenge solver configuration found")
@@ -324,6 +452,24 @@ func (s *Solver) solverForChallenge(ctx context.Context, ch *cmacme.Challenge) (
 		if err != nil {
 			return nil, nil, fmt.Errorf("error instantiating digitalocean challenge solver: %s", err.Error())
 		}
+	case providerConfig.PowerDNS != nil:
+		dbg.Info("preparing to create PowerDNS provider")
+		apiKey, err := s.loadSecretData(&providerConfig.PowerDNS.APIKey, resourceNamespace)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting powerdns API key: %s", err)
+		}
+
+		impl, err = s.dnsProviderConstructors.powerDNS(ctx,
+			powerdns.Host(providerConfig.PowerDNS.Host),
+			powerdns.ServerID(providerConfig.PowerDNS.ServerID),
+			powerdns.APIKey(strings.TrimSpace(string(apiKey))),
+			powerdns.CABundle(providerConfig.PowerDNS.CABundle),
+			powerdns.Nameservers(nameservers),
+			powerdns.UserAgent(s.RESTConfig.UserAgent),
+			powerdns.Resolver(s.DNSResolver))
+		if err != nil {
+			return nil, nil, fmt.Errorf("error instantiating powerdns challenge solver: %s", err)
+		}
 	case providerConfig.Route53 != nil:
 		dbg.Info("preparing to create Route53 provider")
 
@@ -486,12 +632,12 @@ func (s *Solver) prepareChallengeRequest(ctx context.Context, ch *cmacme.Challen
 
 	nameservers, _ := s.nameserversForProviderConfig(dns01Config)
 
-	fqdn, err := util.DNS01LookupFQDN(ctx, ch.Spec.DNSName, followCNAME(dns01Config.CNAMEStrategy), nameservers...)
+	fqdn, err := presentationFQDN(ctx, ch, dns01Config, nameservers...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	zone, err := s.DNSResolver.FindZoneByFQDN(ctx, fqdn, nameservers)
+	zone, err := s.zoneForFQDN(ctx, dns01Config, fqdn, nameservers)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -579,8 +725,10 @@ func NewSolver(ctx *controller.Context) (*Solver, error) {
 			azuredns.NewDNSProviderFromOptions,
 			acmedns.NewDNSProviderFromOptions,
 			digitalocean.NewDNSProviderFromOptions,
+			powerdns.NewDNSProviderFromOptions,
 		},
 		webhookSolvers: initialized,
+		batches:        batcher{window: defaultBatchWindow},
 	}, nil
 }
 
@@ -32,6 +32,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/acmedns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/cloudflare"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/digitalocean"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/powerdns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/route53"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
@@ -452,6 +453,71 @@ func TestSolveForDigitalOcean(t *testing.T) {
 
 }
 
+func TestSolveForPowerDNS(t *testing.T) {
+	t.Parallel()
+	f := &solverFixture{
+		Builder: &test.Builder{
+			KubeObjects: []runtime.Object{
+				newSecret("powerdns", map[string][]byte{
+					"api-key": []byte("FAKE-API-KEY\n"),
+				}, fakeIssuerNamespace),
+			},
+		},
+		Challenge: &cmacme.Challenge{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: fakeIssuerNamespace,
+			},
+			Spec: cmacme.ChallengeSpec{
+				Solver: cmacme.ACMEChallengeSolver{
+					DNS01: &cmacme.ACMEChallengeSolverDNS01{
+						PowerDNS: &cmacme.ACMEIssuerDNS01ProviderPowerDNS{
+							Host:     "https://pdns.example.com:8081",
+							ServerID: "localhost",
+							APIKey: cmmeta.SecretKeySelector{
+								LocalObjectReference: cmmeta.LocalObjectReference{
+									Name: "powerdns",
+								},
+								Key: "api-key",
+							},
+						},
+					},
+				},
+				IssuerRef: cmmeta.IssuerReference{
+					Name: "test-issuer",
+				},
+			},
+		},
+		dnsProviders: newFakeDNSProviders(),
+	}
+
+	f.Setup(t)
+	defer f.Finish(t)
+
+	s := f.Solver
+	_, _, err := s.solverForChallenge(t.Context(), f.Challenge)
+	if err != nil {
+		t.Fatalf("expected solverFor to not error, but got: %s", err)
+	}
+
+	expectedCall := []fakeDNSProviderCall{
+		{
+			name: "powerdns",
+			args: []any{powerdns.DNSProviderOptions{
+				Host:        "https://pdns.example.com:8081",
+				ServerID:    "localhost",
+				APIKey:      "FAKE-API-KEY",
+				Nameservers: s.DNS01Nameservers,
+				UserAgent:   s.RESTConfig.UserAgent,
+				Resolver:    s.DNSResolver,
+			}},
+		},
+	}
+
+	if !reflect.DeepEqual(expectedCall, f.dnsProviders.calls) {
+		t.Fatalf("expected %+v == %+v", expectedCall, f.dnsProviders.calls)
+	}
+}
+
 func TestRoute53TrimCreds(t *testing.T) {
 	t.Parallel()
 	f := &solverFixture{
@@ -925,6 +991,49 @@ func TestNameserversForProviderConfig(t *testing.T) {
 	}
 }
 
+func TestChallengeFQDN(t *testing.T) {
+	tests := map[string]struct {
+		spec     cmacme.ChallengeSpec
+		wantFQDN string
+		wantErr  bool
+	}{
+		"dns-01 challenges use the _acme-challenge name": {
+			spec: cmacme.ChallengeSpec{
+				Type:    cmacme.ACMEChallengeTypeDNS01,
+				DNSName: "example.com",
+			},
+			wantFQDN: "_acme-challenge.example.com.",
+		},
+		"dns-account-01 challenges use the account scoped name": {
+			spec: cmacme.ChallengeSpec{
+				Type:       cmacme.ACMEChallengeTypeDNSAccount01,
+				DNSName:    "example.org",
+				AccountURI: "https://example.com/acme/acct/ExampleAccount",
+			},
+			wantFQDN: "_ujmmovf2vn55tgye._acme-challenge.example.org.",
+		},
+		"dns-account-01 challenges without an account URI fail": {
+			spec: cmacme.ChallengeSpec{
+				Type:    cmacme.ACMEChallengeTypeDNSAccount01,
+				DNSName: "example.org",
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fqdn, err := challengeFQDN(t.Context(), &cmacme.Challenge{Spec: tt.spec}, false)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if fqdn != tt.wantFQDN {
+				t.Errorf("fqdn: got %q, want %q", fqdn, tt.wantFQDN)
+			}
+		})
+	}
+}
+
 func TestRoute53AssumeRole(t *testing.T) {
 	t.Parallel()
 	type result struct {
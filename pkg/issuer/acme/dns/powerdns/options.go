@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerdns
+
+import (
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+// DNSProviderOptions holds the full configuration for the PowerDNS DNS provider.
+type DNSProviderOptions struct {
+	// Host is the base URL of the PowerDNS HTTP API.
+	Host string
+	// ServerID is the ID of the PowerDNS server that hosts the zones.
+	ServerID string
+	// APIKey is the PowerDNS HTTP API key.
+	APIKey string
+	// CABundle is a PEM bundle used to verify the PowerDNS HTTP API's certificate.
+	CABundle []byte
+	// Nameservers is the list of nameservers used for DNS-01 propagation checks.
+	Nameservers []string
+	// UserAgent is the HTTP User-Agent string sent to the PowerDNS HTTP API.
+	UserAgent string
+	// Resolver performs DNS lookups during challenge verification.
+	Resolver util.Resolver
+}
+
+// DNSProviderOption is a functional option for configuring a DNSProvider.
+type DNSProviderOption interface {
+	ApplyToDNSProviderOptions(*DNSProviderOptions)
+}
+
+// Host sets the base URL of the PowerDNS HTTP API on DNSProviderOptions.
+type Host string
+
+// ApplyToDNSProviderOptions sets the Host field.
+func (h Host) ApplyToDNSProviderOptions(o *DNSProviderOptions) {
+	o.Host = string(h)
+}
+
+// ServerID sets the PowerDNS server ID on DNSProviderOptions.
+type ServerID string
+
+// ApplyToDNSProviderOptions sets the ServerID field.
+func (s ServerID) ApplyToDNSProviderOptions(o *DNSProviderOptions) {
+	o.ServerID = string(s)
+}
+
+// APIKey sets the PowerDNS HTTP API key on DNSProviderOptions.
+type APIKey string
+
+// ApplyToDNSProviderOptions sets the APIKey field.
+func (k APIKey) ApplyToDNSProviderOptions(o *DNSProviderOptions) {
+	o.APIKey = string(k)
+}
+
+// CABundle sets the PEM bundle used to verify the PowerDNS HTTP API's
+// certificate on DNSProviderOptions.
+type CABundle []byte
+
+// ApplyToDNSProviderOptions sets the CABundle field.
+func (c CABundle) ApplyToDNSProviderOptions(o *DNSProviderOptions) {
+	o.CABundle = []byte(c)
+}
+
+// Nameservers sets the DNS nameservers used for propagation checks on DNSProviderOptions.
+type Nameservers []string
+
+// ApplyToDNSProviderOptions sets the Nameservers field.
+func (n Nameservers) ApplyToDNSProviderOptions(o *DNSProviderOptions) {
+	o.Nameservers = []string(n)
+}
+
+// UserAgent sets the HTTP User-Agent string on DNSProviderOptions.
+type UserAgent string
+
+// ApplyToDNSProviderOptions sets the UserAgent field.
+func (u UserAgent) ApplyToDNSProviderOptions(o *DNSProviderOptions) {
+	o.UserAgent = string(u)
+}
+
+// WithResolver sets the Resolver used for DNS lookups on DNSProviderOptions.
+type WithResolver struct{ util.Resolver }
+
+// ApplyToDNSProviderOptions sets the Resolver field.
+func (r WithResolver) ApplyToDNSProviderOptions(o *DNSProviderOptions) {
+	o.Resolver = r.Resolver
+}
+
+// Resolver sets the Resolver used for DNS lookups on DNSProviderOptions.
+func Resolver(r util.Resolver) WithResolver {
+	return WithResolver{Resolver: r}
+}
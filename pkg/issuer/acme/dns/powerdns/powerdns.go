@@ -0,0 +1,275 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package powerdns implements a DNS provider for solving the DNS-01
+// challenge using the PowerDNS Authoritative Server HTTP API.
+package powerdns
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	utiloptions "github.com/cert-manager/cert-manager/internal/options"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+const (
+	// defaultServerID is the only server ID supported by the PowerDNS
+	// Authoritative Server.
+	defaultServerID = "localhost"
+
+	// recordTTL is the TTL of the TXT records created by the provider.
+	recordTTL = 60
+
+	// requestTimeout bounds each request to the PowerDNS HTTP API.
+	requestTimeout = 30 * time.Second
+)
+
+// DNSProvider is an implementation of the acme.ChallengeProvider interface
+type DNSProvider struct {
+	dns01Nameservers []string
+	client           *http.Client
+	baseURL          *url.URL
+	serverID         string
+	apiKey           string
+	userAgent        string
+	resolver         util.Resolver
+}
+
+// NewDNSProviderFromOptions constructs an ACME DNS provider for PowerDNS.
+//
+// All options are passed via the variadic options parameter.
+//
+// Required options:
+// - Host
+// - APIKey
+// - Nameservers
+// - Resolver
+func NewDNSProviderFromOptions(_ context.Context, options ...DNSProviderOption) (*DNSProvider, error) {
+	var opt DNSProviderOptions
+	for _, o := range options {
+		o.ApplyToDNSProviderOptions(&opt)
+	}
+
+	err := errors.Join(
+		utiloptions.Required(&opt.Host, "PowerDNS host missing"),
+		utiloptions.Required(&opt.APIKey, "PowerDNS API key missing"),
+		utiloptions.Required(&opt.Resolver, "resolver is required"),
+		utiloptions.NotEmpty(&opt.Nameservers, "nameservers is required"),
+		utiloptions.Default(&opt.ServerID, defaultServerID),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL, err := url.Parse(opt.Host)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PowerDNS host %q: %w", opt.Host, err)
+	}
+	if baseURL.Scheme != "http" && baseURL.Scheme != "https" {
+		return nil, fmt.Errorf("invalid PowerDNS host %q: scheme must be http or https", opt.Host)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(opt.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(opt.CABundle) {
+			return nil, errors.New("PowerDNS CA bundle didn't contain any valid certificates")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	return &DNSProvider{
+		dns01Nameservers: opt.Nameservers,
+		client:           &http.Client{Transport: transport, Timeout: requestTimeout},
+		baseURL:          baseURL,
+		serverID:         opt.ServerID,
+		apiKey:           opt.APIKey,
+		userAgent:        opt.UserAgent,
+		resolver:         opt.Resolver,
+	}, nil
+}
+
+// Present creates a TXT record to fulfil the dns-01 challenge
+func (c *DNSProvider) Present(ctx context.Context, _, fqdn, value string) error {
+	// if PowerDNS does not have this zone then we will find out later
+	zoneName, err := c.resolver.FindZoneByFQDN(ctx, fqdn, c.dns01Nameservers)
+	if err != nil {
+		return err
+	}
+
+	rrset, err := c.findTxtRRSet(ctx, zoneName, fqdn)
+	if err != nil {
+		return err
+	}
+
+	content := strconv.Quote(value)
+	if slices.ContainsFunc(rrset.Records, func(r record) bool { return r.Content == content }) {
+		return nil
+	}
+
+	// PowerDNS replaces whole RRSets, so the existing records of this name
+	// must be kept to avoid removing other challenges' values, for example
+	// those of concurrent orders for example.com and *.example.com.
+	rrset.Records = append(rrset.Records, record{Content: content})
+	rrset.ChangeType = "REPLACE"
+	if rrset.TTL == 0 {
+		rrset.TTL = recordTTL
+	}
+
+	return c.patchRRSet(ctx, zoneName, rrset)
+}
+
+// CleanUp removes the TXT record matching the specified parameters
+func (c *DNSProvider) CleanUp(ctx context.Context, _, fqdn, value string) error {
+	zoneName, err := c.resolver.FindZoneByFQDN(ctx, fqdn, c.dns01Nameservers)
+	if err != nil {
+		return err
+	}
+
+	rrset, err := c.findTxtRRSet(ctx, zoneName, fqdn)
+	if err != nil {
+		return err
+	}
+
+	// Only delete the record holding this challenge's value, leaving any
+	// sibling challenge's record of the same name in place.
+	content := strconv.Quote(value)
+	records := slices.DeleteFunc(slices.Clone(rrset.Records), func(r record) bool { return r.Content == content })
+	if len(records) == len(rrset.Records) {
+		return nil
+	}
+
+	rrset.Records = records
+	rrset.ChangeType = "REPLACE"
+	if len(records) == 0 {
+		rrset.ChangeType = "DELETE"
+	}
+
+	return c.patchRRSet(ctx, zoneName, rrset)
+}
+
+// zone is the subset of the PowerDNS zone resource used by the provider.
+type zone struct {
+	RRSets []rrset `json:"rrsets"`
+}
+
+type rrset struct {
+	Name       string   `json:"name"`
+	Type       string   `json:"type"`
+	TTL        int      `json:"ttl,omitempty"`
+	ChangeType string   `json:"changetype,omitempty"`
+	Records    []record `json:"records"`
+}
+
+type record struct {
+	Content  string `json:"content"`
+	Disabled bool   `json:"disabled"`
+}
+
+// apiError is the error returned by the PowerDNS HTTP API.
+type apiError struct {
+	StatusCode int
+	Message    string `json:"error"`
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("PowerDNS API returned %d: %s", e.StatusCode, e.Message)
+}
+
+// findTxtRRSet returns the TXT RRSet named fqdn in zoneName. If there is no
+// such RRSet, an empty one with the right name and type is returned.
+func (c *DNSProvider) findTxtRRSet(ctx context.Context, zoneName, fqdn string) (rrset, error) {
+	name := util.ToFqdn(fqdn)
+
+	// The rrset_name and rrset_type filters are ignored by versions of
+	// PowerDNS older than 4.8, so the RRSets are also filtered here.
+	query := url.Values{"rrset_name": {name}, "rrset_type": {"TXT"}}
+	var z zone
+	if err := c.do(ctx, http.MethodGet, zoneName, query, nil, &z); err != nil {
+		return rrset{}, err
+	}
+
+	for _, set := range z.RRSets {
+		if set.Type == "TXT" && set.Name == name {
+			return set, nil
+		}
+	}
+	return rrset{Name: name, Type: "TXT"}, nil
+}
+
+func (c *DNSProvider) patchRRSet(ctx context.Context, zoneName string, set rrset) error {
+	return c.do(ctx, http.MethodPatch, zoneName, nil, zone{RRSets: []rrset{set}}, nil)
+}
+
+// do sends a request to the zone resource of zoneName, and decodes the
+// response into out if it is not nil.
+func (c *DNSProvider) do(ctx context.Context, method, zoneName string, query url.Values, in, out any) error {
+	u := c.baseURL.JoinPath("api", "v1", "servers", c.serverID, "zones", util.ToFqdn(zoneName))
+	u.RawQuery = query.Encode()
+
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-API-Key", c.apiKey)
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		apiErr := &apiError{StatusCode: resp.StatusCode}
+		if err := json.NewDecoder(resp.Body).Decode(apiErr); err != nil || apiErr.Message == "" {
+			apiErr.Message = http.StatusText(resp.StatusCode)
+		}
+		return apiErr
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
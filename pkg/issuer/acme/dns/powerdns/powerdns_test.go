@@ -0,0 +1,325 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerdns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+func TestNewDNSProviderFromOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []DNSProviderOption
+		wantErr string
+	}{
+		{
+			name: "valid options",
+			options: []DNSProviderOption{
+				Host("https://pdns.example.com:8081"),
+				APIKey("123"),
+				Nameservers(util.RecursiveNameservers),
+				UserAgent("cert-manager-test"),
+				Resolver(util.NewCachingResolver()),
+			},
+		},
+		{
+			name: "missing host",
+			options: []DNSProviderOption{
+				APIKey("123"),
+				Nameservers(util.RecursiveNameservers),
+				Resolver(util.NewCachingResolver()),
+			},
+			wantErr: "PowerDNS host missing",
+		},
+		{
+			name: "host without http scheme",
+			options: []DNSProviderOption{
+				Host("pdns.example.com:8081"),
+				APIKey("123"),
+				Nameservers(util.RecursiveNameservers),
+				Resolver(util.NewCachingResolver()),
+			},
+			wantErr: `invalid PowerDNS host "pdns.example.com:8081": scheme must be http or https`,
+		},
+		{
+			name: "missing API key",
+			options: []DNSProviderOption{
+				Host("https://pdns.example.com:8081"),
+				Nameservers(util.RecursiveNameservers),
+				Resolver(util.NewCachingResolver()),
+			},
+			wantErr: "PowerDNS API key missing",
+		},
+		{
+			name: "invalid CA bundle",
+			options: []DNSProviderOption{
+				Host("https://pdns.example.com:8081"),
+				APIKey("123"),
+				CABundle("not a certificate"),
+				Nameservers(util.RecursiveNameservers),
+				Resolver(util.NewCachingResolver()),
+			},
+			wantErr: "PowerDNS CA bundle didn't contain any valid certificates",
+		},
+		{
+			name: "missing resolver",
+			options: []DNSProviderOption{
+				Host("https://pdns.example.com:8081"),
+				APIKey("123"),
+				Nameservers(util.RecursiveNameservers),
+			},
+			wantErr: "resolver is required",
+		},
+		{
+			name: "missing nameservers",
+			options: []DNSProviderOption{
+				Host("https://pdns.example.com:8081"),
+				APIKey("123"),
+				Resolver(util.NewCachingResolver()),
+			},
+			wantErr: "nameservers is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewDNSProviderFromOptions(t.Context(), tt.options...)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// fakeResolver is a util.Resolver stub that always resolves to a fixed
+// zone, so tests don't need to perform real DNS lookups.
+type fakeResolver struct {
+	zone string
+}
+
+func (f fakeResolver) FindZoneByFQDN(_ context.Context, _ string, _ []string) (string, error) {
+	return f.zone, nil
+}
+
+func (f fakeResolver) LookupAuthoritativeNameservers(_ context.Context, _ string, _ []string) ([]string, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (f fakeResolver) CheckTXTRecordPropagation(_ context.Context, _, _ string, _ []string, _ util.UseAuthoritative) (bool, error) {
+	return false, fmt.Errorf("not implemented")
+}
+
+const (
+	testZone   = "example.com."
+	testFQDN   = "_acme-challenge.example.com."
+	testAPIKey = "fake-api-key"
+)
+
+// fakePDNSServer is a minimal in-memory stand-in for the zone resource of
+// the PowerDNS HTTP API. It supports fetching a zone filtered by RRSet name
+// and type, and replacing or deleting RRSets.
+type fakePDNSServer struct {
+	t *testing.T
+
+	mu      sync.Mutex
+	rrsets  map[string]rrset
+	patches []rrset
+}
+
+func newFakePDNSServer(t *testing.T, rrsets ...rrset) (*httptest.Server, *fakePDNSServer) {
+	t.Helper()
+
+	s := &fakePDNSServer{t: t, rrsets: map[string]rrset{}}
+	for _, set := range rrsets {
+		s.rrsets[set.Name+"/"+set.Type] = set
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/servers/localhost/zones/"+testZone, s.handleGet)
+	mux.HandleFunc("PATCH /api/v1/servers/localhost/zones/"+testZone, s.handlePatch)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-API-Key") != testAPIKey {
+			w.WriteHeader(http.StatusUnauthorized)
+			assert.NoError(t, json.NewEncoder(w).Encode(map[string]string{"error": "Unauthorized"}))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, s
+}
+
+func (s *fakePDNSServer) handleGet(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	assert.Equal(s.t, "TXT", q.Get("rrset_type"))
+	assert.Equal(s.t, testFQDN, q.Get("rrset_name"))
+
+	s.mu.Lock()
+	var z zone
+	if set, ok := s.rrsets[q.Get("rrset_name")+"/"+q.Get("rrset_type")]; ok {
+		z.RRSets = append(z.RRSets, set)
+	}
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	assert.NoError(s.t, json.NewEncoder(w).Encode(z))
+}
+
+func (s *fakePDNSServer) handlePatch(w http.ResponseWriter, r *http.Request) {
+	var z zone
+	if !assert.NoError(s.t, json.NewDecoder(r.Body).Decode(&z)) {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, set := range z.RRSets {
+		s.patches = append(s.patches, set)
+		key := set.Name + "/" + set.Type
+		switch set.ChangeType {
+		case "REPLACE":
+			set.ChangeType = ""
+			s.rrsets[key] = set
+		case "DELETE":
+			delete(s.rrsets, key)
+		default:
+			s.t.Errorf("unexpected changetype %q", set.ChangeType)
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *fakePDNSServer) rrset() (rrset, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	set, ok := s.rrsets[testFQDN+"/TXT"]
+	return set, ok
+}
+
+func newTestProvider(t *testing.T, serverURL, apiKey string) *DNSProvider {
+	t.Helper()
+
+	provider, err := NewDNSProviderFromOptions(t.Context(),
+		Host(serverURL),
+		APIKey(apiKey),
+		Nameservers(util.RecursiveNameservers),
+		UserAgent("cert-manager-test"),
+		Resolver(fakeResolver{zone: testZone}),
+	)
+	require.NoError(t, err)
+	return provider
+}
+
+func TestPresentCreatesRRSet(t *testing.T) {
+	server, fake := newFakePDNSServer(t)
+	provider := newTestProvider(t, server.URL, testAPIKey)
+
+	require.NoError(t, provider.Present(t.Context(), "example.com", testFQDN, "value"))
+
+	set, ok := fake.rrset()
+	require.True(t, ok)
+	assert.Equal(t, recordTTL, set.TTL)
+	assert.Equal(t, []record{{Content: `"value"`}}, set.Records)
+}
+
+// TestPresentKeepsOtherRecords checks that Present does not replace the TXT
+// records of other challenges sharing the same name, as PowerDNS replaces
+// whole RRSets.
+func TestPresentKeepsOtherRecords(t *testing.T) {
+	server, fake := newFakePDNSServer(t, rrset{
+		Name: testFQDN, Type: "TXT", TTL: 120,
+		Records: []record{{Content: `"other"`}},
+	})
+	provider := newTestProvider(t, server.URL, testAPIKey)
+
+	require.NoError(t, provider.Present(t.Context(), "example.com", testFQDN, "value"))
+
+	set, ok := fake.rrset()
+	require.True(t, ok)
+	assert.Equal(t, 120, set.TTL)
+	assert.Equal(t, []record{{Content: `"other"`}, {Content: `"value"`}}, set.Records)
+}
+
+func TestPresentIsIdempotent(t *testing.T) {
+	server, fake := newFakePDNSServer(t, rrset{
+		Name: testFQDN, Type: "TXT", TTL: 60,
+		Records: []record{{Content: `"value"`}},
+	})
+	provider := newTestProvider(t, server.URL, testAPIKey)
+
+	require.NoError(t, provider.Present(t.Context(), "example.com", testFQDN, "value"))
+	assert.Empty(t, fake.patches)
+}
+
+func TestCleanUpOnlyDeletesMatchingValue(t *testing.T) {
+	server, fake := newFakePDNSServer(t, rrset{
+		Name: testFQDN, Type: "TXT", TTL: 60,
+		Records: []record{{Content: `"other"`}, {Content: `"value"`}},
+	})
+	provider := newTestProvider(t, server.URL, testAPIKey)
+
+	require.NoError(t, provider.CleanUp(t.Context(), "example.com", testFQDN, "value"))
+
+	set, ok := fake.rrset()
+	require.True(t, ok)
+	assert.Equal(t, []record{{Content: `"other"`}}, set.Records)
+}
+
+func TestCleanUpDeletesEmptyRRSet(t *testing.T) {
+	server, fake := newFakePDNSServer(t, rrset{
+		Name: testFQDN, Type: "TXT", TTL: 60,
+		Records: []record{{Content: `"value"`}},
+	})
+	provider := newTestProvider(t, server.URL, testAPIKey)
+
+	require.NoError(t, provider.CleanUp(t.Context(), "example.com", testFQDN, "value"))
+
+	_, ok := fake.rrset()
+	assert.False(t, ok)
+}
+
+func TestCleanUpMissingRecord(t *testing.T) {
+	server, fake := newFakePDNSServer(t)
+	provider := newTestProvider(t, server.URL, testAPIKey)
+
+	require.NoError(t, provider.CleanUp(t.Context(), "example.com", testFQDN, "value"))
+	assert.Empty(t, fake.patches)
+}
+
+func TestAPIError(t *testing.T) {
+	server, _ := newFakePDNSServer(t)
+	provider := newTestProvider(t, server.URL, "wrong-api-key")
+
+	err := provider.Present(t.Context(), "example.com", testFQDN, "value")
+	assert.EqualError(t, err, "PowerDNS API returned 401: Unauthorized")
+}
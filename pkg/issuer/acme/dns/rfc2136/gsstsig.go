@@ -0,0 +1,330 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rfc2136
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/jcmturner/gokrb5/v8/client"
+	"github.com/jcmturner/gokrb5/v8/config"
+	krbcrypto "github.com/jcmturner/gokrb5/v8/crypto"
+	"github.com/jcmturner/gokrb5/v8/gssapi"
+	"github.com/jcmturner/gokrb5/v8/iana/flags"
+	"github.com/jcmturner/gokrb5/v8/iana/keyusage"
+	"github.com/jcmturner/gokrb5/v8/keytab"
+	"github.com/jcmturner/gokrb5/v8/messages"
+	"github.com/jcmturner/gokrb5/v8/spnego"
+	"github.com/jcmturner/gokrb5/v8/types"
+	"github.com/miekg/dns"
+)
+
+const (
+	// gssTSIGAlgorithm is the TSIG algorithm name used by GSS-TSIG, see
+	// RFC3645 section 2.
+	gssTSIGAlgorithm = "gss-tsig."
+
+	// tkeyModeGSSAPI is the TKEY mode used to negotiate a GSS-API security
+	// context, see RFC2930 section 2.5.
+	tkeyModeGSSAPI = 3
+
+	// gssTSIGContextLifetime is the lifetime requested for negotiated
+	// security contexts. A new context is negotiated for every update, so
+	// this only needs to cover a single exchange.
+	gssTSIGContextLifetime = time.Hour
+)
+
+// GSSTSIGConfig holds the Kerberos configuration used to authenticate dynamic
+// updates using GSS-TSIG.
+type GSSTSIGConfig struct {
+	// Realm is the Kerberos realm of the principal.
+	Realm string
+	// Username is the name of the principal, without the realm.
+	Username string
+	// Keytab is the content of a keytab holding the keys of the principal.
+	Keytab []byte
+	// KDCs are the addresses of the realm's Key Distribution Centers. If
+	// empty, KDCs are discovered using DNS SRV records.
+	KDCs []string
+	// ServicePrincipalName is the service principal name of the DNS server.
+	// Defaults to DNS/<host>, where <host> is the host of the nameserver.
+	ServicePrincipalName string
+}
+
+// gssTSIG negotiates GSS-API security contexts with a DNS server, as described
+// in RFC3645, and uses them to sign dynamic updates.
+// Only Kerberos encryption types using the RFC4121 token format, such as AES,
+// are supported.
+type gssTSIG struct {
+	realm    string
+	username string
+	keytab   *keytab.Keytab
+	krb5conf *config.Config
+	spn      string
+
+	// serviceTicket obtains a ticket for the DNS server. It is replaced in
+	// tests, which cannot rely on a Key Distribution Center.
+	serviceTicket func(cl *client.Client, spn string) (messages.Ticket, types.EncryptionKey, error)
+}
+
+func newGSSTSIG(cfg GSSTSIGConfig, nameserver string) (*gssTSIG, error) {
+	if cfg.Realm == "" || cfg.Username == "" {
+		return nil, fmt.Errorf("GSS-TSIG realm and username must be specified")
+	}
+
+	kt := keytab.New()
+	if err := kt.Unmarshal(cfg.Keytab); err != nil {
+		return nil, fmt.Errorf("error parsing GSS-TSIG keytab: %w", err)
+	}
+
+	realm := strings.ToUpper(cfg.Realm)
+	krb5conf := config.New()
+	krb5conf.LibDefaults.DefaultRealm = realm
+	krb5conf.LibDefaults.DNSLookupKDC = len(cfg.KDCs) == 0
+	krb5conf.Realms = []config.Realm{{Realm: realm, KDC: cfg.KDCs}}
+
+	spn := cfg.ServicePrincipalName
+	if spn == "" {
+		host, _, err := net.SplitHostPort(nameserver)
+		if err != nil {
+			return nil, err
+		}
+		spn = "DNS/" + host
+	}
+
+	return &gssTSIG{
+		realm:         realm,
+		username:      cfg.Username,
+		keytab:        kt,
+		krb5conf:      krb5conf,
+		spn:           spn,
+		serviceTicket: loginServiceTicket,
+	}, nil
+}
+
+func loginServiceTicket(cl *client.Client, spn string) (messages.Ticket, types.EncryptionKey, error) {
+	if err := cl.Login(); err != nil {
+		return messages.Ticket{}, types.EncryptionKey{}, fmt.Errorf("error logging in to Kerberos realm: %w", err)
+	}
+	return cl.GetServiceTicket(spn)
+}
+
+// negotiate establishes a security context with nameserver using a TKEY
+// exchange, and returns a TSIG provider signing messages using it.
+func (g *gssTSIG) negotiate(nameserver string) (*gssTSIGContext, error) {
+	// Active Directory domain controllers don't support FAST armoring.
+	cl := client.NewWithKeytab(g.username, g.realm, g.keytab, g.krb5conf, client.DisablePAFXFAST(true))
+	defer cl.Destroy()
+
+	tkt, sessionKey, err := g.serviceTicket(cl, g.spn)
+	if err != nil {
+		return nil, fmt.Errorf("error obtaining Kerberos service ticket for %q: %w", g.spn, err)
+	}
+
+	apReq, err := spnego.NewKRB5TokenAPREQ(cl, tkt, sessionKey,
+		[]int{gssapi.ContextFlagMutual, gssapi.ContextFlagInteg},
+		[]int{flags.APOptionMutualRequired})
+	if err != nil {
+		return nil, err
+	}
+	token, err := apReq.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	// The authenticator is needed to check the server's reply and to get the
+	// initial sequence number of the context.
+	if err := apReq.APReq.DecryptAuthenticator(sessionKey); err != nil {
+		return nil, err
+	}
+	auth := apReq.APReq.Authenticator
+
+	keyName, err := gssTSIGKeyName()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	m := new(dns.Msg)
+	m.SetQuestion(keyName, dns.TypeTKEY)
+	m.RecursionDesired = false
+	m.Question[0].Qclass = dns.ClassANY
+	m.Extra = []dns.RR{&dns.TKEY{
+		Hdr:        dns.RR_Header{Name: keyName, Rrtype: dns.TypeTKEY, Class: dns.ClassANY},
+		Algorithm:  gssTSIGAlgorithm,
+		Mode:       tkeyModeGSSAPI,
+		Inception:  uint32(now.Unix()),
+		Expiration: uint32(now.Add(gssTSIGContextLifetime).Unix()),
+		KeySize:    uint16(len(token)),
+		Key:        hex.EncodeToString(token),
+	}}
+
+	// Kerberos tokens don't usually fit in UDP messages, so the exchange is
+	// always made over TCP. The reply is read without being unpacked by
+	// dns.Conn, as its TSIG can only be verified once the context is
+	// established.
+	conn, err := dns.DialTimeout("tcp", nameserver, exchangeTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(now.Add(exchangeTimeout)); err != nil {
+		return nil, err
+	}
+	if err := conn.WriteMsg(m); err != nil {
+		return nil, err
+	}
+	buf, err := conn.ReadMsgHeader(nil)
+	if err != nil {
+		return nil, err
+	}
+	reply := new(dns.Msg)
+	if err := reply.Unpack(buf); err != nil {
+		return nil, err
+	}
+	if reply.Rcode != dns.RcodeSuccess {
+		return nil, fmt.Errorf("GSS-TSIG negotiation failed. Server replied: %s", dns.RcodeToString[reply.Rcode])
+	}
+
+	var tkey *dns.TKEY
+	for _, rr := range reply.Answer {
+		if rr, ok := rr.(*dns.TKEY); ok && strings.EqualFold(rr.Hdr.Name, keyName) {
+			tkey = rr
+		}
+	}
+	if tkey == nil {
+		return nil, fmt.Errorf("GSS-TSIG negotiation failed. Server did not reply with a TKEY record")
+	}
+	if tkey.Error != dns.RcodeSuccess {
+		return nil, fmt.Errorf("GSS-TSIG negotiation failed. Server replied: %s", dns.RcodeToString[int(tkey.Error)])
+	}
+
+	ctx, err := acceptContext(tkey, keyName, sessionKey, auth)
+	if err != nil {
+		return nil, err
+	}
+	// The server should sign its final reply, proving it holds the key of
+	// the security context.
+	if reply.IsTsig() != nil {
+		if err := dns.TsigVerifyWithProvider(buf, ctx, "", false); err != nil {
+			return nil, fmt.Errorf("error verifying GSS-TSIG negotiation reply: %w", err)
+		}
+	}
+
+	return ctx, nil
+}
+
+// acceptContext checks the AP-REP returned by the server in reply to the
+// AP-REQ holding auth, and returns the established security context.
+func acceptContext(tkey *dns.TKEY, keyName string, sessionKey types.EncryptionKey, auth types.Authenticator) (*gssTSIGContext, error) {
+	b, err := hex.DecodeString(tkey.Key)
+	if err != nil {
+		return nil, err
+	}
+	var token spnego.KRB5Token
+	if err := token.Unmarshal(b); err != nil {
+		return nil, err
+	}
+	if token.IsKRBError() {
+		return nil, fmt.Errorf("GSS-TSIG negotiation failed: %w", token.KRBError)
+	}
+	if !token.IsAPRep() {
+		// Multiple round trips are not needed by Kerberos.
+		return nil, fmt.Errorf("GSS-TSIG negotiation failed. Server did not reply with an AP-REP")
+	}
+
+	plain, err := krbcrypto.DecryptEncPart(token.APRep.EncPart, sessionKey, keyusage.AP_REP_ENCPART)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting AP-REP: %w", err)
+	}
+	var encPart messages.EncAPRepPart
+	if err := encPart.Unmarshal(plain); err != nil {
+		return nil, err
+	}
+	if !encPart.CTime.Equal(auth.CTime.Truncate(time.Second)) || encPart.Cusec != auth.Cusec {
+		return nil, fmt.Errorf("GSS-TSIG negotiation failed. AP-REP does not match AP-REQ")
+	}
+
+	ctx := &gssTSIGContext{keyName: keyName, key: sessionKey}
+	if encPart.Subkey.KeyType != 0 {
+		ctx.key = encPart.Subkey
+		ctx.acceptorSubkey = true
+	}
+	ctx.seqNum.Store(uint64(auth.SeqNumber))
+	return ctx, nil
+}
+
+func gssTSIGKeyName() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return dns.Fqdn(hex.EncodeToString(b) + ".sig-cert-manager"), nil
+}
+
+// gssTSIGContext is an established GSS-API security context, implementing
+// github.com/miekg/dns.TsigProvider using RFC4121 MIC tokens.
+type gssTSIGContext struct {
+	keyName        string
+	key            types.EncryptionKey
+	acceptorSubkey bool
+	seqNum         atomic.Uint64
+}
+
+var _ dns.TsigProvider = &gssTSIGContext{}
+
+// Generate returns a MIC token over msg, as sent by the context initiator.
+func (c *gssTSIGContext) Generate(msg []byte, t *dns.TSIG) ([]byte, error) {
+	if dns.CanonicalName(t.Hdr.Name) != dns.CanonicalName(c.keyName) || dns.CanonicalName(t.Algorithm) != gssTSIGAlgorithm {
+		return nil, dns.ErrKeyAlg
+	}
+	mic := &gssapi.MICToken{
+		SndSeqNum: c.seqNum.Add(1) - 1,
+		Payload:   msg,
+	}
+	if c.acceptorSubkey {
+		mic.Flags = gssapi.MICTokenFlagAcceptorSubkey
+	}
+	if err := mic.SetChecksum(c.key, keyusage.GSSAPI_INITIATOR_SIGN); err != nil {
+		return nil, err
+	}
+	return mic.Marshal()
+}
+
+// Verify checks that the MIC token of t was sent by the context acceptor over
+// msg.
+func (c *gssTSIGContext) Verify(msg []byte, t *dns.TSIG) error {
+	if dns.CanonicalName(t.Hdr.Name) != dns.CanonicalName(c.keyName) || dns.CanonicalName(t.Algorithm) != gssTSIGAlgorithm {
+		return dns.ErrKeyAlg
+	}
+	b, err := hex.DecodeString(t.MAC)
+	if err != nil {
+		return err
+	}
+	var mic gssapi.MICToken
+	if err := mic.Unmarshal(b, true); err != nil {
+		return err
+	}
+	mic.Payload = msg
+	if ok, err := mic.Verify(c.key, keyusage.GSSAPI_ACCEPTOR_SIGN); !ok || err != nil {
+		return dns.ErrSig
+	}
+	return nil
+}
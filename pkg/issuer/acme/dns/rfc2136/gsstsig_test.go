@@ -0,0 +1,320 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rfc2136
+
+import (
+	"encoding/asn1"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jcmturner/gokrb5/v8/asn1tools"
+	"github.com/jcmturner/gokrb5/v8/client"
+	krbcrypto "github.com/jcmturner/gokrb5/v8/crypto"
+	"github.com/jcmturner/gokrb5/v8/gssapi"
+	"github.com/jcmturner/gokrb5/v8/iana/asnAppTag"
+	"github.com/jcmturner/gokrb5/v8/iana/etypeID"
+	"github.com/jcmturner/gokrb5/v8/iana/keyusage"
+	"github.com/jcmturner/gokrb5/v8/iana/msgtype"
+	"github.com/jcmturner/gokrb5/v8/iana/nametype"
+	"github.com/jcmturner/gokrb5/v8/keytab"
+	"github.com/jcmturner/gokrb5/v8/messages"
+	"github.com/jcmturner/gokrb5/v8/spnego"
+	"github.com/jcmturner/gokrb5/v8/types"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRealm = "EXAMPLE.COM"
+	testSPN   = "DNS/ns.example.com"
+	testZone  = "example.com."
+	testFQDN  = "_acme-challenge.www.example.com."
+)
+
+func newTestKeytab(t *testing.T, principal, password string) *keytab.Keytab {
+	kt := keytab.New()
+	require.NoError(t, kt.AddEntry(principal, testRealm, password, time.Now(), 1, etypeID.AES256_CTS_HMAC_SHA1_96))
+	return kt
+}
+
+// gssAcceptor is a minimal stand-in for a DNS server accepting GSS-TSIG
+// updates, as Active Directory domain controllers do. It implements both
+// the TKEY negotiation of security contexts and their use to verify and sign
+// messages.
+type gssAcceptor struct {
+	t      *testing.T
+	keytab *keytab.Keytab
+
+	lock     sync.Mutex
+	contexts map[string]types.EncryptionKey
+	updates  int
+}
+
+var _ dns.TsigProvider = &gssAcceptor{}
+
+func (a *gssAcceptor) key(name string) (types.EncryptionKey, bool) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	key, ok := a.contexts[dns.CanonicalName(name)]
+	return key, ok
+}
+
+func (a *gssAcceptor) Generate(msg []byte, t *dns.TSIG) ([]byte, error) {
+	key, ok := a.key(t.Hdr.Name)
+	if !ok {
+		return nil, dns.ErrSecret
+	}
+	mic := &gssapi.MICToken{
+		Flags:   gssapi.MICTokenFlagSentByAcceptor | gssapi.MICTokenFlagAcceptorSubkey,
+		Payload: msg,
+	}
+	if err := mic.SetChecksum(key, keyusage.GSSAPI_ACCEPTOR_SIGN); err != nil {
+		return nil, err
+	}
+	return mic.Marshal()
+}
+
+func (a *gssAcceptor) Verify(msg []byte, t *dns.TSIG) error {
+	key, ok := a.key(t.Hdr.Name)
+	if !ok {
+		return dns.ErrSecret
+	}
+	b, err := hex.DecodeString(t.MAC)
+	if err != nil {
+		return err
+	}
+	var mic gssapi.MICToken
+	if err := mic.Unmarshal(b, false); err != nil {
+		return err
+	}
+	if mic.Flags&gssapi.MICTokenFlagAcceptorSubkey == 0 {
+		return dns.ErrSig
+	}
+	mic.Payload = msg
+	if ok, err := mic.Verify(key, keyusage.GSSAPI_INITIATOR_SIGN); !ok {
+		return err
+	}
+	return nil
+}
+
+func (a *gssAcceptor) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	m := new(dns.Msg)
+	m.SetReply(req)
+
+	switch {
+	case req.Opcode == dns.OpcodeQuery && req.Question[0].Qtype == dns.TypeTKEY:
+		tkey, ok := req.Extra[0].(*dns.TKEY)
+		if !assert.True(a.t, ok, "expected TKEY record in additional section") {
+			m.Rcode = dns.RcodeFormatError
+			break
+		}
+		reply, err := a.accept(tkey)
+		if !assert.NoError(a.t, err) {
+			m.Rcode = dns.RcodeRefused
+			break
+		}
+		m.Answer = []dns.RR{reply}
+		m.SetTsig(tkey.Hdr.Name, gssTSIGAlgorithm, 300, time.Now().Unix())
+	case req.Opcode == dns.OpcodeUpdate:
+		tsig := req.IsTsig()
+		if tsig == nil || w.TsigStatus() != nil {
+			m.Rcode = dns.RcodeRefused
+			break
+		}
+		a.lock.Lock()
+		a.updates++
+		a.lock.Unlock()
+		m.SetTsig(tsig.Hdr.Name, gssTSIGAlgorithm, 300, time.Now().Unix())
+	default:
+		m.Rcode = dns.RcodeNotImplemented
+	}
+
+	assert.NoError(a.t, w.WriteMsg(m))
+}
+
+// accept checks the AP-REQ held by tkey, and returns a TKEY record holding
+// an AP-REP asserting a new acceptor subkey.
+func (a *gssAcceptor) accept(tkey *dns.TKEY) (*dns.TKEY, error) {
+	b, err := hex.DecodeString(tkey.Key)
+	if err != nil {
+		return nil, err
+	}
+	var token spnego.KRB5Token
+	if err := token.Unmarshal(b); err != nil {
+		return nil, err
+	}
+	apReq := token.APReq
+	if err := apReq.Ticket.DecryptEncPart(a.keytab, nil); err != nil {
+		return nil, err
+	}
+	sessionKey := apReq.Ticket.DecryptedEncPart.Key
+	if err := apReq.DecryptAuthenticator(sessionKey); err != nil {
+		return nil, err
+	}
+
+	et, err := krbcrypto.GetEtype(sessionKey.KeyType)
+	if err != nil {
+		return nil, err
+	}
+	subkey, err := types.GenerateEncryptionKey(et)
+	if err != nil {
+		return nil, err
+	}
+
+	encPart, err := asn1.MarshalWithParams(messages.EncAPRepPart{
+		CTime:  apReq.Authenticator.CTime,
+		Cusec:  apReq.Authenticator.Cusec,
+		Subkey: subkey,
+	}, fmt.Sprintf("application,explicit,tag:%d", asnAppTag.EncAPRepPart))
+	if err != nil {
+		return nil, err
+	}
+	ed, err := krbcrypto.GetEncryptedData(encPart, sessionKey, keyusage.AP_REP_ENCPART, 0)
+	if err != nil {
+		return nil, err
+	}
+	apRep, err := asn1.MarshalWithParams(messages.APRep{
+		PVNO:    5,
+		MsgType: msgtype.KRB_AP_REP,
+		EncPart: ed,
+	}, fmt.Sprintf("application,explicit,tag:%d", asnAppTag.APREP))
+	if err != nil {
+		return nil, err
+	}
+	oid, err := asn1.Marshal(asn1.ObjectIdentifier(gssapi.OIDKRB5.OID()))
+	if err != nil {
+		return nil, err
+	}
+	repToken := asn1tools.AddASNAppTag(append(append(oid, 0x02, 0x00), apRep...), 0)
+
+	a.lock.Lock()
+	a.contexts[dns.CanonicalName(tkey.Hdr.Name)] = subkey
+	a.lock.Unlock()
+
+	return &dns.TKEY{
+		Hdr:        dns.RR_Header{Name: tkey.Hdr.Name, Rrtype: dns.TypeTKEY, Class: dns.ClassANY},
+		Algorithm:  gssTSIGAlgorithm,
+		Mode:       tkeyModeGSSAPI,
+		Inception:  tkey.Inception,
+		Expiration: tkey.Expiration,
+		KeySize:    uint16(len(repToken)),
+		Key:        hex.EncodeToString(repToken),
+	}, nil
+}
+
+func runGSSAcceptor(t *testing.T, a *gssAcceptor) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	started := make(chan struct{})
+	server := &dns.Server{
+		Listener:          l,
+		Handler:           a,
+		TsigProvider:      a,
+		NotifyStartedFunc: func() { close(started) },
+		// The default accept function refuses dynamic updates.
+		MsgAcceptFunc: func(dns.Header) dns.MsgAcceptAction { return dns.MsgAccept },
+	}
+	go func() {
+		assert.NoError(t, server.ActivateAndServe())
+	}()
+	<-started
+	t.Cleanup(func() { assert.NoError(t, server.Shutdown()) })
+
+	return l.Addr().String()
+}
+
+func TestGSSTSIGUpdate(t *testing.T) {
+	serviceKeytab := newTestKeytab(t, testSPN, "service-password")
+	clientKeytab, err := newTestKeytab(t, "cert-manager", "client-password").Marshal()
+	require.NoError(t, err)
+
+	acceptor := &gssAcceptor{t: t, keytab: serviceKeytab, contexts: map[string]types.EncryptionKey{}}
+	nameserver := runGSSAcceptor(t, acceptor)
+
+	provider, err := NewDNSProviderCredentials(nameserver, "", "", "",
+		WithNetwork("tcp"),
+		WithGSSTSIG(GSSTSIGConfig{
+			Realm:                testRealm,
+			Username:             "cert-manager",
+			Keytab:               clientKeytab,
+			KDCs:                 []string{"127.0.0.1:88"},
+			ServicePrincipalName: testSPN,
+		}))
+	require.NoError(t, err)
+
+	// Tickets are issued by the test rather than obtained from a KDC.
+	provider.gssTSIG.serviceTicket = func(cl *client.Client, spn string) (messages.Ticket, types.EncryptionKey, error) {
+		now := time.Now().UTC()
+		sname := types.NewPrincipalName(nametype.KRB_NT_SRV_INST, spn)
+		return messages.NewTicket(cl.Credentials.CName(), cl.Credentials.Domain(), sname, testRealm,
+			types.NewKrbFlags(), serviceKeytab, etypeID.AES256_CTS_HMAC_SHA1_96, 1, now, now, now.Add(time.Hour), now.Add(time.Hour))
+	}
+
+	require.NoError(t, provider.Present("www.example.com", testFQDN, testZone, "value"))
+	require.NoError(t, provider.CleanUp("www.example.com", testFQDN, testZone, "value"))
+	assert.Equal(t, 2, acceptor.updates)
+	assert.Len(t, acceptor.contexts, 2)
+}
+
+func TestGSSTSIGContext(t *testing.T) {
+	key := types.EncryptionKey{KeyType: etypeID.AES256_CTS_HMAC_SHA1_96, KeyValue: make([]byte, 32)}
+	ctx := &gssTSIGContext{keyName: "key.example.com.", key: key, acceptorSubkey: true}
+	acceptor := &gssAcceptor{contexts: map[string]types.EncryptionKey{"key.example.com.": key}}
+
+	tests := map[string]struct {
+		tsig    *dns.TSIG
+		wantErr error
+	}{
+		"message is signed using the context": {
+			tsig: &dns.TSIG{Hdr: dns.RR_Header{Name: "key.example.com."}, Algorithm: gssTSIGAlgorithm},
+		},
+		"message signed with another key name": {
+			tsig:    &dns.TSIG{Hdr: dns.RR_Header{Name: "other.example.com."}, Algorithm: gssTSIGAlgorithm},
+			wantErr: dns.ErrKeyAlg,
+		},
+		"message signed with another algorithm": {
+			tsig:    &dns.TSIG{Hdr: dns.RR_Header{Name: "key.example.com."}, Algorithm: dns.HmacSHA256},
+			wantErr: dns.ErrKeyAlg,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mac, err := ctx.Generate([]byte("message"), test.tsig)
+			if test.wantErr != nil {
+				assert.ErrorIs(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			test.tsig.MAC = hex.EncodeToString(mac)
+			assert.NoError(t, acceptor.Verify([]byte("message"), test.tsig))
+			assert.Error(t, acceptor.Verify([]byte("tampered"), test.tsig))
+
+			reply, err := acceptor.Generate([]byte("reply"), test.tsig)
+			require.NoError(t, err)
+			test.tsig.MAC = hex.EncodeToString(reply)
+			assert.NoError(t, ctx.Verify([]byte("reply"), test.tsig))
+			assert.ErrorIs(t, ctx.Verify([]byte("tampered"), test.tsig), dns.ErrSig)
+		})
+	}
+}
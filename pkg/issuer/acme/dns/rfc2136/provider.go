@@ -31,6 +31,7 @@ import (
 	whapi "github.com/cert-manager/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	dnsutil "github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
 
@@ -116,6 +117,36 @@ func (s *Solver) CleanUp(ch *whapi.ChallengeRequest) error {
 	return nil
 }
 
+// PresentBatch presents the records for several challenge requests, which
+// must share the same solver configuration and zone, in a single update.
+func (s *Solver) PresentBatch(chs []*whapi.ChallengeRequest) error {
+	p, err := s.buildDNSProvider(chs[0])
+	if err != nil {
+		return err
+	}
+
+	return p.PresentBatch(chs[0].ResolvedZone, challengeRecords(chs))
+}
+
+// CleanUpBatch cleans up the records for several challenge requests, which
+// must share the same solver configuration and zone, in a single update.
+func (s *Solver) CleanUpBatch(chs []*whapi.ChallengeRequest) error {
+	p, err := s.buildDNSProvider(chs[0])
+	if err != nil {
+		return err
+	}
+
+	return p.CleanUpBatch(chs[0].ResolvedZone, challengeRecords(chs))
+}
+
+func challengeRecords(chs []*whapi.ChallengeRequest) []dnsutil.ChallengeRecord {
+	records := make([]dnsutil.ChallengeRecord, 0, len(chs))
+	for _, ch := range chs {
+		records = append(records, dnsutil.ChallengeRecord{Domain: ch.DNSName, FQDN: ch.ResolvedFQDN, Value: ch.Key})
+	}
+	return records
+}
+
 func (s *Solver) Initialize(kubeClientConfig *restclient.Config, stopCh <-chan struct{}) error {
 	for _, opt := range s.initOpts {
 		opt(s)
@@ -190,5 +221,27 @@ func (s *Solver) buildDNSProvider(ch *whapi.ChallengeRequest) (*DNSProvider, err
 		key = string(secret)
 	}
 
-	return NewDNSProviderCredentials(cfg.Nameserver, cfg.TSIGAlgorithm, cfg.TSIGKeyName, key, WithNetwork(string(cfg.Protocol)))
+	opts := []ProviderOption{WithNetwork(string(cfg.Protocol))}
+	if cfg.SIG0 != nil {
+		privateKey, err := loadSecretKeySelector(l, cfg.SIG0.PrivateKeySecretRef, "")
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithSIG0(cfg.SIG0.PublicKey, privateKey))
+	}
+	if cfg.GSSTSIG != nil {
+		keytab, err := loadSecretKeySelector(l, cfg.GSSTSIG.KeytabSecretRef, "")
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithGSSTSIG(GSSTSIGConfig{
+			Realm:                cfg.GSSTSIG.Realm,
+			Username:             cfg.GSSTSIG.Username,
+			Keytab:               keytab,
+			KDCs:                 cfg.GSSTSIG.KDCs,
+			ServicePrincipalName: cfg.GSSTSIG.ServicePrincipalName,
+		}))
+	}
+
+	return NewDNSProviderCredentials(cfg.Nameserver, cfg.TSIGAlgorithm, cfg.TSIGKeyName, key, opts...)
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/miekg/dns"
 
 	"github.com/cert-manager/cert-manager/internal/apis/certmanager/validation/util"
+	dnsutil "github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
 
@@ -48,6 +49,13 @@ type DNSProvider struct {
 	network       string
 	tsigKeyName   string
 	tsigSecret    string
+
+	sig0PublicKey  string
+	sig0PrivateKey []byte
+	sig0           *sig0Signer
+
+	gssTSIGConfig *GSSTSIGConfig
+	gssTSIG       *gssTSIG
 }
 
 // ProviderOption is some configuration that modifies rfc2136 DNS provider.
@@ -64,6 +72,24 @@ func WithNetwork(network string) ProviderOption {
 	}
 }
 
+// WithSIG0 authenticates updates using SIG(0) with the given key pair,
+// instead of TSIG. publicKey is a KEY resource record and privateKey is the
+// matching private key in the BIND private key format.
+func WithSIG0(publicKey string, privateKey []byte) ProviderOption {
+	return func(d *DNSProvider) {
+		d.sig0PublicKey = publicKey
+		d.sig0PrivateKey = privateKey
+	}
+}
+
+// WithGSSTSIG authenticates updates using GSS-TSIG with the given Kerberos
+// configuration, instead of TSIG with a shared secret.
+func WithGSSTSIG(cfg GSSTSIGConfig) ProviderOption {
+	return func(d *DNSProvider) {
+		d.gssTSIGConfig = &cfg
+	}
+}
+
 // NewDNSProviderCredentials uses the supplied credentials to return a
 // DNSProvider instance configured for rfc2136 dynamic update. To disable TSIG
 // authentication, leave the TSIG parameters as empty strings.
@@ -88,6 +114,27 @@ func NewDNSProviderCredentials(nameserver, tsigAlgorithm, tsigKeyName, tsigSecre
 		d.tsigSecret = tsigSecret
 	}
 
+	if d.sig0PublicKey != "" && d.gssTSIGConfig != nil {
+		return nil, fmt.Errorf("SIG(0) and GSS-TSIG cannot be used together")
+	}
+	if (d.sig0PublicKey != "" || d.gssTSIGConfig != nil) && d.tsigKeyName != "" {
+		return nil, fmt.Errorf("TSIG cannot be used together with SIG(0) or GSS-TSIG")
+	}
+	if d.sig0PublicKey != "" {
+		signer, err := newSIG0Signer(d.sig0PublicKey, d.sig0PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		d.sig0 = signer
+	}
+	if d.gssTSIGConfig != nil {
+		g, err := newGSSTSIG(*d.gssTSIGConfig, d.nameserver)
+		if err != nil {
+			return nil, err
+		}
+		d.gssTSIG = g
+	}
+
 	if tsigAlgorithm == "" {
 		tsigAlgorithm = dns.HmacMD5
 	} else {
@@ -116,21 +163,36 @@ func NewDNSProviderCredentials(nameserver, tsigAlgorithm, tsigKeyName, tsigSecre
 }
 
 // Present creates a TXT record using the specified parameters
-func (r *DNSProvider) Present(_, fqdn, zone, value string) error {
-	return r.changeRecord("INSERT", fqdn, zone, value, 60)
+func (r *DNSProvider) Present(domain, fqdn, zone, value string) error {
+	return r.changeRecords("INSERT", zone, []dnsutil.ChallengeRecord{{Domain: domain, FQDN: fqdn, Value: value}}, 60)
 }
 
 // CleanUp removes the TXT record matching the specified parameters
-func (r *DNSProvider) CleanUp(_, fqdn, zone, value string) error {
-	return r.changeRecord("REMOVE", fqdn, zone, value, 60)
+func (r *DNSProvider) CleanUp(domain, fqdn, zone, value string) error {
+	return r.changeRecords("REMOVE", zone, []dnsutil.ChallengeRecord{{Domain: domain, FQDN: fqdn, Value: value}}, 60)
+}
+
+// PresentBatch creates the TXT records for several challenges in zone using a
+// single dynamic update.
+func (r *DNSProvider) PresentBatch(zone string, records []dnsutil.ChallengeRecord) error {
+	return r.changeRecords("INSERT", zone, records, 60)
 }
 
-func (r *DNSProvider) changeRecord(action, fqdn, zone, value string, ttl uint32) error {
-	// Create RR
-	rr := new(dns.TXT)
-	rr.Hdr = dns.RR_Header{Name: fqdn, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: ttl}
-	rr.Txt = []string{value}
-	rrs := []dns.RR{rr}
+// CleanUpBatch removes the TXT records for several challenges in zone using a
+// single dynamic update.
+func (r *DNSProvider) CleanUpBatch(zone string, records []dnsutil.ChallengeRecord) error {
+	return r.changeRecords("REMOVE", zone, records, 60)
+}
+
+func (r *DNSProvider) changeRecords(action, zone string, records []dnsutil.ChallengeRecord, ttl uint32) error {
+	// Create RRs
+	rrs := make([]dns.RR, 0, len(records))
+	for _, record := range records {
+		rr := new(dns.TXT)
+		rr.Hdr = dns.RR_Header{Name: record.FQDN, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: ttl}
+		rr.Txt = []string{record.Value}
+		rrs = append(rrs, rr)
+	}
 
 	// Create dynamic update packet
 	m := new(dns.Msg)
@@ -154,7 +216,25 @@ func (r *DNSProvider) changeRecord(action, fqdn, zone, value string, ttl uint32)
 	}
 
 	// Send the query
-	reply, _, err := c.Exchange(m, r.nameserver)
+	var reply *dns.Msg
+	var err error
+	switch {
+	case r.sig0 != nil:
+		reply, err = r.sig0.exchange(c, m, r.nameserver)
+	case r.gssTSIG != nil:
+		// A new security context is negotiated for each update, as
+		// challenges are presented and cleaned up rarely.
+		var ctx *gssTSIGContext
+		ctx, err = r.gssTSIG.negotiate(r.nameserver)
+		if err != nil {
+			return err
+		}
+		m.SetTsig(ctx.keyName, gssTSIGAlgorithm, 300, time.Now().Unix())
+		c.TsigProvider = ctx
+		reply, _, err = c.Exchange(m, r.nameserver)
+	default:
+		reply, _, err = c.Exchange(m, r.nameserver)
+	}
 	if err != nil {
 		return fmt.Errorf("DNS update failed: %v", err)
 	}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rfc2136
+
+import (
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dnsutil "github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+func TestBatchUpdate(t *testing.T) {
+	var mu sync.Mutex
+	var updates [][]string
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	started := make(chan struct{})
+	server := &dns.Server{
+		PacketConn:        pc,
+		NotifyStartedFunc: func() { close(started) },
+		MsgAcceptFunc:     func(dns.Header) dns.MsgAcceptAction { return dns.MsgAccept },
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+			var update []string
+			for _, rr := range req.Ns {
+				update = append(update, rr.String())
+			}
+			mu.Lock()
+			updates = append(updates, update)
+			mu.Unlock()
+
+			m := new(dns.Msg)
+			m.SetReply(req)
+			assert.NoError(t, w.WriteMsg(m))
+		}),
+	}
+	go func() {
+		assert.NoError(t, server.ActivateAndServe())
+	}()
+	<-started
+	t.Cleanup(func() { assert.NoError(t, server.Shutdown()) })
+
+	provider, err := NewDNSProviderCredentials(pc.LocalAddr().String(), "", "", "")
+	require.NoError(t, err)
+
+	records := []dnsutil.ChallengeRecord{
+		{Domain: "www.example.com", FQDN: "_acme-challenge.www.example.com.", Value: "value1"},
+		{Domain: "mail.example.com", FQDN: "_acme-challenge.mail.example.com.", Value: "value2"},
+	}
+	require.NoError(t, provider.PresentBatch(testZone, records))
+	require.NoError(t, provider.CleanUpBatch(testZone, records))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, [][]string{
+		{
+			"_acme-challenge.www.example.com.\t60\tIN\tTXT\t\"value1\"",
+			"_acme-challenge.mail.example.com.\t60\tIN\tTXT\t\"value2\"",
+		},
+		{
+			"_acme-challenge.www.example.com.\t0\tNONE\tTXT\t\"value1\"",
+			"_acme-challenge.mail.example.com.\t0\tNONE\tTXT\t\"value2\"",
+		},
+	}, updates)
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rfc2136
+
+import (
+	"bytes"
+	"crypto"
+	"fmt"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// exchangeTimeout bounds the time taken to send a message and read its reply
+// when messages are not sent using dns.Client.Exchange.
+const exchangeTimeout = 10 * time.Second
+
+// sig0Signer signs dynamic updates using SIG(0), as described in RFC2931.
+type sig0Signer struct {
+	key    *dns.KEY
+	signer crypto.Signer
+}
+
+// newSIG0Signer parses a public KEY resource record, as found in the
+// K<name>+<alg>+<id>.key file generated by dnssec-keygen, and the matching
+// private key in the BIND private key format.
+func newSIG0Signer(publicKey string, privateKey []byte) (*sig0Signer, error) {
+	rr, err := dns.NewRR(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing SIG(0) public key: %w", err)
+	}
+
+	var key *dns.KEY
+	switch rr := rr.(type) {
+	case *dns.KEY:
+		key = rr
+	case *dns.DNSKEY:
+		// Keys generated without "-T KEY" are DNSKEY records, which have
+		// the same format as the KEY records used by SIG(0).
+		key = &dns.KEY{DNSKEY: *rr}
+	default:
+		return nil, fmt.Errorf("SIG(0) public key must be a KEY resource record")
+	}
+
+	privKey, err := key.ReadPrivateKey(bytes.NewReader(privateKey), "SIG(0) private key")
+	if err != nil {
+		return nil, fmt.Errorf("error parsing SIG(0) private key: %w", err)
+	}
+	signer, ok := privKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("SIG(0) private key algorithm %s is not supported", dns.AlgorithmToString[key.Algorithm])
+	}
+
+	s := &sig0Signer{key: key, signer: signer}
+	// ReadPrivateKey doesn't check that the private key belongs to the
+	// public key, so check that signed messages can be verified.
+	if err := s.verifyKeyPair(); err != nil {
+		return nil, fmt.Errorf("SIG(0) private key doesn't match public key: %w", err)
+	}
+	return s, nil
+}
+
+func (s *sig0Signer) verifyKeyPair() error {
+	m := new(dns.Msg)
+	m.SetUpdate(s.key.Hdr.Name)
+	buf, err := s.sign(m)
+	if err != nil {
+		return err
+	}
+	signed := new(dns.Msg)
+	if err := signed.Unpack(buf); err != nil {
+		return err
+	}
+	return signed.Extra[len(signed.Extra)-1].(*dns.SIG).Verify(s.key, buf)
+}
+
+// sign returns m signed using SIG(0), in wire format.
+func (s *sig0Signer) sign(m *dns.Msg) ([]byte, error) {
+	now := time.Now()
+	sig := &dns.SIG{
+		RRSIG: dns.RRSIG{
+			Algorithm:  s.key.Algorithm,
+			KeyTag:     s.key.KeyTag(),
+			SignerName: dns.Fqdn(s.key.Hdr.Name),
+			Inception:  uint32(now.Add(-5 * time.Minute).Unix()),
+			Expiration: uint32(now.Add(5 * time.Minute).Unix()),
+		},
+	}
+	return sig.Sign(s.signer, m)
+}
+
+// exchange signs m and sends it to nameserver, returning the reply.
+// dns.Client only supports signing messages using TSIG, so the signed message
+// is written to the connection directly.
+func (s *sig0Signer) exchange(c *dns.Client, m *dns.Msg, nameserver string) (*dns.Msg, error) {
+	buf, err := s.sign(m)
+	if err != nil {
+		return nil, fmt.Errorf("error signing update with SIG(0): %w", err)
+	}
+
+	conn, err := c.Dial(nameserver)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(exchangeTimeout)); err != nil {
+		return nil, err
+	}
+	if _, err := conn.Write(buf); err != nil {
+		return nil, err
+	}
+	return conn.ReadMsg()
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rfc2136
+
+import (
+	"net"
+	"sync/atomic"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSIG0Key(t *testing.T, algorithm uint8, bits int) (*dns.KEY, []byte) {
+	key := &dns.KEY{DNSKEY: dns.DNSKEY{
+		Hdr:       dns.RR_Header{Name: "update.example.com.", Rrtype: dns.TypeKEY, Class: dns.ClassINET},
+		Flags:     512,
+		Protocol:  3,
+		Algorithm: algorithm,
+	}}
+	privateKey, err := key.Generate(bits)
+	require.NoError(t, err)
+	return key, []byte(key.PrivateKeyString(privateKey))
+}
+
+func Test_newSIG0Signer(t *testing.T) {
+	ed25519Key, ed25519PrivateKey := newTestSIG0Key(t, dns.ED25519, 256)
+	ecdsaKey, ecdsaPrivateKey := newTestSIG0Key(t, dns.ECDSAP256SHA256, 256)
+	dnskey := ecdsaKey.DNSKEY
+	dnskey.Hdr.Rrtype = dns.TypeDNSKEY
+
+	tests := map[string]struct {
+		publicKey  string
+		privateKey []byte
+		wantErr    string
+	}{
+		"ED25519 KEY record": {
+			publicKey:  ed25519Key.String(),
+			privateKey: ed25519PrivateKey,
+		},
+		"ECDSA KEY record with comments, as written by dnssec-keygen": {
+			publicKey:  "; This is a key, keyid 12345, for update.example.com.\n" + ecdsaKey.String(),
+			privateKey: ecdsaPrivateKey,
+		},
+		"DNSKEY record": {
+			publicKey:  dnskey.String(),
+			privateKey: ecdsaPrivateKey,
+		},
+		"not a KEY record": {
+			publicKey: "update.example.com. IN TXT \"key\"",
+			wantErr:   "SIG(0) public key must be a KEY resource record",
+		},
+		"invalid public key": {
+			publicKey: "not a record",
+			wantErr:   "error parsing SIG(0) public key",
+		},
+		"private key of another algorithm": {
+			publicKey:  ed25519Key.String(),
+			privateKey: ecdsaPrivateKey,
+			wantErr:    "SIG(0) private key doesn't match public key",
+		},
+		"invalid private key": {
+			publicKey:  ed25519Key.String(),
+			privateKey: []byte("not a private key"),
+			wantErr:    "error parsing SIG(0) private key",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := newSIG0Signer(test.publicKey, test.privateKey)
+			if test.wantErr != "" {
+				assert.ErrorContains(t, err, test.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSIG0Update(t *testing.T) {
+	key, privateKey := newTestSIG0Key(t, dns.ED25519, 256)
+
+	var updates atomic.Int32
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	started := make(chan struct{})
+	server := &dns.Server{
+		PacketConn:        pc,
+		NotifyStartedFunc: func() { close(started) },
+		MsgAcceptFunc:     func(dns.Header) dns.MsgAcceptAction { return dns.MsgAccept },
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+			m := new(dns.Msg)
+			m.SetReply(req)
+			// Updates are not compressed, so packing the request again
+			// gives the signed bytes.
+			sig, ok := req.Extra[len(req.Extra)-1].(*dns.SIG)
+			buf, err := req.Pack()
+			if !ok || err != nil || sig.Verify(key, buf) != nil {
+				m.Rcode = dns.RcodeRefused
+			} else {
+				updates.Add(1)
+			}
+			assert.NoError(t, w.WriteMsg(m))
+		}),
+	}
+	go func() {
+		assert.NoError(t, server.ActivateAndServe())
+	}()
+	<-started
+	t.Cleanup(func() { assert.NoError(t, server.Shutdown()) })
+
+	provider, err := NewDNSProviderCredentials(pc.LocalAddr().String(), "", "", "", WithSIG0(key.String(), privateKey))
+	require.NoError(t, err)
+
+	require.NoError(t, provider.Present("www.example.com", testFQDN, testZone, "value"))
+	require.NoError(t, provider.CleanUp("www.example.com", testFQDN, testZone, "value"))
+	assert.Equal(t, int32(2), updates.Load())
+}
+
+func TestNewDNSProviderCredentialsAuthentication(t *testing.T) {
+	key, privateKey := newTestSIG0Key(t, dns.ED25519, 256)
+
+	tests := map[string]struct {
+		tsigKeyName string
+		opts        []ProviderOption
+		wantErr     string
+	}{
+		"SIG(0) and GSS-TSIG": {
+			opts:    []ProviderOption{WithSIG0(key.String(), privateKey), WithGSSTSIG(GSSTSIGConfig{})},
+			wantErr: "SIG(0) and GSS-TSIG cannot be used together",
+		},
+		"TSIG and SIG(0)": {
+			tsigKeyName: "some-name",
+			opts:        []ProviderOption{WithSIG0(key.String(), privateKey)},
+			wantErr:     "TSIG cannot be used together with SIG(0) or GSS-TSIG",
+		},
+		"GSS-TSIG without realm": {
+			opts:    []ProviderOption{WithGSSTSIG(GSSTSIGConfig{Username: "cert-manager"})},
+			wantErr: "GSS-TSIG realm and username must be specified",
+		},
+		"GSS-TSIG with invalid keytab": {
+			opts:    []ProviderOption{WithGSSTSIG(GSSTSIGConfig{Realm: testRealm, Username: "cert-manager", Keytab: []byte("keytab")})},
+			wantErr: "error parsing GSS-TSIG keytab",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewDNSProviderCredentials("127.0.0.1", "", test.tsigKeyName, "c2VjcmV0", test.opts...)
+			assert.ErrorContains(t, err, test.wantErr)
+		})
+	}
+}
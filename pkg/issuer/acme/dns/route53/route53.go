@@ -109,34 +109,62 @@ func NewDNSProvider(
 
 // Present creates a TXT record using the specified parameters
 func (r *DNSProvider) Present(ctx context.Context, domain, fqdn, value string) error {
-	value = `"` + value + `"`
-	return r.changeRecord(ctx, route53types.ChangeActionUpsert, fqdn, value, route53TTL)
+	return r.PresentBatch(ctx, []util.ChallengeRecord{{Domain: domain, FQDN: fqdn, Value: value}})
 }
 
 // CleanUp removes the TXT record matching the specified parameters
 func (r *DNSProvider) CleanUp(ctx context.Context, domain, fqdn, value string) error {
-	value = `"` + value + `"`
-	return r.changeRecord(ctx, route53types.ChangeActionDelete, fqdn, value, route53TTL)
+	return r.CleanUpBatch(ctx, []util.ChallengeRecord{{Domain: domain, FQDN: fqdn, Value: value}})
 }
 
-func (r *DNSProvider) changeRecord(ctx context.Context, action route53types.ChangeAction, fqdn, value string, ttl int) error {
+// PresentBatch creates the TXT records for several challenges in the same
+// hosted zone using a single change batch.
+func (r *DNSProvider) PresentBatch(ctx context.Context, records []util.ChallengeRecord) error {
+	return r.changeRecords(ctx, route53types.ChangeActionUpsert, records, route53TTL)
+}
+
+// CleanUpBatch removes the TXT records for several challenges in the same
+// hosted zone using a single change batch.
+func (r *DNSProvider) CleanUpBatch(ctx context.Context, records []util.ChallengeRecord) error {
+	err := r.changeRecords(ctx, route53types.ChangeActionDelete, records, route53TTL)
+	if apiErr, ok := errors.AsType[*route53types.InvalidChangeBatch](err); ok && len(records) > 1 {
+		// Route 53 rejects the whole change batch if any of the records
+		// has already been deleted, so delete the records one at a time.
+		logf.FromContext(ctx).V(logf.DebugLevel).Info(
+			"Got InvalidChangeBatch error when attempting to delete the TXT records. "+
+				"Deleting the TXT records one at a time.",
+			"error", apiErr,
+		)
+		var errs []error
+		for _, record := range records {
+			errs = append(errs, r.CleanUp(ctx, record.Domain, record.FQDN, record.Value))
+		}
+		return errors.Join(errs...)
+	}
+	return err
+}
+
+// changeRecords applies action to the TXT records for all of records, which
+// must be in the same hosted zone, using a single change batch.
+func (r *DNSProvider) changeRecords(ctx context.Context, action route53types.ChangeAction, records []util.ChallengeRecord, ttl int) error {
 	log := logf.FromContext(ctx)
-	hostedZoneID, err := r.getHostedZoneID(ctx, fqdn)
+	hostedZoneID, err := r.getHostedZoneID(ctx, records[0].FQDN)
 	if err != nil {
 		return fmt.Errorf("failed to determine Route 53 hosted zone ID: %w", err)
 	}
 
-	recordSet := newTXTRecordSet(fqdn, value, ttl)
+	changes := make([]route53types.Change, 0, len(records))
+	for _, record := range records {
+		changes = append(changes, route53types.Change{
+			Action:            action,
+			ResourceRecordSet: newTXTRecordSet(record.FQDN, `"`+record.Value+`"`, ttl),
+		})
+	}
 	reqParams := &route53.ChangeResourceRecordSetsInput{
 		HostedZoneId: aws.String(hostedZoneID),
 		ChangeBatch: &route53types.ChangeBatch{
 			Comment: aws.String("Managed by cert-manager"),
-			Changes: []route53types.Change{
-				{
-					Action:            action,
-					ResourceRecordSet: recordSet,
-				},
-			},
+			Changes: changes,
 		},
 	}
 
@@ -145,7 +173,7 @@ func (r *DNSProvider) changeRecord(ctx context.Context, action route53types.Chan
 		// If we try to delete something and get a 'InvalidChangeBatch' that
 		// means it's already deleted, no need to consider it an error.
 		var apiErr *route53types.InvalidChangeBatch
-		if errors.As(err, &apiErr) && action == route53types.ChangeActionDelete {
+		if errors.As(err, &apiErr) && action == route53types.ChangeActionDelete && len(records) == 1 {
 			log.V(logf.DebugLevel).Info(
 				"Got InvalidChangeBatch error when attempting to delete the TXT record. "+
 					"Ignoring the error and assuming that the TXT record has already been deleted.",
@@ -12,8 +12,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -366,6 +368,66 @@ func TestRoute53Cleanup(t *testing.T) {
 	}
 }
 
+func TestRoute53Batch(t *testing.T) {
+	tests := map[string]struct {
+		cleanUp        bool
+		changeResponse MockResponse
+		// wantChanges is the number of changes in each change batch sent.
+		wantChanges []int
+	}{
+		"present creates all records in a single change batch": {
+			changeResponse: MockResponse{StatusCode: 200, Body: ChangeResourceRecordSetsResponse},
+			wantChanges:    []int{2},
+		},
+		"clean up deletes all records in a single change batch": {
+			cleanUp:        true,
+			changeResponse: MockResponse{StatusCode: 200, Body: ChangeResourceRecordSetsResponse},
+			wantChanges:    []int{2},
+		},
+		"clean up deletes records one at a time if some were already deleted": {
+			cleanUp:        true,
+			changeResponse: MockResponse{StatusCode: 400, Body: ChangeResourceRecordSets400Response},
+			wantChanges:    []int{2, 1, 1},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+
+			var changes []int
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				resp := MockResponse{StatusCode: 200, Body: GetChangeResponse}
+				if r.URL.Path == "/2013-04-01/hostedzone/ABCDEFG/rrset" {
+					body, err := io.ReadAll(r.Body)
+					assert.NoError(t, err)
+					changes = append(changes, strings.Count(string(body), "<Change>"))
+					resp = test.changeResponse
+				}
+				w.Header().Set("Content-Type", "application/xml")
+				w.WriteHeader(resp.StatusCode)
+				_, _ = w.Write([]byte(resp.Body))
+			}))
+			defer ts.Close()
+
+			provider, err := makeRoute53Provider(ts)
+			require.NoError(t, err)
+			provider.hostedZoneID = "ABCDEFG"
+
+			records := []util.ChallengeRecord{
+				{Domain: "example.com", FQDN: "_acme-challenge.example.com.", Value: "123456d=="},
+				{Domain: "www.example.com", FQDN: "_acme-challenge.www.example.com.", Value: "654321d=="},
+			}
+			if test.cleanUp {
+				err = provider.CleanUpBatch(ctx, records)
+			} else {
+				err = provider.PresentBatch(ctx, records)
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantChanges, changes)
+		})
+	}
+}
+
 func TestAssumeRole(t *testing.T) {
 	// Set the AWS config file to a non-existent file to ensure that the
 	// SDK does not load any local configuration.
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -271,7 +272,8 @@ func (c *CachingResolver) LookupAuthoritativeNameservers(ctx context.Context, fq
 // CheckTXTRecordPropagation follows any CNAME chain for fqdn, then verifies
 // that value is present in the TXT records returned by the given nameservers.
 // When useAuthoritative is true, the authoritative nameservers for the zone are
-// resolved via LookupAuthoritativeNameservers and queried instead.
+// resolved via LookupAuthoritativeNameservers and queried instead, unless any
+// of the given nameservers uses DNS-over-HTTPS or DNS-over-TLS.
 func (c *CachingResolver) CheckTXTRecordPropagation(
 	ctx context.Context,
 	fqdn, value string,
@@ -292,6 +294,15 @@ func (c *CachingResolver) CheckTXTRecordPropagation(
 		return checkAuthoritativeNss(ctx, fqdn, value, nameservers)
 	}
 
+	// The authoritative nameservers can only be queried using plain DNS on
+	// port 53. Encrypted resolvers are configured when that is not possible,
+	// for example when egress to port 53 is blocked, so check the record
+	// using the provided nameservers instead.
+	if slices.ContainsFunc(nameservers, isEncryptedNameserver) {
+		logf.FromContext(ctx).V(logf.DebugLevel).Info("Skipping authoritative nameserver check as encrypted DNS resolvers are configured", "fqdn", fqdn, "nameservers", nameservers)
+		return checkAuthoritativeNss(ctx, fqdn, value, nameservers)
+	}
+
 	// Find the authoritative nameservers
 	authoritativeNss, err := c.LookupAuthoritativeNameservers(ctx, fqdn, nameservers)
 	if err != nil {
@@ -487,6 +487,7 @@ func TestCachingResolver_CheckTXTRecordPropagation(t *testing.T) {
 		name             string
 		givenFQDN        string
 		givenValue       string
+		givenNameservers []string
 		useAuthoritative bool
 		mockDNS          []interaction
 		expectFound      bool
@@ -591,6 +592,31 @@ func TestCachingResolver_CheckTXTRecordPropagation(t *testing.T) {
 			},
 			expectFound: true,
 		},
+		{
+			// Authoritative nameservers can only be queried using plain DNS,
+			// so the encrypted resolvers are queried directly instead.
+			name:             "TXT found via encrypted resolvers, useAuthoritative=true",
+			givenFQDN:        "example.com.",
+			givenValue:       "token123",
+			givenNameservers: []string{"tls://dns.example.net", "https://dns.example.net/dns-query"},
+			useAuthoritative: true,
+			mockDNS: []interaction{
+				{"CNAME example.com.", &dns.Msg{MsgHdr: dns.MsgHdr{Rcode: dns.RcodeSuccess}}},
+				{"TXT example.com.", &dns.Msg{
+					MsgHdr: dns.MsgHdr{Rcode: dns.RcodeSuccess},
+					Answer: []dns.RR{
+						&dns.TXT{Hdr: dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: 300}, Txt: []string{"token123"}},
+					},
+				}},
+				{"TXT example.com.", &dns.Msg{
+					MsgHdr: dns.MsgHdr{Rcode: dns.RcodeSuccess},
+					Answer: []dns.RR{
+						&dns.TXT{Hdr: dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: 300}, Txt: []string{"token123"}},
+					},
+				}},
+			},
+			expectFound: true,
+		},
 		{
 			name:             "error from authoritative NS lookup propagates",
 			givenFQDN:        "example.com.",
@@ -610,8 +636,12 @@ func TestCachingResolver_CheckTXTRecordPropagation(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			withMockDNSQuery(t, tt.mockDNS)
+			nameservers := tt.givenNameservers
+			if nameservers == nil {
+				nameservers = []string{"not-used"}
+			}
 			c := CachingResolver{}
-			found, err := c.CheckTXTRecordPropagation(t.Context(), tt.givenFQDN, tt.givenValue, []string{"not-used"}, UseAuthoritative(tt.useAuthoritative))
+			found, err := c.CheckTXTRecordPropagation(t.Context(), tt.givenFQDN, tt.givenValue, nameservers, UseAuthoritative(tt.useAuthoritative))
 			if tt.expectErr != "" {
 				require.EqualError(t, err, tt.expectErr)
 				return
@@ -10,7 +10,11 @@ package util
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base32"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/miekg/dns"
 )
@@ -33,6 +37,99 @@ func DNS01LookupFQDN(ctx context.Context, domain string, followCNAME bool, names
 	return fqdn, nil
 }
 
+// DNSAccount01LookupFQDN returns a DNS name which will be updated to solve the
+// dns-account-01 challenge for the ACME account identified by accountURI.
+// The name is of the form `_<label>._acme-challenge.<domain>.`, see
+// DNSAccount01Label.
+func DNSAccount01LookupFQDN(ctx context.Context, domain, accountURI string, followCNAME bool, nameservers ...string) (string, error) {
+	if accountURI == "" {
+		return "", fmt.Errorf("an ACME account URI is required to solve dns-account-01 challenges")
+	}
+
+	fqdn := fmt.Sprintf("%s._acme-challenge.%s.", DNSAccount01Label(accountURI), domain)
+
+	// Check if the domain has CNAME then return that
+	if followCNAME {
+		var err error
+		fqdn, err = followCNAMEs(ctx, fqdn, nameservers)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return fqdn, nil
+}
+
+// ChallengeRecord is the TXT record presented to solve a single DNS01
+// challenge. It is used by providers that can present or clean up the records
+// for several challenges in the same zone at once.
+type ChallengeRecord struct {
+	// Domain is the domain name that the challenge is for.
+	Domain string
+	// FQDN is the fully qualified name of the TXT record.
+	FQDN string
+	// Value is the content of the TXT record.
+	Value string
+}
+
+// DelegationError is returned by DelegatedFQDN when a challenge record is not
+// delegated to the expected validation zone using a CNAME record.
+type DelegationError struct {
+	// FQDN is the challenge record that is expected to be delegated.
+	FQDN string
+	// Target is the name that FQDN resolves to after following CNAME
+	// records. It is equal to FQDN if FQDN is not a CNAME record.
+	Target string
+	// Zone is the validation zone that FQDN is expected to be delegated to.
+	Zone string
+}
+
+func (e *DelegationError) Error() string {
+	if e.Target == e.FQDN {
+		return fmt.Sprintf("expected %s to be a CNAME record into validation zone %s, but no CNAME record was found", e.FQDN, e.Zone)
+	}
+	return fmt.Sprintf("expected %s to be a CNAME record into validation zone %s, but it resolves to %s", e.FQDN, e.Zone, e.Target)
+}
+
+// BatchPendingError is returned when presenting or cleaning up the record for
+// a challenge while the change waits to be applied together with the changes
+// for other challenges in the same zone. The call should be repeated after
+// RetryAfter, which returns the result once the batch has been applied.
+type BatchPendingError struct {
+	// RetryAfter is the time after which the batch is expected to have been
+	// applied.
+	RetryAfter time.Duration
+}
+
+func (e *BatchPendingError) Error() string {
+	return fmt.Sprintf("waiting for the DNS01 record change to be applied together with other changes in the same zone, retrying in %s", e.RetryAfter)
+}
+
+// DelegatedFQDN follows the CNAME records for the challenge record fqdn and
+// returns the name they resolve to, which must be within the validation zone.
+// A *DelegationError is returned if it is not.
+func DelegatedFQDN(ctx context.Context, fqdn, zone string, nameservers ...string) (string, error) {
+	target, err := followCNAMEs(ctx, fqdn, nameservers)
+	if err != nil {
+		return "", err
+	}
+
+	zone = dns.Fqdn(zone)
+	if !dns.IsSubDomain(zone, target) {
+		return "", &DelegationError{FQDN: fqdn, Target: target, Zone: zone}
+	}
+
+	return target, nil
+}
+
+// DNSAccount01Label returns the account-scoped label used by the
+// dns-account-01 challenge: an underscore followed by the lowercase base32
+// encoding of the first 10 bytes of the SHA-256 digest of the account URI.
+func DNSAccount01Label(accountURI string) string {
+	digest := sha256.Sum256([]byte(accountURI))
+	return "_" + strings.ToLower(base32.StdEncoding.EncodeToString(digest[:10]))
+}
+
 // FindBestMatch returns the longest match for a given domain within a list of domains
 func FindBestMatch(query string, domains ...string) (string, error) {
 	var maxSoFar int
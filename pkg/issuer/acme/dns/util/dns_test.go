@@ -5,10 +5,13 @@
 package util
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/miekg/dns"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type input struct {
@@ -98,3 +101,73 @@ func TestLongestMatches(t *testing.T) {
 		})
 	}
 }
+
+func TestDNSAccount01LookupFQDN(t *testing.T) {
+	// example taken from draft-ietf-acme-dns-account-label
+	fqdn, err := DNSAccount01LookupFQDN(t.Context(), "example.org", "https://example.com/acme/acct/ExampleAccount", false)
+	assert.NoError(t, err)
+	assert.Equal(t, "_ujmmovf2vn55tgye._acme-challenge.example.org.", fqdn)
+
+	_, err = DNSAccount01LookupFQDN(t.Context(), "example.org", "", false)
+	assert.Error(t, err)
+}
+
+func TestDelegatedFQDN(t *testing.T) {
+	cname := func(name, target string) *dns.Msg {
+		return &dns.Msg{
+			MsgHdr: dns.MsgHdr{Rcode: dns.RcodeSuccess},
+			Answer: []dns.RR{
+				&dns.CNAME{Hdr: dns.RR_Header{Name: name, Rrtype: dns.TypeCNAME, Class: dns.ClassINET, Ttl: 300}, Target: target},
+			},
+		}
+	}
+	noCNAME := &dns.Msg{MsgHdr: dns.MsgHdr{Rcode: dns.RcodeSuccess}}
+
+	tests := map[string]struct {
+		mock    []interaction
+		want    string
+		wantErr string
+	}{
+		"CNAME into the validation zone": {
+			mock: []interaction{
+				{"CNAME _acme-challenge.example.com.", cname("_acme-challenge.example.com.", "example.com.acme.example-validation.net.")},
+				{"CNAME example.com.acme.example-validation.net.", noCNAME},
+			},
+			want: "example.com.acme.example-validation.net.",
+		},
+		"CNAME chain into the validation zone": {
+			mock: []interaction{
+				{"CNAME _acme-challenge.example.com.", cname("_acme-challenge.example.com.", "_acme-challenge.example.org.")},
+				{"CNAME _acme-challenge.example.org.", cname("_acme-challenge.example.org.", "example.com.ACME.example-validation.net.")},
+				{"CNAME example.com.ACME.example-validation.net.", noCNAME},
+			},
+			want: "example.com.ACME.example-validation.net.",
+		},
+		"no CNAME record": {
+			mock: []interaction{
+				{"CNAME _acme-challenge.example.com.", noCNAME},
+			},
+			wantErr: "expected _acme-challenge.example.com. to be a CNAME record into validation zone acme.example-validation.net., but no CNAME record was found",
+		},
+		"CNAME outside the validation zone": {
+			mock: []interaction{
+				{"CNAME _acme-challenge.example.com.", cname("_acme-challenge.example.com.", "_acme-challenge.example.org.")},
+				{"CNAME _acme-challenge.example.org.", noCNAME},
+			},
+			wantErr: "expected _acme-challenge.example.com. to be a CNAME record into validation zone acme.example-validation.net., but it resolves to _acme-challenge.example.org.",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			withMockDNSQuery(t, test.mock)
+			got, err := DelegatedFQDN(t.Context(), "_acme-challenge.example.com.", "acme.example-validation.net")
+			if test.wantErr != "" {
+				require.EqualError(t, err, test.wantErr)
+				assert.True(t, errors.As(err, new(*DelegationError)))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
@@ -11,6 +11,8 @@ package util
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"io"
 	"net"
@@ -30,6 +32,10 @@ import (
 // malicious DoH server from sending an arbitrarily large response.
 const maxDNSResponseSize = 128 * 1024
 
+// defaultDoTPort is the port used for DNS-over-TLS (RFC 7858) nameservers
+// that do not specify one.
+const defaultDoTPort = "853"
+
 type preCheckDNSFunc func(ctx context.Context, fqdn, value string, nameservers []string,
 	useAuthoritative bool) (bool, error)
 type dnsQueryFunc func(ctx context.Context, fqdn string, rtype uint16, nameservers []string, recursive bool) (in *dns.Msg, err error)
@@ -166,6 +172,8 @@ func checkAuthoritativeNss(ctx context.Context, fqdn, value string, nameservers
 
 // DNSQuery will query a nameserver, iterating through the supplied servers as it retries
 // The nameserver should include a port, to facilitate testing where we talk to a mock dns server.
+// Nameservers prefixed with https:// are queried using DNS-over-HTTPS, and
+// nameservers prefixed with tls:// are queried using DNS-over-TLS.
 func DNSQuery(ctx context.Context, fqdn string, rtype uint16, nameservers []string, recursive bool) (in *dns.Msg, err error) {
 	switch rtype {
 	case dns.TypeCAA, dns.TypeCNAME, dns.TypeNS, dns.TypeSOA, dns.TypeTXT:
@@ -200,6 +208,9 @@ func DNSQuery(ctx context.Context, fqdn string, rtype uint16, nameservers []stri
 		if strings.HasPrefix(ns, "https://") {
 			in, _, err = http.Exchange(ctx, m, ns)
 
+		} else if strings.HasPrefix(ns, "tls://") {
+			in, _, err = dotExchange(ctx, m, ns)
+
 		} else {
 			in, _, err = udp.ExchangeContext(ctx, m, ns)
 
@@ -219,6 +230,39 @@ func DNSQuery(ctx context.Context, fqdn string, rtype uint16, nameservers []stri
 	return in, err
 }
 
+// dotRootCAs is the set of root certificates used to verify DNS-over-TLS
+// servers. If nil, the system roots are used.
+var dotRootCAs *x509.CertPool
+
+// dotExchange sends m to the DNS-over-TLS (RFC 7858) server at a, which is in
+// the format tls://<host>[:<port>]. The server certificate is verified
+// against host.
+func dotExchange(ctx context.Context, m *dns.Msg, a string) (r *dns.Msg, rtt time.Duration, err error) {
+	addr := strings.TrimPrefix(a, "tls://")
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = strings.Trim(addr, "[]")
+		addr = net.JoinHostPort(host, defaultDoTPort)
+	}
+
+	c := &dns.Client{
+		Net:     "tcp-tls",
+		Timeout: DNSTimeout,
+		TLSConfig: &tls.Config{
+			ServerName: host,
+			RootCAs:    dotRootCAs,
+			MinVersion: tls.VersionTLS12,
+		},
+	}
+	return c.ExchangeContext(ctx, m, addr)
+}
+
+// isEncryptedNameserver returns true if nameserver is a DNS-over-HTTPS or
+// DNS-over-TLS endpoint, rather than a plain DNS server.
+func isEncryptedNameserver(nameserver string) bool {
+	return strings.HasPrefix(nameserver, "https://") || strings.HasPrefix(nameserver, "tls://")
+}
+
 type httpDNSClient struct {
 	HTTPClient *http.Client
 }
@@ -12,12 +12,20 @@ package util
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"math/big"
+	"net"
 	"reflect"
 	"sort"
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/miekg/dns"
 	"github.com/stretchr/testify/assert"
@@ -173,6 +181,69 @@ func TestCheckAuthoritativeNss(t *testing.T) {
 	})
 }
 
+func TestDNSQueryDoT(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		DNSNames:     []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+	})
+	require.NoError(t, err)
+	started := make(chan struct{})
+	server := &dns.Server{
+		Listener:          listener,
+		Net:               "tcp-tls",
+		NotifyStartedFunc: func() { close(started) },
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+			m := new(dns.Msg)
+			m.SetReply(req)
+			m.Answer = append(m.Answer, &dns.TXT{
+				Hdr: dns.RR_Header{Name: req.Question[0].Name, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: 60},
+				Txt: []string{"token123"},
+			})
+			assert.NoError(t, w.WriteMsg(m))
+		}),
+	}
+	go func() {
+		assert.NoError(t, server.ActivateAndServe())
+	}()
+	<-started
+	t.Cleanup(func() { assert.NoError(t, server.Shutdown()) })
+
+	_, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+
+	t.Run("verifies the server certificate", func(t *testing.T) {
+		_, err := DNSQuery(t.Context(), "example.com.", dns.TypeTXT, []string{"tls://127.0.0.1:" + port}, true)
+		assert.ErrorContains(t, err, "certificate")
+	})
+
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+	dotRootCAs = roots
+	t.Cleanup(func() { dotRootCAs = nil })
+
+	for _, ns := range []string{"tls://127.0.0.1:" + port, "tls://localhost:" + port} {
+		t.Run(ns, func(t *testing.T) {
+			in, err := DNSQuery(t.Context(), "example.com.", dns.TypeTXT, []string{ns}, true)
+			require.NoError(t, err)
+			require.Len(t, in.Answer, 1)
+			assert.Equal(t, []string{"token123"}, in.Answer[0].(*dns.TXT).Txt)
+		})
+	}
+}
+
 // These tests don't require mocking out dnsQuery as getNameservers doesn't rely
 // on it.
 func TestResolveConfServers(t *testing.T) {
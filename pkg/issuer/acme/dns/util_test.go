@@ -30,6 +30,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/clouddns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/cloudflare"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/digitalocean"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/powerdns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/route53"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 )
@@ -185,6 +186,14 @@ func newFakeDNSProviders() *fakeDNSProviders {
 			f.call("digitalocean", opt)
 			return nil, nil
 		},
+		powerDNS: func(ctx context.Context, options ...powerdns.DNSProviderOption) (*powerdns.DNSProvider, error) {
+			var opt powerdns.DNSProviderOptions
+			for _, o := range options {
+				o.ApplyToDNSProviderOptions(&opt)
+			}
+			f.call("powerdns", opt)
+			return nil, nil
+		},
 	}
 	return f
 }
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"crypto/rsa"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/cert-manager/cert-manager/pkg/acme"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/errors"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
+)
+
+const (
+	errorAccountDeactivationFailed = "ErrDeactivateACMEAccount"
+	successAccountDeactivated      = "ACMEAccountDeactivated"
+
+	messageAccountDeactivationFailed  = "Failed to deactivate ACME account: "
+	messageAccountDeactivationSkipped = "Not deactivating ACME account as its private key could not be loaded: "
+	messageAccountDeactivated         = "The ACME account was deactivated"
+)
+
+// Finalize deactivates the issuer's ACME account if the issuer has
+// deactivateAccountOnDeletion set.
+// Failures that retrying will not resolve, such as a missing private key or
+// an account that was already deactivated, are recorded as events and do not
+// block the deletion of the issuer.
+func (a *Acme) Finalize(ctx context.Context, issuer v1.GenericIssuer) error {
+	log := logf.FromContext(ctx)
+
+	if !issuer.GetSpec().ACME.DeactivateAccountOnDeletion || issuer.GetStatus().ACMEStatus().URI == "" {
+		return nil
+	}
+
+	ns := a.resourceNamespace(issuer)
+	sel := acme.PrivateKeySelector(issuer.GetSpec().ACME.PrivateKey)
+	pk, err := a.keyFromSecret(ctx, ns, sel.Name, sel.Key)
+	rsaPk, ok := pk.(*rsa.PrivateKey)
+	if err == nil && !ok {
+		err = errors.NewInvalidData(messageTemplateNotRSA, sel.Name)
+	}
+	if apierrors.IsNotFound(err) || errors.IsInvalidData(err) {
+		msg := messageAccountDeactivationSkipped + err.Error()
+		log.V(logf.WarnLevel).Info(msg)
+		a.recorder.Event(issuer, corev1.EventTypeWarning, errorAccountDeactivationFailed, msg)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	cl := a.clientBuilder(accounts.NewClientOptions{
+		SkipTLSVerify: issuer.GetSpec().ACME.SkipTLSVerify,
+		CABundle:      issuer.GetSpec().ACME.CABundle,
+		Server:        issuer.GetSpec().ACME.Server,
+		PrivateKey:    rsaPk,
+	})
+	if err := cl.DeactivateReg(ctx); err != nil {
+		msg := messageAccountDeactivationFailed + err.Error()
+		log.Error(err, "failed to deactivate ACME account")
+		a.recorder.Event(issuer, corev1.EventTypeWarning, errorAccountDeactivationFailed, msg)
+
+		// Do not retry if the ACME server rejected the request, for example
+		// because the account does not exist or is already deactivated.
+		acmeErr, ok := err.(*acmeapi.Error)
+		if err == acmeapi.ErrNoAccount || (ok && acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500) {
+			return nil
+		}
+		return err
+	}
+
+	a.accountRegistry.RemoveClient(string(issuer.GetUID()))
+
+	log.V(logf.InfoLevel).Info("deactivated ACME account")
+	a.recorder.Event(issuer, corev1.EventTypeNormal, successAccountDeactivated, messageAccountDeactivated)
+
+	return nil
+}
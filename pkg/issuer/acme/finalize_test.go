@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	fakeregistry "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
+	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
+)
+
+func TestAcme_Finalize(t *testing.T) {
+	pk := mustGenerateRSAKey(t)
+	notFoundErr := apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "test")
+
+	tests := map[string]struct {
+		deactivate    bool
+		accountURI    string
+		keyErr        error
+		deactivateErr error
+
+		expectDeactivate bool
+		expectRemoved    bool
+		expectErr        bool
+		expectedEvents   []string
+	}{
+		"account is not deactivated if not enabled": {
+			accountURI: "https://acme-v02.api.letsencrypt.org/acme/acct/1",
+		},
+		"account is not deactivated if it was never registered": {
+			deactivate: true,
+		},
+		"account is deactivated": {
+			deactivate:       true,
+			accountURI:       "https://acme-v02.api.letsencrypt.org/acme/acct/1",
+			expectDeactivate: true,
+			expectRemoved:    true,
+			expectedEvents:   []string{fmt.Sprintf("%s %s %s", corev1.EventTypeNormal, successAccountDeactivated, messageAccountDeactivated)},
+		},
+		"missing private key does not block deletion": {
+			deactivate:     true,
+			accountURI:     "https://acme-v02.api.letsencrypt.org/acme/acct/1",
+			keyErr:         notFoundErr,
+			expectedEvents: []string{fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, errorAccountDeactivationFailed, messageAccountDeactivationSkipped+notFoundErr.Error())},
+		},
+		"account rejected by the ACME server does not block deletion": {
+			deactivate:       true,
+			accountURI:       "https://acme-v02.api.letsencrypt.org/acme/acct/1",
+			deactivateErr:    &acmeapi.Error{StatusCode: 403},
+			expectDeactivate: true,
+			expectedEvents:   []string{fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, errorAccountDeactivationFailed, messageAccountDeactivationFailed+(&acmeapi.Error{StatusCode: 403}).Error())},
+		},
+		"unknown error is retried": {
+			deactivate:       true,
+			accountURI:       "https://acme-v02.api.letsencrypt.org/acme/acct/1",
+			deactivateErr:    fmt.Errorf("test"),
+			expectDeactivate: true,
+			expectErr:        true,
+			expectedEvents:   []string{fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, errorAccountDeactivationFailed, messageAccountDeactivationFailed+"test")},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			issuer := gen.Issuer("test-issuer",
+				gen.SetIssuerACMEURL(acmev2Prod),
+				gen.SetIssuerACMEPrivKeyRef("test"),
+				gen.SetIssuerACMEAccountURL(test.accountURI),
+				func(iss cmapi.GenericIssuer) {
+					iss.GetSpec().ACME.DeactivateAccountOnDeletion = test.deactivate
+				},
+			)
+
+			deactivateCalled := false
+			cl := acmecl.FakeACME{
+				FakeDeactivateReg: func(context.Context) error {
+					deactivateCalled = true
+					return test.deactivateErr
+				},
+			}
+			removed := false
+			registry := &fakeregistry.FakeRegistry{
+				RemoveClientFunc: func(uid string) {
+					removed = true
+				},
+			}
+			recorder := new(controllertest.FakeRecorder)
+			a := Acme{
+				resourceNamespace: func(iss cmapi.GenericIssuer) string {
+					return iss.GetNamespace()
+				},
+				keyFromSecret: func(context.Context, string, string, string) (crypto.Signer, error) {
+					return pk, test.keyErr
+				},
+				clientBuilder: func(accounts.NewClientOptions) acmecl.Interface {
+					return &cl
+				},
+				accountRegistry: registry,
+				recorder:        recorder,
+			}
+
+			err := a.Finalize(t.Context(), issuer)
+			if test.expectErr != (err != nil) {
+				t.Errorf("Expected error: %v, got %v", test.expectErr, err)
+			}
+			if deactivateCalled != test.expectDeactivate {
+				t.Errorf("Expected DeactivateReg to be called: %v, was called: %v", test.expectDeactivate, deactivateCalled)
+			}
+			if removed != test.expectRemoved {
+				t.Errorf("Expected client to be removed from the registry: %v, was removed: %v", test.expectRemoved, removed)
+			}
+			if !slices.Equal(test.expectedEvents, recorder.Events) {
+				t.Errorf("Expected events:\n%+#v\ngot:%+#v", test.expectedEvents, recorder.Events)
+			}
+		})
+	}
+}
@@ -47,6 +47,12 @@ const (
 	HTTP01Timeout = time.Minute * 15
 	// acmeSolverListenPort is the port acmesolver should listen on
 	acmeSolverListenPort = 8089
+	// tlsALPNSolverListenPort is the port acmesolver should listen on when
+	// solving TLS-ALPN-01 challenges
+	tlsALPNSolverListenPort = 8443
+	// tlsALPNServicePort is the port the solver Service exposes for
+	// TLS-ALPN-01 challenges, which ACME servers validate on port 443
+	tlsALPNServicePort = 443
 
 	loggerName = "http01"
 
@@ -60,7 +66,8 @@ var (
 	challengeGvk = cmacme.SchemeGroupVersion.WithKind("Challenge")
 )
 
-// Solver is an implementation of the acme http-01 challenge solver protocol
+// Solver is an implementation of the acme http-01 and tls-alpn-01 challenge
+// solver protocols. Both are solved by running an acmesolver pod.
 type Solver struct {
 	*controller.Context
 
@@ -68,24 +75,35 @@ type Solver struct {
 	serviceLister   cache.GenericLister
 	ingressLister   networkingv1listers.IngressLister
 	httpRouteLister gwapilisters.HTTPRouteLister
+	tlsRouteLister  gwapilisters.TLSRouteLister
 
-	testReachability reachabilityTest
-	requiredPasses   int
+	testReachability        reachabilityTest
+	testTLSALPNReachability tlsALPNReachabilityTest
+	requiredPasses          int
 }
 
 type reachabilityTest func(ctx context.Context, url *url.URL, key string, dnsServers []string, userAgent string) error
 
+type tlsALPNReachabilityTest func(ctx context.Context, domain, keyAuth string, dnsServers []string) error
+
 // NewSolver returns a new ACME HTTP01 solver for the given *controller.Context.
 func NewSolver(ctx *controller.Context) (*Solver, error) {
-	return &Solver{
-		Context:          ctx,
-		podLister:        ctx.HTTP01ResourceMetadataInformersFactory.ForResource(corev1.SchemeGroupVersion.WithResource("pods")).Lister(),
-		serviceLister:    ctx.HTTP01ResourceMetadataInformersFactory.ForResource(corev1.SchemeGroupVersion.WithResource("services")).Lister(),
-		ingressLister:    ctx.KubeSharedInformerFactory.Ingresses().Lister(),
-		httpRouteLister:  ctx.GWShared.Gateway().V1().HTTPRoutes().Lister(),
-		testReachability: testReachability,
-		requiredPasses:   5,
-	}, nil
+	s := &Solver{
+		Context:                 ctx,
+		podLister:               ctx.HTTP01ResourceMetadataInformersFactory.ForResource(corev1.SchemeGroupVersion.WithResource("pods")).Lister(),
+		serviceLister:           ctx.HTTP01ResourceMetadataInformersFactory.ForResource(corev1.SchemeGroupVersion.WithResource("services")).Lister(),
+		ingressLister:           ctx.KubeSharedInformerFactory.Ingresses().Lister(),
+		httpRouteLister:         ctx.GWShared.Gateway().V1().HTTPRoutes().Lister(),
+		testReachability:        testReachability,
+		testTLSALPNReachability: testTLSALPNReachability,
+		requiredPasses:          5,
+	}
+	// Obtaining the lister registers the TLSRoute informer, which would
+	// never sync if the TLSRoute CRD is not installed.
+	if ctx.GatewayTLSRouteSolverEnabled {
+		s.tlsRouteLister = ctx.GWShared.Gateway().V1().TLSRoutes().Lister()
+	}
+	return s, nil
 }
 
 func http01IngressCfgForChallenge(ch *cmacme.Challenge) (*cmacme.ACMEChallengeSolverHTTP01Ingress, error) {
@@ -103,12 +121,21 @@ func getServiceType(ch *cmacme.Challenge) (corev1.ServiceType, error) {
 	if ch.Spec.Solver.HTTP01 != nil && ch.Spec.Solver.HTTP01.GatewayHTTPRoute != nil {
 		return ch.Spec.Solver.HTTP01.GatewayHTTPRoute.ServiceType, nil
 	}
-	return "", fmt.Errorf("neither HTTP01 Ingress nor Gateway solvers were found")
+	if ch.Spec.Solver.TLSALPN01 != nil {
+		return ch.Spec.Solver.TLSALPN01.ServiceType, nil
+	}
+	return "", fmt.Errorf("neither HTTP01 Ingress, HTTP01 Gateway nor TLSALPN01 solvers were found")
 }
 
-// Present will realise the resources required to solve the given HTTP01
-// challenge validation in the apiserver. If those resources already exist, it
-// will return nil (i.e. this function is idempotent).
+// isTLSALPN01 returns true if the challenge is solved using the tls-alpn-01
+// challenge type.
+func isTLSALPN01(ch *cmacme.Challenge) bool {
+	return ch.Spec.Type == cmacme.ACMEChallengeTypeTLSALPN01
+}
+
+// Present will realise the resources required to solve the given HTTP01 or
+// TLSALPN01 challenge validation in the apiserver. If those resources already
+// exist, it will return nil (i.e. this function is idempotent).
 func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
 	log := logf.FromContext(ctx).WithName(loggerName)
 	ctx = logf.NewContext(ctx, log)
@@ -119,6 +146,21 @@ func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 		return utilerrors.NewAggregate([]error{podErr, svcErr})
 	}
 	var ingressErr, gatewayErr error
+	if isTLSALPN01(ch) {
+		if ch.Spec.Solver.TLSALPN01 == nil {
+			return utilerrors.NewAggregate([]error{podErr, fmt.Errorf("couldn't Present challenge %s/%s: no TLSALPN01 solver was specified", ch.Namespace, ch.Name)})
+		}
+		if ch.Spec.Solver.TLSALPN01.GatewayTLSRoute != nil {
+			if !s.GatewaySolverEnabled {
+				return fmt.Errorf("couldn't Present challenge %s/%s: gateway api is not enabled", ch.Namespace, ch.Name)
+			}
+			if !s.GatewayTLSRouteSolverEnabled {
+				return fmt.Errorf("couldn't Present challenge %s/%s: the gateway api TLSRoute CRD is not installed", ch.Namespace, ch.Name)
+			}
+			_, gatewayErr = s.ensureGatewayTLSRoute(ctx, ch, svcName)
+		}
+		return utilerrors.NewAggregate([]error{podErr, gatewayErr})
+	}
 	if ch.Spec.Solver.HTTP01 != nil {
 		if ch.Spec.Solver.HTTP01.Ingress != nil {
 			_, ingressErr = s.ensureIngress(ctx, ch, svcName)
@@ -163,13 +205,32 @@ func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.
 
 	ctx, cancel := context.WithTimeout(ctx, HTTP01Timeout)
 	defer cancel()
-	url := s.buildChallengeUrl(ch)
-	log = log.WithValues("url", url)
+
+	dnsServers := s.HTTP01SolverNameservers
+	// IP address identifiers are checked by connecting to the IP address
+	// directly, so there is no name to resolve.
+	if net.ParseIP(ch.Spec.DNSName) != nil {
+		dnsServers = nil
+	}
+
+	var test func(ctx context.Context) error
+	if isTLSALPN01(ch) {
+		log = log.WithValues("domain", ch.Spec.DNSName)
+		test = func(ctx context.Context) error {
+			return s.testTLSALPNReachability(ctx, ch.Spec.DNSName, ch.Spec.Key, dnsServers)
+		}
+	} else {
+		url := s.buildChallengeUrl(ch)
+		log = log.WithValues("url", url)
+		test = func(ctx context.Context) error {
+			return s.testReachability(ctx, url, ch.Spec.Key, dnsServers, s.Context.RESTConfig.UserAgent)
+		}
+	}
 	ctx = logf.NewContext(ctx, log)
 
 	log.V(logf.DebugLevel).Info("running self check multiple times to ensure challenge has propagated", "required_passes", s.requiredPasses)
 	for i := range s.requiredPasses {
-		err := s.testReachability(ctx, url, ch.Spec.Key, s.HTTP01SolverNameservers, s.Context.RESTConfig.UserAgent)
+		err := test(ctx)
 		if err != nil {
 			return err
 		}
@@ -210,6 +271,79 @@ func (s *Solver) buildChallengeUrl(ch *cmacme.Challenge) *url.URL {
 	return url
 }
 
+// dialerForNameservers returns a dialer which resolves names using the given
+// DNS servers, or the system resolver if none are given.
+func dialerForNameservers(dnsServers []string) *net.Dialer {
+	dialer := &net.Dialer{
+		Timeout: 3 * time.Second,
+	}
+	if len(dnsServers) == 0 {
+		return dialer
+	}
+	// we need to increment a counter to iterate through the dns servers as the dialer will not
+	// return an error if the dns server is not responding.
+	counter := 0
+	dialer.Resolver = &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			d := net.Dialer{
+				Timeout: 3 * time.Second,
+			}
+			s := dnsServers[counter%len(dnsServers)]
+			counter++
+			return d.DialContext(ctx, network, s)
+		},
+	}
+	return dialer
+}
+
+// testTLSALPNReachability will attempt to connect to port 443 of 'domain'
+// negotiating the acme-tls/1 protocol, and check that the presented
+// certificate answers the challenge for 'keyAuth'.
+func testTLSALPNReachability(ctx context.Context, domain, keyAuth string, dnsServers []string) error {
+	log := logf.FromContext(ctx)
+	log.V(logf.DebugLevel).Info("performing TLSALPN01 reachability check")
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	config := &tls.Config{
+		NextProtos: []string{solver.ACMETLS1Protocol},
+		// The challenge certificate is self-signed, and is checked below.
+		InsecureSkipVerify: true, // #nosec G402 -- ACME TLS-ALPN-01 challenge certificates are self-signed by design (RFC 8737)
+	}
+	if net.ParseIP(domain) == nil {
+		config.ServerName = domain
+	}
+	dialer := &tls.Dialer{
+		NetDialer: dialerForNameservers(dnsServers),
+		Config:    config,
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(domain, "443"))
+	if err != nil {
+		log.V(logf.DebugLevel).Info("failed to perform self check TLS handshake", "error", err)
+		return fmt.Errorf("failed to perform self check TLS handshake with '%s': %v", domain, err)
+	}
+	defer conn.Close()
+
+	state := conn.(*tls.Conn).ConnectionState()
+	if state.NegotiatedProtocol != solver.ACMETLS1Protocol {
+		return fmt.Errorf("server did not negotiate the %q protocol", solver.ACMETLS1Protocol)
+	}
+	if len(state.PeerCertificates) == 0 {
+		return fmt.Errorf("server did not present a certificate")
+	}
+	if err := solver.VerifyTLSALPN01ChallengeCertificate(state.PeerCertificates[0], domain, keyAuth); err != nil {
+		log.V(logf.DebugLevel).Info("certificate presented by server did not answer the challenge", "error", err)
+		return err
+	}
+
+	log.V(logf.DebugLevel).Info("reachability test succeeded")
+
+	return nil
+}
+
 // testReachability will attempt to connect to the 'domain' with 'path' and
 // check if the returned body equals 'key'
 func testReachability(ctx context.Context, url *url.URL, key string, dnsServers []string, userAgent string) error {
@@ -272,24 +406,7 @@ func testReachability(ctx context.Context, url *url.URL, key string, dnsServers
 
 	if len(dnsServers) != 0 {
 		transport.DialContext = func(ctx context.Context, network, addr string) (conn net.Conn, err error) {
-			// we need to increment a counter to iterate through the dns servers as the dialer will not
-			// return an error if the dns server is not responding.
-			counter := 0
-			dialer := &net.Dialer{
-				Timeout: 3 * time.Second,
-				Resolver: &net.Resolver{
-					PreferGo: true,
-					Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
-						d := net.Dialer{
-							Timeout: 3 * time.Second,
-						}
-						s := dnsServers[counter%len(dnsServers)]
-						counter++
-						return d.DialContext(ctx, network, s)
-					},
-				},
-			}
-			return dialer.DialContext(ctx, network, addr)
+			return dialerForNameservers(dnsServers).DialContext(ctx, network, addr)
 		}
 	}
 	client := &http.Client{
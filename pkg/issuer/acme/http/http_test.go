@@ -45,10 +45,17 @@ func countReachabilityTestCalls(counter *int, t reachabilityTest) reachabilityTe
 
 func TestCheck(t *testing.T) {
 	type testT struct {
-		name             string
-		reachabilityTest reachabilityTest
-		challenge        *cmacme.Challenge
-		expectedErr      bool
+		name                    string
+		reachabilityTest        reachabilityTest
+		tlsALPNReachabilityTest tlsALPNReachabilityTest
+		challenge               *cmacme.Challenge
+		expectedErr             bool
+	}
+	tlsALPN01Challenge := &cmacme.Challenge{
+		Spec: cmacme.ChallengeSpec{
+			Type:    cmacme.ACMEChallengeTypeTLSALPN01,
+			DNSName: "example.com",
+		},
 	}
 	tests := []testT{
 		{
@@ -65,6 +72,25 @@ func TestCheck(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name:      "should pass tls-alpn-01 using the tls-alpn-01 reachability test",
+			challenge: tlsALPN01Challenge,
+			reachabilityTest: func(context.Context, *url.URL, string, []string, string) error {
+				return fmt.Errorf("http-01 reachability test should not be used")
+			},
+			tlsALPNReachabilityTest: func(context.Context, string, string, []string) error {
+				return nil
+			},
+			expectedErr: false,
+		},
+		{
+			name:      "should error tls-alpn-01",
+			challenge: tlsALPN01Challenge,
+			tlsALPNReachabilityTest: func(context.Context, string, string, []string) error {
+				return fmt.Errorf("failed")
+			},
+			expectedErr: true,
+		},
 	}
 
 	for i := range tests {
@@ -78,7 +104,11 @@ func TestCheck(t *testing.T) {
 			s := Solver{
 				Context:          &controller.Context{RESTConfig: new(rest.Config)},
 				testReachability: countReachabilityTestCalls(&calls, test.reachabilityTest),
-				requiredPasses:   requiredCallsForPass,
+				testTLSALPNReachability: func(ctx context.Context, domain, keyAuth string, dnsServers []string) error {
+					calls++
+					return test.tlsALPNReachabilityTest(ctx, domain, keyAuth, dnsServers)
+				},
+				requiredPasses: requiredCallsForPass,
 			}
 
 			err := s.Check(t.Context(), nil, test.challenge)
@@ -98,6 +128,56 @@ func TestCheck(t *testing.T) {
 	}
 }
 
+func TestCheckIPAddressIdentifier(t *testing.T) {
+	tests := map[string]struct {
+		ip           string
+		expectedHost string
+	}{
+		"IPv4 address": {
+			ip:           "192.0.2.1",
+			expectedHost: "192.0.2.1",
+		},
+		"IPv6 address": {
+			ip:           "2001:db8::1",
+			expectedHost: "[2001:db8::1]",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := Solver{
+				Context: &controller.Context{
+					RESTConfig: new(rest.Config),
+					ACMEOptions: controller.ACMEOptions{
+						HTTP01SolverNameservers: []string{"127.0.0.1:53"},
+					},
+				},
+				testReachability: func(_ context.Context, url *url.URL, _ string, dnsServers []string, _ string) error {
+					if url.Host != test.expectedHost {
+						return fmt.Errorf("expected self check to target %q, but got %q", test.expectedHost, url.Host)
+					}
+					if len(dnsServers) != 0 {
+						return fmt.Errorf("expected no nameservers to be used for an IP address, but got %v", dnsServers)
+					}
+					return nil
+				},
+				requiredPasses: 1,
+			}
+
+			ch := &cmacme.Challenge{
+				Spec: cmacme.ChallengeSpec{
+					Type:    cmacme.ACMEChallengeTypeHTTP01,
+					DNSName: test.ip,
+					Token:   "token",
+				},
+			}
+			if err := s.Check(t.Context(), nil, ch); err != nil {
+				t.Errorf("Expected Check to succeed, but got %v", err)
+			}
+		})
+	}
+}
+
 func TestReachabilityCustomDnsServers(t *testing.T) {
 	site := "https://cert-manager.io"
 	u, err := url.Parse(site)
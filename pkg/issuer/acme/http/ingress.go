@@ -173,11 +173,6 @@ func (s *Solver) buildIngressResource(ch *cmacme.Challenge, svcName string) (*ne
 
 	ingPathToAdd := ingressPath(ch.Spec.Token, svcName)
 
-	httpHost := ch.Spec.DNSName
-	// if we need to verify ownership of an IP the challenge should propagate on all hosts
-	if net.ParseIP(httpHost) != nil {
-		httpHost = ""
-	}
 	ing := &networkingv1.Ingress{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName:    "cm-acme-http-solver-",
@@ -190,7 +185,7 @@ func (s *Solver) buildIngressResource(ch *cmacme.Challenge, svcName string) (*ne
 			IngressClassName: ingressClassName,
 			Rules: []networkingv1.IngressRule{
 				{
-					Host: httpHost,
+					Host: ingressRuleHost(ch),
 					IngressRuleValue: networkingv1.IngressRuleValue{
 						HTTP: &networkingv1.HTTPIngressRuleValue{
 							Paths: []networkingv1.HTTPIngressPath{
@@ -249,9 +244,10 @@ func (s *Solver) addChallengePathToIngress(ctx context.Context, ch *cmacme.Chall
 	}
 
 	ingPathToAdd := ingressPath(ch.Spec.Token, svcName)
+	httpHost := ingressRuleHost(ch)
 	// check for an existing Rule for the given domain on the ingress resource
 	for _, rule := range ing.Spec.Rules {
-		if rule.Host == ch.Spec.DNSName {
+		if rule.Host == httpHost {
 			if rule.HTTP == nil {
 				rule.HTTP = &networkingv1.HTTPIngressRuleValue{}
 			}
@@ -275,7 +271,7 @@ func (s *Solver) addChallengePathToIngress(ctx context.Context, ch *cmacme.Chall
 
 	// if one doesn't exist, create a new IngressRule
 	ing.Spec.Rules = append(ing.Spec.Rules, networkingv1.IngressRule{
-		Host: ch.Spec.DNSName,
+		Host: httpHost,
 		IngressRuleValue: networkingv1.IngressRuleValue{
 			HTTP: &networkingv1.HTTPIngressRuleValue{
 				Paths: []networkingv1.HTTPIngressPath{ingPathToAdd},
@@ -337,10 +333,11 @@ func (s *Solver) cleanupIngresses(ctx context.Context, ch *cmacme.Challenge) err
 
 	log.V(logf.DebugLevel).Info("attempting to clean up automatically added solver paths on ingress resource")
 	ingPathToDel := solverPathFn(ch.Spec.Token)
+	httpHost := ingressRuleHost(ch)
 	var ingRules []networkingv1.IngressRule
 	for _, rule := range ing.Spec.Rules {
 		// always retain rules that are not for the same DNSName
-		if rule.Host != ch.Spec.DNSName {
+		if rule.Host != httpHost {
 			ingRules = append(ingRules, rule)
 			continue
 		}
@@ -378,6 +375,16 @@ func (s *Solver) cleanupIngresses(ctx context.Context, ch *cmacme.Challenge) err
 	return nil
 }
 
+// ingressRuleHost returns the host of the ingress rule used to solve this
+// challenge. Ingress rules can't match on an IP address, so when verifying
+// ownership of an IP the challenge is served by a rule matching all hosts.
+func ingressRuleHost(ch *cmacme.Challenge) string {
+	if net.ParseIP(ch.Spec.DNSName) != nil {
+		return ""
+	}
+	return ch.Spec.DNSName
+}
+
 // ingressPath returns the ingress HTTPIngressPath object needed to solve this
 // challenge.
 func ingressPath(token, serviceName string) networkingv1.HTTPIngressPath {
@@ -553,6 +553,51 @@ func TestEnsureIngress(t *testing.T) {
 				}
 			},
 		},
+		"should add a rule matching all hosts to an existing ingress for an IP address": {
+			Builder: &test.Builder{
+				KubeObjects: []runtime.Object{
+					&networkingv1.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "testingress",
+							Namespace: defaultTestNamespace,
+						},
+						Spec: networkingv1.IngressSpec{
+							Rules: []networkingv1.IngressRule{
+								{
+									Host: "example.com",
+									IngressRuleValue: networkingv1.IngressRuleValue{
+										HTTP: &networkingv1.HTTPIngressRuleValue{},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Challenge: &cmacme.Challenge{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultTestNamespace,
+				},
+				Spec: cmacme.ChallengeSpec{
+					DNSName: "192.0.2.1",
+					Token:   "abcd",
+					Solver: cmacme.ACMEChallengeSolver{
+						HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+							Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{
+								Name: "testingress",
+							},
+						},
+					},
+				},
+			},
+			CheckFn: checkOneIngress(func(t *testing.T, ingress *networkingv1.Ingress) {
+				require.Len(t, ingress.Spec.Rules, 2)
+				assert.Equal(t, "example.com", ingress.Spec.Rules[0].Host)
+				assert.Empty(t, ingress.Spec.Rules[1].Host)
+				require.Len(t, ingress.Spec.Rules[1].HTTP.Paths, 1)
+				assert.Equal(t, "/.well-known/acme-challenge/abcd", ingress.Spec.Rules[1].HTTP.Paths[0].Path)
+			}),
+		},
 		"class field is passed to ingress as the annotation kubernetes.io/ingress.class": {
 			Challenge: &cmacme.Challenge{Spec: cmacme.ChallengeSpec{Solver: cmacme.ACMEChallengeSolver{HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
 				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{
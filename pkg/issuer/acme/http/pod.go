@@ -47,6 +47,15 @@ func podLabels(ch *cmacme.Challenge) map[string]string {
 	}
 }
 
+// solverGenerateName returns the name prefix used for the resources created
+// to solve the given challenge.
+func solverGenerateName(ch *cmacme.Challenge) string {
+	if isTLSALPN01(ch) {
+		return "cm-acme-tls-alpn-solver-"
+	}
+	return "cm-acme-http-solver-"
+}
+
 // acmeIdentityLabelKeys defines the set of label keys reserved for ACME
 // challenge resource discovery. These cannot be overridden by global solver
 // extra labels.
@@ -184,6 +193,10 @@ func (s *Solver) buildPod(ch *cmacme.Challenge) *corev1.Pod {
 				ch.Spec.Solver.HTTP01.GatewayHTTPRoute.PodTemplate)
 		}
 	}
+	if ch.Spec.Solver.TLSALPN01 != nil {
+		pod = s.mergePodObjectMetaWithPodTemplate(pod,
+			ch.Spec.Solver.TLSALPN01.PodTemplate)
+	}
 
 	return pod
 }
@@ -202,9 +215,33 @@ func (s *Solver) buildDefaultPod(ch *cmacme.Challenge) *corev1.Pod {
 		runtimeClassName = new(s.ACMEOptions.HTTP01SolverRuntimeClassName)
 	}
 
+	// TODO: replace this with some kind of cmdline generator
+	args := []string{
+		fmt.Sprintf("--listen-port=%d", acmeSolverListenPort),
+		fmt.Sprintf("--domain=%s", ch.Spec.DNSName),
+		fmt.Sprintf("--token=%s", ch.Spec.Token),
+		fmt.Sprintf("--key=%s", ch.Spec.Key),
+	}
+	port := corev1.ContainerPort{
+		Name:          "http",
+		ContainerPort: acmeSolverListenPort,
+	}
+	if isTLSALPN01(ch) {
+		args = []string{
+			"--challenge-type=tls-alpn-01",
+			fmt.Sprintf("--listen-port=%d", tlsALPNSolverListenPort),
+			fmt.Sprintf("--domain=%s", ch.Spec.DNSName),
+			fmt.Sprintf("--key=%s", ch.Spec.Key),
+		}
+		port = corev1.ContainerPort{
+			Name:          "https",
+			ContainerPort: tlsALPNSolverListenPort,
+		}
+	}
+
 	return &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
-			GenerateName: "cm-acme-http-solver-",
+			GenerateName: solverGenerateName(ch),
 			Namespace:    ch.Namespace,
 			Labels:       podLabels,
 			Annotations: map[string]string{
@@ -214,7 +251,7 @@ func (s *Solver) buildDefaultPod(ch *cmacme.Challenge) *corev1.Pod {
 			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ch, challengeGvk)},
 		},
 		Spec: corev1.PodSpec{
-			// The HTTP01 solver process does not need access to the
+			// The solver process does not need access to the
 			// Kubernetes API server, so we turn off automounting of
 			// the Kubernetes ServiceAccount token.
 			// See https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/#opt-out-of-api-credential-automounting
@@ -236,13 +273,7 @@ func (s *Solver) buildDefaultPod(ch *cmacme.Challenge) *corev1.Pod {
 					Name:            "acmesolver",
 					Image:           s.ACMEOptions.HTTP01SolverImage,
 					ImagePullPolicy: corev1.PullIfNotPresent,
-					// TODO: replace this with some kind of cmdline generator
-					Args: []string{
-						fmt.Sprintf("--listen-port=%d", acmeSolverListenPort),
-						fmt.Sprintf("--domain=%s", ch.Spec.DNSName),
-						fmt.Sprintf("--token=%s", ch.Spec.Token),
-						fmt.Sprintf("--key=%s", ch.Spec.Key),
-					},
+					Args:            args,
 					Resources: corev1.ResourceRequirements{
 						Requests: corev1.ResourceList{
 							corev1.ResourceCPU:    s.ACMEOptions.HTTP01SolverResourceRequestCPU,
@@ -253,12 +284,7 @@ func (s *Solver) buildDefaultPod(ch *cmacme.Challenge) *corev1.Pod {
 							corev1.ResourceMemory: s.ACMEOptions.HTTP01SolverResourceLimitsMemory,
 						},
 					},
-					Ports: []corev1.ContainerPort{
-						{
-							Name:          "http",
-							ContainerPort: acmeSolverListenPort,
-						},
-					},
+					Ports: []corev1.ContainerPort{port},
 					SecurityContext: &corev1.SecurityContext{
 						ReadOnlyRootFilesystem:   new(true),
 						AllowPrivilegeEscalation: new(false),
@@ -939,3 +939,42 @@ func validateContainerResources(t *testing.T, container corev1.Container, expect
 		}
 	}
 }
+
+func TestBuildPodTLSALPN01(t *testing.T) {
+	s := &Solver{Context: &controller.Context{}}
+	ch := &cmacme.Challenge{
+		Spec: cmacme.ChallengeSpec{
+			Type:    cmacme.ACMEChallengeTypeTLSALPN01,
+			DNSName: "example.com",
+			Token:   "token",
+			Key:     "key",
+			Solver: cmacme.ACMEChallengeSolver{
+				TLSALPN01: &cmacme.ACMEChallengeSolverTLSALPN01{
+					PodTemplate: &cmacme.ACMEChallengeSolverHTTP01IngressPodTemplate{
+						ACMEChallengeSolverHTTP01IngressPodObjectMeta: cmacme.ACMEChallengeSolverHTTP01IngressPodObjectMeta{
+							Labels: map[string]string{"custom": "label"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pod := s.buildPod(ch)
+
+	assert.Equal(t, "cm-acme-tls-alpn-solver-", pod.GenerateName)
+	assert.Equal(t, "label", pod.Labels["custom"])
+	assert.Len(t, pod.Spec.Containers, 1)
+	assert.Equal(t, []string{
+		"--challenge-type=tls-alpn-01",
+		"--listen-port=8443",
+		"--domain=example.com",
+		"--key=key",
+	}, pod.Spec.Containers[0].Args)
+	assert.Equal(t, []corev1.ContainerPort{
+		{
+			Name:          "https",
+			ContainerPort: tlsALPNSolverListenPort,
+		},
+	}, pod.Spec.Containers[0].Ports)
+}
@@ -115,30 +115,37 @@ func (s *Solver) buildService(ch *cmacme.Challenge) (*corev1.Service, error) {
 	maps.Copy(serviceLabels, podLabels)
 	maps.Copy(serviceLabels, filterACMEIdentityLabels(s.ACMEOptions.HTTP01SolverExtraLabels))
 
+	port := corev1.ServicePort{
+		Name:       "http",
+		Port:       acmeSolverListenPort,
+		TargetPort: intstr.FromInt32(acmeSolverListenPort),
+	}
+	if isTLSALPN01(ch) {
+		port = corev1.ServicePort{
+			Name:       "https",
+			Port:       tlsALPNServicePort,
+			TargetPort: intstr.FromInt32(tlsALPNSolverListenPort),
+		}
+	}
+
 	service := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
-			GenerateName: "cm-acme-http-solver-",
+			GenerateName: solverGenerateName(ch),
 			Namespace:    ch.Namespace,
 			Labels:       serviceLabels,
 			Annotations: map[string]string{
-				"auth.istio.io/8089": "NONE",
+				fmt.Sprintf("auth.istio.io/%d", port.Port): "NONE",
 			},
 			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ch, challengeGvk)},
 		},
 		Spec: corev1.ServiceSpec{
-			Type: corev1.ServiceTypeNodePort,
-			Ports: []corev1.ServicePort{
-				{
-					Name:       "http",
-					Port:       acmeSolverListenPort,
-					TargetPort: intstr.FromInt32(acmeSolverListenPort),
-				},
-			},
+			Type:     corev1.ServiceTypeNodePort,
+			Ports:    []corev1.ServicePort{port},
 			Selector: podLabels,
 		},
 	}
 
-	// checking for presence of http01 config and if set serviceType is set, override our default (NodePort)
+	// checking for presence of http01 or tlsalpn01 config and if set serviceType is set, override our default (NodePort)
 	serviceType, err := getServiceType(ch)
 	if err != nil {
 		return nil, err
@@ -27,6 +27,7 @@ import (
 	coretesting "k8s.io/client-go/testing"
 
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
 )
 
@@ -353,3 +354,36 @@ func TestBuildServiceExtraLabels(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildServiceTLSALPN01(t *testing.T) {
+	s := &Solver{Context: &controller.Context{}}
+	ch := &cmacme.Challenge{
+		Spec: cmacme.ChallengeSpec{
+			Type:    cmacme.ACMEChallengeTypeTLSALPN01,
+			DNSName: "example.com",
+			Token:   "token",
+			Key:     "key",
+			Solver: cmacme.ACMEChallengeSolver{
+				TLSALPN01: &cmacme.ACMEChallengeSolverTLSALPN01{
+					ServiceType: corev1.ServiceTypeLoadBalancer,
+				},
+			},
+		},
+	}
+
+	svc, err := s.buildService(ch)
+	if err != nil {
+		t.Fatalf("error building service: %v", err)
+	}
+
+	assert.Equal(t, "cm-acme-tls-alpn-solver-", svc.GenerateName)
+	assert.Equal(t, corev1.ServiceTypeLoadBalancer, svc.Spec.Type)
+	assert.Equal(t, []corev1.ServicePort{
+		{
+			Name:       "https",
+			Port:       tlsALPNServicePort,
+			TargetPort: intstr.FromInt32(tlsALPNSolverListenPort),
+		},
+	}, svc.Spec.Ports)
+	assert.Equal(t, map[string]string{"auth.istio.io/443": "NONE"}, svc.Annotations)
+}
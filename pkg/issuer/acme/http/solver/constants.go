@@ -19,4 +19,8 @@ package solver
 const (
 	// HTTPChallengePath is the path prefix used for http-01 challenge requests
 	HTTPChallengePath = "/.well-known/acme-challenge"
+
+	// ACMETLS1Protocol is the ALPN protocol name used for tls-alpn-01
+	// challenge connections, as defined in RFC 8737.
+	ACMETLS1Protocol = "acme-tls/1"
 )
@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package solver
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// idPeACMEIdentifier is the OID of the acmeIdentifier certificate extension
+// defined in RFC 8737 section 6.1.
+var idPeACMEIdentifier = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 31}
+
+// TLSALPN01Solver answers tls-alpn-01 challenge connections for a single
+// domain. Connections negotiating the acme-tls/1 protocol are presented with
+// the challenge certificate, all others are rejected.
+type TLSALPN01Solver struct {
+	ListenPort int
+
+	Domain string
+	Key    string
+
+	listener net.Listener
+	mu       sync.Mutex
+	closed   bool
+}
+
+func (s *TLSALPN01Solver) Listen(log logr.Logger) error {
+	log.Info("starting listener",
+		"expected_domain", s.Domain,
+		"expected_key", s.Key,
+		"listen_port", s.ListenPort,
+	)
+
+	cert, err := TLSALPN01ChallengeCertificate(s.Domain, s.Key)
+	if err != nil {
+		return err
+	}
+
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{ACMETLS1Protocol},
+		GetCertificate: func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+			log := log.WithValues("server_name", hello.ServerName, "protocols", hello.SupportedProtos)
+			if !slices.Contains(hello.SupportedProtos, ACMETLS1Protocol) {
+				log.Info("rejecting connection that does not negotiate the acme-tls/1 protocol")
+				return nil, fmt.Errorf("only the %s protocol is supported", ACMETLS1Protocol)
+			}
+			// An IP address identifier is sent as a reverse DNS name in the
+			// SNI extension (RFC 8738 section 6), which we don't compare.
+			if hello.ServerName != "" && net.ParseIP(s.Domain) == nil && hello.ServerName != s.Domain {
+				log.Info("rejecting connection for unexpected server name", "expected_domain", s.Domain)
+				return nil, fmt.Errorf("unexpected server name %q", hello.ServerName)
+			}
+			log.Info("got successful challenge request, presenting challenge certificate")
+			return &cert, nil
+		},
+	}
+
+	listener, err := tls.Listen("tcp", fmt.Sprintf(":%d", s.ListenPort), config)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.listener = listener
+	s.mu.Unlock()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			s.mu.Lock()
+			closed := s.closed
+			s.mu.Unlock()
+			if closed {
+				return nil
+			}
+			return err
+		}
+		go func() {
+			defer conn.Close()
+			_ = conn.SetDeadline(time.Now().Add(10 * time.Second))
+			// The handshake is all that is needed to answer the challenge.
+			if err := conn.(*tls.Conn).Handshake(); err != nil {
+				log.Info("tls handshake failed", "error", err)
+			}
+		}()
+	}
+}
+
+// Shutdown stops the listener. Connections that are being handshaked are
+// closed once they complete or time out.
+func (s *TLSALPN01Solver) Shutdown(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Close()
+}
+
+// TLSALPN01ChallengeCertificate returns a self-signed certificate which
+// answers the tls-alpn-01 challenge for the domain, as described in RFC 8737
+// section 3. keyAuth is the key authorization of the challenge.
+func TLSALPN01ChallengeCertificate(domain, keyAuth string) (tls.Certificate, error) {
+	digest := sha256.Sum256([]byte(keyAuth))
+	extValue, err := asn1.Marshal(digest[:])
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: "ACME challenge"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		ExtraExtensions: []pkix.Extension{
+			{Id: idPeACMEIdentifier, Critical: true, Value: extValue},
+		},
+	}
+	if ip := net.ParseIP(domain); ip != nil {
+		tmpl.IPAddresses = []net.IP{ip}
+	} else {
+		tmpl.DNSNames = []string{domain}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
+
+// VerifyTLSALPN01ChallengeCertificate checks that the certificate answers the
+// tls-alpn-01 challenge for the domain with the given key authorization.
+func VerifyTLSALPN01ChallengeCertificate(cert *x509.Certificate, domain, keyAuth string) error {
+	if ip := net.ParseIP(domain); ip != nil {
+		if len(cert.IPAddresses) != 1 || !cert.IPAddresses[0].Equal(ip) || len(cert.DNSNames) != 0 {
+			return fmt.Errorf("challenge certificate must contain exactly one IP address SAN %q", domain)
+		}
+	} else if len(cert.DNSNames) != 1 || cert.DNSNames[0] != domain || len(cert.IPAddresses) != 0 {
+		return fmt.Errorf("challenge certificate must contain exactly one DNS name SAN %q", domain)
+	}
+
+	digest := sha256.Sum256([]byte(keyAuth))
+	for _, ext := range cert.Extensions {
+		if !ext.Id.Equal(idPeACMEIdentifier) {
+			continue
+		}
+		if !ext.Critical {
+			return errors.New("acmeIdentifier extension of challenge certificate is not critical")
+		}
+		var presented []byte
+		if rest, err := asn1.Unmarshal(ext.Value, &presented); err != nil || len(rest) != 0 {
+			return errors.New("acmeIdentifier extension of challenge certificate is malformed")
+		}
+		if !bytes.Equal(presented, digest[:]) {
+			return errors.New("acmeIdentifier extension of challenge certificate does not match the key authorization")
+		}
+		return nil
+	}
+
+	return errors.New("challenge certificate does not contain the acmeIdentifier extension")
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package solver
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTLSALPN01ChallengeCertificate(t *testing.T) {
+	cases := map[string]struct {
+		certDomain   string
+		certKey      string
+		verifyDomain string
+		verifyKey    string
+		expectErr    bool
+	}{
+		"verifies a DNS name challenge certificate": {
+			certDomain:   "www.example.com",
+			certKey:      "token.thumbprint",
+			verifyDomain: "www.example.com",
+			verifyKey:    "token.thumbprint",
+		},
+		"verifies an IP address challenge certificate": {
+			certDomain:   "192.0.2.1",
+			certKey:      "token.thumbprint",
+			verifyDomain: "192.0.2.1",
+			verifyKey:    "token.thumbprint",
+		},
+		"rejects a certificate for a different domain": {
+			certDomain:   "www.example.com",
+			certKey:      "token.thumbprint",
+			verifyDomain: "www.example2.com",
+			verifyKey:    "token.thumbprint",
+			expectErr:    true,
+		},
+		"rejects a certificate for a different key authorization": {
+			certDomain:   "www.example.com",
+			certKey:      "token.thumbprint",
+			verifyDomain: "www.example.com",
+			verifyKey:    "token.otherthumbprint",
+			expectErr:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cert, err := TLSALPN01ChallengeCertificate(tc.certDomain, tc.certKey)
+			require.NoError(t, err)
+
+			parsed, err := x509.ParseCertificate(cert.Certificate[0])
+			require.NoError(t, err)
+
+			err = VerifyTLSALPN01ChallengeCertificate(parsed, tc.verifyDomain, tc.verifyKey)
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTLSALPN01Solver(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := l.Addr().(*net.TCPAddr).Port
+	require.NoError(t, l.Close())
+
+	s := &TLSALPN01Solver{
+		ListenPort: port,
+		Domain:     "www.example.com",
+		Key:        "token.thumbprint",
+	}
+	errCh := make(chan error, 1)
+	go func() { errCh <- s.Listen(logr.Discard()) }()
+	defer func() {
+		require.NoError(t, s.Shutdown(t.Context()))
+		require.NoError(t, <-errCh)
+	}()
+
+	dial := func(serverName string, protos []string) (*tls.Conn, error) {
+		var conn *tls.Conn
+		var err error
+		// wait for the listener to be started
+		for range 50 {
+			conn, err = tls.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", port), &tls.Config{
+				ServerName:         serverName,
+				NextProtos:         protos,
+				InsecureSkipVerify: true, // #nosec G402 -- the challenge certificate is self-signed
+			})
+			var opErr *net.OpError
+			if !errors.As(err, &opErr) || opErr.Op != "dial" {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		return conn, err
+	}
+
+	t.Run("presents the challenge certificate over acme-tls/1", func(t *testing.T) {
+		conn, err := dial("www.example.com", []string{ACMETLS1Protocol})
+		require.NoError(t, err)
+		defer conn.Close()
+
+		state := conn.ConnectionState()
+		assert.Equal(t, ACMETLS1Protocol, state.NegotiatedProtocol)
+		require.Len(t, state.PeerCertificates, 1)
+		assert.NoError(t, VerifyTLSALPN01ChallengeCertificate(state.PeerCertificates[0], "www.example.com", "token.thumbprint"))
+	})
+
+	t.Run("rejects connections without acme-tls/1", func(t *testing.T) {
+		_, err := dial("www.example.com", []string{"h2", "http/1.1"})
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects connections for a different server name", func(t *testing.T) {
+		_, err := dial("www.example2.com", []string{ACMETLS1Protocol})
+		assert.Error(t, err)
+	})
+}
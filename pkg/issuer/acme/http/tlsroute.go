@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net"
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/retry"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// ensureGatewayTLSRoute ensures that the TLSRoutes needed to solve a
+// TLS-ALPN-01 challenge exist.
+func (s *Solver) ensureGatewayTLSRoute(ctx context.Context, ch *cmacme.Challenge, svcName string) (*gwapi.TLSRoute, error) {
+	if ch == nil {
+		return nil, fmt.Errorf("ensureGatewayTLSRoute received nil *acme.Challenge")
+	}
+	log := logf.FromContext(ctx).WithName("ensureGatewayTLSRoute")
+
+	tlsRoute, err := s.getGatewayTLSRoute(ctx, ch)
+	if err != nil {
+		return nil, err
+	}
+
+	if tlsRoute == nil {
+		log.Info("creating TLSRoute for challenge", "name", ch.Name, "namespace", ch.Namespace)
+		return s.createGatewayTLSRoute(ctx, ch, svcName)
+	}
+
+	log.Info("Found existing TLSRoute for challenge", "name", ch.Name, "namespace", ch.Namespace)
+
+	return s.checkAndUpdateGatewayTLSRoute(ctx, ch, svcName, tlsRoute)
+}
+
+func (s *Solver) getGatewayTLSRoute(ctx context.Context, ch *cmacme.Challenge) (*gwapi.TLSRoute, error) {
+	log := logf.FromContext(ctx).WithName("getGatewayTLSRoute")
+	tlsRoutes, err := s.tlsRouteLister.TLSRoutes(ch.Namespace).List(labels.Set(podLabels(ch)).AsSelector())
+	if err != nil {
+		return nil, err
+	}
+	switch len(tlsRoutes) {
+	case 0:
+		return nil, nil
+	case 1:
+		return tlsRoutes[0], nil
+	default:
+		// It should not be possible for multiple TLSRoutes for this challenge to exist
+		// If we find this, try to delete them.
+		for _, tlsRoute := range tlsRoutes[1:] {
+			log.Info("Deleting extra TLSRoute", "name", tlsRoute.Name, "namespace", tlsRoute.Namespace)
+			err := s.GWClient.GatewayV1().TLSRoutes(tlsRoute.Namespace).Delete(ctx, tlsRoute.Name, metav1.DeleteOptions{})
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, fmt.Errorf("multiple TLSRoutes found")
+	}
+}
+
+func (s *Solver) tlsRouteLabels(ch *cmacme.Challenge) map[string]string {
+	labels := podLabels(ch)
+	maps.Copy(labels, filterACMEIdentityLabels(s.ACMEOptions.HTTP01SolverExtraLabels))
+	maps.Copy(labels, ch.Spec.Solver.TLSALPN01.GatewayTLSRoute.Labels)
+	return labels
+}
+
+func (s *Solver) createGatewayTLSRoute(ctx context.Context, ch *cmacme.Challenge, svcName string) (*gwapi.TLSRoute, error) {
+	tlsRoute := &gwapi.TLSRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName:    solverGenerateName(ch),
+			Namespace:       ch.Namespace,
+			Labels:          s.tlsRouteLabels(ch),
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ch, challengeGvk)},
+		},
+		Spec: generateTLSRouteSpec(ch, svcName),
+	}
+	return s.GWClient.GatewayV1().TLSRoutes(ch.Namespace).Create(ctx, tlsRoute, metav1.CreateOptions{})
+}
+
+func (s *Solver) checkAndUpdateGatewayTLSRoute(ctx context.Context, ch *cmacme.Challenge, svcName string, tlsRoute *gwapi.TLSRoute) (*gwapi.TLSRoute, error) {
+	log := logf.FromContext(ctx, "checkAndUpdateGatewayTLSRoute")
+	expectedSpec := generateTLSRouteSpec(ch, svcName)
+	expectedLabels := s.tlsRouteLabels(ch)
+	if reflect.DeepEqual(expectedSpec, tlsRoute.Spec) && reflect.DeepEqual(expectedLabels, tlsRoute.Labels) {
+		return tlsRoute, nil
+	}
+	log.Info("TLSRoute is out of date, updating", "name", tlsRoute.Name, "namespace", tlsRoute.Namespace)
+	var ret *gwapi.TLSRoute
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		oldTLSRoute, err := s.GWClient.GatewayV1().TLSRoutes(tlsRoute.Namespace).Get(ctx, tlsRoute.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		newTLSRoute := oldTLSRoute.DeepCopy()
+		newTLSRoute.Spec = expectedSpec
+		newTLSRoute.Labels = expectedLabels
+		ret, err = s.GWClient.GatewayV1().TLSRoutes(newTLSRoute.Namespace).Update(ctx, newTLSRoute, metav1.UpdateOptions{})
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func generateTLSRouteSpec(ch *cmacme.Challenge, svcName string) gwapi.TLSRouteSpec {
+	// The ACME server sends the domain being validated as the TLS server
+	// name, so the route only matches connections for that domain.
+	// IP address identifiers are sent as reverse DNS names (RFC 8738), which
+	// cannot be matched on, so no hostname is set for those.
+	var hostnames []gwapi.Hostname
+	if net.ParseIP(ch.Spec.DNSName) == nil {
+		hostnames = []gwapi.Hostname{gwapi.Hostname(ch.Spec.DNSName)}
+	}
+
+	return gwapi.TLSRouteSpec{
+		CommonRouteSpec: gwapi.CommonRouteSpec{
+			ParentRefs: ch.Spec.Solver.TLSALPN01.GatewayTLSRoute.ParentRefs,
+		},
+		Hostnames: hostnames,
+		Rules: []gwapi.TLSRouteRule{
+			{
+				BackendRefs: []gwapi.BackendRef{
+					{
+						BackendObjectReference: gwapi.BackendObjectReference{
+							Group:     func() *gwapi.Group { g := gwapi.Group(""); return &g }(),
+							Kind:      func() *gwapi.Kind { k := gwapi.Kind("Service"); return &k }(),
+							Name:      gwapi.ObjectName(svcName),
+							Namespace: func() *gwapi.Namespace { n := gwapi.Namespace(ch.Namespace); return &n }(),
+							Port:      func() *gwapi.PortNumber { p := gwapi.PortNumber(tlsALPNServicePort); return &p }(),
+						},
+						Weight: new(int32(1)),
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/diff"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller/test"
+)
+
+func tlsALPN01Challenge(dnsName string) *cmacme.Challenge {
+	return &cmacme.Challenge{
+		Spec: cmacme.ChallengeSpec{
+			Type:    cmacme.ACMEChallengeTypeTLSALPN01,
+			DNSName: dnsName,
+			Solver: cmacme.ACMEChallengeSolver{
+				TLSALPN01: &cmacme.ACMEChallengeSolverTLSALPN01{
+					GatewayTLSRoute: &cmacme.ACMEChallengeSolverTLSALPN01GatewayTLSRoute{
+						Labels: map[string]string{"custom": "label"},
+						ParentRefs: []gwapi.ParentReference{
+							{Name: "gateway"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGetGatewayTLSRouteForChallenge(t *testing.T) {
+	const createdTLSRouteKey = "createdTLSRoute"
+	tests := map[string]solverFixture{
+		"should return one tlsroute that matches": {
+			Challenge: tlsALPN01Challenge("example.com"),
+			PreFn: func(t *testing.T, s *solverFixture) {
+				tlsRoute, err := s.Solver.createGatewayTLSRoute(t.Context(), s.Challenge, "fakeservice")
+				if err != nil {
+					t.Errorf("error preparing test: %v", err)
+				}
+
+				s.testResources[createdTLSRouteKey] = tlsRoute
+				s.Builder.Sync()
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...any) {
+				createdTLSRoute := s.testResources[createdTLSRouteKey].(*gwapi.TLSRoute)
+				gotTLSRoute := args[0].(*gwapi.TLSRoute)
+				if !reflect.DeepEqual(gotTLSRoute, createdTLSRoute) {
+					t.Errorf("Expected %v to equal %v", gotTLSRoute, createdTLSRoute)
+				}
+				if gotTLSRoute.Labels["custom"] != "label" {
+					t.Errorf("Expected TLSRoute to have label 'custom=label', but got %v", gotTLSRoute.Labels)
+				}
+			},
+		},
+		"should not return a tlsroute for the same certificate but different domain": {
+			Challenge: tlsALPN01Challenge("example.com"),
+			PreFn: func(t *testing.T, s *solverFixture) {
+				_, err := s.Solver.createGatewayTLSRoute(t.Context(), tlsALPN01Challenge("notexample.com"), "fakeservice")
+				if err != nil {
+					t.Errorf("error preparing test: %v", err)
+				}
+
+				s.Builder.Sync()
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...any) {
+				gotTLSRoute := args[0].(*gwapi.TLSRoute)
+				if gotTLSRoute != nil {
+					t.Errorf("Expected function to not return a TLSRoute, but got: %v", gotTLSRoute)
+				}
+			},
+		},
+		"should clean-up if there are multiple tlsroute resources": {
+			Challenge: tlsALPN01Challenge("example.com"),
+			Err:       true,
+			PreFn: func(t *testing.T, s *solverFixture) {
+				_, err := s.Solver.createGatewayTLSRoute(t.Context(), s.Challenge, "fakeservice")
+				if err != nil {
+					t.Errorf("error preparing test: %v", err)
+				}
+
+				_, err = s.Solver.createGatewayTLSRoute(t.Context(), s.Challenge, "fakeservice")
+				if err != nil {
+					t.Errorf("error preparing test: %v", err)
+				}
+
+				s.Builder.Sync()
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...any) {
+				tlsRoutes, err := s.Solver.tlsRouteLister.List(labels.NewSelector())
+				if err != nil {
+					t.Errorf("error listing TLSRoutes: %v", err)
+					t.Fail()
+					return
+				}
+				if len(tlsRoutes) != 1 {
+					t.Errorf("Expected 1 TLSRoute, but got: %v", len(tlsRoutes))
+				}
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.Setup(t)
+			resp, err := test.Solver.getGatewayTLSRoute(t.Context(), test.Challenge)
+			if err != nil && !test.Err {
+				t.Errorf("Expected function to not error, but got: %v", err)
+			}
+			if err == nil && test.Err {
+				t.Errorf("Expected function to get an error, but got: %v", err)
+			}
+			test.Finish(t, resp, err)
+		})
+	}
+}
+
+func TestEnsureGatewayTLSRoute(t *testing.T) {
+	tests := map[string]solverFixture{
+		"should create a tlsroute if none exists": {
+			Challenge: tlsALPN01Challenge("example.com"),
+			CheckFn: func(t *testing.T, s *solverFixture, args ...any) {
+				tlsRoutes, err := s.Solver.tlsRouteLister.List(labels.NewSelector())
+				if err != nil {
+					t.Errorf("error listing TLSRoutes: %v", err)
+					t.Fail()
+					return
+				}
+
+				if len(tlsRoutes) != 1 {
+					t.Fatalf("Expected 1 TLSRoute, but got: %v", len(tlsRoutes))
+				}
+
+				if tlsRoutes[0].GenerateName != "cm-acme-tls-alpn-solver-" {
+					t.Errorf("Expected TLSRoute generateName to be %q, but got %q", "cm-acme-tls-alpn-solver-", tlsRoutes[0].GenerateName)
+				}
+			},
+		},
+		"should update challenge tlsroute if service changes": {
+			Challenge: tlsALPN01Challenge("example.com"),
+			PreFn: func(t *testing.T, s *solverFixture) {
+				_, err := s.Solver.createGatewayTLSRoute(t.Context(), s.Challenge, "anotherfakeservice")
+				if err != nil {
+					t.Errorf("error preparing test: %v", err)
+				}
+				s.Builder.Sync()
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...any) {
+				tlsRoutes, err := s.Solver.tlsRouteLister.List(labels.NewSelector())
+				if err != nil {
+					t.Errorf("error listing TLSRoutes: %v", err)
+					t.Fail()
+					return
+				}
+
+				if len(tlsRoutes) != 1 {
+					t.Fatalf("Expected 1 TLSRoute, but got: %v", len(tlsRoutes))
+				}
+
+				gotTLSRouteSpec := tlsRoutes[0].Spec
+				expectedTLSRoute := generateTLSRouteSpec(s.Challenge, "fakeservice")
+				if !reflect.DeepEqual(gotTLSRouteSpec, expectedTLSRoute) {
+					t.Errorf("Expected TLSRoute specs to match, but got diff:\n%v",
+						diff.ObjectDiff(gotTLSRouteSpec, expectedTLSRoute))
+				}
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.Setup(t)
+			resp, err := test.Solver.ensureGatewayTLSRoute(t.Context(), test.Challenge, "fakeservice")
+			if err != nil && !test.Err {
+				t.Errorf("Expected function to not error, but got: %v", err)
+			}
+			if err == nil && test.Err {
+				t.Errorf("Expected function to get an error, but got: %v", err)
+			}
+			test.Finish(t, resp, err)
+		})
+	}
+}
+
+func TestGenerateTLSRouteSpec(t *testing.T) {
+	tests := []struct {
+		name              string
+		dnsName           string
+		expectedHostnames []gwapi.Hostname
+	}{
+		{
+			name:              "should include hostname for DNS name",
+			dnsName:           "example.com",
+			expectedHostnames: []gwapi.Hostname{"example.com"},
+		},
+		{
+			name:              "should not include hostname for IPv4 address",
+			dnsName:           "192.0.2.1",
+			expectedHostnames: nil,
+		},
+		{
+			name:              "should not include hostname for IPv6 address",
+			dnsName:           "2001:db8::1",
+			expectedHostnames: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := generateTLSRouteSpec(tlsALPN01Challenge(tt.dnsName), "fakeservice")
+
+			if !reflect.DeepEqual(spec.Hostnames, tt.expectedHostnames) {
+				t.Errorf("Expected hostnames %v, but got %v", tt.expectedHostnames, spec.Hostnames)
+			}
+			if len(spec.Rules) != 1 || len(spec.Rules[0].BackendRefs) != 1 {
+				t.Fatalf("Expected a single rule with a single backend, but got %v", spec.Rules)
+			}
+			backend := spec.Rules[0].BackendRefs[0]
+			if backend.Name != "fakeservice" || backend.Port == nil || *backend.Port != tlsALPNServicePort {
+				t.Errorf("Expected backend to be fakeservice:%d, but got %v", tlsALPNServicePort, backend.BackendObjectReference)
+			}
+		})
+	}
+}
+
+func TestPresentGatewayTLSRouteNotInstalled(t *testing.T) {
+	b := &test.Builder{T: t}
+	b.Init()
+	defer b.Stop()
+	b.Context.GatewaySolverEnabled = true
+
+	s, err := NewSolver(b.Context)
+	require.NoError(t, err)
+	b.Start()
+
+	// No TLSRoute informer is registered, as it would never sync.
+	assert.Nil(t, s.tlsRouteLister)
+
+	ch := tlsALPN01Challenge("example.com")
+	ch.Name, ch.Namespace = "test", "default"
+	err = s.Present(t.Context(), nil, ch)
+	assert.ErrorContains(t, err, "the gateway api TLSRoute CRD is not installed")
+}
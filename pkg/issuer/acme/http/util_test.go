@@ -86,6 +86,8 @@ func (s *solverFixture) Finish(t *testing.T, args ...any) {
 
 func buildFakeSolver(b *test.Builder) (*Solver, error) {
 	b.Init()
+	b.Context.GatewaySolverEnabled = true
+	b.Context.GatewayTLSRouteSolverEnabled = true
 	s, err := NewSolver(b.Context)
 	if err != nil {
 		return nil, err
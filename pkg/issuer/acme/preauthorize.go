@@ -0,0 +1,381 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"github.com/cert-manager/cert-manager/pkg/acme"
+	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/solverpicker"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
+)
+
+const (
+	errorPreAuthorizationFailed = "ErrPreAuthorize"
+
+	messagePreAuthorizationFailed      = "Failed to pre-authorize %q: %v"
+	messagePreAuthorizationUnsupported = "The ACME server does not support pre-authorization"
+
+	// defaultPreAuthorizationRenewBefore is how long before a
+	// pre-authorization expires that a new one is obtained, if not set on the
+	// issuer.
+	defaultPreAuthorizationRenewBefore = 72 * time.Hour
+
+	// preAuthorizationMinimumBackoff and preAuthorizationMaximumBackoff bound
+	// how long to wait before requesting a new authorization for a DNS name
+	// whose last authorization failed. The backoff doubles with each
+	// consecutive failure, as it does for failed issuances.
+	preAuthorizationMinimumBackoff = time.Hour
+	preAuthorizationMaximumBackoff = 32 * time.Hour
+)
+
+// preAuthorize ensures that the issuer holds a valid authorization for each
+// DNS name configured to be pre-authorized. Pending authorizations are solved
+// using Challenge resources owned by the issuer, which are deleted once the
+// authorization is no longer pending.
+func (a *Acme) preAuthorize(ctx context.Context, issuer v1.GenericIssuer) error {
+	preAuthz := issuer.GetSpec().ACME.PreAuthorization
+	status := issuer.GetStatus().ACMEStatus()
+
+	var errs []error
+	var pending []string
+	if preAuthz != nil {
+		cl, err := a.accountRegistry.GetClient(string(issuer.GetUID()))
+		if err != nil {
+			return err
+		}
+
+		dir, err := cl.Discover(ctx)
+		if err != nil {
+			return err
+		}
+		if dir.AuthzURL == "" {
+			a.recorder.Event(issuer, corev1.EventTypeWarning, errorPreAuthorizationFailed, messagePreAuthorizationUnsupported)
+			return nil
+		}
+
+		renewBefore := preAuthorizationRenewBefore(preAuthz)
+
+		current := make(map[string]cmacme.ACMEPreAuthorizationStatus, len(status.PreAuthorizations))
+		for _, st := range status.PreAuthorizations {
+			current[st.DNSName] = st
+		}
+
+		var statuses []cmacme.ACMEPreAuthorizationStatus
+		for _, dnsName := range preAuthz.DNSNames {
+			st, err := a.syncPreAuthorization(ctx, cl, issuer, dnsName, current[dnsName], renewBefore)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			if st.URL == "" && st.LastFailureTime == nil {
+				continue
+			}
+			if st.State == cmacme.Pending {
+				pending = append(pending, st.URL)
+			}
+			statuses = append(statuses, st)
+		}
+		status.PreAuthorizations = statuses
+	} else {
+		status.PreAuthorizations = nil
+	}
+
+	if err := a.deleteFinishedPreAuthorizationChallenges(ctx, issuer, pending); err != nil {
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// preAuthorizationRenewBefore returns how long before a pre-authorization
+// expires that a new one is obtained.
+func preAuthorizationRenewBefore(preAuthz *cmacme.ACMEPreAuthorization) time.Duration {
+	if preAuthz.RenewBefore != nil {
+		return preAuthz.RenewBefore.Duration
+	}
+	return defaultPreAuthorizationRenewBefore
+}
+
+// preAuthorizationRetryTime returns the time after which a new authorization
+// can be requested for a DNS name whose last authorization failed, and false
+// if it has not failed.
+func preAuthorizationRetryTime(st cmacme.ACMEPreAuthorizationStatus) (time.Time, bool) {
+	if st.LastFailureTime == nil {
+		return time.Time{}, false
+	}
+	delay := preAuthorizationMinimumBackoff
+	for i := 1; i < st.FailedAttempts && delay < preAuthorizationMaximumBackoff; i++ {
+		delay *= 2
+	}
+	return st.LastFailureTime.Add(min(delay, preAuthorizationMaximumBackoff)), true
+}
+
+// nextPreAuthorizationRenewal returns the time at which the first of the
+// issuer's valid pre-authorizations has to be renewed, or at which the first
+// failed one can be retried, and false if there are none. Pending
+// pre-authorizations are instead synced whenever their Challenge changes.
+func nextPreAuthorizationRenewal(issuer v1.GenericIssuer) (time.Time, bool) {
+	preAuthz := issuer.GetSpec().ACME.PreAuthorization
+	if preAuthz == nil {
+		return time.Time{}, false
+	}
+	renewBefore := preAuthorizationRenewBefore(preAuthz)
+
+	var next time.Time
+	for _, st := range issuer.GetStatus().ACMEStatus().PreAuthorizations {
+		var renewAt time.Time
+		switch {
+		case st.State == cmacme.Pending:
+			continue
+		case st.State == cmacme.Valid && st.Expires != nil:
+			renewAt = st.Expires.Add(-renewBefore)
+		default:
+			retryAt, failed := preAuthorizationRetryTime(st)
+			if !failed {
+				continue
+			}
+			renewAt = retryAt
+		}
+		if next.IsZero() || renewAt.Before(next) {
+			next = renewAt
+		}
+	}
+	return next, !next.IsZero()
+}
+
+// syncPreAuthorization returns the status of the authorization for the given
+// DNS name, obtaining a new authorization if the current one is missing,
+// failed or about to expire.
+// Authorizations that failed, or were rejected by the ACME server, are
+// recorded in the status and as events rather than returned as errors, and a
+// new authorization is only requested once the backoff period since the last
+// failure has elapsed.
+func (a *Acme) syncPreAuthorization(ctx context.Context, cl acmecl.Interface, issuer v1.GenericIssuer, dnsName string, current cmacme.ACMEPreAuthorizationStatus, renewBefore time.Duration) (cmacme.ACMEPreAuthorizationStatus, error) {
+	log := logf.FromContext(ctx).WithValues("dnsName", dnsName)
+	current.DNSName = dnsName
+
+	switch current.State {
+	case cmacme.Valid:
+		if current.Expires != nil && a.clock.Now().Add(renewBefore).Before(current.Expires.Time) {
+			return current, nil
+		}
+		log.V(logf.InfoLevel).Info("pre-authorization is about to expire, requesting a new authorization")
+	case cmacme.Pending:
+		authz, err := cl.GetAuthorization(ctx, current.URL)
+		if err != nil {
+			return current, a.handlePreAuthorizationError(issuer, dnsName, err)
+		}
+		current = preAuthorizationStatus(current, authz)
+		if current.State == cmacme.Valid {
+			log.V(logf.InfoLevel).Info("pre-authorization is valid")
+			return current, nil
+		}
+		if current.State == cmacme.Pending {
+			return current, a.ensurePreAuthorizationChallenge(ctx, cl, issuer, authz)
+		}
+		log.V(logf.InfoLevel).Info("pre-authorization failed", "state", current.State)
+		a.recorder.Eventf(issuer, corev1.EventTypeWarning, errorPreAuthorizationFailed, messagePreAuthorizationFailed, dnsName, fmt.Sprintf("authorization is %s", current.State))
+		current = a.preAuthorizationFailed(current)
+	}
+
+	if retryAt, failed := preAuthorizationRetryTime(current); failed && a.clock.Now().Before(retryAt) {
+		log.V(logf.DebugLevel).Info("backing off before requesting a new authorization", "retryAt", retryAt)
+		return current, nil
+	}
+
+	authz, err := cl.Authorize(ctx, dnsName)
+	if err != nil {
+		if err := a.handlePreAuthorizationError(issuer, dnsName, err); err != nil {
+			return current, err
+		}
+		return a.preAuthorizationFailed(current), nil
+	}
+	log.V(logf.DebugLevel).Info("created new pre-authorization", "url", authz.URI)
+
+	st := preAuthorizationStatus(current, authz)
+	if st.State == cmacme.Pending {
+		return st, a.ensurePreAuthorizationChallenge(ctx, cl, issuer, authz)
+	}
+	return st, nil
+}
+
+// preAuthorizationFailed returns the given status with a new failed
+// authorization recorded.
+func (a *Acme) preAuthorizationFailed(st cmacme.ACMEPreAuthorizationStatus) cmacme.ACMEPreAuthorizationStatus {
+	st.LastFailureTime = &metav1.Time{Time: a.clock.Now()}
+	st.FailedAttempts++
+	return st
+}
+
+// handlePreAuthorizationError records a failure to pre-authorize the given
+// DNS name, and returns the error if it should be retried.
+func (a *Acme) handlePreAuthorizationError(issuer v1.GenericIssuer, dnsName string, err error) error {
+	a.recorder.Eventf(issuer, corev1.EventTypeWarning, errorPreAuthorizationFailed, messagePreAuthorizationFailed, dnsName, err)
+
+	if acmeErr, ok := err.(*acmeapi.Error); ok && acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
+		return nil
+	}
+	return err
+}
+
+// ensurePreAuthorizationChallenge creates a Challenge resource, owned by the
+// issuer, to solve the given pending authorization.
+func (a *Acme) ensurePreAuthorizationChallenge(ctx context.Context, cl acmecl.Interface, issuer v1.GenericIssuer, authz *acmeapi.Authorization) error {
+	log := logf.FromContext(ctx).WithValues("dnsName", authz.Identifier.Value)
+
+	issuerGVK := issuerGroupVersionKind(issuer)
+	issuerRef := cmmeta.IssuerReference{
+		Name:  issuer.GetName(),
+		Kind:  issuerGVK.Kind,
+		Group: issuerGVK.Group,
+	}
+
+	challenges := make([]cmacme.ACMEChallenge, len(authz.Challenges))
+	for i, ch := range authz.Challenges {
+		challenges[i] = cmacme.ACMEChallenge{URL: ch.URI, Token: ch.Token, Type: ch.Type}
+	}
+	// Solvers are selected as they would be for an Order for only this DNS
+	// name, so that solvers with a dnsNames or dnsZones selector match.
+	order := &cmacme.Order{
+		Spec: cmacme.OrderSpec{
+			IssuerRef: issuerRef,
+			DNSNames:  []string{authz.Identifier.Value},
+		},
+	}
+	solver, selected := solverpicker.Pick(ctx, authz.Identifier.Value, challenges, issuer.GetSpec().ACME.Solvers, order)
+	if solver == nil || selected == nil {
+		a.recorder.Eventf(issuer, corev1.EventTypeWarning, errorPreAuthorizationFailed, messagePreAuthorizationFailed,
+			authz.Identifier.Value, "no configured challenge solvers can be used for this challenge")
+		return nil
+	}
+
+	chType, err := acme.ChallengeType(selected.Type)
+	if err != nil {
+		return err
+	}
+	key, err := acme.ChallengeKey(cl, chType, selected.Token)
+	if err != nil {
+		return err
+	}
+	var accountURI string
+	if chType == cmacme.ACMEChallengeTypeDNSAccount01 {
+		accountURI = issuer.GetStatus().ACMEStatus().URI
+	}
+
+	spec := cmacme.ChallengeSpec{
+		AuthorizationURL: authz.URI,
+		Type:             chType,
+		URL:              selected.URL,
+		DNSName:          authz.Identifier.Value,
+		Token:            selected.Token,
+		Key:              key,
+		Solver:           *solver,
+		IssuerRef:        issuerRef,
+		AccountURI:       accountURI,
+	}
+	name, err := apiutil.ComputeName(issuer.GetName(), spec)
+	if err != nil {
+		return err
+	}
+
+	ns := a.resourceNamespace(issuer)
+	if _, err := a.challengeLister.Challenges(ns).Get(name); err == nil || !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	log.V(logf.DebugLevel).Info("creating Challenge resource for pre-authorization", "challenge", name)
+	_, err = a.cmClient.AcmeV1().Challenges(ns).Create(ctx, &cmacme.Challenge{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       ns,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(issuer, issuerGVK)},
+		},
+		Spec: spec,
+	}, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+// deleteFinishedPreAuthorizationChallenges deletes the Challenge resources
+// owned by the issuer that do not belong to one of the given pending
+// authorizations.
+func (a *Acme) deleteFinishedPreAuthorizationChallenges(ctx context.Context, issuer v1.GenericIssuer, pendingURLs []string) error {
+	chs, err := a.challengeLister.Challenges(a.resourceNamespace(issuer)).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, ch := range chs {
+		if !metav1.IsControlledBy(ch, issuer) {
+			continue
+		}
+		if ch.DeletionTimestamp != nil || slices.Contains(pendingURLs, ch.Spec.AuthorizationURL) {
+			continue
+		}
+		logf.FromContext(ctx).V(logf.DebugLevel).Info("deleting Challenge resource of finished pre-authorization", "challenge", ch.Name)
+		err := a.cmClient.AcmeV1().Challenges(ch.Namespace).Delete(ctx, ch.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// preAuthorizationStatus returns the status of the given authorization. The
+// failures recorded in the previous status are kept until an authorization
+// becomes valid.
+func preAuthorizationStatus(previous cmacme.ACMEPreAuthorizationStatus, authz *acmeapi.Authorization) cmacme.ACMEPreAuthorizationStatus {
+	st := cmacme.ACMEPreAuthorizationStatus{
+		DNSName: previous.DNSName,
+		URL:     authz.URI,
+		State:   cmacme.State(authz.Status),
+	}
+	if !authz.Expires.IsZero() {
+		st.Expires = &metav1.Time{Time: authz.Expires}
+	}
+	if st.State != cmacme.Valid {
+		st.LastFailureTime = previous.LastFailureTime
+		st.FailedAttempts = previous.FailedAttempts
+	}
+	return st
+}
+
+func issuerGroupVersionKind(issuer v1.GenericIssuer) schema.GroupVersionKind {
+	if _, ok := issuer.(*v1.ClusterIssuer); ok {
+		return v1.SchemeGroupVersion.WithKind(v1.ClusterIssuerKind)
+	}
+	return v1.SchemeGroupVersion.WithKind(v1.IssuerKind)
+}
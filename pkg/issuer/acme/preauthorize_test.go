@@ -0,0 +1,474 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	fakeregistry "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
+	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmfake "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
+	cmacmelisters "github.com/cert-manager/cert-manager/pkg/client/listers/acme/v1"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
+)
+
+func TestAcme_preAuthorize(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := fakeclock.NewFakeClock(now)
+
+	const authzURL = "https://acme/authz/1"
+	const newAuthzURL = "https://acme/authz/2"
+
+	pendingAuthz := func(url string) *acmeapi.Authorization {
+		return &acmeapi.Authorization{
+			URI:        url,
+			Status:     acmeapi.StatusPending,
+			Identifier: acmeapi.AuthzID{Type: "dns", Value: "example.com"},
+			Challenges: []*acmeapi.Challenge{
+				{Type: "http-01", URI: url + "/http-01", Token: "token"},
+			},
+		}
+	}
+	invalidAuthz := func(url string) *acmeapi.Authorization {
+		return &acmeapi.Authorization{
+			URI:        url,
+			Status:     acmeapi.StatusInvalid,
+			Identifier: acmeapi.AuthzID{Type: "dns", Value: "example.com"},
+		}
+	}
+	validAuthz := func(url string, expires time.Time) *acmeapi.Authorization {
+		return &acmeapi.Authorization{
+			URI:        url,
+			Status:     acmeapi.StatusValid,
+			Identifier: acmeapi.AuthzID{Type: "dns", Value: "example.com"},
+			Expires:    expires,
+		}
+	}
+
+	issuerWithStatus := func(preAuthz *cmacme.ACMEPreAuthorization, statuses ...cmacme.ACMEPreAuthorizationStatus) *cmapi.Issuer {
+		iss := gen.Issuer("test-issuer",
+			gen.SetIssuerNamespace("default"),
+			gen.SetIssuerACMEURL(acmev2Prod),
+			gen.SetIssuerACMESolvers([]cmacme.ACMEChallengeSolver{
+				{HTTP01: &cmacme.ACMEChallengeSolverHTTP01{Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{}}},
+			}),
+			func(iss cmapi.GenericIssuer) {
+				iss.GetSpec().ACME.PreAuthorization = preAuthz
+				iss.GetStatus().ACMEStatus().PreAuthorizations = statuses
+			},
+		)
+		iss.UID = "test-uid"
+		return iss
+	}
+	preAuthz := &cmacme.ACMEPreAuthorization{DNSNames: []string{"example.com"}}
+	ownedChallenge := func(iss *cmapi.Issuer, url string) *cmacme.Challenge {
+		return &cmacme.Challenge{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "test-issuer-1",
+				Namespace:       "default",
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(iss, cmapi.SchemeGroupVersion.WithKind(cmapi.IssuerKind))},
+			},
+			Spec: cmacme.ChallengeSpec{AuthorizationURL: url},
+		}
+	}
+
+	tests := map[string]struct {
+		issuer            *cmapi.Issuer
+		existingChallenge func(*cmapi.Issuer) *cmacme.Challenge
+		directory         acmeapi.Directory
+		authorize         func(context.Context, string) (*acmeapi.Authorization, error)
+		getAuthorization  func(context.Context, string) (*acmeapi.Authorization, error)
+
+		expectedStatuses       []cmacme.ACMEPreAuthorizationStatus
+		expectChallengeCreated bool
+		expectChallengeDeleted bool
+		expectErr              bool
+		expectedEvents         []string
+		expectAuthorize        bool
+	}{
+		"owned challenges are deleted if pre-authorization is not configured": {
+			issuer:                 issuerWithStatus(nil, cmacme.ACMEPreAuthorizationStatus{DNSName: "example.com", URL: authzURL, State: cmacme.Pending}),
+			existingChallenge:      func(iss *cmapi.Issuer) *cmacme.Challenge { return ownedChallenge(iss, authzURL) },
+			expectChallengeDeleted: true,
+		},
+		"new authorization is requested and a challenge created": {
+			issuer:          issuerWithStatus(preAuthz),
+			directory:       acmeapi.Directory{AuthzURL: "https://acme/new-authz"},
+			expectAuthorize: true,
+			authorize: func(context.Context, string) (*acmeapi.Authorization, error) {
+				return pendingAuthz(authzURL), nil
+			},
+			expectedStatuses:       []cmacme.ACMEPreAuthorizationStatus{{DNSName: "example.com", URL: authzURL, State: cmacme.Pending}},
+			expectChallengeCreated: true,
+		},
+		"valid authorization that is not about to expire is kept": {
+			issuer: issuerWithStatus(preAuthz,
+				cmacme.ACMEPreAuthorizationStatus{DNSName: "example.com", URL: authzURL, State: cmacme.Valid, Expires: &metav1.Time{Time: now.Add(30 * 24 * time.Hour)}}),
+			directory:        acmeapi.Directory{AuthzURL: "https://acme/new-authz"},
+			expectedStatuses: []cmacme.ACMEPreAuthorizationStatus{{DNSName: "example.com", URL: authzURL, State: cmacme.Valid, Expires: &metav1.Time{Time: now.Add(30 * 24 * time.Hour)}}},
+		},
+		"valid authorization that is about to expire is renewed": {
+			issuer: issuerWithStatus(preAuthz,
+				cmacme.ACMEPreAuthorizationStatus{DNSName: "example.com", URL: authzURL, State: cmacme.Valid, Expires: &metav1.Time{Time: now.Add(time.Hour)}}),
+			directory:       acmeapi.Directory{AuthzURL: "https://acme/new-authz"},
+			expectAuthorize: true,
+			authorize: func(context.Context, string) (*acmeapi.Authorization, error) {
+				return validAuthz(newAuthzURL, now.Add(30*24*time.Hour)), nil
+			},
+			expectedStatuses: []cmacme.ACMEPreAuthorizationStatus{{DNSName: "example.com", URL: newAuthzURL, State: cmacme.Valid, Expires: &metav1.Time{Time: now.Add(30 * 24 * time.Hour)}}},
+		},
+		"challenge is deleted once a pending authorization becomes valid": {
+			issuer: issuerWithStatus(preAuthz,
+				cmacme.ACMEPreAuthorizationStatus{DNSName: "example.com", URL: authzURL, State: cmacme.Pending}),
+			existingChallenge: func(iss *cmapi.Issuer) *cmacme.Challenge { return ownedChallenge(iss, authzURL) },
+			directory:         acmeapi.Directory{AuthzURL: "https://acme/new-authz"},
+			getAuthorization: func(context.Context, string) (*acmeapi.Authorization, error) {
+				return validAuthz(authzURL, now.Add(30*24*time.Hour)), nil
+			},
+			expectedStatuses:       []cmacme.ACMEPreAuthorizationStatus{{DNSName: "example.com", URL: authzURL, State: cmacme.Valid, Expires: &metav1.Time{Time: now.Add(30 * 24 * time.Hour)}}},
+			expectChallengeDeleted: true,
+		},
+		"authorization rejected by the ACME server is recorded as a failure": {
+			issuer:          issuerWithStatus(preAuthz),
+			directory:       acmeapi.Directory{AuthzURL: "https://acme/new-authz"},
+			expectAuthorize: true,
+			authorize: func(context.Context, string) (*acmeapi.Authorization, error) {
+				return nil, &acmeapi.Error{StatusCode: 403}
+			},
+			expectedStatuses: []cmacme.ACMEPreAuthorizationStatus{{DNSName: "example.com", LastFailureTime: &metav1.Time{Time: now}, FailedAttempts: 1}},
+			expectedEvents:   []string{fmt.Sprintf("%s %s "+messagePreAuthorizationFailed, corev1.EventTypeWarning, errorPreAuthorizationFailed, "example.com", &acmeapi.Error{StatusCode: 403})},
+		},
+		"pending authorization that becomes invalid is recorded as a failure and not retried immediately": {
+			issuer: issuerWithStatus(preAuthz,
+				cmacme.ACMEPreAuthorizationStatus{DNSName: "example.com", URL: authzURL, State: cmacme.Pending}),
+			existingChallenge: func(iss *cmapi.Issuer) *cmacme.Challenge { return ownedChallenge(iss, authzURL) },
+			directory:         acmeapi.Directory{AuthzURL: "https://acme/new-authz"},
+			getAuthorization: func(context.Context, string) (*acmeapi.Authorization, error) {
+				return invalidAuthz(authzURL), nil
+			},
+			expectedStatuses:       []cmacme.ACMEPreAuthorizationStatus{{DNSName: "example.com", URL: authzURL, State: cmacme.Invalid, LastFailureTime: &metav1.Time{Time: now}, FailedAttempts: 1}},
+			expectChallengeDeleted: true,
+			expectedEvents:         []string{fmt.Sprintf("%s %s "+messagePreAuthorizationFailed, corev1.EventTypeWarning, errorPreAuthorizationFailed, "example.com", "authorization is invalid")},
+		},
+		"failed authorization is not retried before the backoff has elapsed": {
+			issuer: issuerWithStatus(preAuthz,
+				cmacme.ACMEPreAuthorizationStatus{DNSName: "example.com", URL: authzURL, State: cmacme.Invalid, LastFailureTime: &metav1.Time{Time: now.Add(-90 * time.Minute)}, FailedAttempts: 2}),
+			directory:        acmeapi.Directory{AuthzURL: "https://acme/new-authz"},
+			expectedStatuses: []cmacme.ACMEPreAuthorizationStatus{{DNSName: "example.com", URL: authzURL, State: cmacme.Invalid, LastFailureTime: &metav1.Time{Time: now.Add(-90 * time.Minute)}, FailedAttempts: 2}},
+		},
+		"failed authorization is retried once the backoff has elapsed, keeping the recorded failures": {
+			issuer: issuerWithStatus(preAuthz,
+				cmacme.ACMEPreAuthorizationStatus{DNSName: "example.com", URL: newAuthzURL, State: cmacme.Invalid, LastFailureTime: &metav1.Time{Time: now.Add(-2 * time.Hour)}, FailedAttempts: 2}),
+			directory:       acmeapi.Directory{AuthzURL: "https://acme/new-authz"},
+			expectAuthorize: true,
+			authorize: func(context.Context, string) (*acmeapi.Authorization, error) {
+				return pendingAuthz(authzURL), nil
+			},
+			expectedStatuses:       []cmacme.ACMEPreAuthorizationStatus{{DNSName: "example.com", URL: authzURL, State: cmacme.Pending, LastFailureTime: &metav1.Time{Time: now.Add(-2 * time.Hour)}, FailedAttempts: 2}},
+			expectChallengeCreated: true,
+		},
+		"recorded failures are cleared once an authorization becomes valid": {
+			issuer: issuerWithStatus(preAuthz,
+				cmacme.ACMEPreAuthorizationStatus{DNSName: "example.com", URL: authzURL, State: cmacme.Pending, LastFailureTime: &metav1.Time{Time: now.Add(-2 * time.Hour)}, FailedAttempts: 2}),
+			directory: acmeapi.Directory{AuthzURL: "https://acme/new-authz"},
+			getAuthorization: func(context.Context, string) (*acmeapi.Authorization, error) {
+				return validAuthz(authzURL, now.Add(30*24*time.Hour)), nil
+			},
+			expectedStatuses: []cmacme.ACMEPreAuthorizationStatus{{DNSName: "example.com", URL: authzURL, State: cmacme.Valid, Expires: &metav1.Time{Time: now.Add(30 * 24 * time.Hour)}}},
+		},
+		"unknown error is retried": {
+			issuer:          issuerWithStatus(preAuthz),
+			directory:       acmeapi.Directory{AuthzURL: "https://acme/new-authz"},
+			expectAuthorize: true,
+			authorize: func(context.Context, string) (*acmeapi.Authorization, error) {
+				return nil, fmt.Errorf("test")
+			},
+			expectErr:      true,
+			expectedEvents: []string{fmt.Sprintf("%s %s "+messagePreAuthorizationFailed, corev1.EventTypeWarning, errorPreAuthorizationFailed, "example.com", "test")},
+		},
+		"ACME server without pre-authorization support": {
+			issuer:         issuerWithStatus(preAuthz),
+			expectedEvents: []string{fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, errorPreAuthorizationFailed, messagePreAuthorizationUnsupported)},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			cmClient := cmfake.NewClientset()
+			if test.existingChallenge != nil {
+				ch := test.existingChallenge(test.issuer)
+				require.NoError(t, indexer.Add(ch))
+				_, err := cmClient.AcmeV1().Challenges(ch.Namespace).Create(t.Context(), ch, metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+
+			authorizeCalled := false
+			cl := &acmecl.FakeACME{
+				FakeDiscover: func(context.Context) (acmeapi.Directory, error) {
+					return test.directory, nil
+				},
+				FakeAuthorize: func(ctx context.Context, domain string) (*acmeapi.Authorization, error) {
+					authorizeCalled = true
+					return test.authorize(ctx, domain)
+				},
+				FakeGetAuthorization: test.getAuthorization,
+				FakeHTTP01ChallengeResponse: func(string) (string, error) {
+					return "key", nil
+				},
+			}
+			recorder := new(controllertest.FakeRecorder)
+			a := Acme{
+				resourceNamespace: func(iss cmapi.GenericIssuer) string {
+					return iss.GetNamespace()
+				},
+				accountRegistry: &fakeregistry.FakeRegistry{
+					GetClientFunc: func(string) (acmecl.Interface, error) {
+						return cl, nil
+					},
+				},
+				cmClient:        cmClient,
+				challengeLister: cmacmelisters.NewChallengeLister(indexer),
+				recorder:        recorder,
+				clock:           clock,
+			}
+
+			err := a.preAuthorize(t.Context(), test.issuer)
+			if test.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expectAuthorize, authorizeCalled)
+			assert.Equal(t, test.expectedStatuses, test.issuer.Status.ACME.PreAuthorizations)
+			if !slices.Equal(test.expectedEvents, recorder.Events) {
+				t.Errorf("Expected events:\n%+#v\ngot:%+#v", test.expectedEvents, recorder.Events)
+			}
+
+			chs, err := cmClient.AcmeV1().Challenges("default").List(t.Context(), metav1.ListOptions{})
+			require.NoError(t, err)
+			var created, existing bool
+			for _, ch := range chs.Items {
+				if ch.Name == "test-issuer-1" {
+					existing = true
+					continue
+				}
+				created = true
+				assert.Equal(t, authzURL, ch.Spec.AuthorizationURL)
+				assert.Equal(t, cmacme.ACMEChallengeTypeHTTP01, ch.Spec.Type)
+				assert.Equal(t, "key", ch.Spec.Key)
+				assert.True(t, metav1.IsControlledBy(&ch, test.issuer))
+			}
+			assert.Equal(t, test.expectChallengeCreated, created)
+			if test.existingChallenge != nil {
+				assert.Equal(t, test.expectChallengeDeleted, !existing)
+			}
+		})
+	}
+}
+
+func TestAcme_NextSetup(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	issuerWith := func(preAuthz *cmacme.ACMEPreAuthorization, statuses ...cmacme.ACMEPreAuthorizationStatus) *cmapi.Issuer {
+		return gen.Issuer("test-issuer",
+			gen.SetIssuerACMEURL(acmev2Prod),
+			func(iss cmapi.GenericIssuer) {
+				iss.GetSpec().ACME.PreAuthorization = preAuthz
+				iss.GetStatus().ACMEStatus().PreAuthorizations = statuses
+			},
+		)
+	}
+	valid := func(dnsName string, expires time.Time) cmacme.ACMEPreAuthorizationStatus {
+		return cmacme.ACMEPreAuthorizationStatus{DNSName: dnsName, State: cmacme.Valid, Expires: &metav1.Time{Time: expires}}
+	}
+	withKeyRotation := func(iss *cmapi.Issuer, lastRollover time.Time, period time.Duration) *cmapi.Issuer {
+		iss.Spec.ACME.AccountKeyRollover = &cmacme.ACMEAccountKeyRollover{RotationPeriod: &metav1.Duration{Duration: period}}
+		if !lastRollover.IsZero() {
+			iss.Status.ACME.LastKeyRolloverTime = &metav1.Time{Time: lastRollover}
+		}
+		return iss
+	}
+	preAuthz := &cmacme.ACMEPreAuthorization{
+		DNSNames:    []string{"example.com", "example.org"},
+		RenewBefore: &metav1.Duration{Duration: time.Hour},
+	}
+
+	tests := map[string]struct {
+		issuer        *cmapi.Issuer
+		expectedAfter time.Duration
+		expectedOK    bool
+	}{
+		"nothing is scheduled if pre-authorization is not configured": {
+			issuer: issuerWith(nil, valid("example.com", now.Add(2*time.Hour))),
+		},
+		"nothing is scheduled if there are no valid pre-authorizations": {
+			issuer: issuerWith(preAuthz, cmacme.ACMEPreAuthorizationStatus{DNSName: "example.com", State: cmacme.Pending}),
+		},
+		"the first pre-authorization to expire is renewed renewBefore before it expires": {
+			issuer:        issuerWith(preAuthz, valid("example.com", now.Add(5*time.Hour)), valid("example.org", now.Add(3*time.Hour))),
+			expectedAfter: 2 * time.Hour,
+			expectedOK:    true,
+		},
+		"renewal defaults to 72h before a pre-authorization expires": {
+			issuer:        issuerWith(&cmacme.ACMEPreAuthorization{DNSNames: []string{"example.com"}}, valid("example.com", now.Add(100*time.Hour))),
+			expectedAfter: 28 * time.Hour,
+			expectedOK:    true,
+		},
+		"account key rotation is scheduled at the end of the rotation period": {
+			issuer:        withKeyRotation(issuerWith(nil), now.Add(-time.Hour), 3*time.Hour),
+			expectedAfter: 2 * time.Hour,
+			expectedOK:    true,
+		},
+		"account key rotation is not scheduled before the first rollover time is recorded": {
+			issuer: withKeyRotation(issuerWith(nil), time.Time{}, 3*time.Hour),
+		},
+		"the earlier of account key rotation and pre-authorization renewal is scheduled": {
+			issuer:        withKeyRotation(issuerWith(preAuthz, valid("example.com", now.Add(5*time.Hour))), now, 3*time.Hour),
+			expectedAfter: 3 * time.Hour,
+			expectedOK:    true,
+		},
+		"failed pre-authorizations are retried once their backoff has elapsed": {
+			issuer: issuerWith(preAuthz,
+				valid("example.com", now.Add(5*time.Hour)),
+				cmacme.ACMEPreAuthorizationStatus{DNSName: "example.org", State: cmacme.Invalid, LastFailureTime: &metav1.Time{Time: now.Add(-time.Hour)}, FailedAttempts: 2}),
+			expectedAfter: time.Hour,
+			expectedOK:    true,
+		},
+		"overdue renewals are scheduled immediately": {
+			issuer:     issuerWith(preAuthz, valid("example.com", now.Add(30*time.Minute))),
+			expectedOK: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := Acme{clock: fakeclock.NewFakeClock(now)}
+			after, ok := a.NextSetup(test.issuer)
+			assert.Equal(t, test.expectedOK, ok)
+			assert.Equal(t, test.expectedAfter, after)
+		})
+	}
+}
+
+func TestAcme_preAuthorizeRepeatedFailures(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := fakeclock.NewFakeClock(now)
+
+	iss := gen.Issuer("test-issuer",
+		gen.SetIssuerNamespace("default"),
+		gen.SetIssuerACMEURL(acmev2Prod),
+		gen.SetIssuerACMESolvers([]cmacme.ACMEChallengeSolver{
+			{HTTP01: &cmacme.ACMEChallengeSolverHTTP01{Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{}}},
+		}),
+		func(iss cmapi.GenericIssuer) {
+			iss.GetSpec().ACME.PreAuthorization = &cmacme.ACMEPreAuthorization{DNSNames: []string{"example.com"}}
+		},
+	)
+	iss.UID = "test-uid"
+
+	// Every authorization is invalidated, as it would be by a broken solver.
+	authorizeCalls := 0
+	cl := &acmecl.FakeACME{
+		FakeDiscover: func(context.Context) (acmeapi.Directory, error) {
+			return acmeapi.Directory{AuthzURL: "https://acme/new-authz"}, nil
+		},
+		FakeAuthorize: func(context.Context, string) (*acmeapi.Authorization, error) {
+			authorizeCalls++
+			return &acmeapi.Authorization{
+				URI:        fmt.Sprintf("https://acme/authz/%d", authorizeCalls),
+				Status:     acmeapi.StatusPending,
+				Identifier: acmeapi.AuthzID{Type: "dns", Value: "example.com"},
+				Challenges: []*acmeapi.Challenge{{Type: "http-01", URI: "https://acme/chall", Token: "token"}},
+			}, nil
+		},
+		FakeGetAuthorization: func(_ context.Context, url string) (*acmeapi.Authorization, error) {
+			return &acmeapi.Authorization{
+				URI:        url,
+				Status:     acmeapi.StatusInvalid,
+				Identifier: acmeapi.AuthzID{Type: "dns", Value: "example.com"},
+			}, nil
+		},
+		FakeHTTP01ChallengeResponse: func(string) (string, error) {
+			return "key", nil
+		},
+	}
+	a := Acme{
+		resourceNamespace: func(iss cmapi.GenericIssuer) string {
+			return iss.GetNamespace()
+		},
+		accountRegistry: &fakeregistry.FakeRegistry{
+			GetClientFunc: func(string) (acmecl.Interface, error) {
+				return cl, nil
+			},
+		},
+		cmClient:        cmfake.NewClientset(),
+		challengeLister: cmacmelisters.NewChallengeLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})),
+		recorder:        new(controllertest.FakeRecorder),
+		clock:           clock,
+	}
+	sync := func() {
+		t.Helper()
+		require.NoError(t, a.preAuthorize(t.Context(), iss))
+	}
+
+	sync()
+	require.Equal(t, 1, authorizeCalls)
+
+	expectedBackoffs := []time.Duration{
+		time.Hour, 2 * time.Hour, 4 * time.Hour, 8 * time.Hour, 16 * time.Hour, 32 * time.Hour, 32 * time.Hour,
+	}
+	for i, backoff := range expectedBackoffs {
+		attempt := i + 1
+
+		// The pending authorization is found to be invalid, which is
+		// recorded without requesting a new authorization.
+		sync()
+		require.Equal(t, attempt, authorizeCalls)
+		require.Len(t, iss.Status.ACME.PreAuthorizations, 1)
+		st := iss.Status.ACME.PreAuthorizations[0]
+		assert.Equal(t, cmacme.Invalid, st.State)
+		assert.Equal(t, attempt, st.FailedAttempts)
+		assert.Equal(t, &metav1.Time{Time: clock.Now()}, st.LastFailureTime)
+
+		after, ok := a.NextSetup(iss)
+		assert.True(t, ok)
+		assert.Equal(t, backoff, after, "attempt %d", attempt)
+
+		clock.Step(backoff - time.Second)
+		sync()
+		require.Equal(t, attempt, authorizeCalls, "a new authorization was requested before the backoff elapsed")
+
+		clock.Step(time.Second)
+		sync()
+		require.Equal(t, attempt+1, authorizeCalls, "a new authorization was not requested once the backoff elapsed")
+	}
+}
@@ -23,8 +23,11 @@ import (
 	"crypto/x509"
 	"encoding/base64"
 	"fmt"
+	"net/http"
 	"net/url"
+	"slices"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -34,24 +37,36 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
 	"github.com/cert-manager/cert-manager/pkg/acme/client"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	"github.com/cert-manager/cert-manager/pkg/util/errors"
+	"github.com/cert-manager/cert-manager/pkg/util/kube"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
 )
 
 const (
+	// eabClientIDKey and eabClientSecretKey are the keys of the OAuth client
+	// credentials in the Secret referenced by an External Account Binding
+	// provisioner.
+	eabClientIDKey     = "client-id"
+	eabClientSecretKey = "client-secret"
+
 	errorAccountRegistrationFailed = "ErrRegisterACMEAccount"
 	errorAccountVerificationFailed = "ErrVerifyACMEAccount"
 	errorAccountUpdateFailed       = "ErrUpdateACMEAccount"
 	errorInvalidConfig             = "InvalidConfig"
 	errorInvalidURL                = "InvalidURL"
 	errorInvalidSolver             = "InvalidSolver"
+	errorAccountKeyRolloverFailed  = "ErrRolloverACMEAccountKey"
 
-	successAccountRegistered = "ACMEAccountRegistered"
-	successAccountVerified   = "ACMEAccountVerified"
+	successAccountRegistered  = "ACMEAccountRegistered"
+	successAccountVerified    = "ACMEAccountVerified"
+	successEABProvisioned     = "ExternalAccountBindingProvisioned"
+	successAccountKeyRollover = "AccountKeyRolledOver"
+	successAccountKeyRotated  = "AccountKeyRotated"
 
 	messageAccountRegistrationFailed     = "Failed to register ACME account: "
 	messageAccountVerificationFailed     = "Failed to verify ACME account: "
@@ -60,18 +75,29 @@ const (
 	messageAccountVerified               = "The ACME account was verified with the ACME server"
 	messageNoSecretKeyGenerationDisabled = "the ACME issuer config has 'disableAccountKeyGeneration' set to true, but the secret was not found: "
 	messageInvalidPrivateKey             = "Account private key is invalid: "
+	messageAccountKeyRolloverFailed      = "Failed to roll over ACME account key: "
+	messageAccountKeyRolledOver          = "The ACME account was rolled over to the new private key"
+	messageAccountKeyRotated             = "Generated a new ACME account private key, the account will be rolled over to it"
 
 	messageTemplateUpdateToV2              = "Your ACME server URL is set to a v1 endpoint (%s). You should update the spec.acme.server field to %q"
 	messageTemplateNotRSA                  = "ACME private key in %q is not of type RSA"
 	messageTemplateFailedToParseURL        = "Failed to parse existing ACME server URI %q: %v"
 	messageTemplateFailedToParseAccountURL = "Failed to parse existing ACME account URI %q: %v"
 	messageTemplateFailedToGetEABKey       = "failed to get External Account Binding key from secret: %v"
+	messageTemplateFailedToGetEABCreds     = "failed to get External Account Binding provisioner credentials from secret: %v"
+	messageTemplateEABProvisioned          = "Provisioned External Account Binding %q to register a new ACME account"
 )
 
 // Setup will verify an existing ACME registration, or create one if not
 // already registered.
 func (a *Acme) Setup(ctx context.Context, issuer v1.GenericIssuer) error {
 	result := a.setup(ctx, issuer)
+	if result.err == nil && result.status == cmmeta.ConditionTrue {
+		result.err = a.rotateAccountKey(ctx, issuer)
+	}
+	if result.err == nil && result.status == cmmeta.ConditionTrue {
+		result.err = a.preAuthorize(ctx, issuer)
+	}
 	apiutil.SetIssuerCondition(
 		issuer,
 		issuer.GetGeneration(),
@@ -84,6 +110,19 @@ func (a *Acme) Setup(ctx context.Context, issuer v1.GenericIssuer) error {
 	return result.err
 }
 
+// NextSetup returns how long until the account key has to be rotated or the
+// first of the issuer's pre-authorizations has to be renewed.
+func (a *Acme) NextSetup(issuer v1.GenericIssuer) (time.Duration, bool) {
+	next, ok := nextAccountKeyRotation(issuer)
+	if renewal, renew := nextPreAuthorizationRenewal(issuer); renew && (!ok || renewal.Before(next)) {
+		next, ok = renewal, true
+	}
+	if !ok {
+		return 0, false
+	}
+	return max(next.Sub(a.clock.Now()), 0), true
+}
+
 type setupResult struct {
 	err error
 
@@ -193,6 +232,12 @@ func (a *Acme) setup(ctx context.Context, issuer v1.GenericIssuer) setupResult {
 		}
 	}
 
+	if issuer.GetSpec().ACME.AccountKeyRollover != nil {
+		if result := a.rolloverAccountKey(ctx, issuer, ns, rsaPk); result != nil {
+			return *result
+		}
+	}
+
 	isPKChecksumSame := a.accountRegistry.IsKeyCheckSumCached(issuer.GetStatus().ACMEStatus().LastPrivateKeyHash, rsaPk)
 
 	// TODO: don't always clear the client cache.
@@ -254,13 +299,14 @@ func (a *Acme) setup(ctx context.Context, issuer v1.GenericIssuer) setupResult {
 	})
 
 	// If the Host components of the server URL and the account URL match,
-	// and the cached email matches the registered email, then
+	// and the cached email and contacts match the registered ones, then
 	// we skip re-checking the account status to save excess calls to the
 	// ACME api.
 	if hasReadyCondition &&
 		issuer.GetStatus().ACMEStatus().URI != "" &&
 		parsedAccountURL.Host == parsedServerURL.Host &&
 		issuer.GetStatus().ACMEStatus().LastRegisteredEmail == issuer.GetSpec().ACME.Email &&
+		slices.Equal(issuer.GetStatus().ACMEStatus().LastRegisteredContacts, issuer.GetSpec().ACME.Contacts) &&
 		isPKChecksumSame {
 		log.V(logf.InfoLevel).Info("skipping re-verifying ACME account as cached registration " +
 			"details look sufficient")
@@ -325,8 +371,49 @@ func (a *Acme) setup(ctx context.Context, issuer v1.GenericIssuer) setupResult {
 		}
 	}
 
+	var account *acmeapi.Account
+	if provObj := issuer.GetSpec().ACME.ExternalAccountBindingProvisioner; provObj != nil {
+		// Provisioned credentials may only be usable once, so only provision
+		// them if no account is registered for the private key yet, i.e. when
+		// the issuer is first set up or its private key has been rotated.
+		account, err = cl.GetReg(ctx, "")
+		if err == acmeapi.ErrNoAccount {
+			eabAccount, err = a.provisionExternalAccountBinding(ctx, ns, issuer.GetSpec().ACME)
+			switch {
+			// Do not re-try if the client credentials do not exist or the
+			// endpoint returned an invalid response.
+			case apierrors.IsNotFound(err), errors.IsInvalidData(err):
+				log.Error(err, "failed to provision an external account binding")
+				msg := messageAccountRegistrationFailed + err.Error()
+				a.recorder.Event(issuer, corev1.EventTypeWarning, errorAccountRegistrationFailed, msg)
+				return setupResult{
+					err: nil,
+
+					status:  cmmeta.ConditionFalse,
+					reason:  errorAccountRegistrationFailed,
+					message: msg,
+				}
+
+			case err != nil:
+				msg := messageAccountRegistrationFailed + err.Error()
+				return setupResult{
+					err: fmt.Errorf("%s", msg),
+
+					status:  cmmeta.ConditionFalse,
+					reason:  errorAccountRegistrationFailed,
+					message: msg,
+				}
+			}
+
+			a.recorder.Event(issuer, corev1.EventTypeNormal, successEABProvisioned,
+				fmt.Sprintf(messageTemplateEABProvisioned, eabAccount.KID))
+		}
+	}
+
 	// register an ACME account or retrieve it if it already exists.
-	account, err := a.registerAccount(ctx, cl, issuer.GetSpec().ACME.Email, eabAccount)
+	if account == nil && err == nil {
+		account, err = a.registerAccount(ctx, cl, accountContacts(issuer.GetSpec().ACME), eabAccount)
+	}
 	if err != nil {
 		// TODO: this error could be from an account registration or an attempt
 		// to retrieve an existing account - perhaps we should log different
@@ -372,9 +459,8 @@ func (a *Acme) setup(ctx context.Context, issuer v1.GenericIssuer) setupResult {
 	}
 
 	// if we got an account successfully, we must check if the registered
-	// email is the same as in the issuer spec
-	specEmail := issuer.GetSpec().ACME.Email
-	account, registeredEmail, err := ensureEmailUpToDate(ctx, cl, account, specEmail)
+	// email and contacts are the same as in the issuer spec
+	account, err = ensureContactsUpToDate(ctx, cl, account, accountContacts(issuer.GetSpec().ACME))
 	if err != nil {
 		msg := messageAccountUpdateFailed + err.Error()
 		log.Error(err, "failed to update ACME account")
@@ -418,12 +504,11 @@ func (a *Acme) setup(ctx context.Context, issuer v1.GenericIssuer) setupResult {
 	}
 
 	log.V(logf.InfoLevel).Info("verified existing registration with ACME server")
-	privateKeyBytes := x509.MarshalPKCS1PrivateKey(rsaPk)
-	checksum := sha256.Sum256(privateKeyBytes)
-	checksumString := base64.StdEncoding.EncodeToString(checksum[:])
 	issuer.GetStatus().ACMEStatus().URI = account.URI
-	issuer.GetStatus().ACMEStatus().LastRegisteredEmail = registeredEmail
-	issuer.GetStatus().ACMEStatus().LastPrivateKeyHash = checksumString
+	issuer.GetStatus().ACMEStatus().LastRegisteredEmail = issuer.GetSpec().ACME.Email
+	issuer.GetStatus().ACMEStatus().LastRegisteredContacts = issuer.GetSpec().ACME.Contacts
+	issuer.GetStatus().ACMEStatus().OrdersURL = account.OrdersURL
+	issuer.GetStatus().ACMEStatus().LastPrivateKeyHash = privateKeyChecksum(rsaPk)
 	// ensure the cached client in the account registry is up to date
 	a.accountRegistry.AddClient(string(issuer.GetUID()), accounts.NewClientOptions{
 		SkipTLSVerify: issuer.GetSpec().ACME.SkipTLSVerify,
@@ -441,49 +526,197 @@ func (a *Acme) setup(ctx context.Context, issuer v1.GenericIssuer) setupResult {
 	}
 }
 
-func ensureEmailUpToDate(ctx context.Context, cl client.Interface, acc *acmeapi.Account, specEmail string) (*acmeapi.Account, string, error) {
+// rolloverAccountKey rolls the issuer's ACME account over to the given private
+// key, if the key has changed since the account was last verified and the
+// previous private key is the one the account was verified with. Otherwise a
+// new account will be registered for the key, as without a rollover config.
+// A non-nil result is returned if setup should not continue.
+func (a *Acme) rolloverAccountKey(ctx context.Context, issuer v1.GenericIssuer, ns string, newKey *rsa.PrivateKey) *setupResult {
 	log := logf.FromContext(ctx)
+	status := issuer.GetStatus().ACMEStatus()
 
-	// if no email was specified, then registeredEmail will remain empty
-	registeredEmail := ""
-	if len(acc.Contact) > 0 {
-		registeredEmail = strings.Replace(acc.Contact[0], "mailto:", "", 1)
+	if status.URI == "" || status.LastPrivateKeyHash == "" || status.LastPrivateKeyHash == privateKeyChecksum(newKey) {
+		return nil
 	}
 
-	// if they are different, we update the account
-	if registeredEmail != specEmail {
-		log.V(logf.DebugLevel).Info("updating ACME account email address", "email", specEmail)
-		emailurl := []string(nil)
-		if specEmail != "" {
-			emailurl = []string{fmt.Sprintf("mailto:%s", strings.ToLower(specEmail))}
+	sel := previousPrivateKeySelector(issuer.GetSpec().ACME)
+	pk, err := a.keyFromSecret(ctx, ns, sel.Name, sel.Key)
+	if err != nil {
+		log.V(logf.InfoLevel).Info("not rolling over ACME account key as the previous private key could not be loaded, a new account will be registered", "error", err.Error())
+		return nil
+	}
+	oldKey, ok := pk.(*rsa.PrivateKey)
+	if !ok || privateKeyChecksum(oldKey) != status.LastPrivateKeyHash {
+		log.V(logf.InfoLevel).Info("not rolling over ACME account key as the previous private key is not the one the account was verified with, a new account will be registered")
+		return nil
+	}
+
+	cl := a.clientBuilder(accounts.NewClientOptions{
+		SkipTLSVerify: issuer.GetSpec().ACME.SkipTLSVerify,
+		CABundle:      issuer.GetSpec().ACME.CABundle,
+		Server:        issuer.GetSpec().ACME.Server,
+		PrivateKey:    oldKey,
+	})
+	err = cl.AccountKeyRollover(ctx, newKey)
+	acmeErr, isACMEErr := err.(*acmeapi.Error)
+	// A conflict means that the new key is already registered with an
+	// account, e.g. because the issuer's status could not be updated after
+	// a previous rollover.
+	if isACMEErr && acmeErr.StatusCode == http.StatusConflict {
+		log.V(logf.InfoLevel).Info("new ACME account key is already registered, skipping rollover")
+		err = nil
+	}
+	if err != nil {
+		msg := messageAccountKeyRolloverFailed + err.Error()
+		log.Error(err, "failed to roll over ACME account key")
+		a.recorder.Event(issuer, corev1.EventTypeWarning, errorAccountKeyRolloverFailed, msg)
+
+		result := &setupResult{
+			err: err,
+
+			status:  cmmeta.ConditionFalse,
+			reason:  errorAccountKeyRolloverFailed,
+			message: msg,
 		}
-		acc.Contact = emailurl
+		// Do not retry if the ACME server rejected the rollover, for example
+		// because the previous key is no longer bound to the account.
+		if isACMEErr && acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
+			result.err = nil
+		}
+		return result
+	}
+
+	log.V(logf.InfoLevel).Info("rolled over ACME account to the new private key")
+	a.recorder.Event(issuer, corev1.EventTypeNormal, successAccountKeyRollover, messageAccountKeyRolledOver)
+	status.LastPrivateKeyHash = privateKeyChecksum(newKey)
+	status.LastKeyRolloverTime = &metav1.Time{Time: a.clock.Now()}
+
+	return nil
+}
+
+// rotateAccountKey generates a new private key for the issuer's ACME account
+// once its current key is older than the configured rotation period. The
+// current key is moved to the `<key>.previous` entry of the private key
+// Secret, and the account is rolled over to the new key by the next setup,
+// which is triggered by the Secret update.
+func (a *Acme) rotateAccountKey(ctx context.Context, issuer v1.GenericIssuer) error {
+	log := logf.FromContext(ctx)
+
+	rollover := issuer.GetSpec().ACME.AccountKeyRollover
+	if rollover == nil || rollover.RotationPeriod == nil {
+		return nil
+	}
+
+	status := issuer.GetStatus().ACMEStatus()
+	now := a.clock.Now()
+	if status.LastKeyRolloverTime == nil {
+		status.LastKeyRolloverTime = &metav1.Time{Time: now}
+		return nil
+	}
+	if now.Before(status.LastKeyRolloverTime.Add(rollover.RotationPeriod.Duration)) {
+		return nil
+	}
+
+	ns := a.resourceNamespace(issuer)
+	sel := acme.PrivateKeySelector(issuer.GetSpec().ACME.PrivateKey)
+	secret, err := a.secretsClient.Secrets(ns).Get(ctx, sel.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get ACME account private key: %w", err)
+	}
+
+	// Only rotate the key that the account is verified with, as a rotated
+	// key may not have been rolled over to yet.
+	pk, _, err := kube.ParseTLSKeyFromSecret(ctx, secret, sel.Key)
+	if err != nil {
+		return fmt.Errorf("failed to parse ACME account private key: %w", err)
+	}
+	if currentKey, ok := pk.(*rsa.PrivateKey); !ok || privateKeyChecksum(currentKey) != status.LastPrivateKeyHash {
+		return nil
+	}
+
+	newKey, err := pki.GenerateRSAPrivateKey(pki.MinRSAKeySize)
+	if err != nil {
+		return err
+	}
+
+	secret = secret.DeepCopy()
+	secret.Data[previousPrivateKeySelector(issuer.GetSpec().ACME).Key] = secret.Data[sel.Key]
+	secret.Data[sel.Key] = pki.EncodePKCS1PrivateKey(newKey)
+	if _, err := a.secretsClient.Secrets(ns).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to store new ACME account private key: %w", err)
+	}
+
+	log.V(logf.InfoLevel).Info("generated new ACME account private key")
+	a.recorder.Event(issuer, corev1.EventTypeNormal, successAccountKeyRotated, messageAccountKeyRotated)
+
+	return nil
+}
+
+// nextAccountKeyRotation returns the time at which the issuer's account key
+// has to be rotated, and false if it is not rotated periodically.
+func nextAccountKeyRotation(issuer v1.GenericIssuer) (time.Time, bool) {
+	rollover := issuer.GetSpec().ACME.AccountKeyRollover
+	lastRollover := issuer.GetStatus().ACMEStatus().LastKeyRolloverTime
+	if rollover == nil || rollover.RotationPeriod == nil || lastRollover == nil {
+		return time.Time{}, false
+	}
+	return lastRollover.Add(rollover.RotationPeriod.Duration), true
+}
+
+// previousPrivateKeySelector returns the selector of the private key that was
+// used before the issuer's current ACME account private key.
+func previousPrivateKeySelector(iss *cmacme.ACMEIssuer) cmmeta.SecretKeySelector {
+	if iss.AccountKeyRollover != nil && iss.AccountKeyRollover.PreviousPrivateKey != nil {
+		return acme.PrivateKeySelector(*iss.AccountKeyRollover.PreviousPrivateKey)
+	}
+	sel := acme.PrivateKeySelector(iss.PrivateKey)
+	sel.Key += ".previous"
+	return sel
+}
+
+// privateKeyChecksum returns the checksum of an ACME account private key, as
+// stored in the issuer's status.
+func privateKeyChecksum(pk *rsa.PrivateKey) string {
+	checksum := sha256.Sum256(x509.MarshalPKCS1PrivateKey(pk))
+	return base64.StdEncoding.EncodeToString(checksum[:])
+}
+
+// accountContacts returns the contact URIs of the ACME account configured by
+// the given issuer: the email address, if set, followed by any additional
+// contacts.
+func accountContacts(iss *cmacme.ACMEIssuer) []string {
+	var contacts []string
+	if iss.Email != "" {
+		contacts = append(contacts, fmt.Sprintf("mailto:%s", strings.ToLower(iss.Email)))
+	}
+	return append(contacts, iss.Contacts...)
+}
+
+func ensureContactsUpToDate(ctx context.Context, cl client.Interface, acc *acmeapi.Account, specContacts []string) (*acmeapi.Account, error) {
+	log := logf.FromContext(ctx)
+
+	// if they are different, we update the account
+	if !slices.EqualFunc(acc.Contact, specContacts, strings.EqualFold) {
+		log.V(logf.DebugLevel).Info("updating ACME account contacts", "contacts", specContacts)
+		acc.Contact = specContacts
 
 		var err error
 		acc, err = cl.UpdateReg(ctx, acc)
 		if err != nil {
-			return nil, "", err
+			return nil, err
 		}
-
-		// update the registeredEmail var so it is updated properly in the status below
-		registeredEmail = specEmail
 	}
 
-	return acc, registeredEmail, nil
+	return acc, nil
 }
 
 // registerAccount will register a new ACME account with the server. If an
 // account with the clients private key already exists, it will attempt to look
 // up and verify the corresponding account, and will return that. If this fails
 // due to a not found error it will register a new account with the given key.
-func (a *Acme) registerAccount(ctx context.Context, cl client.Interface, acmeEmail string, eabAccount *acmeapi.ExternalAccountBinding) (*acmeapi.Account, error) {
-	emailurl := []string(nil)
-	if acmeEmail != "" {
-		emailurl = []string{fmt.Sprintf("mailto:%s", strings.ToLower(acmeEmail))}
-	}
-
+func (a *Acme) registerAccount(ctx context.Context, cl client.Interface, contacts []string, eabAccount *acmeapi.ExternalAccountBinding) (*acmeapi.Account, error) {
 	acc := &acmeapi.Account{
-		Contact:                emailurl,
+		Contact:                contacts,
 		ExternalAccountBinding: eabAccount,
 	}
 
@@ -532,6 +765,41 @@ func (a *Acme) getEABKey(ctx context.Context, ns string, eab cmmeta.SecretKeySel
 	return keyData, nil
 }
 
+// provisionExternalAccountBinding obtains new External Account Binding
+// credentials from the provisioning endpoint of the given ACME issuer, using
+// the OAuth 2.0 client credentials stored in the referenced Secret.
+func (a *Acme) provisionExternalAccountBinding(ctx context.Context, ns string, iss *cmacme.ACMEIssuer) (*acmeapi.ExternalAccountBinding, error) {
+	prov := iss.ExternalAccountBindingProvisioner
+
+	sec, err := a.secretsClient.Secrets(ns).Get(ctx, prov.CredentialsRef.Name, metav1.GetOptions{})
+	// Surface IsNotFound API error to not cause re-sync
+	if apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf(messageTemplateFailedToGetEABCreds, err)
+	}
+
+	clientID, ok := sec.Data[eabClientIDKey]
+	if !ok {
+		return nil, errors.NewInvalidData("failed to find OAuth client ID in Secret %q at index %q", prov.CredentialsRef.Name, eabClientIDKey)
+	}
+	clientSecret, ok := sec.Data[eabClientSecretKey]
+	if !ok {
+		return nil, errors.NewInvalidData("failed to find OAuth client secret in Secret %q at index %q", prov.CredentialsRef.Name, eabClientSecretKey)
+	}
+
+	return a.provisionEAB(ctx, accounts.ProvisionEABOptions{
+		SkipTLSVerify: iss.SkipTLSVerify,
+		CABundle:      iss.CABundle,
+		URL:           prov.URL,
+		TokenURL:      prov.TokenURL,
+		ClientID:      string(clientID),
+		ClientSecret:  string(clientSecret),
+		Scopes:        prov.Scopes,
+	})
+}
+
 // createAccountPrivateKey will generate a new RSA private key, and create it
 // as a secret resource in the apiserver.
 func (a *Acme) createAccountPrivateKey(ctx context.Context, sel cmmeta.SecretKeySelector, ns string) (*rsa.PrivateKey, error) {
@@ -34,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/watch"
 	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
 	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
 	fakeclock "k8s.io/utils/clock/testing"
 
 	"github.com/cert-manager/cert-manager/internal/test/testutil"
@@ -44,6 +45,7 @@ import (
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmacmelisters "github.com/cert-manager/cert-manager/pkg/client/listers/acme/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller"
 	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
 	"github.com/cert-manager/cert-manager/pkg/util/errors"
@@ -99,6 +101,12 @@ func TestAcme_Setup(t *testing.T) {
 		eabSecret = gen.Secret(someString,
 			gen.SetSecretData(map[string][]byte{"key": []byte("ZEdWemRBbz0K")}))
 
+		// eabCredentialsSecret is a mock value for the secret with the OAuth
+		// client credentials of an EAB provisioner.
+		eabCredentialsSecret = gen.Secret(someString,
+			gen.SetSecretData(map[string][]byte{"client-id": []byte("id"), "client-secret": []byte("secret")}))
+		provisionedEAB = &acmeapi.ExternalAccountBinding{KID: "provisioned", Key: []byte("key")}
+
 		// 'dGVzdAo=\n' is 'ZEdWemRBbz0K' decoded + a newline.
 		// This is the decoded EAB key that we send to the ACME server.
 		// TODO: could the newline cause any issues?
@@ -138,6 +146,11 @@ func TestAcme_Setup(t *testing.T) {
 		eabSecret       *corev1.Secret
 		eabSecretGetErr error
 
+		// EAB returned by the provisionEAB stub.
+		provisionedEAB *acmeapi.ExternalAccountBinding
+		// Error returned by the provisionEAB stub.
+		provisionEABErr error
+
 		// expected ACME account passed to cl.Register
 		expectedRegisteredAcc *acmeapi.Account
 		// expected issuer conditions after Setup has been called.
@@ -370,6 +383,88 @@ func TestAcme_Setup(t *testing.T) {
 					gen.SetIssuerConditionMessage(messageAccountRegistered)),
 			},
 		},
+		"ACME account with provisioned EAB registered successfully": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEEABProvisioner("https://eab", "https://token", someString)),
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+			getRegErr:                  acmeapi.ErrNoAccount,
+			eabSecret:                  eabCredentialsSecret,
+			provisionedEAB:             provisionedEAB,
+			expectedRegisteredAcc:      &acmeapi.Account{ExternalAccountBinding: provisionedEAB},
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyTrueCondition,
+					gen.SetIssuerConditionStatus(cmmeta.ConditionTrue),
+					gen.SetIssuerConditionReason(successAccountRegistered),
+					gen.SetIssuerConditionMessage(messageAccountRegistered)),
+			},
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s %s", corev1.EventTypeNormal, successEABProvisioned, fmt.Sprintf(messageTemplateEABProvisioned, "provisioned"))},
+		},
+		"EAB provisioner for issuer specified, ACME account already exists, no EAB is provisioned": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEEABProvisioner("https://eab", "https://token", someString)),
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+			getRegAcc:                  &acmeapi.Account{},
+			eabSecret:                  eabCredentialsSecret,
+			provisionEABErr:            someErr,
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyTrueCondition,
+					gen.SetIssuerConditionStatus(cmmeta.ConditionTrue),
+					gen.SetIssuerConditionReason(successAccountRegistered),
+					gen.SetIssuerConditionMessage(messageAccountRegistered)),
+			},
+		},
+		"EAB provisioner for issuer specified, but the credentials secret is not found": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEEABProvisioner("https://eab", "https://token", someString)),
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			getRegErr:                  acmeapi.ErrNoAccount,
+			eabSecretGetErr:            notFoundErr,
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyFalseCondition,
+					gen.SetIssuerConditionReason(errorAccountRegistrationFailed),
+					gen.SetIssuerConditionMessage(messageAccountRegistrationFailed+notFoundErr.Error())),
+			},
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, errorAccountRegistrationFailed, messageAccountRegistrationFailed+notFoundErr.Error())},
+		},
+		"EAB provisioner for issuer specified, provisioning fails with unknown error": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEEABProvisioner("https://eab", "https://token", someString)),
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			getRegErr:                  acmeapi.ErrNoAccount,
+			eabSecret:                  eabCredentialsSecret,
+			provisionEABErr:            someErr,
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyFalseCondition,
+					gen.SetIssuerConditionReason(errorAccountRegistrationFailed),
+					gen.SetIssuerConditionMessage(messageAccountRegistrationFailed+someErr.Error())),
+			},
+			wantsErr: true,
+		},
+		"ACME account with an email and additional contacts is registered successfully": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEEmail(someEmail),
+				gen.SetIssuerACMEContacts("tel:+12025550123")),
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+			expectedRegisteredAcc: &acmeapi.Account{
+				Contact: []string{someEmailURL, "tel:+12025550123"},
+			},
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyTrueCondition,
+					gen.SetIssuerConditionStatus(cmmeta.ConditionTrue),
+					gen.SetIssuerConditionReason(successAccountRegistered),
+					gen.SetIssuerConditionMessage(messageAccountRegistered)),
+			},
+		},
 		"ACME account with legacy EAB key algorithm set and with an email is registered successfully": {
 			issuer: gen.IssuerFrom(baseIssuer,
 				gen.SetIssuerACMEEmail(someEmail),
@@ -574,6 +669,13 @@ func TestAcme_Setup(t *testing.T) {
 				accountRegistry: ar,
 				keyFromSecret:   kfs,
 				clientBuilder:   clientBuilderMock(&cl),
+				provisionEAB: func(_ context.Context, opts accounts.ProvisionEABOptions) (*acmeapi.ExternalAccountBinding, error) {
+					if opts.ClientID != "id" || opts.ClientSecret != "secret" {
+						t.Errorf("Unexpected EAB provisioner client credentials: %q, %q", opts.ClientID, opts.ClientSecret)
+					}
+					return test.provisionedEAB, test.provisionEABErr
+				},
+				challengeLister: cmacmelisters.NewChallengeLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})),
 				recorder:        recorder,
 			}
 
@@ -625,6 +727,241 @@ func TestAcme_Setup(t *testing.T) {
 	}
 }
 
+func TestAcme_RolloverAccountKey(t *testing.T) {
+	fixedClockStart := time.Now()
+	fakeclock := fakeclock.NewFakeClock(fixedClockStart)
+
+	oldKey := mustGenerateRSAKey(t).(*rsa.PrivateKey)
+	newKey := mustGenerateRSAKey(t).(*rsa.PrivateKey)
+	otherKey := mustGenerateRSAKey(t).(*rsa.PrivateKey)
+
+	tests := map[string]struct {
+		lastPrivateKeyHash string
+		// Private key returned by keyFromSecret for the previous key.
+		previousKey crypto.Signer
+		// Error returned by cl.AccountKeyRollover
+		rolloverErr error
+
+		expectRollover      bool
+		expectResult        *setupResult
+		expectRolledOver    bool
+		expectedEvents      []string
+		expectedPrivKeyHash string
+	}{
+		"private key did not change": {
+			lastPrivateKeyHash:  privateKeyChecksum(newKey),
+			previousKey:         oldKey,
+			expectedPrivKeyHash: privateKeyChecksum(newKey),
+		},
+		"previous private key is not the one the account was verified with": {
+			lastPrivateKeyHash:  privateKeyChecksum(oldKey),
+			previousKey:         otherKey,
+			expectedPrivKeyHash: privateKeyChecksum(oldKey),
+		},
+		"account is rolled over to the new private key": {
+			lastPrivateKeyHash:  privateKeyChecksum(oldKey),
+			previousKey:         oldKey,
+			expectRollover:      true,
+			expectRolledOver:    true,
+			expectedEvents:      []string{fmt.Sprintf("%s %s %s", corev1.EventTypeNormal, successAccountKeyRollover, messageAccountKeyRolledOver)},
+			expectedPrivKeyHash: privateKeyChecksum(newKey),
+		},
+		"new private key is already registered": {
+			lastPrivateKeyHash:  privateKeyChecksum(oldKey),
+			previousKey:         oldKey,
+			rolloverErr:         &acmeapi.Error{StatusCode: 409},
+			expectRollover:      true,
+			expectRolledOver:    true,
+			expectedEvents:      []string{fmt.Sprintf("%s %s %s", corev1.EventTypeNormal, successAccountKeyRollover, messageAccountKeyRolledOver)},
+			expectedPrivKeyHash: privateKeyChecksum(newKey),
+		},
+		"rollover rejected by the ACME server is not retried": {
+			lastPrivateKeyHash: privateKeyChecksum(oldKey),
+			previousKey:        oldKey,
+			rolloverErr:        &acmeapi.Error{StatusCode: 403},
+			expectRollover:     true,
+			expectResult: &setupResult{
+				status:  cmmeta.ConditionFalse,
+				reason:  errorAccountKeyRolloverFailed,
+				message: messageAccountKeyRolloverFailed + (&acmeapi.Error{StatusCode: 403}).Error(),
+			},
+			expectedEvents:      []string{fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, errorAccountKeyRolloverFailed, messageAccountKeyRolloverFailed+(&acmeapi.Error{StatusCode: 403}).Error())},
+			expectedPrivKeyHash: privateKeyChecksum(oldKey),
+		},
+		"rollover failing with an unknown error is retried": {
+			lastPrivateKeyHash: privateKeyChecksum(oldKey),
+			previousKey:        oldKey,
+			rolloverErr:        fmt.Errorf("test"),
+			expectRollover:     true,
+			expectResult: &setupResult{
+				err:     fmt.Errorf("test"),
+				status:  cmmeta.ConditionFalse,
+				reason:  errorAccountKeyRolloverFailed,
+				message: messageAccountKeyRolloverFailed + "test",
+			},
+			expectedEvents:      []string{fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, errorAccountKeyRolloverFailed, messageAccountKeyRolloverFailed+"test")},
+			expectedPrivKeyHash: privateKeyChecksum(oldKey),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			issuer := gen.Issuer("test-issuer",
+				gen.SetIssuerACMEURL(acmev2Prod),
+				gen.SetIssuerACMEPrivKeyRef("test"),
+				gen.SetIssuerACMEAccountURL("https://acme-v02.api.letsencrypt.org/acme/acct/1"),
+				gen.SetIssuerACMELastPrivateKeyHash(test.lastPrivateKeyHash),
+				func(iss cmapi.GenericIssuer) {
+					iss.GetSpec().ACME.AccountKeyRollover = &cmacme.ACMEAccountKeyRollover{}
+				},
+			)
+
+			var gotKeySelector string
+			rolloverCalled := false
+			cl := acmecl.FakeACME{
+				FakeAccountKeyRollover: func(_ context.Context, key crypto.Signer) error {
+					rolloverCalled = true
+					if key != newKey {
+						t.Errorf("Expected rollover to the new private key")
+					}
+					return test.rolloverErr
+				},
+			}
+			recorder := new(controllertest.FakeRecorder)
+			a := Acme{
+				keyFromSecret: func(_ context.Context, _, name, keyName string) (crypto.Signer, error) {
+					gotKeySelector = name + "/" + keyName
+					return test.previousKey, nil
+				},
+				clientBuilder: func(opts accounts.NewClientOptions) acmecl.Interface {
+					if opts.PrivateKey != test.previousKey {
+						t.Errorf("Expected client to be built with the previous private key")
+					}
+					return &cl
+				},
+				recorder: recorder,
+				clock:    fakeclock,
+			}
+
+			result := a.rolloverAccountKey(t.Context(), issuer, "default", newKey)
+
+			if test.lastPrivateKeyHash != privateKeyChecksum(newKey) && gotKeySelector != "test/tls.key.previous" {
+				t.Errorf("Expected previous private key to be loaded from test/tls.key.previous, got %q", gotKeySelector)
+			}
+			if rolloverCalled != test.expectRollover {
+				t.Errorf("Expected AccountKeyRollover to be called: %v, was called: %v", test.expectRollover, rolloverCalled)
+			}
+			if !reflect.DeepEqual(test.expectResult, result) {
+				t.Errorf("Expected result %#+v, got %#+v", test.expectResult, result)
+			}
+			if !slices.Equal(test.expectedEvents, recorder.Events) {
+				t.Errorf("Expected events:\n%+#v\ngot:%+#v", test.expectedEvents, recorder.Events)
+			}
+
+			status := issuer.GetStatus().ACMEStatus()
+			if status.LastPrivateKeyHash != test.expectedPrivKeyHash {
+				t.Errorf("Expected last private key hash %q, got %q", test.expectedPrivKeyHash, status.LastPrivateKeyHash)
+			}
+			if test.expectRolledOver != (status.LastKeyRolloverTime != nil) {
+				t.Errorf("Expected last key rollover time to be set: %v, got %v", test.expectRolledOver, status.LastKeyRolloverTime)
+			}
+		})
+	}
+}
+
+func TestAcme_RotateAccountKey(t *testing.T) {
+	fixedClockStart := time.Now()
+	fakeclock := fakeclock.NewFakeClock(fixedClockStart)
+
+	currentKey := mustGenerateRSAKey(t).(*rsa.PrivateKey)
+	otherKey := mustGenerateRSAKey(t).(*rsa.PrivateKey)
+	currentKeyPEM := pki.EncodePKCS1PrivateKey(currentKey)
+
+	period := 24 * time.Hour
+
+	tests := map[string]struct {
+		lastKeyRolloverTime *metav1.Time
+		lastPrivateKeyHash  string
+
+		expectUpdate              bool
+		expectLastKeyRolloverTime *metav1.Time
+	}{
+		"key age is tracked from the first setup": {
+			lastPrivateKeyHash:        privateKeyChecksum(currentKey),
+			expectLastKeyRolloverTime: &metav1.Time{Time: fixedClockStart},
+		},
+		"key is not rotated before the rotation period has passed": {
+			lastKeyRolloverTime:       &metav1.Time{Time: fixedClockStart.Add(-period + time.Minute)},
+			lastPrivateKeyHash:        privateKeyChecksum(currentKey),
+			expectLastKeyRolloverTime: &metav1.Time{Time: fixedClockStart.Add(-period + time.Minute)},
+		},
+		"key is rotated once the rotation period has passed": {
+			lastKeyRolloverTime:       &metav1.Time{Time: fixedClockStart.Add(-period)},
+			lastPrivateKeyHash:        privateKeyChecksum(currentKey),
+			expectUpdate:              true,
+			expectLastKeyRolloverTime: &metav1.Time{Time: fixedClockStart.Add(-period)},
+		},
+		"key is not rotated again before the account was rolled over": {
+			lastKeyRolloverTime:       &metav1.Time{Time: fixedClockStart.Add(-period)},
+			lastPrivateKeyHash:        privateKeyChecksum(otherKey),
+			expectLastKeyRolloverTime: &metav1.Time{Time: fixedClockStart.Add(-period)},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			issuer := gen.Issuer("test-issuer",
+				gen.SetIssuerACMEURL(acmev2Prod),
+				gen.SetIssuerACMEPrivKeyRef("test"),
+				gen.SetIssuerACMELastPrivateKeyHash(test.lastPrivateKeyHash),
+				func(iss cmapi.GenericIssuer) {
+					iss.GetSpec().ACME.AccountKeyRollover = &cmacme.ACMEAccountKeyRollover{
+						RotationPeriod: &metav1.Duration{Duration: period},
+					}
+					iss.GetStatus().ACMEStatus().LastKeyRolloverTime = test.lastKeyRolloverTime
+				},
+			)
+
+			var updated *corev1.Secret
+			secretsClient := coreclients.NewFakeSecretsGetter(
+				coreclients.SetFakeSecretsGetterGet(gen.Secret("test",
+					gen.SetSecretData(map[string][]byte{"tls.key": currentKeyPEM})), nil),
+				coreclients.SetFakeSecretsGetterUpdateFn(func(_ context.Context, secret *corev1.Secret, _ metav1.UpdateOptions) (*corev1.Secret, error) {
+					updated = secret
+					return secret, nil
+				}),
+			)
+			a := Acme{
+				resourceNamespace: func(iss cmapi.GenericIssuer) string {
+					return iss.GetNamespace()
+				},
+				secretsClient: secretsClient,
+				recorder:      new(controllertest.FakeRecorder),
+				clock:         fakeclock,
+			}
+
+			if err := a.rotateAccountKey(t.Context(), issuer); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if test.expectUpdate != (updated != nil) {
+				t.Fatalf("Expected private key Secret to be updated: %v, got %v", test.expectUpdate, updated != nil)
+			}
+			if updated != nil {
+				if !reflect.DeepEqual(currentKeyPEM, updated.Data["tls.key.previous"]) {
+					t.Errorf("Expected the current private key to be moved to tls.key.previous")
+				}
+				if reflect.DeepEqual(currentKeyPEM, updated.Data["tls.key"]) {
+					t.Errorf("Expected a new private key to be stored in tls.key")
+				}
+			}
+			if got := issuer.GetStatus().ACMEStatus().LastKeyRolloverTime; !reflect.DeepEqual(test.expectLastKeyRolloverTime, got) {
+				t.Errorf("Expected last key rollover time %v, got %v", test.expectLastKeyRolloverTime, got)
+			}
+		})
+	}
+}
+
 // keyFromSecretMockBuilder returns a mock implementation of keyFromSecretFunc.
 func keyFromSecretMockBuilder(wasCalled *bool, key crypto.Signer, err error) keyFromSecretFunc {
 	return func(context.Context, string, string, string) (crypto.Signer, error) {
@@ -0,0 +1,39 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsigner
+
+import (
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+)
+
+// ExternalSigner is an Issuer implementation which forwards requests to an
+// external signer plugin over gRPC.
+type ExternalSigner struct {
+	*controller.Context
+}
+
+func NewExternalSigner(ctx *controller.Context) (issuer.Interface, error) {
+	return &ExternalSigner{
+		Context: ctx,
+	}, nil
+}
+
+func init() {
+	issuer.RegisterIssuer(apiutil.IssuerExternalSigner, NewExternalSigner)
+}
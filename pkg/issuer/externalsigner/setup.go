@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsigner
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/externalsigner"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	errorPluginCheck = "ErrPluginCheck"
+
+	successPluginVerified = "PluginVerified"
+
+	messageErrorPluginCheck = "Error checking external signer plugin: "
+
+	messagePluginVerified = "External signer plugin verified"
+)
+
+// Setup verifies that the external signer plugin is reachable, and is able to
+// sign certificates for the issuer.
+func (e *ExternalSigner) Setup(ctx context.Context, issuer v1.GenericIssuer) error {
+	log := logf.FromContext(ctx, "setup")
+
+	socketPath := externalsigner.SocketPath(e.ExternalSignerSocketDirectory, issuer.GetSpec().ExternalSigner.Name)
+	log = log.WithValues("socket", socketPath)
+
+	client, err := externalsigner.NewClient(socketPath)
+	if err == nil {
+		defer client.Close()
+		_, err = client.Check(ctx, &externalsigner.CheckRequest{Issuer: externalsigner.IssuerFor(issuer)})
+	}
+	if err != nil {
+		log.Error(err, "error checking external signer plugin")
+		s := messageErrorPluginCheck + err.Error()
+		e.Recorder.Event(issuer, corev1.EventTypeWarning, errorPluginCheck, s)
+		apiutil.SetIssuerCondition(issuer, issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionFalse, errorPluginCheck, s)
+		// Configuration errors will not be resolved by retrying.
+		if externalsigner.IsPermanentError(err) {
+			return nil
+		}
+		return err
+	}
+
+	log.V(logf.DebugLevel).Info("external signer plugin verified")
+	e.Recorder.Event(issuer, corev1.EventTypeNormal, successPluginVerified, messagePluginVerified)
+	apiutil.SetIssuerCondition(issuer, issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionTrue, successPluginVerified, messagePluginVerified)
+
+	return nil
+}
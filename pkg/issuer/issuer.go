@@ -18,6 +18,7 @@ package issuer
 
 import (
 	"context"
+	"time"
 
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 )
@@ -29,6 +30,23 @@ type Interface interface {
 	Setup(ctx context.Context, issuer v1.GenericIssuer) error
 }
 
+// Finalizer is implemented by issuers that hold resources outside of the
+// cluster which have to be released before the issuer is deleted.
+type Finalizer interface {
+	// Finalize releases the resources held by the issuer. It will be called
+	// before the cert-manager finalizers are removed from a deleted issuer.
+	Finalize(ctx context.Context, issuer v1.GenericIssuer) error
+}
+
+// Rescheduler is implemented by issuers that have to be set up again at a
+// later time, even if neither the issuer nor the resources it references
+// change.
+type Rescheduler interface {
+	// NextSetup returns how long after a successful Setup the issuer should
+	// be set up again, and false if it does not need to be.
+	NextSetup(issuer v1.GenericIssuer) (time.Duration, bool)
+}
+
 type IssueResponse struct {
 	// Certificate is the certificate resource that should be stored in the
 	// target secret.
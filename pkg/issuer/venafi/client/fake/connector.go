@@ -29,6 +29,7 @@ type Connector struct {
 	ReadZoneConfigurationFunc func() (*endpoint.ZoneConfiguration, error)
 	RetrieveCertificateFunc   func(*certificate.Request) (*certificate.PEMCollection, error)
 	RequestCertificateFunc    func(*certificate.Request) (string, error)
+	RevokeCertificateFunc     func(*certificate.RevocationRequest) (endpoint.RevocationRequestResponse, error)
 }
 
 func (f Connector) Default() *Connector {
@@ -65,3 +66,10 @@ func (f *Connector) RequestCertificate(req *certificate.Request) (requestID stri
 	}
 	return f.Connector.RequestCertificate(req)
 }
+
+func (f *Connector) RevokeCertificate(req *certificate.RevocationRequest) (response endpoint.RevocationRequestResponse, err error) {
+	if f.RevokeCertificateFunc != nil {
+		return f.RevokeCertificateFunc(req)
+	}
+	return f.Connector.RevokeCertificate(req)
+}
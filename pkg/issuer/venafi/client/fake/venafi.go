@@ -28,6 +28,7 @@ type Venafi struct {
 	PingFn                  func() error
 	RequestCertificateFn    func(csrPEM []byte, duration time.Duration, customFields []api.CustomField) (string, error)
 	RetrieveCertificateFn   func(pickupID string, csrPEM []byte, duration time.Duration, customFields []api.CustomField) ([]byte, error)
+	RevokeCertificateFn     func(certPEM []byte, reason string) error
 	ReadZoneConfigurationFn func() (*endpoint.ZoneConfiguration, error)
 	VerifyCredentialsFn     func() error
 }
@@ -44,6 +45,10 @@ func (v *Venafi) RetrieveCertificate(pickupID string, csrPEM []byte, duration ti
 	return v.RetrieveCertificateFn(pickupID, csrPEM, duration, customFields)
 }
 
+func (v *Venafi) RevokeCertificate(certPEM []byte, reason string) error {
+	return v.RevokeCertificateFn(certPEM, reason)
+}
+
 func (v *Venafi) ReadZoneConfiguration() (*endpoint.ZoneConfiguration, error) {
 	return v.ReadZoneConfigurationFn()
 }
@@ -70,6 +70,15 @@ func (ic instrumentedConnector) RetrieveCertificate(req *certificate.Request) (*
 	return pemCollection, err
 }
 
+func (ic instrumentedConnector) RevokeCertificate(req *certificate.RevocationRequest) (endpoint.RevocationRequestResponse, error) {
+	start := time.Now()
+	ic.logger.V(logf.TraceLevel).Info("calling RevokeCertificate")
+	resp, err := ic.conn.RevokeCertificate(req)
+	labels := []string{"revoke_certificate"}
+	ic.metrics.ObserveVenafiRequestDuration(time.Since(start), labels...)
+	return resp, err
+}
+
 func (ic instrumentedConnector) Ping() error {
 	start := time.Now()
 	ic.logger.V(logf.TraceLevel).Info("calling Ping")
@@ -17,7 +17,9 @@ limitations under the License.
 package client
 
 import (
+	"crypto/sha1" // #nosec G505 -- Venafi identifies certificates by SHA-1 thumbprint
 	"crypto/x509"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strings"
@@ -104,6 +106,24 @@ func (v *Venafi) RetrieveCertificate(pickupID string, csrPEM []byte, duration ti
 	return []byte(chain), nil
 }
 
+// RevokeCertificate revokes a certificate previously issued by Venafi. The
+// certificate is identified by its SHA-1 thumbprint, and reason must be one of
+// the revocation reasons understood by vcert, e.g. `key-compromise`.
+func (v *Venafi) RevokeCertificate(certPEM []byte, reason string) error {
+	cert, err := pki.DecodeX509CertificateBytes(certPEM)
+	if err != nil {
+		return err
+	}
+
+	thumbprint := sha1.Sum(cert.Raw) // #nosec G401 -- Venafi identifies certificates by SHA-1 thumbprint
+	_, err = v.vcertClient.RevokeCertificate(&certificate.RevocationRequest{
+		Thumbprint: strings.ToUpper(hex.EncodeToString(thumbprint[:])),
+		Reason:     reason,
+		Comments:   "Revoked by cert-manager",
+	})
+	return err
+}
+
 func (v *Venafi) buildVReq(csrPEM []byte, duration time.Duration, customFields []api.CustomField) (*certificate.Request, error) {
 	// Retrieve a copy of the Venafi zone.
 	// This contains default values and policy control info that we can apply
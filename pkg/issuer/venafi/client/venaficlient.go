@@ -82,6 +82,7 @@ type VenafiClientBuilder func(namespace string, secretsLister internalinformers.
 type Interface interface {
 	RequestCertificate(csrPEM []byte, duration time.Duration, customFields []api.CustomField) (string, error)
 	RetrieveCertificate(pickupID string, csrPEM []byte, duration time.Duration, customFields []api.CustomField) ([]byte, error)
+	RevokeCertificate(certPEM []byte, reason string) error
 	Ping() error
 	ReadZoneConfiguration() (*endpoint.ZoneConfiguration, error)
 	SetClient(endpoint.Connector)
@@ -112,6 +113,7 @@ type connector interface {
 	ReadZoneConfiguration() (config *endpoint.ZoneConfiguration, err error)
 	RequestCertificate(req *certificate.Request) (requestID string, err error)
 	RetrieveCertificate(req *certificate.Request) (certificates *certificate.PEMCollection, err error)
+	RevokeCertificate(req *certificate.RevocationRequest) (response endpoint.RevocationRequestResponse, err error)
 }
 
 // New constructs a Venafi client Interface. Errors may be network errors and
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyprovider
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// signTimeout bounds Sign calls to a plugin, as crypto.Signer does not take a
+// context.
+const signTimeout = 30 * time.Second
+
+// Provider is a pki.KeyProvider which forwards all operations to a key
+// provider plugin.
+type Provider struct {
+	client *Client
+}
+
+var _ pki.KeyProvider = &Provider{}
+
+// New returns a Provider for the key provider plugin listening on the unix
+// socket at the given path.
+func New(socketPath string) (*Provider, error) {
+	client, err := NewClient(socketPath)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{client: client}, nil
+}
+
+// GenerateKey asks the plugin to generate a private key for the given
+// Certificate.
+func (p *Provider) GenerateKey(ctx context.Context, crt *v1.Certificate) ([]byte, error) {
+	resp, err := p.client.GenerateKey(ctx, GenerateKeyRequestFor(crt))
+	if err != nil {
+		return nil, providerError(err)
+	}
+	if len(resp.Handle) == 0 {
+		return nil, fmt.Errorf("key provider plugin returned an empty key handle")
+	}
+	return resp.Handle, nil
+}
+
+// Signer returns a crypto.Signer which signs using the plugin. The plugin
+// checks that the key was generated for the given Certificate when returning
+// its public key, and again for every signature.
+func (p *Provider) Signer(ctx context.Context, crt types.NamespacedName, handle []byte) (crypto.Signer, error) {
+	owner := Certificate{Name: crt.Name, Namespace: crt.Namespace}
+	resp, err := p.client.PublicKey(ctx, &PublicKeyRequest{Certificate: owner, Handle: handle})
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	pub, err := x509.ParsePKIXPublicKey(resp.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("key provider plugin returned an invalid public key: %w", err)
+	}
+
+	return &signer{client: p.client, crt: owner, handle: handle, pub: pub}, nil
+}
+
+// Delete asks the plugin to delete the private key referenced by the given
+// handle.
+func (p *Provider) Delete(ctx context.Context, handle []byte) error {
+	if _, err := p.client.Delete(ctx, &DeleteRequest{Handle: handle}); err != nil {
+		return providerError(err)
+	}
+	return nil
+}
+
+// signer is a crypto.Signer for a private key held by a plugin.
+type signer struct {
+	client *Client
+	crt    Certificate
+	handle []byte
+	pub    crypto.PublicKey
+}
+
+func (s *signer) Public() crypto.PublicKey {
+	return s.pub
+}
+
+func (s *signer) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	req := &SignRequest{
+		Certificate: s.crt,
+		Handle:      s.handle,
+		Digest:      digest,
+	}
+	if opts != nil && opts.HashFunc() != 0 {
+		req.Hash = opts.HashFunc().String()
+	}
+	if pssOpts, ok := opts.(*rsa.PSSOptions); ok {
+		req.PSSSaltLength = &pssOpts.SaltLength
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), signTimeout)
+	defer cancel()
+
+	resp, err := s.client.Sign(ctx, req)
+	if err != nil {
+		return nil, providerError(err)
+	}
+	return resp.Signature, nil
+}
@@ -0,0 +1,327 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyprovider
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/types"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+type fakeKey struct {
+	key crypto.Signer
+	crt Certificate
+}
+
+// fakeServer is a key provider plugin holding keys in memory, which checks
+// the owner of keys as plugins are required to.
+type fakeServer struct {
+	lock sync.Mutex
+	keys map[string]fakeKey
+	next int
+}
+
+func (f *fakeServer) GenerateKey(_ context.Context, req *GenerateKeyRequest) (*GenerateKeyResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	crt := &cmapi.Certificate{Spec: cmapi.CertificateSpec{PrivateKey: &cmapi.CertificatePrivateKey{
+		Algorithm: cmapi.PrivateKeyAlgorithm(req.PrivateKey.Algorithm),
+		Size:      req.PrivateKey.Size,
+	}}}
+	key, err := pki.GeneratePrivateKeyForCertificate(crt)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	f.next++
+	handle := strconv.Itoa(f.next)
+	f.keys[handle] = fakeKey{key: key, crt: req.Certificate}
+	return &GenerateKeyResponse{Handle: []byte(handle)}, nil
+}
+
+func (f *fakeServer) key(crt Certificate, handle []byte) (crypto.Signer, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	key, ok := f.keys[string(handle)]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "unknown key")
+	}
+	if key.crt != crt {
+		return nil, status.Error(codes.PermissionDenied, "key was generated for another Certificate")
+	}
+	return key.key, nil
+}
+
+func (f *fakeServer) PublicKey(_ context.Context, req *PublicKeyRequest) (*PublicKeyResponse, error) {
+	key, err := f.key(req.Certificate, req.Handle)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		return nil, err
+	}
+	return &PublicKeyResponse{PublicKey: pub}, nil
+}
+
+func (f *fakeServer) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	key, err := f.key(req.Certificate, req.Handle)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := req.SignerOpts()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	sig, err := key.Sign(rand.Reader, req.Digest, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &SignResponse{Signature: sig}, nil
+}
+
+func (f *fakeServer) Delete(_ context.Context, req *DeleteRequest) (*DeleteResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	delete(f.keys, string(req.Handle))
+	return &DeleteResponse{}, nil
+}
+
+// startServer serves the given implementation on a unix socket, and returns
+// the path of the socket.
+func startServer(t *testing.T, srv Server) string {
+	// t.TempDir may exceed the maximum length of a unix socket path.
+	dir, err := os.MkdirTemp("", "keyprovider")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	socketPath := filepath.Join(dir, "test.sock")
+	lis, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+
+	s := grpc.NewServer()
+	RegisterServer(s, srv)
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	return socketPath
+}
+
+func TestProvider(t *testing.T) {
+	srv := &fakeServer{keys: map[string]fakeKey{}}
+	provider, err := New(startServer(t, srv))
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		algorithm cmapi.PrivateKeyAlgorithm
+		opts      crypto.SignerOpts
+		verify    func(pub crypto.PublicKey, digest, sig []byte) bool
+	}{
+		"ECDSA": {
+			algorithm: cmapi.ECDSAKeyAlgorithm,
+			opts:      crypto.SHA256,
+			verify: func(pub crypto.PublicKey, digest, sig []byte) bool {
+				return ecdsa.VerifyASN1(pub.(*ecdsa.PublicKey), digest, sig)
+			},
+		},
+		"RSA PKCS#1 v1.5": {
+			algorithm: cmapi.RSAKeyAlgorithm,
+			opts:      crypto.SHA256,
+			verify: func(pub crypto.PublicKey, digest, sig []byte) bool {
+				return rsa.VerifyPKCS1v15(pub.(*rsa.PublicKey), crypto.SHA256, digest, sig) == nil
+			},
+		},
+		"RSA PSS": {
+			algorithm: cmapi.RSAKeyAlgorithm,
+			opts:      &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA256},
+			verify: func(pub crypto.PublicKey, digest, sig []byte) bool {
+				return rsa.VerifyPSS(pub.(*rsa.PublicKey), crypto.SHA256, digest, sig, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}) == nil
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := gen.Certificate("test-crt",
+				gen.SetCertificateNamespace("test-ns"),
+				gen.SetCertificateKeyAlgorithm(test.algorithm),
+			)
+			owner := types.NamespacedName{Namespace: "test-ns", Name: "test-crt"}
+
+			handle, err := provider.GenerateKey(t.Context(), crt)
+			require.NoError(t, err)
+
+			signer, err := provider.Signer(t.Context(), owner, handle)
+			require.NoError(t, err)
+
+			digest := sha256.Sum256([]byte("message"))
+			sig, err := signer.Sign(rand.Reader, digest[:], test.opts)
+			require.NoError(t, err)
+			assert.True(t, test.verify(signer.Public(), digest[:], sig))
+
+			// Keys cannot be used by another Certificate.
+			_, err = provider.Signer(t.Context(), types.NamespacedName{Namespace: "other-ns", Name: "test-crt"}, handle)
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			assert.False(t, errors.Is(err, pki.ErrKeyProviderUnavailable))
+
+			require.NoError(t, provider.Delete(t.Context(), handle))
+			_, err = provider.Signer(t.Context(), owner, handle)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+
+			// Deleting a key which does not exist succeeds.
+			require.NoError(t, provider.Delete(t.Context(), handle))
+		})
+	}
+}
+
+func TestProviderUnavailable(t *testing.T) {
+	dir, err := os.MkdirTemp("", "keyprovider")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	provider, err := New(filepath.Join(dir, "missing.sock"))
+	require.NoError(t, err)
+
+	_, err = provider.GenerateKey(t.Context(), gen.Certificate("test-crt"))
+	assert.ErrorIs(t, err, pki.ErrKeyProviderUnavailable)
+}
+
+// TestWireFormat guards the JSON encoding of the messages, which plugins
+// depend on as there is no protobuf definition of the service. Messages are
+// encoded using encoding/json by the codec of the externalsigner package.
+func TestWireFormat(t *testing.T) {
+	saltLength := 32
+	data, err := json.Marshal(&SignRequest{
+		Certificate:   Certificate{Name: "test-crt", Namespace: "test-ns"},
+		Handle:        []byte("handle"),
+		Digest:        []byte("digest"),
+		Hash:          crypto.SHA256.String(),
+		PSSSaltLength: &saltLength,
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"certificate": {"name": "test-crt", "namespace": "test-ns"},
+		"handle": "aGFuZGxl", "digest": "ZGlnZXN0", "hash": "SHA-256", "pssSaltLength": 32
+	}`, string(data))
+
+	data, err = json.Marshal(GenerateKeyRequestFor(gen.Certificate("test-crt",
+		gen.SetCertificateNamespace("test-ns"),
+		gen.SetCertificateKeyAlgorithm(cmapi.ECDSAKeyAlgorithm),
+		gen.SetCertificateKeySize(384),
+	)))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"certificate": {"name": "test-crt", "namespace": "test-ns"},
+		"privateKey": {"algorithm": "ECDSA", "size": 384}
+	}`, string(data))
+
+	assert.Equal(t, "/certmanager.keyprovider.v1alpha1.KeyProvider/Sign", signMethod)
+}
+
+func TestSignerOpts(t *testing.T) {
+	saltLength := rsa.PSSSaltLengthAuto
+	tests := map[string]struct {
+		req     SignRequest
+		exp     crypto.SignerOpts
+		wantErr bool
+	}{
+		"message signed without a digest": {
+			req: SignRequest{},
+			exp: crypto.Hash(0),
+		},
+		"digest": {
+			req: SignRequest{Hash: "SHA-384"},
+			exp: crypto.SHA384,
+		},
+		"PSS": {
+			req: SignRequest{Hash: "SHA-256", PSSSaltLength: &saltLength},
+			exp: &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthAuto, Hash: crypto.SHA256},
+		},
+		"unknown hash": {
+			req:     SignRequest{Hash: "SHA-1024"},
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			opts, err := test.req.SignerOpts()
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.exp, opts)
+		})
+	}
+}
+
+func TestIsPermanentError(t *testing.T) {
+	tests := map[string]struct {
+		err error
+		exp bool
+	}{
+		"permission denied is permanent": {
+			err: status.Error(codes.PermissionDenied, "denied"),
+			exp: true,
+		},
+		"not found is permanent": {
+			err: status.Error(codes.NotFound, "unknown key"),
+			exp: true,
+		},
+		"unavailable is retried": {
+			err: status.Error(codes.Unavailable, "not ready"),
+			exp: false,
+		},
+		"errors without a status are retried": {
+			err: net.ErrClosed,
+			exp: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.exp, IsPermanentError(test.err))
+		})
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package keyprovider implements out-of-process key providers: a
+// pki.KeyProvider which forwards all operations to a plugin, for example
+// one fronting a hardware security module or KMS, so that the plugin does
+// not have to be compiled into the cert-manager controller.
+//
+// A key provider plugin serves the KeyProvider service on a unix socket,
+// which is registered with the controller using the
+// keyProviderConfig.plugins option.
+//
+// # Wire format
+//
+// As for external signer plugins, there is no protobuf definition of the
+// service: requests and responses are JSON objects carried over gRPC with the
+// "application/grpc+json" content type. The JSON encoding of the message
+// types in this package, as given by their field tags, is the contract. The
+// service has the following unary methods:
+//
+//	/certmanager.keyprovider.v1alpha1.KeyProvider/GenerateKey  GenerateKeyRequest -> GenerateKeyResponse
+//	/certmanager.keyprovider.v1alpha1.KeyProvider/PublicKey    PublicKeyRequest   -> PublicKeyResponse
+//	/certmanager.keyprovider.v1alpha1.KeyProvider/Sign         SignRequest        -> SignResponse
+//	/certmanager.keyprovider.v1alpha1.KeyProvider/Delete       DeleteRequest      -> DeleteResponse
+//
+// Byte fields are base64 encoded strings.
+//
+// # Key ownership
+//
+// Key handles are stored in the Secrets of Certificates, which users may be
+// able to copy into the Secret of another Certificate. Plugins must record
+// the Certificate each key was generated for, and reject PublicKey and Sign
+// requests for any other Certificate with the PermissionDenied code.
+//
+// # Versioning
+//
+// The protocol version, currently ProtocolVersion, is part of the service
+// name. Within a version, fields may only be added to messages, and both
+// sides must ignore fields they do not know.
+//
+// # Errors
+//
+// Errors returned by plugins should carry a gRPC status code:
+// InvalidArgument, FailedPrecondition, PermissionDenied, NotFound and
+// OutOfRange fail the operation permanently, while all other codes are
+// retried.
+package keyprovider
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/cert-manager/cert-manager/pkg/externalsigner"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	// ProtocolVersion is the version of the key provider protocol
+	// implemented by this package.
+	ProtocolVersion = "v1alpha1"
+
+	// ServiceName is the fully qualified name of the KeyProvider service,
+	// which includes the protocol version.
+	ServiceName = "certmanager.keyprovider." + ProtocolVersion + ".KeyProvider"
+
+	generateKeyMethod = "/" + ServiceName + "/GenerateKey"
+	publicKeyMethod   = "/" + ServiceName + "/PublicKey"
+	signMethod        = "/" + ServiceName + "/Sign"
+	deleteMethod      = "/" + ServiceName + "/Delete"
+)
+
+// Server is implemented by key provider plugins.
+type Server interface {
+	// GenerateKey generates a private key for the given Certificate.
+	GenerateKey(context.Context, *GenerateKeyRequest) (*GenerateKeyResponse, error)
+
+	// PublicKey returns the public key of the given private key.
+	PublicKey(context.Context, *PublicKeyRequest) (*PublicKeyResponse, error)
+
+	// Sign signs a digest with the given private key.
+	Sign(context.Context, *SignRequest) (*SignResponse, error)
+
+	// Delete deletes the given private key.
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+}
+
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "GenerateKey", Handler: unaryHandler(generateKeyMethod, Server.GenerateKey)},
+		{MethodName: "PublicKey", Handler: unaryHandler(publicKeyMethod, Server.PublicKey)},
+		{MethodName: "Sign", Handler: unaryHandler(signMethod, Server.Sign)},
+		{MethodName: "Delete", Handler: unaryHandler(deleteMethod, Server.Delete)},
+	},
+}
+
+// RegisterServer registers the given key provider implementation with a
+// gRPC server.
+func RegisterServer(s grpc.ServiceRegistrar, srv Server) {
+	s.RegisterService(&serviceDesc, srv)
+}
+
+func unaryHandler[Req, Resp any](fullMethod string, call func(Server, context.Context, *Req) (*Resp, error)) grpc.MethodHandler {
+	return func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+		in := new(Req)
+		if err := dec(in); err != nil {
+			return nil, err
+		}
+		if interceptor == nil {
+			return call(srv.(Server), ctx, in)
+		}
+		info := &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod}
+		return interceptor(ctx, in, info, func(ctx context.Context, req any) (any, error) {
+			return call(srv.(Server), ctx, req.(*Req))
+		})
+	}
+}
+
+// Client is a client of a key provider plugin.
+type Client struct {
+	conn *grpc.ClientConn
+}
+
+// NewClient returns a client of the key provider plugin listening on the unix
+// socket at the given path. The connection is established lazily, on the
+// first call.
+func NewClient(socketPath string) (*Client, error) {
+	conn, err := grpc.NewClient("unix://"+socketPath,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.CallContentSubtype(externalsigner.CodecName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create key provider client for %q: %w", socketPath, err)
+	}
+	return &Client{conn: conn}, nil
+}
+
+func (c *Client) GenerateKey(ctx context.Context, req *GenerateKeyRequest) (*GenerateKeyResponse, error) {
+	out := new(GenerateKeyResponse)
+	if err := c.conn.Invoke(ctx, generateKeyMethod, req, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *Client) PublicKey(ctx context.Context, req *PublicKeyRequest) (*PublicKeyResponse, error) {
+	out := new(PublicKeyResponse)
+	if err := c.conn.Invoke(ctx, publicKeyMethod, req, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *Client) Sign(ctx context.Context, req *SignRequest) (*SignResponse, error) {
+	out := new(SignResponse)
+	if err := c.conn.Invoke(ctx, signMethod, req, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *Client) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	if err := c.conn.Invoke(ctx, deleteMethod, req, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Close closes the connection to the plugin.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// IsPermanentError returns true if the given error returned by a plugin
+// means that retrying the operation will not succeed.
+func IsPermanentError(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.PermissionDenied, codes.NotFound, codes.OutOfRange:
+		return true
+	default:
+		return false
+	}
+}
+
+// providerError returns the given error of a call to a plugin, wrapping
+// ErrKeyProviderUnavailable if the call should be retried.
+func providerError(err error) error {
+	if IsPermanentError(err) {
+		return err
+	}
+	return fmt.Errorf("%w: %w", pki.ErrKeyProviderUnavailable, err)
+}
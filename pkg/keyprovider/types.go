@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyprovider
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"fmt"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// Certificate identifies the Certificate a private key is generated for, or
+// used by.
+type Certificate struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// PrivateKey holds the fields of a Certificate's spec.privateKey that a key
+// provider needs to generate a private key.
+type PrivateKey struct {
+	// Algorithm is the private key algorithm, one of "RSA", "ECDSA",
+	// "Ed25519", "Ed448" or "MLDSA". It defaults to "RSA" if empty.
+	Algorithm string `json:"algorithm,omitempty"`
+
+	// Size is the key size for RSA, the curve size for ECDSA and the
+	// parameter set for ML-DSA. The algorithm's default is used if zero.
+	Size int `json:"size,omitempty"`
+}
+
+// GenerateKeyRequest asks a key provider to generate a private key for a
+// Certificate.
+type GenerateKeyRequest struct {
+	Certificate Certificate `json:"certificate"`
+	PrivateKey  PrivateKey  `json:"privateKey"`
+}
+
+// GenerateKeyResponse holds the handle of a generated private key.
+type GenerateKeyResponse struct {
+	// Handle is an opaque reference to the private key, which is stored in
+	// the Certificate's Secret. It must not contain the private key.
+	Handle []byte `json:"handle"`
+}
+
+// PublicKeyRequest asks a key provider for the public key of the private
+// key referenced by a handle. The provider must check that the key was
+// generated for the given Certificate.
+type PublicKeyRequest struct {
+	Certificate Certificate `json:"certificate"`
+	Handle      []byte      `json:"handle"`
+}
+
+// PublicKeyResponse holds the public key of a private key.
+type PublicKeyResponse struct {
+	// PublicKey is the DER encoded PKIX public key.
+	PublicKey []byte `json:"publicKey"`
+}
+
+// SignRequest asks a key provider to sign with the private key referenced by
+// a handle. The provider must check that the key was generated for the given
+// Certificate.
+type SignRequest struct {
+	Certificate Certificate `json:"certificate"`
+	Handle      []byte      `json:"handle"`
+
+	// Digest is the digest to sign, or the message itself for algorithms
+	// which do not sign a digest, such as Ed25519.
+	Digest []byte `json:"digest"`
+
+	// Hash is the name of the hash function used to compute Digest, as
+	// given by crypto.Hash.String, for example "SHA-256". It is empty if
+	// Digest is the message itself.
+	Hash string `json:"hash,omitempty"`
+
+	// PSSSaltLength is set if the digest is to be signed using RSASSA-PSS,
+	// and holds the salt length as defined by rsa.PSSOptions.
+	PSSSaltLength *int `json:"pssSaltLength,omitempty"`
+}
+
+// SignResponse holds a signature.
+type SignResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+// DeleteRequest asks a key provider to delete the private key referenced by
+// a handle, as it is no longer used by its Certificate. Deleting a key which
+// does not exist must succeed.
+type DeleteRequest struct {
+	Handle []byte `json:"handle"`
+}
+
+// DeleteResponse is returned once a private key has been deleted.
+type DeleteResponse struct{}
+
+// GenerateKeyRequestFor returns the GenerateKeyRequest for the given
+// Certificate.
+func GenerateKeyRequestFor(crt *cmapi.Certificate) *GenerateKeyRequest {
+	req := &GenerateKeyRequest{
+		Certificate: Certificate{Name: crt.Name, Namespace: crt.Namespace},
+	}
+	if pk := crt.Spec.PrivateKey; pk != nil {
+		req.PrivateKey = PrivateKey{Algorithm: string(pk.Algorithm), Size: pk.Size}
+	}
+	return req
+}
+
+// SignerOpts returns the crypto.SignerOpts to sign the request's digest
+// with, for use by key providers written in Go.
+func (r *SignRequest) SignerOpts() (crypto.SignerOpts, error) {
+	hash := crypto.Hash(0)
+	if r.Hash != "" {
+		var ok bool
+		if hash, ok = hashesByName[r.Hash]; !ok {
+			return nil, fmt.Errorf("unsupported hash function %q", r.Hash)
+		}
+	}
+	if r.PSSSaltLength != nil {
+		return &rsa.PSSOptions{SaltLength: *r.PSSSaltLength, Hash: hash}, nil
+	}
+	return hash, nil
+}
+
+var hashesByName = map[string]crypto.Hash{}
+
+func init() {
+	for h := crypto.MD4; h <= crypto.BLAKE2b_512; h++ {
+		hashesByName[h.String()] = h
+	}
+}
@@ -0,0 +1,509 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ocspresponder implements an RFC 6960 OCSP responder for
+// certificates issued by CA Issuers and ClusterIssuers.
+package ocspresponder
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/crypto/ocsp"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/clock"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/kube"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	// certificateSerialIndex indexes CertificateRequests by the hex encoded
+	// serial number of their issued certificate.
+	certificateSerialIndex = "ocsp-certificate-serial"
+
+	// maxRequestSize limits the size of OCSP requests sent using POST.
+	maxRequestSize = 10 * 1024
+)
+
+// Responder is an http.Handler that answers OCSP requests for certificates
+// issued by CA Issuers and ClusterIssuers.
+// Certificates listed in the CRL published by the issuer are reported as
+// revoked, so that they stay revoked once their CertificateRequest has been
+// deleted. Otherwise the status of a certificate is derived from the
+// CertificateRequest that it was issued for: certificates for
+// CertificateRequests annotated with `cert-manager.io/revocation-reason` are
+// reported as revoked, other known certificates as good, and certificates
+// without a CertificateRequest as unknown. Responses are signed by the CA
+// keypair itself.
+type Responder struct {
+	issuerLister        cmlisters.IssuerLister
+	clusterIssuerLister cmlisters.ClusterIssuerLister
+	secretLister        internalinformers.SecretLister
+	requestIndexer      cache.Indexer
+
+	mustSync []cache.InformerSynced
+
+	issuerOptions    controllerpkg.IssuerOptions
+	responseDuration time.Duration
+	clock            clock.Clock
+	log              logr.Logger
+
+	// cacheLock guards caCache and crlCache, which hold the parsed contents
+	// of CA and CRL Secrets so that they are only parsed again once the
+	// Secret changes.
+	cacheLock sync.Mutex
+	caCache   map[types.NamespacedName]cachedCA
+	crlCache  map[types.NamespacedName]cachedCRL
+}
+
+// cachedCA is the CA keypair parsed from a given version of a CA Secret.
+type cachedCA struct {
+	resourceVersion string
+	cert            *x509.Certificate
+	key             crypto.Signer
+}
+
+// cachedCRL is the set of revocation entries, keyed by serial number, parsed
+// from a given version of a CRL Secret and verified against caCert.
+type cachedCRL struct {
+	resourceVersion string
+	caCert          *x509.Certificate
+	entries         map[string]x509.RevocationListEntry
+}
+
+// New constructs a Responder using the informers of the given controller
+// context. The informers must be started by the caller after New returns.
+func New(ctx *controllerpkg.Context, responseDuration time.Duration) (*Responder, error) {
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
+	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
+	secretInformer := ctx.KubeSharedInformerFactory.Secrets()
+
+	if err := certificateRequestInformer.Informer().AddIndexers(cache.Indexers{
+		certificateSerialIndex: certificateSerialIndexFunc,
+	}); err != nil {
+		return nil, fmt.Errorf("error adding CertificateRequest indexer: %w", err)
+	}
+
+	r := &Responder{
+		issuerLister:     issuerInformer.Lister(),
+		secretLister:     secretInformer.Lister(),
+		requestIndexer:   certificateRequestInformer.Informer().GetIndexer(),
+		issuerOptions:    ctx.IssuerOptions,
+		responseDuration: responseDuration,
+		clock:            ctx.Clock,
+		log:              logf.FromContext(ctx.RootContext, "ocsp-responder"),
+		caCache:          make(map[types.NamespacedName]cachedCA),
+		crlCache:         make(map[types.NamespacedName]cachedCRL),
+		mustSync: []cache.InformerSynced{
+			issuerInformer.Informer().HasSynced,
+			certificateRequestInformer.Informer().HasSynced,
+			secretInformer.Informer().HasSynced,
+		},
+	}
+
+	// ClusterIssuers are only available if we are running in non-namespaced
+	// mode.
+	if ctx.Namespace == "" {
+		clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+		r.clusterIssuerLister = clusterIssuerInformer.Lister()
+		r.mustSync = append(r.mustSync, clusterIssuerInformer.Informer().HasSynced)
+	}
+
+	return r, nil
+}
+
+// certificateSerialIndexFunc indexes CertificateRequests by the serial number
+// of their issued certificate.
+func certificateSerialIndexFunc(obj any) ([]string, error) {
+	cr, ok := obj.(*cmapi.CertificateRequest)
+	if !ok || len(cr.Status.Certificate) == 0 {
+		return nil, nil
+	}
+
+	cert, err := pki.DecodeX509CertificateBytes(cr.Status.Certificate)
+	if err != nil {
+		// Requests with an invalid certificate are not indexed, and so
+		// are reported as unknown.
+		return nil, nil
+	}
+
+	return []string{serialKey(cert.SerialNumber)}, nil
+}
+
+func serialKey(serial *big.Int) string {
+	return serial.Text(16)
+}
+
+func (r *Responder) hasSynced() bool {
+	for _, synced := range r.mustSync {
+		if !synced() {
+			return false
+		}
+	}
+	return true
+}
+
+// ServeHTTP implements the OCSP HTTP transport described in RFC 6960
+// Appendix A, accepting both GET and POST requests.
+func (r *Responder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	var (
+		der []byte
+		err error
+	)
+	switch req.Method {
+	case http.MethodGet:
+		der, err = decodeGETRequest(req.URL)
+	case http.MethodPost:
+		der, err = io.ReadAll(io.LimitReader(req.Body, maxRequestSize+1))
+		if err == nil && len(der) > maxRequestSize {
+			err = fmt.Errorf("request exceeds %d bytes", maxRequestSize)
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err != nil {
+		r.log.V(logf.DebugLevel).Info("failed to read OCSP request", "error", err.Error())
+		writeResponse(w, ocsp.MalformedRequestErrorResponse, time.Time{})
+		return
+	}
+
+	ocspReq, err := ocsp.ParseRequest(der)
+	if err != nil {
+		r.log.V(logf.DebugLevel).Info("failed to parse OCSP request", "error", err.Error())
+		writeResponse(w, ocsp.MalformedRequestErrorResponse, time.Time{})
+		return
+	}
+
+	if !r.hasSynced() {
+		writeResponse(w, ocsp.TryLaterErrorResponse, time.Time{})
+		return
+	}
+
+	resp, nextUpdate := r.respond(req.Context(), ocspReq)
+	writeResponse(w, resp, nextUpdate)
+}
+
+// decodeGETRequest returns the DER encoded OCSP request contained in the
+// path of a GET request, which is the URL encoding of its base64 encoding.
+func decodeGETRequest(u *url.URL) ([]byte, error) {
+	p, err := url.PathUnescape(strings.TrimPrefix(u.EscapedPath(), "/"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Some clients do not URL encode the request, which turns '+' into ' '.
+	return base64.StdEncoding.DecodeString(strings.ReplaceAll(p, " ", "+"))
+}
+
+func writeResponse(w http.ResponseWriter, resp []byte, nextUpdate time.Time) {
+	w.Header().Set("Content-Type", "application/ocsp-response")
+	if !nextUpdate.IsZero() {
+		w.Header().Set("Expires", nextUpdate.UTC().Format(http.TimeFormat))
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(resp)
+}
+
+// respond returns the signed OCSP response for the given request, along with
+// the time at which the response expires. A zero time is returned for error
+// responses.
+func (r *Responder) respond(ctx context.Context, req *ocsp.Request) ([]byte, time.Time) {
+	log := r.log.WithValues("serial", serialKey(req.SerialNumber))
+
+	ca, err := r.findCA(ctx, req)
+	if err != nil {
+		log.Error(err, "failed to find CA for OCSP request")
+		return ocsp.InternalErrorErrorResponse, time.Time{}
+	}
+	if ca == nil {
+		log.V(logf.DebugLevel).Info("OCSP request is not for a certificate issued by a CA issuer")
+		return ocsp.UnauthorizedErrorResponse, time.Time{}
+	}
+	log = logf.WithResource(log, ca.issuer)
+
+	now := r.clock.Now().Truncate(time.Second)
+	template := ocsp.Response{
+		SerialNumber: req.SerialNumber,
+		Status:       ocsp.Unknown,
+		ThisUpdate:   now,
+		NextUpdate:   now.Add(r.responseDuration),
+	}
+
+	// Certificates stay listed in the CRL after their CertificateRequest has
+	// been deleted, so the CRL is checked first.
+	if entry := r.crlEntry(ca, req.SerialNumber); entry != nil {
+		template.Status = ocsp.Revoked
+		template.RevocationReason = entry.ReasonCode
+		template.RevokedAt = entry.RevocationTime
+	} else {
+		cr, err := r.findRequest(req.SerialNumber, ca.cert)
+		if err != nil {
+			log.Error(err, "failed to find CertificateRequest for OCSP request")
+			return ocsp.InternalErrorErrorResponse, time.Time{}
+		}
+		if cr != nil {
+			template.Status = ocsp.Good
+
+			if reason, revoked := cr.Annotations[cmapi.CertificateRequestRevocationReasonAnnotationKey]; revoked {
+				// The reason has been validated by the webhook. The
+				// certificate is not listed in a CRL yet, so the time of
+				// revocation is not known.
+				reasonCode, _ := pki.ParseRevocationReason(reason)
+
+				template.Status = ocsp.Revoked
+				template.RevocationReason = reasonCode
+				template.RevokedAt = now
+			}
+		}
+	}
+
+	resp, err := ocsp.CreateResponse(ca.cert, ca.cert, template, ca.key)
+	if err != nil {
+		log.Error(err, "failed to sign OCSP response")
+		return ocsp.InternalErrorErrorResponse, time.Time{}
+	}
+
+	log.V(logf.DebugLevel).Info("signed OCSP response", "status", template.Status)
+	return resp, template.NextUpdate
+}
+
+// issuerCA is the CA keypair of a CA issuer.
+type issuerCA struct {
+	issuer cmapi.GenericIssuer
+	cert   *x509.Certificate
+	key    crypto.Signer
+}
+
+// findCA returns the CA keypair of the first CA issuer whose CA certificate
+// matches the issuer name and key hashes of the request, or nil if there is
+// none.
+func (r *Responder) findCA(ctx context.Context, req *ocsp.Request) (*issuerCA, error) {
+	if !req.HashAlgorithm.Available() {
+		return nil, nil
+	}
+
+	issuers, err := r.caIssuers()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, iss := range issuers {
+		ca, err := r.caKeyPair(ctx, types.NamespacedName{Namespace: r.issuerOptions.ResourceNamespace(iss), Name: iss.GetSpec().CA.SecretName})
+		if err != nil {
+			// The issuers controller reports this on the Issuer's Ready
+			// condition.
+			continue
+		}
+
+		match, err := matchesRequest(req, ca.cert)
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			return &issuerCA{issuer: iss, cert: ca.cert, key: ca.key}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// caKeyPair returns the CA keypair stored in the given Secret. The parsed
+// keypair is cached until the Secret's resourceVersion changes.
+func (r *Responder) caKeyPair(ctx context.Context, key types.NamespacedName) (cachedCA, error) {
+	secret, err := r.secretLister.Secrets(key.Namespace).Get(key.Name)
+	if err != nil {
+		return cachedCA{}, err
+	}
+
+	r.cacheLock.Lock()
+	cached, ok := r.caCache[key]
+	r.cacheLock.Unlock()
+	if ok && cached.resourceVersion == secret.ResourceVersion {
+		return cached, nil
+	}
+
+	certs, caKey, err := kube.SecretTLSKeyPairAndCA(ctx, r.secretLister, key.Namespace, key.Name)
+	if err != nil {
+		return cachedCA{}, err
+	}
+
+	cached = cachedCA{resourceVersion: secret.ResourceVersion, cert: certs[0], key: caKey}
+	r.cacheLock.Lock()
+	r.caCache[key] = cached
+	r.cacheLock.Unlock()
+
+	return cached, nil
+}
+
+// caIssuers returns all Issuers and ClusterIssuers that are CA issuers.
+func (r *Responder) caIssuers() ([]cmapi.GenericIssuer, error) {
+	var issuers []cmapi.GenericIssuer
+
+	namespaced, err := r.issuerLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, iss := range namespaced {
+		if iss.Spec.CA != nil {
+			issuers = append(issuers, iss)
+		}
+	}
+
+	if r.clusterIssuerLister == nil {
+		return issuers, nil
+	}
+
+	clusterIssuers, err := r.clusterIssuerLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, iss := range clusterIssuers {
+		if iss.Spec.CA != nil {
+			issuers = append(issuers, iss)
+		}
+	}
+
+	return issuers, nil
+}
+
+// matchesRequest returns true if the issuer name and key hashes of the
+// request identify the given CA certificate, as described in RFC 6960
+// section 4.1.1.
+func matchesRequest(req *ocsp.Request, caCert *x509.Certificate) (bool, error) {
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(caCert.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return false, fmt.Errorf("error parsing CA public key: %w", err)
+	}
+
+	h := req.HashAlgorithm.New()
+	h.Write(caCert.RawSubject)
+	nameHash := h.Sum(nil)
+
+	h.Reset()
+	h.Write(spki.PublicKey.RightAlign())
+	keyHash := h.Sum(nil)
+
+	return bytes.Equal(nameHash, req.IssuerNameHash) && bytes.Equal(keyHash, req.IssuerKeyHash), nil
+}
+
+// findRequest returns a CertificateRequest whose certificate has the given
+// serial number and was signed by the given CA, or nil if there is none.
+func (r *Responder) findRequest(serial *big.Int, caCert *x509.Certificate) (*cmapi.CertificateRequest, error) {
+	objs, err := r.requestIndexer.ByIndex(certificateSerialIndex, serialKey(serial))
+	if err != nil {
+		return nil, err
+	}
+
+	var found *cmapi.CertificateRequest
+	for _, obj := range objs {
+		cr, ok := obj.(*cmapi.CertificateRequest)
+		if !ok {
+			continue
+		}
+
+		cert, err := pki.DecodeX509CertificateBytes(cr.Status.Certificate)
+		if err != nil || cert.SerialNumber.Cmp(serial) != 0 || cert.CheckSignatureFrom(caCert) != nil {
+			continue
+		}
+
+		// If several requests were issued the same certificate, any one of
+		// them being revoked revokes the certificate.
+		if _, revoked := cr.Annotations[cmapi.CertificateRequestRevocationReasonAnnotationKey]; revoked {
+			return cr, nil
+		}
+		found = cr
+	}
+
+	return found, nil
+}
+
+// crlEntry returns the entry for the certificate with the given serial number
+// in the CRL published by the issuer, or nil if the issuer does not publish a
+// CRL or the certificate is not listed in it. The entries of the CRL are
+// cached until the CRL Secret's resourceVersion changes.
+func (r *Responder) crlEntry(ca *issuerCA, serial *big.Int) *x509.RevocationListEntry {
+	crlConfig := ca.issuer.GetSpec().CA.CRL
+	if crlConfig == nil {
+		return nil
+	}
+
+	key := types.NamespacedName{Namespace: r.issuerOptions.ResourceNamespace(ca.issuer), Name: crlConfig.SecretName}
+	secret, err := r.secretLister.Secrets(key.Namespace).Get(key.Name)
+	if err != nil {
+		if !k8sErrors.IsNotFound(err) {
+			r.log.V(logf.DebugLevel).Info("failed to get CRL secret", "error", err.Error())
+		}
+		return nil
+	}
+
+	r.cacheLock.Lock()
+	cached, ok := r.crlCache[key]
+	r.cacheLock.Unlock()
+	if !ok || cached.resourceVersion != secret.ResourceVersion || !cached.caCert.Equal(ca.cert) {
+		crl, err := pki.ParseCRLSignedBy(secret.Data[cmapi.CRLSecretKey], ca.cert)
+		if err != nil {
+			// The CRL will be replaced by one signed by the current CA.
+			return nil
+		}
+
+		cached = cachedCRL{
+			resourceVersion: secret.ResourceVersion,
+			caCert:          ca.cert,
+			entries:         make(map[string]x509.RevocationListEntry, len(crl.RevokedCertificateEntries)),
+		}
+		for _, entry := range crl.RevokedCertificateEntries {
+			cached.entries[serialKey(entry.SerialNumber)] = entry
+		}
+
+		r.cacheLock.Lock()
+		r.crlCache[key] = cached
+		r.cacheLock.Unlock()
+	}
+
+	entry, ok := cached.entries[serialKey(serial)]
+	if !ok {
+		return nil
+	}
+	return &entry
+}
@@ -0,0 +1,321 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocspresponder
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+var fixedClockStart = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func mustCreateCA(t *testing.T, name string) (*x509.Certificate, crypto.Signer, *corev1.Secret) {
+	key, err := pki.GenerateECPrivateKey(256)
+	require.NoError(t, err)
+	keyPEM, err := pki.EncodeECPrivateKey(key)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		BasicConstraintsValid: true,
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             fixedClockStart,
+		NotAfter:              fixedClockStart.Add(time.Hour * 24 * 365),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		PublicKey:             key.Public(),
+		IsCA:                  true,
+	}
+	certPEM, cert, err := pki.SignCertificate(tmpl, tmpl, key.Public(), key)
+	require.NoError(t, err)
+
+	secret := gen.Secret(name,
+		gen.SetSecretNamespace("default"),
+		gen.SetSecretData(map[string][]byte{
+			corev1.TLSCertKey:       certPEM,
+			corev1.TLSPrivateKeyKey: keyPEM,
+		}),
+	)
+
+	return cert, key, secret
+}
+
+func mustSignLeaf(t *testing.T, caCert *x509.Certificate, caKey crypto.Signer, serial int64) ([]byte, *x509.Certificate) {
+	key, err := pki.GenerateECPrivateKey(256)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "leaf"},
+		NotBefore:    fixedClockStart,
+		NotAfter:     fixedClockStart.Add(time.Hour * 24),
+		PublicKey:    key.Public(),
+	}
+	certPEM, cert, err := pki.SignCertificate(tmpl, caCert, key.Public(), caKey)
+	require.NoError(t, err)
+
+	return certPEM, cert
+}
+
+func TestServeHTTP(t *testing.T) {
+	caCert, caKey, caSecret := mustCreateCA(t, "ca")
+	otherCACert, otherCAKey, _ := mustCreateCA(t, "other-ca")
+
+	issuer := gen.Issuer("ca-issuer",
+		gen.SetIssuerNamespace("default"),
+		gen.SetIssuerCA(cmapi.CAIssuer{SecretName: caSecret.Name}),
+	)
+	issuerRef := cmmeta.IssuerReference{Name: issuer.Name, Kind: cmapi.IssuerKind}
+
+	goodPEM, goodCert := mustSignLeaf(t, caCert, caKey, 10)
+	revokedPEM, revokedCert := mustSignLeaf(t, caCert, caKey, 11)
+	_, unknownCert := mustSignLeaf(t, caCert, caKey, 12)
+	_, deletedRequestCert := mustSignLeaf(t, caCert, caKey, 13)
+	_, otherCALeaf := mustSignLeaf(t, otherCACert, otherCAKey, 10)
+
+	goodCR := gen.CertificateRequest("good",
+		gen.SetCertificateRequestNamespace("default"),
+		gen.SetCertificateRequestIssuer(issuerRef),
+		gen.SetCertificateRequestCertificate(goodPEM),
+	)
+	revokedCR := gen.CertificateRequest("revoked",
+		gen.SetCertificateRequestNamespace("default"),
+		gen.SetCertificateRequestIssuer(issuerRef),
+		gen.SetCertificateRequestCertificate(revokedPEM),
+		gen.AddCertificateRequestAnnotations(map[string]string{
+			cmapi.CertificateRequestRevocationReasonAnnotationKey: "keyCompromise",
+		}),
+	)
+
+	revokedAt := fixedClockStart.Add(-time.Hour)
+	crl, err := pki.SignCRL(caCert, caKey, []x509.RevocationListEntry{
+		{SerialNumber: big.NewInt(11), RevocationTime: revokedAt, ReasonCode: 1},
+		{SerialNumber: big.NewInt(13), RevocationTime: revokedAt, ReasonCode: 1},
+	}, big.NewInt(1), revokedAt, fixedClockStart.Add(time.Hour*23))
+	require.NoError(t, err)
+	crlSecret := gen.Secret("ca-crl",
+		gen.SetSecretNamespace("default"),
+		gen.SetSecretData(map[string][]byte{cmapi.CRLSecretKey: crl}),
+	)
+
+	tests := map[string]struct {
+		issuer   *cmapi.Issuer
+		kubeObjs []runtime.Object
+		cert     *x509.Certificate
+		issuerCA *x509.Certificate
+		get      bool
+
+		expectedStatus    int
+		expectedRevokedAt time.Time
+		expectedError     error
+	}{
+		"a certificate issued for a CertificateRequest should be good": {
+			issuer:         issuer,
+			kubeObjs:       []runtime.Object{caSecret},
+			cert:           goodCert,
+			issuerCA:       caCert,
+			expectedStatus: ocsp.Good,
+		},
+		"requests should also be accepted using GET": {
+			issuer:         issuer,
+			kubeObjs:       []runtime.Object{caSecret},
+			cert:           goodCert,
+			issuerCA:       caCert,
+			get:            true,
+			expectedStatus: ocsp.Good,
+		},
+		"a certificate of a revoked CertificateRequest should be revoked": {
+			issuer:            issuer,
+			kubeObjs:          []runtime.Object{caSecret},
+			cert:              revokedCert,
+			issuerCA:          caCert,
+			expectedStatus:    ocsp.Revoked,
+			expectedRevokedAt: fixedClockStart,
+		},
+		"the revocation time should be read from the published CRL": {
+			issuer: gen.IssuerFrom(issuer, gen.SetIssuerCA(cmapi.CAIssuer{
+				SecretName: caSecret.Name,
+				CRL:        &cmapi.CAIssuerCRL{SecretName: crlSecret.Name},
+			})),
+			kubeObjs:          []runtime.Object{caSecret, crlSecret},
+			cert:              revokedCert,
+			issuerCA:          caCert,
+			expectedStatus:    ocsp.Revoked,
+			expectedRevokedAt: revokedAt,
+		},
+		"a certificate listed in the published CRL should stay revoked once its CertificateRequest is deleted": {
+			issuer: gen.IssuerFrom(issuer, gen.SetIssuerCA(cmapi.CAIssuer{
+				SecretName: caSecret.Name,
+				CRL:        &cmapi.CAIssuerCRL{SecretName: crlSecret.Name},
+			})),
+			kubeObjs:          []runtime.Object{caSecret, crlSecret},
+			cert:              deletedRequestCert,
+			issuerCA:          caCert,
+			expectedStatus:    ocsp.Revoked,
+			expectedRevokedAt: revokedAt,
+		},
+		"a certificate without a CertificateRequest should be unknown": {
+			issuer:         issuer,
+			kubeObjs:       []runtime.Object{caSecret},
+			cert:           unknownCert,
+			issuerCA:       caCert,
+			expectedStatus: ocsp.Unknown,
+		},
+		"a certificate issued by another CA should be unauthorized": {
+			issuer:        issuer,
+			kubeObjs:      []runtime.Object{caSecret},
+			cert:          otherCALeaf,
+			issuerCA:      otherCACert,
+			expectedError: ocsp.ResponseError{Status: ocsp.Unauthorized},
+		},
+		"requests should be unauthorized if the CA secret does not exist": {
+			issuer:        issuer,
+			cert:          goodCert,
+			issuerCA:      caCert,
+			expectedError: ocsp.ResponseError{Status: ocsp.Unauthorized},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(fixedClockStart),
+				KubeObjects:        test.kubeObjs,
+				CertManagerObjects: []runtime.Object{test.issuer, goodCR, revokedCR},
+			}
+			builder.Init()
+
+			responder, err := New(builder.Context, time.Hour)
+			require.NoError(t, err)
+			builder.Start()
+			defer builder.Stop()
+
+			ocspReq, err := ocsp.CreateRequest(test.cert, test.issuerCA, nil)
+			require.NoError(t, err)
+
+			var req *http.Request
+			if test.get {
+				req = httptest.NewRequest(http.MethodGet, "/"+url.PathEscape(base64.StdEncoding.EncodeToString(ocspReq)), nil)
+			} else {
+				req = httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(ocspReq))
+				req.Header.Set("Content-Type", "application/ocsp-request")
+			}
+			rec := httptest.NewRecorder()
+			responder.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, "application/ocsp-response", rec.Header().Get("Content-Type"))
+
+			body, err := io.ReadAll(rec.Body)
+			require.NoError(t, err)
+
+			resp, err := ocsp.ParseResponseForCert(body, test.cert, test.issuerCA)
+			if test.expectedError != nil {
+				assert.Equal(t, test.expectedError, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedStatus, resp.Status)
+			assert.Equal(t, fixedClockStart, resp.ThisUpdate)
+			assert.Equal(t, fixedClockStart.Add(time.Hour), resp.NextUpdate)
+			if test.expectedStatus == ocsp.Revoked {
+				assert.Equal(t, test.expectedRevokedAt, resp.RevokedAt)
+				assert.Equal(t, ocsp.KeyCompromise, resp.RevocationReason)
+			}
+		})
+	}
+}
+
+func TestServeHTTPMalformedRequest(t *testing.T) {
+	builder := &testpkg.Builder{T: t}
+	builder.Init()
+
+	responder, err := New(builder.Context, time.Hour)
+	require.NoError(t, err)
+	builder.Start()
+	defer builder.Stop()
+
+	rec := httptest.NewRecorder()
+	responder.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte("not a request"))))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, ocsp.MalformedRequestErrorResponse, rec.Body.Bytes())
+
+	rec = httptest.NewRecorder()
+	responder.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestCAKeyPairCache(t *testing.T) {
+	_, _, caSecret := mustCreateCA(t, "ca")
+	caSecret.ResourceVersion = "1"
+	rotatedCACert, _, rotatedSecret := mustCreateCA(t, "rotated-ca")
+	rotatedSecret.Name = caSecret.Name
+	rotatedSecret.ResourceVersion = "2"
+
+	builder := &testpkg.Builder{T: t, KubeObjects: []runtime.Object{caSecret}}
+	builder.Init()
+
+	responder, err := New(builder.Context, time.Hour)
+	require.NoError(t, err)
+	builder.Start()
+	defer builder.Stop()
+
+	key := types.NamespacedName{Namespace: caSecret.Namespace, Name: caSecret.Name}
+	first, err := responder.caKeyPair(t.Context(), key)
+	require.NoError(t, err)
+	second, err := responder.caKeyPair(t.Context(), key)
+	require.NoError(t, err)
+	assert.Same(t, first.cert, second.cert, "the CA should not be parsed again while the Secret is unchanged")
+
+	_, err = builder.Client.CoreV1().Secrets(rotatedSecret.Namespace).Update(t.Context(), rotatedSecret, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		secret, err := builder.KubeSharedInformerFactory.Secrets().Lister().Secrets(key.Namespace).Get(key.Name)
+		return err == nil && secret.ResourceVersion == rotatedSecret.ResourceVersion
+	}, time.Second*5, time.Millisecond*10)
+
+	rotated, err := responder.caKeyPair(t.Context(), key)
+	require.NoError(t, err)
+	assert.True(t, rotated.cert.Equal(rotatedCACert), "the CA should be parsed again once the Secret changes")
+}
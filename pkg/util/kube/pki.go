@@ -20,10 +20,14 @@ import (
 	"context"
 	"crypto"
 	"crypto/x509"
+	stderrors "errors"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/cert-manager/cert-manager/pkg/util/errors"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
@@ -38,7 +42,7 @@ func SecretTLSKeyRef(ctx context.Context, secretLister internalinformers.SecretL
 		return nil, err
 	}
 
-	key, _, err := ParseTLSKeyFromSecret(secret, keyName)
+	key, _, err := ParseTLSKeyFromSecret(ctx, secret, keyName)
 	if err != nil {
 		return nil, err
 	}
@@ -54,21 +58,38 @@ func SecretTLSKey(ctx context.Context, secretLister internalinformers.SecretList
 }
 
 // ParseTLSKeyFromSecret will parse and decode a private key from the given
-// Secret at the given key index.
-func ParseTLSKeyFromSecret(secret *corev1.Secret, keyName string) (crypto.Signer, []byte, error) {
+// Secret at the given key index. Private keys held by a KeyProvider are
+// resolved for the Certificate which the Secret belongs to.
+func ParseTLSKeyFromSecret(ctx context.Context, secret *corev1.Secret, keyName string) (crypto.Signer, []byte, error) {
 	keyBytes, ok := secret.Data[keyName]
 	if !ok {
 		return nil, nil, errors.NewInvalidData("no data for %q in secret '%s/%s'", keyName, secret.Namespace, secret.Name)
 	}
 
-	key, err := pki.DecodePrivateKeyBytes(keyBytes)
+	// Errors reaching a KeyProvider are retried, all other errors mean that
+	// the key data can never be decoded.
+	key, err := pki.DecodeCertificatePrivateKeyBytes(ctx, secretCertificateName(secret), keyBytes)
 	if err != nil {
+		if errors.IsInvalidData(err) || stderrors.Is(err, pki.ErrKeyProviderUnavailable) {
+			return nil, keyBytes, err
+		}
 		return nil, keyBytes, errors.NewInvalidData("%s", err)
 	}
 
 	return key, keyBytes, nil
 }
 
+// secretCertificateName returns the namespace and name of the Certificate
+// which the given Secret belongs to: the Certificate named in its
+// certificate-name annotation, or else the Certificate controlling it.
+func secretCertificateName(secret *corev1.Secret) types.NamespacedName {
+	name := secret.Annotations[cmapi.CertificateNameKey]
+	if ref := metav1.GetControllerOf(secret); name == "" && ref != nil && ref.Kind == cmapi.CertificateKind {
+		name = ref.Name
+	}
+	return types.NamespacedName{Namespace: secret.Namespace, Name: name}
+}
+
 func SecretTLSCertChain(ctx context.Context, secretLister internalinformers.SecretLister, namespace, name string) ([]*x509.Certificate, error) {
 	secret, err := secretLister.Secrets(namespace).Get(name)
 	if err != nil {
@@ -124,9 +145,9 @@ func SecretTLSKeyPair(ctx context.Context, secretLister internalinformers.Secret
 	if !ok {
 		return nil, nil, errors.NewInvalidData("no private key data for %q in secret '%s/%s'", corev1.TLSPrivateKeyKey, namespace, name)
 	}
-	key, err := pki.DecodePrivateKeyBytes(keyBytes)
+	key, err := pki.DecodeCertificatePrivateKeyBytes(ctx, secretCertificateName(secret), keyBytes)
 	if err != nil {
-		return nil, nil, errors.NewInvalidData("%s", err)
+		return nil, nil, err
 	}
 
 	certBytes, ok := secret.Data[corev1.TLSCertKey]
@@ -275,7 +275,7 @@ func CertificateTemplateFromCSRPEM(csrPEM []byte, validatorMutators ...Certifica
 		return nil, err
 	}
 
-	if err := csr.CheckSignature(); err != nil {
+	if err := checkCertificateRequestSignature(csr); err != nil {
 		return nil, err
 	}
 
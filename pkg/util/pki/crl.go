@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+)
+
+// RevocationReasonUnspecified is the CRLReason used when no reason has been
+// given for revoking a certificate.
+const RevocationReasonUnspecified = 0
+
+// revocationReasons maps the RFC 5280 section 5.3.1 CRLReason names to their
+// codes. removeFromCRL is omitted as it is only meaningful in delta CRLs.
+var revocationReasons = map[string]int{
+	"unspecified":          0,
+	"keyCompromise":        1,
+	"cACompromise":         2,
+	"affiliationChanged":   3,
+	"superseded":           4,
+	"cessationOfOperation": 5,
+	"certificateHold":      6,
+	"privilegeWithdrawn":   9,
+	"aACompromise":         10,
+}
+
+// ParseRevocationReason returns the RFC 5280 CRLReason code for the given
+// reason name. An empty name is treated as `unspecified`.
+func ParseRevocationReason(reason string) (int, error) {
+	if reason == "" {
+		return RevocationReasonUnspecified, nil
+	}
+
+	code, ok := revocationReasons[reason]
+	if !ok {
+		return 0, fmt.Errorf("unknown revocation reason %q", reason)
+	}
+
+	return code, nil
+}
+
+// RevocationReasonName returns the RFC 5280 CRLReason name for the given code.
+func RevocationReasonName(code int) string {
+	for name, c := range revocationReasons {
+		if c == code {
+			return name
+		}
+	}
+
+	return fmt.Sprintf("unknown(%d)", code)
+}
+
+// SignCRL creates a new certificate revocation list containing the given
+// entries, signed by the given CA certificate and private key.
+// Entries are sorted by serial number so that the output only depends on the
+// set of revoked certificates. It returns the DER encoded CRL.
+func SignCRL(caCert *x509.Certificate, caKey crypto.Signer, entries []x509.RevocationListEntry, number *big.Int, thisUpdate, nextUpdate time.Time) ([]byte, error) {
+	entries = slices.Clone(entries)
+	slices.SortFunc(entries, func(a, b x509.RevocationListEntry) int {
+		return a.SerialNumber.Cmp(b.SerialNumber)
+	})
+
+	template := &x509.RevocationList{
+		RevokedCertificateEntries: entries,
+		Number:                    number,
+		ThisUpdate:                thisUpdate,
+		NextUpdate:                nextUpdate,
+	}
+
+	crl, err := x509.CreateRevocationList(rand.Reader, template, caCert, caKey)
+	if err != nil {
+		return nil, fmt.Errorf("error creating certificate revocation list: %w", err)
+	}
+
+	return crl, nil
+}
+
+// ParseCRLSignedBy parses the given DER encoded certificate revocation list
+// and verifies that it was signed by the given CA certificate.
+func ParseCRLSignedBy(crlDER []byte, caCert *x509.Certificate) (*x509.RevocationList, error) {
+	crl, err := x509.ParseRevocationList(crlDER)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing certificate revocation list: %w", err)
+	}
+
+	if !bytes.Equal(crl.RawIssuer, caCert.RawSubject) {
+		return nil, fmt.Errorf("certificate revocation list was not issued by %q", caCert.Subject)
+	}
+
+	if err := crl.CheckSignatureFrom(caCert); err != nil {
+		return nil, fmt.Errorf("certificate revocation list was not signed by %q: %w", caCert.Subject, err)
+	}
+
+	return crl, nil
+}
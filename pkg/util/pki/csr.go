@@ -22,6 +22,7 @@ import (
 	"crypto/ecdsa"
 	"crypto/ed25519"
 	"crypto/elliptic"
+	"crypto/mldsa"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -34,6 +35,8 @@ import (
 	"net/netip"
 	"net/url"
 
+	"github.com/cloudflare/circl/sign/ed448"
+
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 )
@@ -370,17 +373,33 @@ func SignCertificate(template *x509.Certificate, issuerCert *x509.Certificate, p
 		pubKeyAlgo = x509.Ed25519
 		sigAlgoArg = nil // ignored by signatureAlgorithmFromPublicKey
 
+	case *mldsa.PublicKey:
+		pubKeyAlgo = x509.MLDSA
+		sigAlgoArg = pubKey.Parameters()
+
+	case ed448.PublicKey:
+		// crypto/x509 has no identifiers for Ed448, which only has a single
+		// signature algorithm; see createCertificateWithEd448.
+		pubKeyAlgo = x509.UnknownPublicKeyAlgorithm
+
 	default:
 		return nil, nil, fmt.Errorf("unknown public key type on signing certificate: %T", issuerCert.PublicKey)
 	}
 
 	var err error
-	template.SignatureAlgorithm, err = signatureAlgorithmFromPublicKey(pubKeyAlgo, sigAlgoArg)
-	if err != nil {
-		return nil, nil, err
+	if pubKeyAlgo != x509.UnknownPublicKeyAlgorithm {
+		template.SignatureAlgorithm, err = signatureAlgorithmFromPublicKey(pubKeyAlgo, sigAlgoArg)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
-	derBytes, err := x509.CreateCertificate(rand.Reader, template, issuerCert, publicKey, signerKey)
+	var derBytes []byte
+	if isEd448Key(publicKey) || isEd448Key(typedSigner.Public()) {
+		derBytes, err = createCertificateWithEd448(template, issuerCert, publicKey, typedSigner)
+	} else {
+		derBytes, err = x509.CreateCertificate(rand.Reader, template, issuerCert, publicKey, signerKey)
+	}
 	if err != nil {
 		return nil, nil, fmt.Errorf("error creating x509 certificate: %s", err.Error())
 	}
@@ -389,6 +408,7 @@ func SignCertificate(template *x509.Certificate, issuerCert *x509.Certificate, p
 	if err != nil {
 		return nil, nil, fmt.Errorf("error decoding DER certificate bytes: %s", err.Error())
 	}
+	setEd448PublicKey(cert.PublicKeyAlgorithm, cert.RawSubjectPublicKeyInfo, &cert.PublicKey)
 
 	pemBytes := bytes.NewBuffer([]byte{})
 	err = pem.Encode(pemBytes, &pem.Block{Type: "CERTIFICATE", Bytes: derBytes})
@@ -425,8 +445,15 @@ func SignCSRTemplate(caCerts []*x509.Certificate, caPrivateKey crypto.Signer, te
 
 // EncodeCSR calls x509.CreateCertificateRequest to sign the given CSR template.
 // It returns a DER encoded signed CSR.
+// Ed448 keys, which are not supported by crypto/x509, are handled separately.
 func EncodeCSR(template *x509.CertificateRequest, key crypto.Signer) ([]byte, error) {
-	derBytes, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	var derBytes []byte
+	var err error
+	if isEd448Key(key.Public()) {
+		derBytes, err = createEd448CertificateRequest(template, key)
+	} else {
+		derBytes, err = x509.CreateCertificateRequest(rand.Reader, template, key)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error creating x509 certificate: %s", err.Error())
 	}
@@ -458,7 +485,7 @@ func EncodeX509Chain(certs []*x509.Certificate) ([]byte, error) {
 			continue
 		}
 
-		if cert.CheckSignatureFrom(cert) == nil {
+		if checkCertificateSignatureFrom(cert, cert) == nil {
 			// Don't include self-signed certificate
 			continue
 		}
@@ -476,6 +503,7 @@ var keyAlgorithms = map[v1.PrivateKeyAlgorithm]x509.PublicKeyAlgorithm{
 	v1.RSAKeyAlgorithm:     x509.RSA,
 	v1.ECDSAKeyAlgorithm:   x509.ECDSA,
 	v1.Ed25519KeyAlgorithm: x509.Ed25519,
+	v1.MLDSAKeyAlgorithm:   x509.MLDSA,
 }
 var sigAlgorithms = map[v1.SignatureAlgorithm]x509.SignatureAlgorithm{
 	v1.SHA256WithRSA:   x509.SHA256WithRSA,
@@ -485,6 +513,9 @@ var sigAlgorithms = map[v1.SignatureAlgorithm]x509.SignatureAlgorithm{
 	v1.ECDSAWithSHA384: x509.ECDSAWithSHA384,
 	v1.ECDSAWithSHA512: x509.ECDSAWithSHA512,
 	v1.PureEd25519:     x509.PureEd25519,
+	v1.MLDSA44:         x509.MLDSA44,
+	v1.MLDSA65:         x509.MLDSA65,
+	v1.MLDSA87:         x509.MLDSA87,
 }
 
 // SignatureAlgorithm will determine the appropriate signature algorithm for
@@ -502,13 +533,22 @@ func SignatureAlgorithm(crt *v1.Certificate) (x509.PublicKeyAlgorithm, x509.Sign
 
 	var sigAlgoArg any
 
+	// crypto/x509 has no identifiers for Ed448, which only has a single
+	// signature algorithm; see EncodeCSR and SignCertificate.
+	if specAlgorithm == v1.Ed448KeyAlgorithm {
+		if crt.Spec.SignatureAlgorithm != "" && crt.Spec.SignatureAlgorithm != v1.PureEd448 {
+			return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported signature algorithm for ed448 key: %s", crt.Spec.SignatureAlgorithm)
+		}
+		return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, nil
+	}
+
 	var ok bool
 	if specAlgorithm == "" {
 		pubKeyAlgo = x509.RSA
 	} else {
 		pubKeyAlgo, ok = keyAlgorithms[specAlgorithm]
 		if !ok {
-			return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported algorithm specified: %s. should be either 'ecdsa', 'ed25519' or 'rsa", crt.Spec.PrivateKey.Algorithm)
+			return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported algorithm specified: %s. should be either 'ecdsa', 'ed25519', 'ed448', 'mldsa' or 'rsa", crt.Spec.PrivateKey.Algorithm)
 		}
 	}
 
@@ -539,6 +579,15 @@ func SignatureAlgorithm(crt *v1.Certificate) (x509.PublicKeyAlgorithm, x509.Sign
 		default:
 			return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported ecdsa keysize specified: %d", crt.Spec.PrivateKey.Size)
 		}
+	case x509.MLDSA:
+		if specKeySize == 0 {
+			specKeySize = MLDSA44
+		}
+		params, err := MLDSAParameters(specKeySize)
+		if err != nil {
+			return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, err
+		}
+		sigAlgoArg = params
 	default:
 		// ok
 	}
@@ -556,6 +605,7 @@ func SignatureAlgorithm(crt *v1.Certificate) (x509.PublicKeyAlgorithm, x509.Sign
 // If alg is x509.RSA, arg must be an integer key size in bits
 // If alg is x509.ECDSA, arg must be an elliptic.Curve
 // If alg is x509.Ed25519, arg is ignored
+// If alg is x509.MLDSA, arg must be an mldsa.Parameters
 // All other algorithms and args cause an error
 // The signature algorithms returned by this function are to some degree a matter of preference. The
 // choices here are motivated by what is common and what is required by bodies such as the US DoD.
@@ -606,6 +656,26 @@ func signatureAlgorithmFromPublicKey(alg x509.PublicKeyAlgorithm, arg any) (x509
 	case x509.Ed25519:
 		signatureAlgorithm = x509.PureEd25519
 
+	case x509.MLDSA:
+		params, ok := arg.(mldsa.Parameters)
+		if !ok {
+			return x509.UnknownSignatureAlgorithm, fmt.Errorf("expected to get ML-DSA parameters for ML-DSA key but got %T", arg)
+		}
+
+		switch params {
+		case mldsa.MLDSA44():
+			signatureAlgorithm = x509.MLDSA44
+
+		case mldsa.MLDSA65():
+			signatureAlgorithm = x509.MLDSA65
+
+		case mldsa.MLDSA87():
+			signatureAlgorithm = x509.MLDSA87
+
+		default:
+			return x509.UnknownSignatureAlgorithm, fmt.Errorf("unknown / unsupported ML-DSA parameters attached to ML-DSA signing certificate")
+		}
+
 	default:
 		return x509.UnknownSignatureAlgorithm, fmt.Errorf("got unsupported public key type when trying to calculate signature algorithm")
 	}
@@ -22,6 +22,7 @@ import (
 	"crypto/ecdsa"
 	"crypto/ed25519"
 	"crypto/elliptic"
+	"crypto/mldsa"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -34,6 +35,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cloudflare/circl/sign/ed448"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -200,6 +202,59 @@ func TestSignatureAlgorithmForCertificate(t *testing.T) {
 			expectedSigAlgo: x509.PureEd25519,
 			expectedKeyType: x509.Ed25519,
 		},
+		{
+			name:            "certificate with KeyAlgorithm Ed448",
+			keyAlgo:         cmapi.Ed448KeyAlgorithm,
+			expectedSigAlgo: x509.UnknownSignatureAlgorithm,
+			expectedKeyType: x509.UnknownPublicKeyAlgorithm,
+		},
+		{
+			name:            "certificate with KeyAlgorithm Ed448 and signature PureEd448",
+			keyAlgo:         cmapi.Ed448KeyAlgorithm,
+			sigAlg:          cmapi.PureEd448,
+			expectedSigAlgo: x509.UnknownSignatureAlgorithm,
+			expectedKeyType: x509.UnknownPublicKeyAlgorithm,
+		},
+		{
+			name:      "certificate with KeyAlgorithm Ed448 and signature PureEd25519",
+			keyAlgo:   cmapi.Ed448KeyAlgorithm,
+			sigAlg:    cmapi.PureEd25519,
+			expectErr: true,
+		},
+		{
+			name:            "certificate with KeyAlgorithm ecdsa, size 521 and signature ECDSAWithSHA384",
+			keyAlgo:         cmapi.ECDSAKeyAlgorithm,
+			keySize:         521,
+			sigAlg:          cmapi.ECDSAWithSHA384,
+			expectedSigAlgo: x509.ECDSAWithSHA384,
+			expectedKeyType: x509.ECDSA,
+		},
+		{
+			name:            "certificate with KeyAlgorithm MLDSA and no size set should default to ML-DSA-44",
+			keyAlgo:         cmapi.MLDSAKeyAlgorithm,
+			expectedSigAlgo: x509.MLDSA44,
+			expectedKeyType: x509.MLDSA,
+		},
+		{
+			name:            "certificate with KeyAlgorithm MLDSA and size 65",
+			keyAlgo:         cmapi.MLDSAKeyAlgorithm,
+			keySize:         65,
+			expectedSigAlgo: x509.MLDSA65,
+			expectedKeyType: x509.MLDSA,
+		},
+		{
+			name:            "certificate with KeyAlgorithm MLDSA and size 87",
+			keyAlgo:         cmapi.MLDSAKeyAlgorithm,
+			keySize:         87,
+			expectedSigAlgo: x509.MLDSA87,
+			expectedKeyType: x509.MLDSA,
+		},
+		{
+			name:      "certificate with KeyAlgorithm MLDSA and size 256",
+			keyAlgo:   cmapi.MLDSAKeyAlgorithm,
+			keySize:   256,
+			expectErr: true,
+		},
 		{
 			name:      "certificate with KeyAlgorithm ecdsa and size 100",
 			keyAlgo:   cmapi.ECDSAKeyAlgorithm,
@@ -1131,6 +1186,28 @@ func ed25519Key(t *testing.T) crypto.Signer {
 	return priv
 }
 
+func ed448Key(t *testing.T) crypto.Signer {
+	t.Helper()
+
+	_, priv, err := ed448.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate ed448 key: %s", err)
+	}
+
+	return priv
+}
+
+func mldsaKey(t *testing.T, params mldsa.Parameters) crypto.Signer {
+	t.Helper()
+
+	key, err := mldsa.GenerateKey(params)
+	if err != nil {
+		t.Fatalf("failed to generate %s key: %s", params, err)
+	}
+
+	return key
+}
+
 func Test_SignCertificate_Signatures(t *testing.T) {
 	specs := map[string]struct {
 		SignerKey                  crypto.Signer
@@ -1179,6 +1256,22 @@ func Test_SignCertificate_Signatures(t *testing.T) {
 			SignerKey:                  ed25519Key(t),
 			ExpectedSignatureAlgorithm: x509.PureEd25519,
 		},
+		"Ed448": {
+			SignerKey:                  ed448Key(t),
+			ExpectedSignatureAlgorithm: x509.UnknownSignatureAlgorithm,
+		},
+		"ML-DSA-44": {
+			SignerKey:                  mldsaKey(t, mldsa.MLDSA44()),
+			ExpectedSignatureAlgorithm: x509.MLDSA44,
+		},
+		"ML-DSA-65": {
+			SignerKey:                  mldsaKey(t, mldsa.MLDSA65()),
+			ExpectedSignatureAlgorithm: x509.MLDSA65,
+		},
+		"ML-DSA-87": {
+			SignerKey:                  mldsaKey(t, mldsa.MLDSA87()),
+			ExpectedSignatureAlgorithm: x509.MLDSA87,
+		},
 	}
 
 	for name, spec := range specs {
@@ -0,0 +1,394 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+// crypto/x509 has no support for Ed448, so this file contains the minimal
+// ASN.1 handling needed to encode, parse, sign and verify Ed448 keys,
+// certificates and certificate requests as described in RFC 8410.
+//
+// Certificates and certificate requests are first created by crypto/x509
+// using a placeholder Ed25519 key, after which the SubjectPublicKeyInfo
+// and/or signature of the TBS structure are replaced and the result is
+// re-signed with the real signer.
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+
+	"github.com/cloudflare/circl/sign/ed448"
+	"golang.org/x/crypto/cryptobyte"
+	cryptobyte_asn1 "golang.org/x/crypto/cryptobyte/asn1"
+)
+
+// oidEd448 identifies both Ed448 public keys and PureEd448 signatures.
+var oidEd448 = asn1.ObjectIdentifier{1, 3, 101, 113}
+
+// Indices of the fields that are replaced within a DER encoded
+// TBSCertificate (RFC 5280, Section 4.1) and CertificationRequestInfo
+// (RFC 2986, Section 4.1). crypto/x509 always encodes the optional version
+// of a TBSCertificate.
+const (
+	tbsCertificateSignatureIndex            = 2
+	tbsCertificateSubjectPublicKeyInfoIndex = 6
+	tbsCSRSubjectPublicKeyInfoIndex         = 2
+)
+
+type ed448PKCS8PrivateKey struct {
+	Version    int
+	Algorithm  pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
+
+type ed448SubjectPublicKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+// signedData is the common outer structure of certificates and certificate
+// requests.
+type signedData struct {
+	TBS                asn1.RawValue
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          asn1.BitString
+}
+
+// isEd448Key returns true if the given public or private key is an Ed448 key.
+func isEd448Key(key any) bool {
+	switch key.(type) {
+	case ed448.PublicKey, ed448.PrivateKey:
+		return true
+	default:
+		return false
+	}
+}
+
+// marshalEd448PKCS8PrivateKey encodes an Ed448 private key in PKCS#8 form,
+// using the seed as the CurvePrivateKey as per RFC 8410, Section 7.
+func marshalEd448PKCS8PrivateKey(key ed448.PrivateKey) ([]byte, error) {
+	curvePrivateKey, err := asn1.Marshal(key.Seed())
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(ed448PKCS8PrivateKey{
+		Algorithm:  pkix.AlgorithmIdentifier{Algorithm: oidEd448},
+		PrivateKey: curvePrivateKey,
+	})
+}
+
+// isEd448PKCS8PrivateKey returns true if the given DER encoded PKCS#8
+// structure contains an Ed448 private key.
+func isEd448PKCS8PrivateKey(der []byte) bool {
+	var privKey ed448PKCS8PrivateKey
+	if _, err := asn1.Unmarshal(der, &privKey); err != nil {
+		return false
+	}
+
+	return privKey.Algorithm.Algorithm.Equal(oidEd448)
+}
+
+// parseEd448PKCS8PrivateKey parses an Ed448 private key in PKCS#8 form.
+func parseEd448PKCS8PrivateKey(der []byte) (ed448.PrivateKey, error) {
+	var privKey ed448PKCS8PrivateKey
+	if rest, err := asn1.Unmarshal(der, &privKey); err != nil {
+		return nil, err
+	} else if len(rest) > 0 {
+		return nil, errors.New("trailing data after ASN.1 of ed448 private key")
+	}
+
+	if !privKey.Algorithm.Algorithm.Equal(oidEd448) {
+		return nil, fmt.Errorf("unexpected private key algorithm %v", privKey.Algorithm.Algorithm)
+	}
+	if len(privKey.Algorithm.Parameters.FullBytes) != 0 {
+		return nil, errors.New("ed448 private key algorithm must not contain parameters")
+	}
+
+	var seed []byte
+	if _, err := asn1.Unmarshal(privKey.PrivateKey, &seed); err != nil {
+		return nil, fmt.Errorf("invalid ed448 private key: %w", err)
+	}
+	if len(seed) != ed448.SeedSize {
+		return nil, fmt.Errorf("invalid ed448 private key length: %d", len(seed))
+	}
+
+	return ed448.NewKeyFromSeed(seed), nil
+}
+
+// marshalEd448PublicKey returns the DER encoded SubjectPublicKeyInfo of an
+// Ed448 public key.
+func marshalEd448PublicKey(pub ed448.PublicKey) ([]byte, error) {
+	return asn1.Marshal(ed448SubjectPublicKeyInfo{
+		Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidEd448},
+		PublicKey: asn1.BitString{Bytes: pub, BitLength: 8 * len(pub)},
+	})
+}
+
+// parseEd448PublicKey parses a DER encoded SubjectPublicKeyInfo. It returns
+// nil if the SubjectPublicKeyInfo does not contain a valid Ed448 key.
+func parseEd448PublicKey(der []byte) ed448.PublicKey {
+	var spki ed448SubjectPublicKeyInfo
+	if rest, err := asn1.Unmarshal(der, &spki); err != nil || len(rest) > 0 {
+		return nil
+	}
+
+	if !spki.Algorithm.Algorithm.Equal(oidEd448) || len(spki.PublicKey.RightAlign()) != ed448.PublicKeySize {
+		return nil
+	}
+
+	return ed448.PublicKey(spki.PublicKey.RightAlign())
+}
+
+// setEd448PublicKey populates the PublicKey of a certificate or certificate
+// request holding an Ed448 key, which crypto/x509 leaves unparsed.
+func setEd448PublicKey(algorithm x509.PublicKeyAlgorithm, rawSubjectPublicKeyInfo []byte, publicKey *any) {
+	if algorithm != x509.UnknownPublicKeyAlgorithm || *publicKey != nil {
+		return
+	}
+
+	if pub := parseEd448PublicKey(rawSubjectPublicKeyInfo); pub != nil {
+		*publicKey = pub
+	}
+}
+
+// checkEd448Signature verifies that the given DER encoded certificate or
+// certificate request has been signed by the given Ed448 public key.
+func checkEd448Signature(der []byte, pub ed448.PublicKey) error {
+	var signed signedData
+	if _, err := asn1.Unmarshal(der, &signed); err != nil {
+		return err
+	}
+
+	if !signed.SignatureAlgorithm.Algorithm.Equal(oidEd448) {
+		return x509.ErrUnsupportedAlgorithm
+	}
+
+	if !ed448.Verify(pub, signed.TBS.FullBytes, signed.Signature.RightAlign(), "") {
+		return errors.New("ed448 verification failure")
+	}
+
+	return nil
+}
+
+// checkCertificateSignatureFrom verifies that the signature on cert is a
+// valid signature from parent, supporting Ed448 parents in addition to the
+// algorithms supported by x509.Certificate.CheckSignatureFrom.
+func checkCertificateSignatureFrom(cert, parent *x509.Certificate) error {
+	pub, ok := parent.PublicKey.(ed448.PublicKey)
+	if !ok {
+		return cert.CheckSignatureFrom(parent)
+	}
+
+	// Mirror the constraint checks performed by CheckSignatureFrom.
+	if parent.Version == 3 && !parent.BasicConstraintsValid ||
+		parent.BasicConstraintsValid && !parent.IsCA {
+		return x509.ConstraintViolationError{}
+	}
+	if parent.KeyUsage != 0 && parent.KeyUsage&x509.KeyUsageCertSign == 0 {
+		return x509.ConstraintViolationError{}
+	}
+
+	return checkEd448Signature(cert.Raw, pub)
+}
+
+// checkCertificateRequestSignature verifies the signature on csr, supporting
+// Ed448 keys in addition to the algorithms supported by
+// x509.CertificateRequest.CheckSignature.
+func checkCertificateRequestSignature(csr *x509.CertificateRequest) error {
+	if pub, ok := csr.PublicKey.(ed448.PublicKey); ok {
+		return checkEd448Signature(csr.Raw, pub)
+	}
+
+	return csr.CheckSignature()
+}
+
+// createEd448CertificateRequest creates a certificate request for an Ed448
+// signer, which x509.CreateCertificateRequest does not support.
+func createEd448CertificateRequest(template *x509.CertificateRequest, key crypto.Signer) ([]byte, error) {
+	pub, ok := key.Public().(ed448.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("expected an ed448 signer but got %T", key.Public())
+	}
+
+	_, placeholder, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl := *template
+	tmpl.SignatureAlgorithm = x509.UnknownSignatureAlgorithm
+	derBytes, err := x509.CreateCertificateRequest(rand.Reader, &tmpl, placeholder)
+	if err != nil {
+		return nil, err
+	}
+
+	spki, err := marshalEd448PublicKey(pub)
+	if err != nil {
+		return nil, err
+	}
+
+	return resignTBS(derBytes, map[int][]byte{tbsCSRSubjectPublicKeyInfoIndex: spki}, key, x509.UnknownSignatureAlgorithm)
+}
+
+// createCertificateWithEd448 creates a certificate for an Ed448 public key
+// and/or signed by an Ed448 signer, which x509.CreateCertificate does not
+// support.
+func createCertificateWithEd448(template, parent *x509.Certificate, publicKey crypto.PublicKey, signer crypto.Signer) ([]byte, error) {
+	_, placeholder, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl := *template
+	issuer := *parent
+	if parent == template {
+		issuer = tmpl
+	}
+
+	replacements := map[int][]byte{}
+	x509PublicKey := publicKey
+	x509Signer := signer
+
+	if pub, ok := publicKey.(ed448.PublicKey); ok {
+		spki, err := marshalEd448PublicKey(pub)
+		if err != nil {
+			return nil, err
+		}
+		replacements[tbsCertificateSubjectPublicKeyInfoIndex] = spki
+
+		// crypto/x509 would otherwise derive the SubjectKeyId from the
+		// placeholder key. Use the same method: the leftmost 160 bits of the
+		// SHA-256 hash of the subjectPublicKey (RFC 7093, Section 2).
+		if len(tmpl.SubjectKeyId) == 0 && tmpl.IsCA {
+			h := sha256.Sum256(pub)
+			tmpl.SubjectKeyId = h[:20]
+		}
+
+		x509PublicKey = placeholder.Public()
+	}
+
+	if isEd448Key(signer.Public()) {
+		sigAlg, err := asn1.Marshal(pkix.AlgorithmIdentifier{Algorithm: oidEd448})
+		if err != nil {
+			return nil, err
+		}
+		replacements[tbsCertificateSignatureIndex] = sigAlg
+
+		tmpl.SignatureAlgorithm = x509.UnknownSignatureAlgorithm
+		// The parent's Ed448 public key cannot match the placeholder signer.
+		issuer.PublicKey = nil
+		x509Signer = placeholder
+	}
+
+	derBytes, err := x509.CreateCertificate(rand.Reader, &tmpl, &issuer, x509PublicKey, x509Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	return resignTBS(derBytes, replacements, signer, tmpl.SignatureAlgorithm)
+}
+
+// resignTBS replaces the given top-level fields of the TBS structure of a
+// DER encoded certificate or certificate request, and signs the result with
+// signer.
+func resignTBS(der []byte, replacements map[int][]byte, signer crypto.Signer, sigAlg x509.SignatureAlgorithm) ([]byte, error) {
+	var signed signedData
+	if _, err := asn1.Unmarshal(der, &signed); err != nil {
+		return nil, err
+	}
+
+	tbs, err := replaceSequenceElements(signed.TBS.FullBytes, replacements)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts crypto.SignerOpts
+	if isEd448Key(signer.Public()) {
+		signed.SignatureAlgorithm = pkix.AlgorithmIdentifier{Algorithm: oidEd448}
+		opts = crypto.Hash(0)
+	} else {
+		hashFunc, ok := signatureAlgorithmHashes[sigAlg]
+		if !ok {
+			return nil, fmt.Errorf("unsupported signature algorithm %v", sigAlg)
+		}
+		opts = hashFunc
+	}
+
+	signature, err := crypto.SignMessage(signer, rand.Reader, tbs, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	signed.TBS = asn1.RawValue{FullBytes: tbs}
+	signed.Signature = asn1.BitString{Bytes: signature, BitLength: 8 * len(signature)}
+
+	return asn1.Marshal(signed)
+}
+
+// signatureAlgorithmHashes contains the hash functions of the signature
+// algorithms which may be returned by signatureAlgorithmFromPublicKey.
+var signatureAlgorithmHashes = map[x509.SignatureAlgorithm]crypto.Hash{
+	x509.SHA256WithRSA:   crypto.SHA256,
+	x509.SHA384WithRSA:   crypto.SHA384,
+	x509.SHA512WithRSA:   crypto.SHA512,
+	x509.ECDSAWithSHA256: crypto.SHA256,
+	x509.ECDSAWithSHA384: crypto.SHA384,
+	x509.ECDSAWithSHA512: crypto.SHA512,
+	x509.PureEd25519:     crypto.Hash(0),
+	x509.MLDSA44:         crypto.Hash(0),
+	x509.MLDSA65:         crypto.Hash(0),
+	x509.MLDSA87:         crypto.Hash(0),
+}
+
+// replaceSequenceElements re-encodes a DER encoded SEQUENCE, replacing the
+// elements at the given indices with the given DER encoded values.
+func replaceSequenceElements(der []byte, replacements map[int][]byte) ([]byte, error) {
+	input := cryptobyte.String(der)
+	var seq cryptobyte.String
+	if !input.ReadASN1(&seq, cryptobyte_asn1.SEQUENCE) || !input.Empty() {
+		return nil, errors.New("malformed TBS structure")
+	}
+
+	var b cryptobyte.Builder
+	var readErr error
+	b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		for i := 0; !seq.Empty(); i++ {
+			var element cryptobyte.String
+			if !seq.ReadAnyASN1Element(&element, nil) {
+				readErr = errors.New("malformed TBS structure")
+				return
+			}
+
+			if replacement, ok := replacements[i]; ok {
+				b.AddBytes(replacement)
+			} else {
+				b.AddBytes(element)
+			}
+		}
+	})
+	if readErr != nil {
+		return nil, readErr
+	}
+
+	return b.Bytes()
+}
@@ -21,12 +21,15 @@ import (
 	"crypto/ecdsa"
 	"crypto/ed25519"
 	"crypto/elliptic"
+	"crypto/mldsa"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
 
+	"github.com/cloudflare/circl/sign/ed448"
+
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 )
 
@@ -45,12 +48,19 @@ const (
 	ECCurve384 = 384
 	// ECCurve521 represents a secp521r1 / NIST P-521 ECDSA key.
 	ECCurve521 = 521
+
+	// MLDSA44 represents an ML-DSA-44 key.
+	MLDSA44 = 44
+	// MLDSA65 represents an ML-DSA-65 key.
+	MLDSA65 = 65
+	// MLDSA87 represents an ML-DSA-87 key.
+	MLDSA87 = 87
 )
 
 // GeneratePrivateKeyForCertificate will generate a private key suitable for
 // the provided cert-manager Certificate resource, taking into account the
 // parameters on the provided resource.
-// The returned key will either be RSA or ECDSA.
+// The returned key will either be RSA, ECDSA, Ed25519, Ed448 or ML-DSA.
 func GeneratePrivateKeyForCertificate(crt *v1.Certificate) (crypto.Signer, error) {
 	crt = crt.DeepCopy()
 	if crt.Spec.PrivateKey == nil {
@@ -75,6 +85,16 @@ func GeneratePrivateKeyForCertificate(crt *v1.Certificate) (crypto.Signer, error
 		return GenerateECPrivateKey(keySize)
 	case v1.Ed25519KeyAlgorithm:
 		return GenerateEd25519PrivateKey()
+	case v1.Ed448KeyAlgorithm:
+		return GenerateEd448PrivateKey()
+	case v1.MLDSAKeyAlgorithm:
+		keySize := MLDSA44
+
+		if crt.Spec.PrivateKey.Size > 0 {
+			keySize = crt.Spec.PrivateKey.Size
+		}
+
+		return GenerateMLDSAPrivateKey(keySize)
 	default:
 		return nil, fmt.Errorf("unsupported private key algorithm specified: %s", crt.Spec.PrivateKey.Algorithm)
 	}
@@ -121,10 +141,49 @@ func GenerateEd25519PrivateKey() (ed25519.PrivateKey, error) {
 	return prvkey, err
 }
 
+// GenerateEd448PrivateKey will generate an Ed448 private key
+func GenerateEd448PrivateKey() (ed448.PrivateKey, error) {
+	_, prvkey, err := ed448.GenerateKey(rand.Reader)
+
+	return prvkey, err
+}
+
+// MLDSAParameters returns the ML-DSA parameter set for the given key size.
+// It can be used with 44, 65 and 87 sized keys.
+func MLDSAParameters(keySize int) (mldsa.Parameters, error) {
+	switch keySize {
+	case MLDSA44:
+		return mldsa.MLDSA44(), nil
+	case MLDSA65:
+		return mldsa.MLDSA65(), nil
+	case MLDSA87:
+		return mldsa.MLDSA87(), nil
+	default:
+		return mldsa.Parameters{}, fmt.Errorf("unsupported ml-dsa key size specified: %d", keySize)
+	}
+}
+
+// GenerateMLDSAPrivateKey will generate an ML-DSA private key of the given
+// size. It can be used to generate 44, 65 and 87 sized keys.
+func GenerateMLDSAPrivateKey(keySize int) (*mldsa.PrivateKey, error) {
+	params, err := MLDSAParameters(keySize)
+	if err != nil {
+		return nil, err
+	}
+
+	return mldsa.GenerateKey(params)
+}
+
 // EncodePrivateKey will encode a given crypto.PrivateKey by first inspecting
 // the type of key encoding and then inspecting the type of key provided.
-// It only supports encoding RSA or ECDSA keys.
+// It supports encoding RSA, ECDSA, Ed25519, Ed448 and ML-DSA keys. Ed25519,
+// Ed448 and ML-DSA keys are always PKCS#8 encoded. Keys held by a KeyProvider
+// are encoded as a key handle, regardless of the key encoding.
 func EncodePrivateKey(pk crypto.PrivateKey, keyEncoding v1.PrivateKeyEncoding) ([]byte, error) {
+	if k, ok := pk.(*externalKey); ok {
+		return encodeKeyHandle(k), nil
+	}
+
 	switch keyEncoding {
 	case v1.PrivateKeyEncoding(""), v1.PKCS1:
 		switch k := pk.(type) {
@@ -132,7 +191,7 @@ func EncodePrivateKey(pk crypto.PrivateKey, keyEncoding v1.PrivateKeyEncoding) (
 			return EncodePKCS1PrivateKey(k), nil
 		case *ecdsa.PrivateKey:
 			return EncodeECPrivateKey(k)
-		case ed25519.PrivateKey:
+		case ed25519.PrivateKey, ed448.PrivateKey, *mldsa.PrivateKey:
 			return EncodePKCS8PrivateKey(k)
 		default:
 			return nil, fmt.Errorf("error encoding private key: unknown key type: %T", pk)
@@ -153,7 +212,13 @@ func EncodePKCS1PrivateKey(pk *rsa.PrivateKey) []byte {
 
 // EncodePKCS8PrivateKey will marshal a private key into x509 PEM format.
 func EncodePKCS8PrivateKey(pk any) ([]byte, error) {
-	keyBytes, err := x509.MarshalPKCS8PrivateKey(pk)
+	var keyBytes []byte
+	var err error
+	if k, ok := pk.(ed448.PrivateKey); ok {
+		keyBytes, err = marshalEd448PKCS8PrivateKey(k)
+	} else {
+		keyBytes, err = x509.MarshalPKCS8PrivateKey(pk)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -174,7 +239,8 @@ func EncodeECPrivateKey(pk *ecdsa.PrivateKey) ([]byte, error) {
 }
 
 // PublicKeyForPrivateKey will return the crypto.PublicKey for the given
-// crypto.PrivateKey. It only supports RSA and ECDSA keys.
+// crypto.PrivateKey. It supports RSA, ECDSA, Ed25519, Ed448 and ML-DSA keys,
+// as well as keys held by a KeyProvider.
 func PublicKeyForPrivateKey(pk crypto.PrivateKey) (crypto.PublicKey, error) {
 	switch k := pk.(type) {
 	case *rsa.PrivateKey:
@@ -183,6 +249,12 @@ func PublicKeyForPrivateKey(pk crypto.PrivateKey) (crypto.PublicKey, error) {
 		return k.Public(), nil
 	case ed25519.PrivateKey:
 		return k.Public(), nil
+	case ed448.PrivateKey:
+		return k.Public(), nil
+	case *mldsa.PrivateKey:
+		return k.Public(), nil
+	case *externalKey:
+		return k.Public(), nil
 	default:
 		return nil, fmt.Errorf("unknown private key type: %T", pk)
 	}
@@ -218,6 +290,10 @@ func PublicKeysEqual(a, b crypto.PublicKey) (bool, error) {
 		return pub.Equal(b), nil
 	case ed25519.PublicKey:
 		return pub.Equal(b), nil
+	case ed448.PublicKey:
+		return pub.Equal(b), nil
+	case *mldsa.PublicKey:
+		return pub.Equal(b), nil
 	default:
 		return false, fmt.Errorf("unrecognised public key type: %T", a)
 	}
@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	stdpem "encoding/pem"
+	stderrors "errors"
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/cert-manager/cert-manager/internal/pem"
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/errors"
+)
+
+// KeyProvider generates and holds private keys outside of cert-manager, for
+// example in a PKCS#11 hardware security module or a KMS. Keys held by a
+// KeyProvider are never exported: cert-manager only stores an opaque handle
+// referencing the key, and signs with the crypto.Signer returned by Signer.
+// cert-manager itself includes the directory-backed `local` provider, which
+// is intended for testing, and a client for out-of-process providers in the
+// keyprovider package; other providers are registered by builds of the
+// controller using RegisterKeyProvider.
+type KeyProvider interface {
+	// GenerateKey generates a new private key matching the private key spec
+	// of the given Certificate, and returns a handle referencing it. The key
+	// must only be usable by the given Certificate.
+	GenerateKey(ctx context.Context, crt *v1.Certificate) ([]byte, error)
+
+	// Signer returns a crypto.Signer for the private key referenced by the
+	// given handle. Handles are read from Secrets which users may be able to
+	// write to, so providers must check that the key was generated for the
+	// Certificate with the given namespace and name, and return an error
+	// otherwise. Errors caused by the provider being temporarily
+	// unavailable must wrap ErrKeyProviderUnavailable.
+	Signer(ctx context.Context, crt types.NamespacedName, handle []byte) (crypto.Signer, error)
+
+	// Delete deletes the private key referenced by the given handle, once it
+	// is no longer used by its Certificate. Deleting a key which does not
+	// exist is not an error.
+	Delete(ctx context.Context, handle []byte) error
+}
+
+// ErrKeyProviderUnavailable is wrapped by the errors a KeyProvider returns
+// when it cannot currently be reached, for example because of a network or
+// I/O error. Such errors are retried, whereas all other errors resolving a
+// key handle, including handles naming a provider which is not registered,
+// are invalid data errors.
+var ErrKeyProviderUnavailable = stderrors.New("key provider unavailable")
+
+const (
+	// keyHandlePEMType is the PEM block type used to store key handles in
+	// place of a private key. The name of the provider is stored in the
+	// keyHandleProviderHeader header, and the block contains the handle.
+	keyHandlePEMType        = "CERT-MANAGER KEY HANDLE"
+	keyHandleProviderHeader = "Provider"
+)
+
+var (
+	keyProvidersLock sync.RWMutex
+	keyProviders     = map[string]KeyProvider{}
+)
+
+// RegisterKeyProvider makes a KeyProvider available under the given name,
+// which Certificates refer to in spec.privateKey.provider. Registering a
+// provider under a name which is already in use replaces the existing
+// provider.
+func RegisterKeyProvider(name string, provider KeyProvider) {
+	keyProvidersLock.Lock()
+	defer keyProvidersLock.Unlock()
+
+	keyProviders[name] = provider
+}
+
+func getKeyProvider(name string) (KeyProvider, error) {
+	keyProvidersLock.RLock()
+	defer keyProvidersLock.RUnlock()
+
+	provider, ok := keyProviders[name]
+	if !ok {
+		return nil, fmt.Errorf("key provider %q is not registered", name)
+	}
+	return provider, nil
+}
+
+// externalKey is a private key held by a KeyProvider. It can be used as a
+// crypto.Signer, and is PEM encoded as a key handle.
+type externalKey struct {
+	crypto.Signer
+
+	provider string
+	handle   []byte
+}
+
+// GenerateExternalPrivateKey generates a private key for the given
+// Certificate using the named KeyProvider. The returned crypto.Signer is
+// encoded as a key handle by EncodePrivateKey, and DecodePrivateKeyBytes
+// resolves the handle using the same KeyProvider.
+func GenerateExternalPrivateKey(ctx context.Context, providerName string, crt *v1.Certificate) (crypto.Signer, error) {
+	provider, err := getKeyProvider(providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	handle, err := provider.GenerateKey(ctx, crt)
+	if err != nil {
+		return nil, fmt.Errorf("error generating private key with key provider %q: %w", providerName, err)
+	}
+
+	return newExternalKey(ctx, provider, providerName, types.NamespacedName{Namespace: crt.Namespace, Name: crt.Name}, handle)
+}
+
+// DeleteReplacedExternalPrivateKey deletes the private key referenced by the
+// PEM encoded key handle replaced from the KeyProvider holding it, unless
+// replacement references the same key. It does nothing if replaced is not a
+// key handle.
+func DeleteReplacedExternalPrivateKey(ctx context.Context, replaced, replacement []byte) error {
+	providerName, handle, ok := parseKeyHandle(replaced)
+	if !ok {
+		return nil
+	}
+	if newProviderName, newHandle, ok := parseKeyHandle(replacement); ok && newProviderName == providerName && bytes.Equal(newHandle, handle) {
+		return nil
+	}
+
+	provider, err := getKeyProvider(providerName)
+	if err != nil {
+		return err
+	}
+
+	if err := provider.Delete(ctx, handle); err != nil {
+		return fmt.Errorf("error deleting private key from key provider %q: %w", providerName, err)
+	}
+	return nil
+}
+
+// parseKeyHandle returns the provider name and handle of the given PEM
+// encoded key handle, and false if the data is not a key handle.
+func parseKeyHandle(keyBytes []byte) (string, []byte, bool) {
+	block, _, err := pem.SafeDecodePrivateKey(keyBytes)
+	if err != nil || block.Type != keyHandlePEMType {
+		return "", nil, false
+	}
+	return block.Headers[keyHandleProviderHeader], block.Bytes, true
+}
+
+func newExternalKey(ctx context.Context, provider KeyProvider, providerName string, crt types.NamespacedName, handle []byte) (crypto.Signer, error) {
+	signer, err := provider.Signer(ctx, crt, handle)
+	if err != nil {
+		return nil, fmt.Errorf("error loading private key from key provider %q: %w", providerName, err)
+	}
+
+	return &externalKey{
+		Signer:   signer,
+		provider: providerName,
+		handle:   handle,
+	}, nil
+}
+
+// keyProviderName returns the name of the KeyProvider holding the given
+// private key, or an empty string if the key is held by cert-manager itself.
+func keyProviderName(pk crypto.PrivateKey) string {
+	if k, ok := pk.(*externalKey); ok {
+		return k.provider
+	}
+	return ""
+}
+
+func encodeKeyHandle(k *externalKey) []byte {
+	return stdpem.EncodeToMemory(&stdpem.Block{
+		Type:    keyHandlePEMType,
+		Headers: map[string]string{keyHandleProviderHeader: k.provider},
+		Bytes:   k.handle,
+	})
+}
+
+func decodeKeyHandle(ctx context.Context, crt types.NamespacedName, block *stdpem.Block) (crypto.Signer, error) {
+	providerName := block.Headers[keyHandleProviderHeader]
+	if providerName == "" {
+		return nil, errors.NewInvalidData("error decoding key handle: missing %s header", keyHandleProviderHeader)
+	}
+
+	provider, err := getKeyProvider(providerName)
+	if err != nil {
+		return nil, errors.NewInvalidData("error decoding key handle: %s", err)
+	}
+
+	key, err := newExternalKey(ctx, provider, providerName, crt, block.Bytes)
+	if err != nil && !stderrors.Is(err, ErrKeyProviderUnavailable) {
+		return nil, errors.NewInvalidData("%s", err)
+	}
+	return key, err
+}
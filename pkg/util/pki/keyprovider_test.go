@@ -0,0 +1,240 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/errors"
+)
+
+// fakeKeyProvider holds private keys in memory, and only hands out
+// crypto.Signers which do not expose them.
+type fakeKeyProvider struct {
+	lock sync.Mutex
+	keys map[string]fakeKey
+	next int
+}
+
+type fakeKey struct {
+	key crypto.Signer
+	crt types.NamespacedName
+}
+
+func (p *fakeKeyProvider) GenerateKey(_ context.Context, crt *cmapi.Certificate) ([]byte, error) {
+	pk, err := GeneratePrivateKeyForCertificate(crt)
+	if err != nil {
+		return nil, err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	handle := strconv.Itoa(p.next)
+	p.next++
+	p.keys[handle] = fakeKey{key: pk, crt: types.NamespacedName{Namespace: crt.Namespace, Name: crt.Name}}
+	return []byte(handle), nil
+}
+
+func (p *fakeKeyProvider) Signer(_ context.Context, crt types.NamespacedName, handle []byte) (crypto.Signer, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	k, ok := p.keys[string(handle)]
+	if !ok {
+		return nil, fmt.Errorf("no key with handle %q", handle)
+	}
+	if k.crt != crt {
+		return nil, fmt.Errorf("key with handle %q was not generated for Certificate %s", handle, crt)
+	}
+	return opaqueSigner{k.key}, nil
+}
+
+func (p *fakeKeyProvider) Delete(_ context.Context, handle []byte) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	delete(p.keys, string(handle))
+	return nil
+}
+
+type opaqueSigner struct {
+	key crypto.Signer
+}
+
+func (s opaqueSigner) Public() crypto.PublicKey {
+	return s.key.Public()
+}
+
+func (s opaqueSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return s.key.Sign(rand, digest, opts)
+}
+
+func TestExternalPrivateKey(t *testing.T) {
+	provider := &fakeKeyProvider{keys: map[string]fakeKey{}}
+	RegisterKeyProvider("fake", provider)
+
+	crt := buildCertificateWithKeyParams(cmapi.ECDSAKeyAlgorithm, 384)
+	crt.Namespace = "test-ns"
+	crt.Spec.PrivateKey.Provider = "fake"
+	crtName := types.NamespacedName{Namespace: "test-ns", Name: crt.Name}
+
+	pk, err := GenerateExternalPrivateKey(t.Context(), "fake", crt)
+	require.NoError(t, err)
+
+	// The key handle is written regardless of the requested encoding, and
+	// never contains the private key.
+	keyPEM, err := EncodePrivateKey(pk, cmapi.PKCS1)
+	require.NoError(t, err)
+	block, rest := pem.Decode(keyPEM)
+	require.NotNil(t, block)
+	assert.Empty(t, rest)
+	assert.Equal(t, "CERT-MANAGER KEY HANDLE", block.Type)
+	assert.Equal(t, map[string]string{"Provider": "fake"}, block.Headers)
+	assert.Equal(t, []byte("0"), block.Bytes)
+
+	decoded, err := DecodeCertificatePrivateKeyBytes(t.Context(), crtName, keyPEM)
+	require.NoError(t, err)
+	equal, err := PublicKeysEqual(pk.Public(), decoded.Public())
+	require.NoError(t, err)
+	assert.True(t, equal)
+
+	assert.Empty(t, PrivateKeyMatchesSpec(decoded, crt.Spec))
+
+	localCrt := crt.DeepCopy()
+	localCrt.Spec.PrivateKey.Provider = ""
+	assert.Equal(t, []string{"spec.privateKey.provider"}, PrivateKeyMatchesSpec(decoded, localCrt.Spec))
+
+	resizedCrt := crt.DeepCopy()
+	resizedCrt.Spec.PrivateKey.Size = 256
+	assert.Equal(t, []string{"spec.privateKey.size"}, PrivateKeyMatchesSpec(decoded, resizedCrt.Spec))
+
+	localKey, err := GeneratePrivateKeyForCertificate(localCrt)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"spec.privateKey.provider"}, PrivateKeyMatchesSpec(localKey, crt.Spec))
+
+	// The key can be used to sign both CSRs and certificates.
+	template, err := GenerateCSR(crt)
+	require.NoError(t, err)
+	csrDER, err := EncodeCSR(template, decoded)
+	require.NoError(t, err)
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	require.NoError(t, err)
+	require.NoError(t, csr.CheckSignature())
+
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	_, caCert, err := SignCertificate(caTmpl, caTmpl, decoded.Public(), decoded)
+	require.NoError(t, err)
+	require.NoError(t, caCert.CheckSignatureFrom(caCert))
+
+	// The key handle can only be resolved for the Certificate the key was
+	// generated for.
+	_, err = DecodePrivateKeyBytes(keyPEM)
+	require.EqualError(t, err, `error decoding key handle: private keys held by key provider "fake" can only be used by the Certificate they were generated for`)
+	assert.True(t, errors.IsInvalidData(err))
+	_, err = DecodeCertificatePrivateKeyBytes(t.Context(), types.NamespacedName{Namespace: "other-ns", Name: crt.Name}, keyPEM)
+	require.Error(t, err)
+	assert.True(t, errors.IsInvalidData(err), "expected a key handle copied to another Certificate to be invalid")
+
+	// A key is only deleted once it has been replaced by another key, and
+	// deleting keys held by cert-manager does nothing.
+	require.NoError(t, DeleteReplacedExternalPrivateKey(t.Context(), keyPEM, keyPEM))
+	assert.Len(t, provider.keys, 1)
+	require.NoError(t, DeleteReplacedExternalPrivateKey(t.Context(), keyPEM, nil))
+	assert.Empty(t, provider.keys)
+	_, err = DecodeCertificatePrivateKeyBytes(t.Context(), crtName, keyPEM)
+	assert.True(t, errors.IsInvalidData(err), "expected a deleted key to be invalid")
+	localKeyPEM, err := EncodePrivateKey(localKey, cmapi.PKCS8)
+	require.NoError(t, err)
+	require.NoError(t, DeleteReplacedExternalPrivateKey(t.Context(), localKeyPEM, nil))
+}
+
+func TestDecodeKeyHandleErrors(t *testing.T) {
+	crtName := types.NamespacedName{Namespace: "test-ns", Name: "test"}
+
+	_, err := DecodeCertificatePrivateKeyBytes(t.Context(), crtName, pem.EncodeToMemory(&pem.Block{
+		Type:  "CERT-MANAGER KEY HANDLE",
+		Bytes: []byte("0"),
+	}))
+	require.Error(t, err)
+	assert.True(t, errors.IsInvalidData(err), "expected a key handle without a provider to be invalid")
+
+	_, err = DecodeCertificatePrivateKeyBytes(t.Context(), crtName, pem.EncodeToMemory(&pem.Block{
+		Type:    "CERT-MANAGER KEY HANDLE",
+		Headers: map[string]string{"Provider": "not-registered"},
+		Bytes:   []byte("0"),
+	}))
+	require.EqualError(t, err, `error decoding key handle: key provider "not-registered" is not registered`)
+	assert.True(t, errors.IsInvalidData(err), "expected a key handle for an unregistered provider to be invalid")
+
+	RegisterKeyProvider("unavailable", unavailableKeyProvider{})
+	RegisterKeyProvider("empty", &fakeKeyProvider{keys: map[string]fakeKey{}})
+
+	_, err = DecodeCertificatePrivateKeyBytes(t.Context(), crtName, pem.EncodeToMemory(&pem.Block{
+		Type:    "CERT-MANAGER KEY HANDLE",
+		Headers: map[string]string{"Provider": "empty"},
+		Bytes:   []byte("0"),
+	}))
+	require.Error(t, err)
+	assert.True(t, errors.IsInvalidData(err), "expected a key handle which the provider does not know to be invalid")
+
+	// Failing to reach a provider is not an invalid data error, so that
+	// callers retry rather than discarding the key handle.
+	_, err = DecodeCertificatePrivateKeyBytes(t.Context(), crtName, pem.EncodeToMemory(&pem.Block{
+		Type:    "CERT-MANAGER KEY HANDLE",
+		Headers: map[string]string{"Provider": "unavailable"},
+		Bytes:   []byte("0"),
+	}))
+	require.ErrorIs(t, err, ErrKeyProviderUnavailable)
+	assert.False(t, errors.IsInvalidData(err))
+}
+
+// unavailableKeyProvider is a KeyProvider which can never be reached.
+type unavailableKeyProvider struct{}
+
+func (unavailableKeyProvider) GenerateKey(context.Context, *cmapi.Certificate) ([]byte, error) {
+	return nil, ErrKeyProviderUnavailable
+}
+
+func (unavailableKeyProvider) Signer(context.Context, types.NamespacedName, []byte) (crypto.Signer, error) {
+	return nil, fmt.Errorf("%w: connection refused", ErrKeyProviderUnavailable)
+}
+
+func (unavailableKeyProvider) Delete(context.Context, []byte) error {
+	return fmt.Errorf("%w: connection refused", ErrKeyProviderUnavailable)
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package localkeyprovider implements a pki.KeyProvider which holds private
+// keys in a directory on the local filesystem. It stands in for a hardware
+// security module or KMS in tests and development environments: keys are
+// generated and used by the provider, and cert-manager only ever sees a
+// handle referencing them.
+package localkeyprovider
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// Name is the name under which the provider is registered by the
+// cert-manager controller, for use in spec.privateKey.provider.
+const Name = "local"
+
+// handleLength is the length in bytes of the random identifiers used as key
+// handles.
+const handleLength = 16
+
+// certificateHeader is the PEM header recording the namespace and name of the
+// Certificate a key was generated for.
+const certificateHeader = "Certificate"
+
+// Provider is a pki.KeyProvider which stores PKCS#8 encoded private keys as
+// files in a directory, along with the Certificate they were generated for.
+type Provider struct {
+	dir string
+}
+
+var _ pki.KeyProvider = &Provider{}
+
+// New returns a Provider which stores private keys in the given directory.
+// The directory must already exist.
+func New(dir string) *Provider {
+	return &Provider{dir: dir}
+}
+
+// GenerateKey generates a private key for the given Certificate and stores it
+// in the provider's directory.
+func (p *Provider) GenerateKey(_ context.Context, crt *v1.Certificate) ([]byte, error) {
+	pk, err := pki.GeneratePrivateKeyForCertificate(crt)
+	if err != nil {
+		return nil, err
+	}
+
+	pkData, err := pki.EncodePKCS8PrivateKey(pk)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(pkData)
+	block.Headers = map[string]string{
+		certificateHeader: types.NamespacedName{Namespace: crt.Namespace, Name: crt.Name}.String(),
+	}
+	pkData = pem.EncodeToMemory(block)
+
+	id := make([]byte, handleLength)
+	if _, err := io.ReadFull(rand.Reader, id); err != nil {
+		return nil, err
+	}
+	handle := []byte(hex.EncodeToString(id))
+
+	path, err := p.path(handle)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, pkData, 0600); err != nil {
+		return nil, fmt.Errorf("error storing private key: %w", err)
+	}
+
+	return handle, nil
+}
+
+// Signer loads the private key referenced by the given handle, if it was
+// generated for the given Certificate. The private key itself is not exposed
+// by the returned crypto.Signer.
+func (p *Provider) Signer(_ context.Context, crt types.NamespacedName, handle []byte) (crypto.Signer, error) {
+	path, err := p.path(handle)
+	if err != nil {
+		return nil, err
+	}
+
+	pkData, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("error loading private key: %w", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: error loading private key: %w", pki.ErrKeyProviderUnavailable, err)
+	}
+
+	block, _ := pem.Decode(pkData)
+	if block == nil {
+		return nil, fmt.Errorf("error loading private key: invalid PEM data in %s", path)
+	}
+	if block.Headers[certificateHeader] != crt.String() {
+		return nil, fmt.Errorf("private key %q was not generated for Certificate %s", handle, crt)
+	}
+	block.Headers = nil
+
+	pk, err := pki.DecodePrivateKeyBytes(pem.EncodeToMemory(block))
+	if err != nil {
+		return nil, err
+	}
+
+	return &signer{key: pk}, nil
+}
+
+// Delete removes the private key referenced by the given handle from the
+// provider's directory.
+func (p *Provider) Delete(_ context.Context, handle []byte) error {
+	path, err := p.path(handle)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("%w: error deleting private key: %w", pki.ErrKeyProviderUnavailable, err)
+	}
+	return nil
+}
+
+func (p *Provider) path(handle []byte) (string, error) {
+	// Key handles are always hex encoded, which also prevents them from
+	// referring to files outside of the provider's directory.
+	if id, err := hex.DecodeString(string(handle)); err != nil || len(id) != handleLength {
+		return "", fmt.Errorf("invalid key handle %q", handle)
+	}
+
+	return filepath.Join(p.dir, string(handle)+".pem"), nil
+}
+
+// signer wraps a private key so that it can only be used to sign, as would
+// be the case for a key held by a hardware security module.
+type signer struct {
+	key crypto.Signer
+}
+
+func (s *signer) Public() crypto.PublicKey {
+	return s.key.Public()
+}
+
+func (s *signer) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return s.key.Sign(rand, digest, opts)
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localkeyprovider
+
+import (
+	"crypto/ecdsa"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+func TestProvider(t *testing.T) {
+	dir := t.TempDir()
+	pki.RegisterKeyProvider(Name, New(dir))
+
+	crt := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test"},
+		Spec: cmapi.CertificateSpec{
+			CommonName: "example.com",
+			PrivateKey: &cmapi.CertificatePrivateKey{
+				Algorithm: cmapi.ECDSAKeyAlgorithm,
+				Provider:  Name,
+			},
+		},
+	}
+
+	pk, err := pki.GenerateExternalPrivateKey(t.Context(), Name, crt)
+	require.NoError(t, err)
+	assert.Empty(t, pki.PrivateKeyMatchesSpec(pk, crt.Spec))
+
+	keyPEM, err := pki.EncodePrivateKey(pk, cmapi.PKCS8)
+	require.NoError(t, err)
+	assert.NotContains(t, string(keyPEM), "PRIVATE KEY")
+
+	// The private key is only held in the provider's directory.
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	stored, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(t, err)
+	storedKey, err := pki.DecodePrivateKeyBytes(stored)
+	require.NoError(t, err)
+	assert.True(t, storedKey.(*ecdsa.PrivateKey).PublicKey.Equal(pk.Public()))
+
+	// Signers returned by the provider do not expose the private key.
+	crtName := types.NamespacedName{Namespace: "test-ns", Name: "test"}
+	decoded, err := pki.DecodeCertificatePrivateKeyBytes(t.Context(), crtName, keyPEM)
+	require.NoError(t, err)
+	_, err = pki.EncodePKCS8PrivateKey(decoded)
+	assert.Error(t, err)
+	assert.True(t, storedKey.(*ecdsa.PrivateKey).PublicKey.Equal(decoded.Public()))
+
+	// The key can only be used by the Certificate it was generated for.
+	_, err = pki.DecodeCertificatePrivateKeyBytes(t.Context(), types.NamespacedName{Namespace: "other-ns", Name: "test"}, keyPEM)
+	assert.ErrorContains(t, err, "was not generated for Certificate other-ns/test")
+
+	// Deleting the key removes it from the provider's directory.
+	require.NoError(t, pki.DeleteReplacedExternalPrivateKey(t.Context(), keyPEM, nil))
+	files, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+	require.NoError(t, pki.DeleteReplacedExternalPrivateKey(t.Context(), keyPEM, nil), "deleting a key which does not exist is not an error")
+}
+
+func TestProviderInvalidHandles(t *testing.T) {
+	p := New(t.TempDir())
+
+	for _, handle := range []string{
+		"",
+		"../../etc/passwd",
+		"0123456789abcdef",
+		"0123456789abcdef0123456789abcdeg",
+	} {
+		_, err := p.Signer(t.Context(), types.NamespacedName{}, []byte(handle))
+		assert.ErrorContains(t, err, "invalid key handle", "handle %q", handle)
+		err = p.Delete(t.Context(), []byte(handle))
+		assert.ErrorContains(t, err, "invalid key handle", "handle %q", handle)
+	}
+
+	_, err := p.Signer(t.Context(), types.NamespacedName{}, []byte("0123456789abcdef0123456789abcdef"))
+	assert.ErrorContains(t, err, "error loading private key")
+	assert.NotErrorIs(t, err, pki.ErrKeyProviderUnavailable, "a key which does not exist is not a transient error")
+}
@@ -21,6 +21,7 @@ import (
 	"crypto"
 	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/mldsa"
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
@@ -28,6 +29,7 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/cloudflare/circl/sign/ed448"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
@@ -37,8 +39,8 @@ import (
 
 // PrivateKeyMatchesSpec returns a list of violations for the provided private
 // key against the provided CertificateSpec. It will return an empty list/ nil
-// if there are no violations found. RSA, Ed25519 and ECDSA private keys are
-// supported.
+// if there are no violations found. RSA, Ed25519, Ed448, ECDSA and ML-DSA
+// private keys are supported, including keys held by a KeyProvider.
 // The function panics if the CertificateSpec contains an unknown key algorithm,
 // since this should have been caught by the CertificateSpec validation already.
 func PrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec) []string {
@@ -46,13 +48,27 @@ func PrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec) []s
 	if spec.PrivateKey == nil {
 		spec.PrivateKey = &cmapi.CertificatePrivateKey{}
 	}
+	if keyProviderName(pk) != spec.PrivateKey.Provider {
+		return []string{"spec.privateKey.provider"}
+	}
+
+	// Keys held by a KeyProvider are only available as a crypto.Signer, so
+	// the checks below are made against the public key.
+	var pub crypto.PublicKey
+	if signer, ok := pk.(crypto.Signer); ok {
+		pub = signer.Public()
+	}
 	switch spec.PrivateKey.Algorithm {
 	case "", cmapi.RSAKeyAlgorithm:
-		return rsaPrivateKeyMatchesSpec(pk, spec)
+		return rsaPublicKeyMatchesSpec(pub, spec)
 	case cmapi.Ed25519KeyAlgorithm:
-		return ed25519PrivateKeyMatchesSpec(pk)
+		return ed25519PublicKeyMatchesSpec(pub)
+	case cmapi.Ed448KeyAlgorithm:
+		return ed448PublicKeyMatchesSpec(pub)
 	case cmapi.ECDSAKeyAlgorithm:
-		return ecdsaPrivateKeyMatchesSpec(pk, spec)
+		return ecdsaPublicKeyMatchesSpec(pub, spec)
+	case cmapi.MLDSAKeyAlgorithm:
+		return mldsaPublicKeyMatchesSpec(pub, spec)
 	default:
 		// This should never happen as the CertificateSpec validation should
 		// catch this before it reaches this point.
@@ -60,8 +76,8 @@ func PrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec) []s
 	}
 }
 
-func rsaPrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec) []string {
-	rsaPk, ok := pk.(*rsa.PrivateKey)
+func rsaPublicKeyMatchesSpec(pub crypto.PublicKey, spec cmapi.CertificateSpec) []string {
+	rsaPub, ok := pub.(*rsa.PublicKey)
 	if !ok {
 		return []string{"spec.privateKey.algorithm"}
 	}
@@ -75,14 +91,14 @@ func rsaPrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec)
 	if spec.PrivateKey.Size > 0 {
 		keySize = spec.PrivateKey.Size
 	}
-	if rsaPk.N.BitLen() != keySize {
+	if rsaPub.N.BitLen() != keySize {
 		violations = append(violations, "spec.privateKey.size")
 	}
 	return violations
 }
 
-func ecdsaPrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec) []string {
-	ecdsaPk, ok := pk.(*ecdsa.PrivateKey)
+func ecdsaPublicKeyMatchesSpec(pub crypto.PublicKey, spec cmapi.CertificateSpec) []string {
+	ecdsaPub, ok := pub.(*ecdsa.PublicKey)
 	if !ok {
 		return []string{"spec.privateKey.algorithm"}
 	}
@@ -96,14 +112,14 @@ func ecdsaPrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec
 	if spec.PrivateKey.Size > 0 {
 		expectedKeySize = spec.PrivateKey.Size
 	}
-	if expectedKeySize != ecdsaPk.Curve.Params().BitSize {
+	if expectedKeySize != ecdsaPub.Curve.Params().BitSize {
 		violations = append(violations, "spec.privateKey.size")
 	}
 	return violations
 }
 
-func ed25519PrivateKeyMatchesSpec(pk crypto.PrivateKey) []string {
-	_, ok := pk.(ed25519.PrivateKey)
+func ed25519PublicKeyMatchesSpec(pub crypto.PublicKey) []string {
+	_, ok := pub.(ed25519.PublicKey)
 	if !ok {
 		return []string{"spec.privateKey.algorithm"}
 	}
@@ -111,6 +127,32 @@ func ed25519PrivateKeyMatchesSpec(pk crypto.PrivateKey) []string {
 	return nil
 }
 
+func ed448PublicKeyMatchesSpec(pub crypto.PublicKey) []string {
+	_, ok := pub.(ed448.PublicKey)
+	if !ok {
+		return []string{"spec.privateKey.algorithm"}
+	}
+
+	return nil
+}
+
+func mldsaPublicKeyMatchesSpec(pub crypto.PublicKey, spec cmapi.CertificateSpec) []string {
+	mldsaPub, ok := pub.(*mldsa.PublicKey)
+	if !ok {
+		return []string{"spec.privateKey.algorithm"}
+	}
+	// The default ML-DSA parameter set is ML-DSA-44
+	expectedKeySize := MLDSA44
+	if spec.PrivateKey.Size > 0 {
+		expectedKeySize = spec.PrivateKey.Size
+	}
+	expected, err := MLDSAParameters(expectedKeySize)
+	if err != nil || expected != mldsaPub.Parameters() {
+		return []string{"spec.privateKey.size"}
+	}
+	return nil
+}
+
 func ipSlicesMatch(parsedIPs []net.IP, stringIPs []string) bool {
 	parsedStringIPs := make([]net.IP, len(stringIPs))
 
@@ -124,6 +166,9 @@ func ipSlicesMatch(parsedIPs []net.IP, stringIPs []string) bool {
 // RequestMatchesSpec compares a CertificateRequest with a CertificateSpec
 // and returns a list of field names on the Certificate that do not match their
 // counterpart fields on the CertificateRequest.
+// If the CertificateSpec uses a user supplied CSR, the subject and subject
+// alternative names of the request are not compared, as they are not part of
+// the spec. Callers must compare the request with the CSR itself.
 // If decoding the x509 certificate request fails, an error will be returned.
 func RequestMatchesSpec(req *cmapi.CertificateRequest, spec cmapi.CertificateSpec) ([]string, error) {
 	x509req, err := DecodeX509CertificateRequestBytes(req.Spec.Request)
@@ -131,6 +176,59 @@ func RequestMatchesSpec(req *cmapi.CertificateRequest, spec cmapi.CertificateSpe
 		return nil, err
 	}
 
+	var violations []string
+
+	if spec.CSR == nil {
+		violations, err = requestNamesMatchSpec(x509req, spec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Spec.IsCA != spec.IsCA {
+		violations = append(violations, "spec.isCA")
+	}
+	if !util.EqualKeyUsagesUnsorted(req.Spec.Usages, spec.Usages) {
+		violations = append(violations, "spec.usages")
+	}
+	if req.Spec.Duration != nil && spec.Duration != nil &&
+		req.Spec.Duration.Duration != spec.Duration.Duration {
+		violations = append(violations, "spec.duration")
+	}
+	// RequestMatchesSpec compares the IssuerRef in the CertificateRequest and
+	// CertificateSpec, regardless of any differences which are solely due to
+	// the presence or absence of default group (cert-manager.io) and kind (Issuer).
+	//
+	// We do not want to re-issue the Certificate if the user explicitly adds
+	// the default issuer group and kind.
+	// Nor do we want to re-issue if the user removes the default issuer group and kind.
+	//
+	// And we want to avoid re-issuing if a future version of the cert-manager
+	// CRDs introduces API defaults for issuerRef group and kind. Specifically,
+	// we want to gracefully handle a situation where the platform admin
+	// upgrades the CRDs to a version that has defaults, but not the controller.
+	// In that situation, when the CRDs are upgraded, the controller
+	// re-establishes its watches and refreshes its caches with updated Certificates
+	// and CertificateRequests, containing the new API defaults. But this
+	// doesn't happen transactionally, so the updated Certificates may start
+	// being reconciled before the cached CertificateRequests have been updated
+	// and there will be a mis-match if the Certificate has the default
+	// group/kind set but the CertificateRequest does not.
+	if req.Spec.IssuerRef.Name != spec.IssuerRef.Name ||
+		!apiutil.IssuerKindsEqual(req.Spec.IssuerRef.Kind, spec.IssuerRef.Kind) ||
+		!apiutil.IssuerGroupsEqual(req.Spec.IssuerRef.Group, spec.IssuerRef.Group) {
+		violations = append(violations, "spec.issuerRef")
+	}
+
+	// TODO: check spec.EncodeBasicConstraintsInRequest and spec.EncodeUsagesInRequest
+
+	return violations, nil
+}
+
+// requestNamesMatchSpec compares the subject and subject alternative names of
+// an x509 certificate request with a CertificateSpec, and returns a list of
+// field names on the Certificate that do not match.
+func requestNamesMatchSpec(x509req *x509.CertificateRequest, spec cmapi.CertificateSpec) ([]string, error) {
 	// It is safe to mutate top-level fields in `spec` as it is not a pointer
 	// meaning changes will not affect the caller.
 	if spec.Subject == nil {
@@ -214,43 +312,6 @@ func RequestMatchesSpec(req *cmapi.CertificateRequest, spec cmapi.CertificateSpe
 		}
 	}
 
-	if req.Spec.IsCA != spec.IsCA {
-		violations = append(violations, "spec.isCA")
-	}
-	if !util.EqualKeyUsagesUnsorted(req.Spec.Usages, spec.Usages) {
-		violations = append(violations, "spec.usages")
-	}
-	if req.Spec.Duration != nil && spec.Duration != nil &&
-		req.Spec.Duration.Duration != spec.Duration.Duration {
-		violations = append(violations, "spec.duration")
-	}
-	// RequestMatchesSpec compares the IssuerRef in the CertificateRequest and
-	// CertificateSpec, regardless of any differences which are solely due to
-	// the presence or absence of default group (cert-manager.io) and kind (Issuer).
-	//
-	// We do not want to re-issue the Certificate if the user explicitly adds
-	// the default issuer group and kind.
-	// Nor do we want to re-issue if the user removes the default issuer group and kind.
-	//
-	// And we want to avoid re-issuing if a future version of the cert-manager
-	// CRDs introduces API defaults for issuerRef group and kind. Specifically,
-	// we want to gracefully handle a situation where the platform admin
-	// upgrades the CRDs to a version that has defaults, but not the controller.
-	// In that situation, when the CRDs are upgraded, the controller
-	// re-establishes its watches and refreshes its caches with updated Certificates
-	// and CertificateRequests, containing the new API defaults. But this
-	// doesn't happen transactionally, so the updated Certificates may start
-	// being reconciled before the cached CertificateRequests have been updated
-	// and there will be a mis-match if the Certificate has the default
-	// group/kind set but the CertificateRequest does not.
-	if req.Spec.IssuerRef.Name != spec.IssuerRef.Name ||
-		!apiutil.IssuerKindsEqual(req.Spec.IssuerRef.Kind, spec.IssuerRef.Kind) ||
-		!apiutil.IssuerGroupsEqual(req.Spec.IssuerRef.Group, spec.IssuerRef.Group) {
-		violations = append(violations, "spec.issuerRef")
-	}
-
-	// TODO: check spec.EncodeBasicConstraintsInRequest and spec.EncodeUsagesInRequest
-
 	return violations, nil
 }
 
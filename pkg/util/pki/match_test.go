@@ -58,6 +58,22 @@ func mustGenerateEd25519(t *testing.T) crypto.PrivateKey {
 	return pk
 }
 
+func mustGenerateEd448(t *testing.T) crypto.PrivateKey {
+	pk, err := pki.GenerateEd448PrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pk
+}
+
+func mustGenerateMLDSA(t *testing.T, keySize int) crypto.PrivateKey {
+	pk, err := pki.GenerateMLDSAPrivateKey(keySize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pk
+}
+
 func TestPrivateKeyMatchesSpec(t *testing.T) {
 	tests := map[string]struct {
 		key          crypto.PrivateKey
@@ -97,6 +113,40 @@ func TestPrivateKeyMatchesSpec(t *testing.T) {
 			key:          mustGenerateEd25519(t),
 			expectedAlgo: cmapi.Ed25519KeyAlgorithm,
 		},
+		"should match if keySize and algorithm are correct (Ed448)": {
+			key:          mustGenerateEd448(t),
+			expectedAlgo: cmapi.Ed448KeyAlgorithm,
+		},
+		"should not match if keyAlgorithm is incorrect (Ed448)": {
+			key:          mustGenerateEd25519(t),
+			expectedAlgo: cmapi.Ed448KeyAlgorithm,
+			violations:   []string{"spec.privateKey.algorithm"},
+		},
+		"should not match if keyAlgorithm is incorrect (Ed25519 with Ed448 key)": {
+			key:          mustGenerateEd448(t),
+			expectedAlgo: cmapi.Ed25519KeyAlgorithm,
+			violations:   []string{"spec.privateKey.algorithm"},
+		},
+		"should match if keySize and algorithm are correct (MLDSA)": {
+			key:          mustGenerateMLDSA(t, pki.MLDSA65),
+			expectedAlgo: cmapi.MLDSAKeyAlgorithm,
+			expectedSize: pki.MLDSA65,
+		},
+		"should match if keySize is not set and key is ML-DSA-44": {
+			key:          mustGenerateMLDSA(t, pki.MLDSA44),
+			expectedAlgo: cmapi.MLDSAKeyAlgorithm,
+		},
+		"should not match if MLDSA keySize is incorrect": {
+			key:          mustGenerateMLDSA(t, pki.MLDSA44),
+			expectedAlgo: cmapi.MLDSAKeyAlgorithm,
+			expectedSize: pki.MLDSA87,
+			violations:   []string{"spec.privateKey.size"},
+		},
+		"should not match if keyAlgorithm is incorrect (MLDSA)": {
+			key:          mustGenerateEd25519(t),
+			expectedAlgo: cmapi.MLDSAKeyAlgorithm,
+			violations:   []string{"spec.privateKey.algorithm"},
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -17,17 +17,40 @@ limitations under the License.
 package pki
 
 import (
+	"context"
 	"crypto"
 	"crypto/x509"
 	stdpem "encoding/pem"
 
+	"k8s.io/apimachinery/pkg/types"
+
 	"github.com/cert-manager/cert-manager/internal/pem"
 	"github.com/cert-manager/cert-manager/pkg/util/errors"
 )
 
 // DecodePrivateKeyBytes will decode a PEM encoded private key into a crypto.Signer.
 // It supports ECDSA, RSA and EdDSA private keys only. All other types will return err.
+// Key handles written by EncodePrivateKey for keys held by a KeyProvider are
+// invalid, as they can only be resolved for the Certificate the key was
+// generated for using DecodeCertificatePrivateKeyBytes.
 func DecodePrivateKeyBytes(keyBytes []byte) (crypto.Signer, error) {
+	return decodePrivateKeyBytes(keyBytes, func(block *stdpem.Block) (crypto.Signer, error) {
+		return nil, errors.NewInvalidData("error decoding key handle: private keys held by key provider %q can only be used by the Certificate they were generated for", block.Headers[keyHandleProviderHeader])
+	})
+}
+
+// DecodeCertificatePrivateKeyBytes decodes the PEM encoded private key of the
+// Certificate with the given namespace and name, as DecodePrivateKeyBytes
+// does. Key handles written by EncodePrivateKey for keys held by a
+// KeyProvider are resolved using the registered KeyProvider, which checks
+// that the key was generated for the Certificate.
+func DecodeCertificatePrivateKeyBytes(ctx context.Context, crt types.NamespacedName, keyBytes []byte) (crypto.Signer, error) {
+	return decodePrivateKeyBytes(keyBytes, func(block *stdpem.Block) (crypto.Signer, error) {
+		return decodeKeyHandle(ctx, crt, block)
+	})
+}
+
+func decodePrivateKeyBytes(keyBytes []byte, decodeHandle func(*stdpem.Block) (crypto.Signer, error)) (crypto.Signer, error) {
 	// decode the private key pem
 	block, _, err := pem.SafeDecodePrivateKey(keyBytes)
 	if err != nil {
@@ -36,6 +59,14 @@ func DecodePrivateKeyBytes(keyBytes []byte) (crypto.Signer, error) {
 
 	switch block.Type {
 	case "PRIVATE KEY":
+		if isEd448PKCS8PrivateKey(block.Bytes) {
+			key, err := parseEd448PKCS8PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, errors.NewInvalidData("error parsing pkcs#8 private key: %s", err.Error())
+			}
+			return key, nil
+		}
+
 		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
 		if err != nil {
 			return nil, errors.NewInvalidData("error parsing pkcs#8 private key: %s", err.Error())
@@ -64,6 +95,8 @@ func DecodePrivateKeyBytes(keyBytes []byte) (crypto.Signer, error) {
 			return nil, errors.NewInvalidData("rsa private key failed validation: %s", err.Error())
 		}
 		return key, nil
+	case keyHandlePEMType:
+		return decodeHandle(block)
 	default:
 		return nil, errors.NewInvalidData("unknown private key type: %s", block.Type)
 	}
@@ -92,6 +125,7 @@ func decodeMultipleCerts(certBytes []byte, decodeFn func([]byte) (*stdpem.Block,
 		if err != nil {
 			return nil, errors.NewInvalidData("error parsing X.509 certificate: %s", err.Error())
 		}
+		setEd448PublicKey(cert.PublicKeyAlgorithm, cert.RawSubjectPublicKeyInfo, &cert.PublicKey)
 
 		certs = append(certs, cert)
 	}
@@ -138,6 +172,23 @@ func DecodeX509CertificateRequestBytes(csrBytes []byte) (*x509.CertificateReques
 	if err != nil {
 		return nil, err
 	}
+	setEd448PublicKey(csr.PublicKeyAlgorithm, csr.RawSubjectPublicKeyInfo, &csr.PublicKey)
+
+	return csr, nil
+}
+
+// DecodeSignedX509CertificateRequestBytes decodes a PEM encoded x509
+// certificate request like DecodeX509CertificateRequestBytes, and additionally
+// verifies that it is signed by the private key matching its public key.
+func DecodeSignedX509CertificateRequestBytes(csrBytes []byte) (*x509.CertificateRequest, error) {
+	csr, err := DecodeX509CertificateRequestBytes(csrBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkCertificateRequestSignature(csr); err != nil {
+		return nil, errors.NewInvalidData("error verifying certificate request signature: %s", err)
+	}
 
 	return csr, nil
 }
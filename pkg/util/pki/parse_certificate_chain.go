@@ -237,7 +237,7 @@ func (a *chainNode) tryMergeChain(b *chainNode) (*chainNode, bool) {
 
 	// b's root has been signed by a. Add a as parent of b's root.
 	if bytes.Equal(bRoot.cert.RawIssuer, a.cert.RawSubject) &&
-		bRoot.cert.CheckSignatureFrom(a.cert) == nil {
+		checkCertificateSignatureFrom(bRoot.cert, a.cert) == nil {
 		bRoot.issuer = a
 		return b, true
 	}
@@ -246,7 +246,7 @@ func (a *chainNode) tryMergeChain(b *chainNode) (*chainNode, bool) {
 
 	// a's root has been signed by b. Add b as parent of a's root.
 	if bytes.Equal(aRoot.cert.RawIssuer, b.cert.RawSubject) &&
-		aRoot.cert.CheckSignatureFrom(b.cert) == nil {
+		checkCertificateSignatureFrom(aRoot.cert, b.cert) == nil {
 		aRoot.issuer = b
 		return a, true
 	}
@@ -267,5 +267,5 @@ func (c *chainNode) root() *chainNode {
 // isSelfSignedCertificate returns true if the given X.509 certificate has been
 // signed by itself, which would make it a "root" certificate.
 func isSelfSignedCertificate(cert *x509.Certificate) bool {
-	return cert.CheckSignatureFrom(cert) == nil
+	return checkCertificateSignatureFrom(cert, cert) == nil
 }
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+
+	"golang.org/x/crypto/cryptobyte"
+	cryptobyte_asn1 "golang.org/x/crypto/cryptobyte/asn1"
+)
+
+// PKCS7PEMType is the PEM block type of a PKCS#7 certificate bundle, as
+// written by `openssl crl2pkcs7` and understood by Java's keytool and the
+// Windows certificate import wizard.
+const PKCS7PEMType = "PKCS7"
+
+var (
+	oidPKCS7Data       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidPKCS7SignedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+)
+
+// EncodePKCS7Certificates returns the given certificates as a PEM encoded
+// "degenerate" PKCS#7 SignedData structure (RFC 2315, section 9.1), which
+// contains certificates but no content or signers. This is the format of a
+// `.p7b` file.
+// The certificates are written in the order they are given, so that the
+// output is deterministic and consumers which expect the leaf certificate
+// first see it first.
+func EncodePKCS7Certificates(certs []*x509.Certificate) ([]byte, error) {
+	if len(certs) == 0 {
+		return nil, errors.New("at least one certificate is required to encode a PKCS#7 bundle")
+	}
+
+	b := cryptobyte.NewBuilder(nil)
+	// ContentInfo
+	b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		b.AddASN1ObjectIdentifier(oidPKCS7SignedData)
+		b.AddASN1(cryptobyte_asn1.Tag(0).Constructed().ContextSpecific(), func(b *cryptobyte.Builder) {
+			// SignedData
+			b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+				// version
+				b.AddASN1Int64(1)
+				// digestAlgorithms
+				b.AddASN1(cryptobyte_asn1.SET, func(b *cryptobyte.Builder) {})
+				// contentInfo
+				b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+					b.AddASN1ObjectIdentifier(oidPKCS7Data)
+				})
+				// certificates
+				b.AddASN1(cryptobyte_asn1.Tag(0).Constructed().ContextSpecific(), func(b *cryptobyte.Builder) {
+					for _, cert := range certs {
+						b.AddBytes(cert.Raw)
+					}
+				})
+				// signerInfos
+				b.AddASN1(cryptobyte_asn1.SET, func(b *cryptobyte.Builder) {})
+			})
+		})
+	})
+
+	der, err := b.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: PKCS7PEMType, Bytes: der}), nil
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"testing"
+)
+
+// pkcs7ContentInfo and pkcs7SignedData are the ContentInfo and SignedData
+// structures of RFC 2315, with only the fields a certificate bundle uses.
+type pkcs7ContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     pkcs7SignedData `asn1:"explicit,tag:0"`
+}
+
+type pkcs7SignedData struct {
+	Version          int
+	DigestAlgorithms asn1.RawValue
+	ContentInfo      asn1.RawValue
+	Certificates     []asn1.RawValue `asn1:"tag:0"`
+	SignerInfos      asn1.RawValue
+}
+
+func TestEncodePKCS7Certificates(t *testing.T) {
+	leaf := signTestCert(rsaKey(t, 2048))
+	ca := signTestCert(rsaKey(t, 2048))
+
+	encoded, err := EncodePKCS7Certificates([]*x509.Certificate{leaf, ca})
+	if err != nil {
+		t.Fatalf("unexpected error encoding PKCS#7 bundle: %v", err)
+	}
+
+	block, rest := pem.Decode(encoded)
+	if block == nil || block.Type != "PKCS7" || len(rest) > 0 {
+		t.Fatalf("expected a single PKCS7 PEM block, got: %s", encoded)
+	}
+
+	var contentInfo pkcs7ContentInfo
+	if rest, err := asn1.Unmarshal(block.Bytes, &contentInfo); err != nil || len(rest) > 0 {
+		t.Fatalf("failed to parse PKCS#7 ContentInfo: %v", err)
+	}
+	if !contentInfo.ContentType.Equal(asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}) {
+		t.Errorf("expected SignedData content type, got %s", contentInfo.ContentType)
+	}
+	signedData := contentInfo.Content
+	if signedData.Version != 1 {
+		t.Errorf("expected SignedData version 1, got %d", signedData.Version)
+	}
+	if len(signedData.SignerInfos.Bytes) != 0 {
+		t.Error("expected no signers in a certificate bundle")
+	}
+	if len(signedData.Certificates) != 2 ||
+		!bytes.Equal(signedData.Certificates[0].FullBytes, leaf.Raw) ||
+		!bytes.Equal(signedData.Certificates[1].FullBytes, ca.Raw) {
+		t.Error("expected the bundle to contain the leaf followed by the CA certificate")
+	}
+
+	encodedAgain, err := EncodePKCS7Certificates([]*x509.Certificate{leaf, ca})
+	if err != nil {
+		t.Fatalf("unexpected error encoding PKCS#7 bundle: %v", err)
+	}
+	if !bytes.Equal(encoded, encodedAgain) {
+		t.Error("expected PKCS#7 encoding to be deterministic")
+	}
+
+	if _, err := EncodePKCS7Certificates(nil); err == nil {
+		t.Error("expected an error encoding an empty PKCS#7 bundle")
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki_test
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+)
+
+func TestEncryptDecryptPKCS8PrivateKey(t *testing.T) {
+	pk, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs8DER, err := x509.MarshalPKCS8PrivateKey(pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encrypted, err := pki.EncryptPKCS8PrivateKey(pkcs8DER, []byte("password"))
+	if err != nil {
+		t.Fatalf("unexpected error encrypting private key: %v", err)
+	}
+
+	block, _ := pem.Decode(encrypted)
+	if block == nil || block.Type != "ENCRYPTED PRIVATE KEY" {
+		t.Fatalf("expected an ENCRYPTED PRIVATE KEY PEM block, got: %s", encrypted)
+	}
+	if bytes.Contains(block.Bytes, pkcs8DER) {
+		t.Fatal("encrypted private key contains the plaintext private key")
+	}
+
+	encryptedAgain, err := pki.EncryptPKCS8PrivateKey(pkcs8DER, []byte("password"))
+	if err != nil {
+		t.Fatalf("unexpected error encrypting private key: %v", err)
+	}
+	if bytes.Equal(encrypted, encryptedAgain) {
+		t.Error("expected a random salt and IV to be used for every encryption")
+	}
+
+	decrypted, err := testcrypto.DecryptPKCS8PrivateKey(encrypted, []byte("password"))
+	if err != nil {
+		t.Fatalf("unexpected error decrypting private key: %v", err)
+	}
+	if !bytes.Equal(decrypted, pkcs8DER) {
+		t.Error("decrypted private key does not match the original private key")
+	}
+
+	if _, err := testcrypto.DecryptPKCS8PrivateKey(encrypted, []byte("wrong-password")); err == nil {
+		t.Error("expected an error decrypting with the wrong password")
+	}
+
+	if _, err := pki.EncryptPKCS8PrivateKey(pkcs8DER, nil); err == nil {
+		t.Error("expected an error encrypting with an empty password")
+	}
+
+	if _, err := testcrypto.DecryptPKCS8PrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8DER}), []byte("password")); err == nil {
+		t.Error("expected an error decrypting an unencrypted private key")
+	}
+}
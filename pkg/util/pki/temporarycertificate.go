@@ -16,7 +16,13 @@ limitations under the License.
 
 package pki
 
-import cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
 
 // staticTemporarySerialNumber is a fixed serial number we use for temporary certificates
 const staticTemporarySerialNumber = "1234567890"
@@ -27,7 +33,7 @@ const staticTemporarySerialNumber = "1234567890"
 // This is to mitigate a potential attack against x509 certificates that use a
 // predictable serial number and weak MD5 hashing algorithms.
 // In practice, this shouldn't really be a concern anyway.
-func GenerateLocallySignedTemporaryCertificate(crt *cmapi.Certificate, pkData []byte) ([]byte, error) {
+func GenerateLocallySignedTemporaryCertificate(ctx context.Context, crt *cmapi.Certificate, pkData []byte) ([]byte, error) {
 	// generate a throwaway self-signed root CA
 	caPk, err := GenerateECPrivateKey(ECCurve521)
 	if err != nil {
@@ -54,7 +60,7 @@ func GenerateLocallySignedTemporaryCertificate(crt *cmapi.Certificate, pkData []
 	}
 	template.Subject.SerialNumber = staticTemporarySerialNumber
 
-	signeeKey, err := DecodePrivateKeyBytes(pkData)
+	signeeKey, err := DecodeCertificatePrivateKeyBytes(ctx, types.NamespacedName{Namespace: crt.Namespace, Name: crt.Name}, pkData)
 	if err != nil {
 		return nil, err
 	}
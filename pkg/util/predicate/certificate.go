@@ -17,6 +17,8 @@ limitations under the License.
 package predicate
 
 import (
+	"slices"
+
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 )
 
@@ -40,3 +42,62 @@ func CertificateNextPrivateKeySecretName(name string) Func[*cmapi.Certificate] {
 		return *crt.Status.NextPrivateKeySecretName == name
 	}
 }
+
+// CertificateCSRSecretName returns a predicate that used to filter Certificates
+// to only those with the given 'spec.csr.secretRef.name'.
+func CertificateCSRSecretName(name string) Func[*cmapi.Certificate] {
+	return func(crt *cmapi.Certificate) bool {
+		if crt.Spec.CSR == nil || crt.Spec.CSR.SecretRef == nil {
+			return false
+		}
+		return crt.Spec.CSR.SecretRef.Name == name
+	}
+}
+
+// CertificateEncryptedPKCS8PasswordSecretName returns a predicate that used to
+// filter Certificates to only those with an EncryptedPKCS8 additional output
+// format whose 'passwordSecretRef.name' is the given name.
+func CertificateEncryptedPKCS8PasswordSecretName(name string) Func[*cmapi.Certificate] {
+	return func(crt *cmapi.Certificate) bool {
+		for _, format := range crt.Spec.AdditionalOutputFormats {
+			if format.Type == cmapi.CertificateOutputFormatEncryptedPKCS8 &&
+				format.PasswordSecretRef != nil && format.PasswordSecretRef.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// CertificateConfigMapTarget returns a predicate that used to filter
+// Certificates to only those with a 'spec.configMapTargets' entry for the
+// ConfigMap with the given namespace and name.
+// Entries without a namespace refer to the Certificate's own namespace.
+func CertificateConfigMapTarget(namespace, name string) Func[*cmapi.Certificate] {
+	return func(crt *cmapi.Certificate) bool {
+		for _, target := range crt.Spec.ConfigMapTargets {
+			targetNamespace := target.Namespace
+			if targetNamespace == "" {
+				targetNamespace = crt.Namespace
+			}
+			if targetNamespace == namespace && target.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// CertificateTruststoreSecret returns a predicate that used to filter
+// Certificates to only those whose 'spec.keystores.truststore' Secret, or one
+// of its copies in 'spec.keystores.truststore.namespaces', has the given
+// namespace and name.
+func CertificateTruststoreSecret(namespace, name string) Func[*cmapi.Certificate] {
+	return func(crt *cmapi.Certificate) bool {
+		if crt.Spec.Keystores == nil || crt.Spec.Keystores.Truststore == nil ||
+			crt.Spec.Keystores.Truststore.SecretName != name {
+			return false
+		}
+		return crt.Namespace == namespace || slices.Contains(crt.Spec.Keystores.Truststore.Namespaces, namespace)
+	}
+}
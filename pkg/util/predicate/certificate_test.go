@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 )
 
 func TestCertificateSecretName(t *testing.T) {
@@ -90,3 +91,198 @@ func TestCertificateNextPrivateKeySecretName(t *testing.T) {
 		})
 	}
 }
+
+func TestCertificateCSRSecretName(t *testing.T) {
+	tests := map[string]struct {
+		secretName string
+		cert       *cmapi.Certificate
+		expected   bool
+	}{
+		"returns true if secret name matches": {
+			secretName: "abc",
+			cert: &cmapi.Certificate{Spec: cmapi.CertificateSpec{CSR: &cmapi.CertificateCSRSource{
+				SecretRef: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "abc"}},
+			}}},
+			expected: true,
+		},
+		"returns false if secret name does not match": {
+			secretName: "abc",
+			cert: &cmapi.Certificate{Spec: cmapi.CertificateSpec{CSR: &cmapi.CertificateCSRSource{
+				SecretRef: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "abcd"}},
+			}}},
+			expected: false,
+		},
+		"returns false if the csr is inline": {
+			secretName: "",
+			cert: &cmapi.Certificate{Spec: cmapi.CertificateSpec{CSR: &cmapi.CertificateCSRSource{
+				Request: []byte("csr"),
+			}}},
+			expected: false,
+		},
+		"returns false if csr is not set": {
+			secretName: "",
+			cert:       &cmapi.Certificate{},
+			expected:   false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := CertificateCSRSecretName(test.secretName)(test.cert)
+			if got != test.expected {
+				t.Errorf("unexpected response: got=%t, exp=%t", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestCertificateConfigMapTarget(t *testing.T) {
+	certWithTargets := func(targets ...cmapi.CertificateConfigMapTarget) *cmapi.Certificate {
+		crt := &cmapi.Certificate{Spec: cmapi.CertificateSpec{ConfigMapTargets: targets}}
+		crt.Namespace = "default"
+		return crt
+	}
+	tests := map[string]struct {
+		namespace, name string
+		cert            *cmapi.Certificate
+		expected        bool
+	}{
+		"returns true if a target in the certificate's namespace matches": {
+			namespace: "default",
+			name:      "abc",
+			cert:      certWithTargets(cmapi.CertificateConfigMapTarget{Name: "abc"}),
+			expected:  true,
+		},
+		"returns true if a target in another namespace matches": {
+			namespace: "frontend",
+			name:      "abc",
+			cert: certWithTargets(
+				cmapi.CertificateConfigMapTarget{Name: "def"},
+				cmapi.CertificateConfigMapTarget{Name: "abc", Namespace: "frontend"},
+			),
+			expected: true,
+		},
+		"returns false if the namespace does not match": {
+			namespace: "frontend",
+			name:      "abc",
+			cert:      certWithTargets(cmapi.CertificateConfigMapTarget{Name: "abc"}),
+			expected:  false,
+		},
+		"returns false if the name does not match": {
+			namespace: "default",
+			name:      "abc",
+			cert:      certWithTargets(cmapi.CertificateConfigMapTarget{Name: "abcd"}),
+			expected:  false,
+		},
+		"returns false if no targets are set": {
+			namespace: "default",
+			name:      "abc",
+			cert:      certWithTargets(),
+			expected:  false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := CertificateConfigMapTarget(test.namespace, test.name)(test.cert)
+			if got != test.expected {
+				t.Errorf("unexpected response: got=%t, exp=%t", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestCertificateEncryptedPKCS8PasswordSecretName(t *testing.T) {
+	tests := map[string]struct {
+		secretName string
+		cert       *cmapi.Certificate
+		expected   bool
+	}{
+		"returns true if the password secret name matches": {
+			secretName: "abc",
+			cert: &cmapi.Certificate{Spec: cmapi.CertificateSpec{AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+				{Type: cmapi.CertificateOutputFormatDER},
+				{Type: cmapi.CertificateOutputFormatEncryptedPKCS8, PasswordSecretRef: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "abc"}}},
+			}}},
+			expected: true,
+		},
+		"returns false if the password secret name does not match": {
+			secretName: "abc",
+			cert: &cmapi.Certificate{Spec: cmapi.CertificateSpec{AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+				{Type: cmapi.CertificateOutputFormatEncryptedPKCS8, PasswordSecretRef: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "abcd"}}},
+			}}},
+			expected: false,
+		},
+		"returns false if there is no EncryptedPKCS8 output format": {
+			secretName: "abc",
+			cert: &cmapi.Certificate{Spec: cmapi.CertificateSpec{AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+				{Type: cmapi.CertificateOutputFormatCombinedPEM},
+			}}},
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := CertificateEncryptedPKCS8PasswordSecretName(test.secretName)(test.cert)
+			if got != test.expected {
+				t.Errorf("unexpected response: got=%t, exp=%t", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestCertificateTruststoreSecret(t *testing.T) {
+	certWithTruststore := func(truststore *cmapi.CertificateTruststore) *cmapi.Certificate {
+		crt := &cmapi.Certificate{Spec: cmapi.CertificateSpec{Keystores: &cmapi.CertificateKeystores{Truststore: truststore}}}
+		crt.Namespace = "default"
+		return crt
+	}
+	tests := map[string]struct {
+		namespace, name string
+		cert            *cmapi.Certificate
+		expected        bool
+	}{
+		"returns true if the truststore Secret in the certificate's namespace matches": {
+			namespace: "default",
+			name:      "abc",
+			cert:      certWithTruststore(&cmapi.CertificateTruststore{SecretName: "abc"}),
+			expected:  true,
+		},
+		"returns true if a copy of the truststore Secret in another namespace matches": {
+			namespace: "frontend",
+			name:      "abc",
+			cert:      certWithTruststore(&cmapi.CertificateTruststore{SecretName: "abc", Namespaces: []string{"backend", "frontend"}}),
+			expected:  true,
+		},
+		"returns false if the namespace does not match": {
+			namespace: "frontend",
+			name:      "abc",
+			cert:      certWithTruststore(&cmapi.CertificateTruststore{SecretName: "abc"}),
+			expected:  false,
+		},
+		"returns false if the name does not match": {
+			namespace: "default",
+			name:      "abc",
+			cert:      certWithTruststore(&cmapi.CertificateTruststore{SecretName: "abcd"}),
+			expected:  false,
+		},
+		"returns false if no truststore Secret is set": {
+			namespace: "default",
+			name:      "abc",
+			cert:      certWithTruststore(nil),
+			expected:  false,
+		},
+		"returns false if no keystores are set": {
+			namespace: "default",
+			name:      "abc",
+			cert:      &cmapi.Certificate{},
+			expected:  false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := CertificateTruststoreSecret(test.namespace, test.name)(test.cert)
+			if got != test.expected {
+				t.Errorf("unexpected response: got=%t, exp=%t", got, test.expected)
+			}
+		})
+	}
+}
@@ -24,7 +24,7 @@ import (
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
 
-// Pick will select a solver based on the type of challenge, labels, dns names and dns zones
+// Pick will select a solver based on the type of challenge, labels, dns names, dns zones and ip ranges
 func Pick(ctx context.Context, domainToFind string, challenges []cmacme.ACMEChallenge, solvers []cmacme.ACMEChallengeSolver, o *cmacme.Order) (*cmacme.ACMEChallengeSolver, *cmacme.ACMEChallenge) {
 	log := logf.FromContext(ctx, "selectSolver")
 	dbg := log.V(logf.DebugLevel)
@@ -36,12 +36,23 @@ func Pick(ctx context.Context, domainToFind string, challenges []cmacme.ACMEChal
 	selectedNumDNSZonesMatch := 0
 
 	challengeForSolver := func(solver *cmacme.ACMEChallengeSolver) *cmacme.ACMEChallenge {
+		// account scoped DNS01 solvers prefer dns-account-01 where it is
+		// offered, and otherwise fall back to dns-01 below
+		if solver.DNS01 != nil && solver.DNS01.AccountScoped {
+			for _, ch := range challenges {
+				if ch.Type == "dns-account-01" {
+					return &ch
+				}
+			}
+		}
 		for _, ch := range challenges {
 			switch {
 			case ch.Type == "http-01" && solver.HTTP01 != nil:
 				return &ch
 			case ch.Type == "dns-01" && solver.DNS01 != nil:
 				return &ch
+			case ch.Type == "tls-alpn-01" && solver.TLSALPN01 != nil:
+				return &ch
 			}
 		}
 		return nil
@@ -69,12 +80,19 @@ func Pick(ctx context.Context, domainToFind string, challenges []cmacme.ACMEChal
 		labelsMatch, numLabelsMatch := selectors.Labels(*cfg.Selector).Matches(o.ObjectMeta, domainToFind)
 		dnsNamesMatch, numDNSNamesMatch := selectors.DNSNames(*cfg.Selector).Matches(o.ObjectMeta, domainToFind)
 		dnsZonesMatch, numDNSZonesMatch := selectors.DNSZones(*cfg.Selector).Matches(o.ObjectMeta, domainToFind)
+		ipRangesMatch, numIPRangesMatch := selectors.IPRanges(*cfg.Selector).Matches(o.ObjectMeta, domainToFind)
 
-		if !labelsMatch || !dnsNamesMatch || !dnsZonesMatch {
-			dbg.Info("not selecting solver", "labels_match", labelsMatch, "dnsnames_match", dnsNamesMatch, "dnszones_match", dnsZonesMatch)
+		if !labelsMatch || !dnsNamesMatch || !dnsZonesMatch || !ipRangesMatch {
+			dbg.Info("not selecting solver", "labels_match", labelsMatch, "dnsnames_match", dnsNamesMatch, "dnszones_match", dnsZonesMatch, "ipranges_match", ipRangesMatch)
 			continue
 		}
 
+		// dnsZones only match DNS names and ipRanges only match IP addresses,
+		// so at most one of them can have a non-zero number of matches. They
+		// share the same precedence, so ipRanges matches are compared as if
+		// they were dnsZones matches below.
+		numDNSZonesMatch += numIPRangesMatch
+
 		dbg.Info("selector matches")
 
 		selectSolver := func() {
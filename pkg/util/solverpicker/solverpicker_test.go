@@ -41,6 +41,31 @@ func TestPick(t *testing.T) {
 			},
 		},
 	}
+	accountScopedSolverDNS01 := cmacme.ACMEChallengeSolver{
+		DNS01: &cmacme.ACMEChallengeSolverDNS01{
+			AccountScoped: true,
+			Cloudflare: &cmacme.ACMEIssuerDNS01ProviderCloudflare{
+				Email: "test-cloudflare-email",
+			},
+		},
+	}
+	ipRangeSelectorSolver := func(name string, ranges ...string) cmacme.ACMEChallengeSolver {
+		return cmacme.ACMEChallengeSolver{
+			Selector: &cmacme.CertificateDNSNameSelector{
+				IPRanges: ranges,
+			},
+			HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{
+					Name: name,
+				},
+			},
+		}
+	}
+	wideIPRangeSelectorSolver := ipRangeSelectorSolver("wide-ip-range-selector-solver", "10.0.0.0/8")
+	narrowIPRangeSelectorSolver := ipRangeSelectorSolver("narrow-ip-range-selector-solver", "10.0.1.0/24")
+	emptySelectorSolverTLSALPN01 := cmacme.ACMEChallengeSolver{
+		TLSALPN01: &cmacme.ACMEChallengeSolverTLSALPN01{},
+	}
 	nonMatchingSelectorSolver := cmacme.ACMEChallengeSolver{
 		Selector: &cmacme.CertificateDNSNameSelector{
 			MatchLabels: map[string]string{
@@ -73,6 +98,14 @@ func TestPick(t *testing.T) {
 		Type:  "dns-01",
 		Token: "dns-01-token",
 	}
+	acmeChallengeDNSAccount01 := &cmacme.ACMEChallenge{
+		Type:  "dns-account-01",
+		Token: "dns-account-01-token",
+	}
+	acmeChallengeTLSALPN01 := &cmacme.ACMEChallenge{
+		Type:  "tls-alpn-01",
+		Token: "tls-alpn-01-token",
+	}
 
 	tests := map[string]struct {
 		issuer            cmapi.GenericIssuer
@@ -191,6 +224,94 @@ func TestPick(t *testing.T) {
 			expectedSolver:    &emptySelectorSolverDNS01,
 			expectedChallenge: acmeChallengeDNS01,
 		},
+		"should use DNS-ACCOUNT-01 challenge for an account scoped DNS01 solver if offered": {
+			issuer: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{accountScopedSolverDNS01},
+						},
+					},
+				},
+			},
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"example.com"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeDNS01, *acmeChallengeDNSAccount01},
+			},
+			expectedSolver:    &accountScopedSolverDNS01,
+			expectedChallenge: acmeChallengeDNSAccount01,
+		},
+		"should fall back to DNS01 challenge for an account scoped DNS01 solver if DNS-ACCOUNT-01 is not offered": {
+			issuer: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{accountScopedSolverDNS01},
+						},
+					},
+				},
+			},
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"example.com"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeDNS01},
+			},
+			expectedSolver:    &accountScopedSolverDNS01,
+			expectedChallenge: acmeChallengeDNS01,
+		},
+		"should not use DNS-ACCOUNT-01 challenge for a DNS01 solver that is not account scoped": {
+			issuer: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{emptySelectorSolverDNS01},
+						},
+					},
+				},
+			},
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"example.com"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeDNSAccount01, *acmeChallengeDNS01},
+			},
+			expectedSolver:    &emptySelectorSolverDNS01,
+			expectedChallenge: acmeChallengeDNS01,
+		},
+		"should use TLS-ALPN-01 challenge for a TLSALPN01 solver": {
+			issuer: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{emptySelectorSolverTLSALPN01},
+						},
+					},
+				},
+			},
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"example.com"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeHTTP01, *acmeChallengeDNS01, *acmeChallengeTLSALPN01},
+			},
+			expectedSolver:    &emptySelectorSolverTLSALPN01,
+			expectedChallenge: acmeChallengeTLSALPN01,
+		},
 		"should return nil if none match": {
 			issuer: &cmapi.Issuer{
 				Spec: cmapi.IssuerSpec{
@@ -970,6 +1091,81 @@ func TestPick(t *testing.T) {
 			expectedSolver:    &exampleComDNSNameSelectorSolver,
 			expectedChallenge: acmeChallengeHTTP01,
 		},
+		"uses the solver with the most specific matching ipRange": {
+			issuer: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{
+								emptySelectorSolverHTTP01,
+								wideIPRangeSelectorSolver,
+								narrowIPRangeSelectorSolver,
+							},
+						},
+					},
+				},
+			},
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					IPAddresses: []string{"10.0.1.5"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "10.0.1.5",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeHTTP01},
+			},
+			expectedSolver:    &narrowIPRangeSelectorSolver,
+			expectedChallenge: acmeChallengeHTTP01,
+		},
+		"falls back to a solver without a selector if no ipRange matches": {
+			issuer: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{
+								emptySelectorSolverHTTP01,
+								narrowIPRangeSelectorSolver,
+							},
+						},
+					},
+				},
+			},
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					IPAddresses: []string{"10.0.2.5"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "10.0.2.5",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeHTTP01},
+			},
+			expectedSolver:    &emptySelectorSolverHTTP01,
+			expectedChallenge: acmeChallengeHTTP01,
+		},
+		"does not use an ipRange solver for a DNS name": {
+			issuer: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{
+								wideIPRangeSelectorSolver,
+							},
+						},
+					},
+				},
+			},
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"example.com"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "example.com",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeHTTP01},
+			},
+			expectedSolver:    nil,
+			expectedChallenge: nil,
+		},
 	}
 
 	for name, test := range tests {
@@ -35,6 +35,7 @@ type rfc2136Handler struct {
 	txtRecords map[string][]string
 	zones      []string
 	tsigZone   string
+	sig0Key    *dns.KEY
 	lock       sync.Mutex
 }
 
@@ -74,6 +75,14 @@ func (b *rfc2136Handler) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
 		}
 	}
 
+	if req.Opcode == dns.OpcodeUpdate && b.sig0Key != nil {
+		if err := verifySIG0(b.sig0Key, req); err != nil {
+			log.V(logf.WarnLevel).Info("rejecting update with invalid SIG(0)", "error", err)
+			m.Rcode = dns.RcodeRefused
+			return
+		}
+	}
+
 	// updates are currently accepted for *all* zones
 	if req.Opcode == dns.OpcodeUpdate {
 		for _, rr := range req.Ns {
@@ -115,3 +124,21 @@ func (b *rfc2136Handler) zoneForFQDN(s string) string {
 	}
 	return ""
 }
+
+// verifySIG0 verifies that req is signed using SIG(0) with key.
+// Requests are not compressed by the rfc2136 provider, so packing the
+// request again gives the bytes that were signed.
+func verifySIG0(key *dns.KEY, req *dns.Msg) error {
+	if len(req.Extra) == 0 {
+		return fmt.Errorf("request is not signed")
+	}
+	sig, ok := req.Extra[len(req.Extra)-1].(*dns.SIG)
+	if !ok {
+		return fmt.Errorf("request is not signed")
+	}
+	buf, err := req.Pack()
+	if err != nil {
+		return err
+	}
+	return sig.Verify(key, buf)
+}
@@ -54,6 +54,10 @@ type BasicServer struct {
 	// TSIGZone is the DNS zone that should be used in TSIG responses
 	TSIGZone string
 
+	// SIG0Key is an optional SIG(0) public key. If set, updates must be
+	// signed using SIG(0) with the matching private key.
+	SIG0Key *dns.KEY
+
 	listenAddr string
 	server     *dns.Server
 }
@@ -103,6 +107,7 @@ func (b *BasicServer) RunWithAddress(ctx context.Context, listenAddr, network st
 			txtRecords: make(map[string][]string),
 			zones:      b.Zones,
 			tsigZone:   b.TSIGZone,
+			sig0Key:    b.SIG0Key,
 		}
 	}
 	b.server.Handler = b.Handler
@@ -117,4 +117,9 @@ const (
 
 	// ACMEUseARI denotes whether the ACME CA supports the use of ARI.
 	ACMEUseARI Feature = "ACMEUseARI"
+
+	// ACMEProfilesFeature denotes whether the target issuer is an ACME issuer
+	// whose server offers the "123h" certificate profile, as configured for
+	// the Pebble server used in the e2e tests.
+	ACMEProfilesFeature Feature = "ACMEProfiles"
 )
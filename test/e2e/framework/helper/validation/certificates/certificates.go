@@ -403,6 +403,26 @@ func ExpectValidAdditionalOutputFormats(certificate *cmapi.Certificate, secret *
 				} else {
 					return fmt.Errorf("expected additional output format CombinedPEM key %s to be present in secret", cmapi.CertificateOutputFormatCombinedPEMKey)
 				}
+			case cmapi.CertificateOutputFormatFullChainPEM:
+				if fullChain, ok := secret.Data[cmapi.CertificateOutputFormatFullChainPEMKey]; ok {
+					if !bytes.HasPrefix(fullChain, secret.Data[corev1.TLSCertKey]) {
+						return fmt.Errorf("expected additional output format FullChainPEM %s to start with the signed certificate chain", cmapi.CertificateOutputFormatFullChainPEMKey)
+					}
+					if bytes.Contains(fullChain, []byte("PRIVATE KEY")) {
+						return fmt.Errorf("expected additional output format FullChainPEM %s not to contain the private key", cmapi.CertificateOutputFormatFullChainPEMKey)
+					}
+				} else {
+					return fmt.Errorf("expected additional output format FullChainPEM key %s to be present in secret", cmapi.CertificateOutputFormatFullChainPEMKey)
+				}
+			case cmapi.CertificateOutputFormatPKCS7:
+				if bundle, ok := secret.Data[cmapi.CertificateOutputFormatPKCS7Key]; ok {
+					block, _ := pem.Decode(bundle)
+					if block == nil || block.Type != pki.PKCS7PEMType {
+						return fmt.Errorf("expected additional output format PKCS7 %s to contain a PKCS7 PEM block", cmapi.CertificateOutputFormatPKCS7Key)
+					}
+				} else {
+					return fmt.Errorf("expected additional output format PKCS7 key %s to be present in secret", cmapi.CertificateOutputFormatPKCS7Key)
+				}
 
 			default:
 				return fmt.Errorf("unknown additional output format %s", f.Type)
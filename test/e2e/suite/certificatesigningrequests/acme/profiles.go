@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	experimentalapi "github.com/cert-manager/cert-manager/pkg/apis/experimental/v1alpha1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cert-manager/cert-manager/e2e-tests/framework"
+	"github.com/cert-manager/cert-manager/e2e-tests/framework/helper/validation/certificatesigningrequests"
+	e2eutil "github.com/cert-manager/cert-manager/e2e-tests/util"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testingACMEEmail      = "e2e@cert-manager.io"
+	testingACMEPrivateKey = "test-acme-private-key"
+)
+
+// This test ensures that the ACME profile requested by a
+// CertificateSigningRequest overrides the profile configured on the Issuer.
+var _ = framework.CertManagerDescribe("CertificateSigningRequests ACME Profiles", func() {
+	f := framework.NewDefaultFramework("certificatesigningrequests-acme-profiles")
+	h := f.Helper()
+
+	var (
+		issuer  *cmapi.Issuer
+		request *certificatesv1.CertificateSigningRequest
+	)
+
+	BeforeEach(func(testingCtx context.Context) {
+		framework.RequireFeatureGate(utilfeature.DefaultFeatureGate, feature.ExperimentalCertificateSigningRequestControllers)
+
+		// The supported profiles are defined in the Pebble configuration:
+		// <repository>/make/config/pebble/charts/templates/configmap.yaml
+		var err error
+		issuer, err = f.CertManagerClientSet.CertmanagerV1().Issuers(f.Namespace.Name).Create(testingCtx, gen.Issuer("test-acme-issuer",
+			gen.SetIssuerNamespace(f.Namespace.Name),
+			gen.SetIssuerACMEEmail(testingACMEEmail),
+			gen.SetIssuerACMEURL(f.Config.Addons.ACMEServer.URL),
+			gen.SetIssuerACMEPrivKeyRef(testingACMEPrivateKey),
+			gen.SetIssuerACMESkipTLSVerify(true),
+			gen.SetIssuerACMESolvers([]cmacme.ACMEChallengeSolver{{
+				HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+					Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{
+						Class: &f.Config.Addons.IngressController.IngressClass,
+					},
+				},
+			}}),
+			gen.SetIssuerACMEProfile("123h"),
+		), metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		By("Waiting for Issuer to become Ready")
+		err = e2eutil.WaitForIssuerCondition(testingCtx, f.CertManagerClientSet.CertmanagerV1().Issuers(f.Namespace.Name),
+			issuer.Name,
+			cmapi.IssuerCondition{
+				Type:   cmapi.IssuerConditionReady,
+				Status: cmmeta.ConditionTrue,
+			})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func(testingCtx context.Context) {
+		if request != nil {
+			Expect(f.KubeClientSet.CertificatesV1().CertificateSigningRequests().Delete(testingCtx, request.Name, metav1.DeleteOptions{})).NotTo(HaveOccurred())
+		}
+		Expect(f.CertManagerClientSet.CertmanagerV1().Issuers(f.Namespace.Name).Delete(testingCtx, issuer.Name, metav1.DeleteOptions{})).NotTo(HaveOccurred())
+		Expect(f.KubeClientSet.CoreV1().Secrets(f.Namespace.Name).Delete(testingCtx, testingACMEPrivateKey, metav1.DeleteOptions{})).NotTo(HaveOccurred())
+	})
+
+	It("should obtain a signed certificate, with duration matching the requested profile", func(testingCtx context.Context) {
+		csr, key, err := gen.CSR(x509.RSA, gen.SetCSRDNSNames(e2eutil.RandomSubdomain(f.Config.Addons.IngressController.Domain)))
+		Expect(err).NotTo(HaveOccurred())
+
+		By("Creating a CertificateSigningRequest requesting a different profile to the Issuer")
+		request, err = f.KubeClientSet.CertificatesV1().CertificateSigningRequests().Create(testingCtx, &certificatesv1.CertificateSigningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "acme-profile-",
+				Annotations:  map[string]string{experimentalapi.CertificateSigningRequestACMEProfileAnnotationKey: "456h"},
+			},
+			Spec: certificatesv1.CertificateSigningRequestSpec{
+				Request:    csr,
+				SignerName: fmt.Sprintf("issuers.cert-manager.io/%s.%s", f.Namespace.Name, issuer.Name),
+				Usages:     []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageServerAuth},
+			},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		By("Approving the CertificateSigningRequest")
+		request.Status.Conditions = append(request.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+			Type: certificatesv1.CertificateApproved, Status: corev1.ConditionTrue,
+			Reason: "Approved", Message: "approved for cert-manager.io ACME profiles e2e test",
+			LastUpdateTime: metav1.NewTime(time.Now()), LastTransitionTime: metav1.NewTime(time.Now()),
+		})
+		request, err = f.KubeClientSet.CertificatesV1().CertificateSigningRequests().UpdateApproval(testingCtx, request.Name, request, metav1.UpdateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		By("Waiting for the CertificateSigningRequest to be signed")
+		_, err = h.WaitForCertificateSigningRequestSigned(testingCtx, request.Name, time.Minute*5)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("Verifying the certificate duration matches the requested profile")
+		err = h.ValidateCertificateSigningRequest(request.Name, key,
+			certificatesigningrequests.ExpectDuration(time.Hour*456, time.Second),
+		)
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
@@ -17,5 +17,6 @@ limitations under the License.
 package certificatesigningrequests
 
 import (
+	_ "github.com/cert-manager/cert-manager/e2e-tests/suite/certificatesigningrequests/acme"
 	_ "github.com/cert-manager/cert-manager/e2e-tests/suite/certificatesigningrequests/selfsigned"
 )
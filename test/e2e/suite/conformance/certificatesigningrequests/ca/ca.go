@@ -31,6 +31,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/cert-manager/cert-manager/e2e-tests/framework"
+	"github.com/cert-manager/cert-manager/e2e-tests/framework/helper/featureset"
 	"github.com/cert-manager/cert-manager/e2e-tests/suite/conformance/certificatesigningrequests"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -38,17 +39,25 @@ import (
 )
 
 var _ = framework.ConformanceDescribe("CertificateSigningRequests", func() {
+	unsupportedFeatures := featureset.NewFeatureSet(
+		// CA issuer is not an ACME issuer.
+		featureset.ACMEUseARI,
+		featureset.ACMEProfilesFeature,
+	)
+
 	caIssuer := new(ca)
 	(&certificatesigningrequests.Suite{
-		Name:             "CA Issuer",
-		CreateIssuerFunc: caIssuer.createIssuer,
+		Name:                "CA Issuer",
+		CreateIssuerFunc:    caIssuer.createIssuer,
+		UnsupportedFeatures: unsupportedFeatures,
 	}).Define()
 
 	caClusterIssuer := new(ca)
 	(&certificatesigningrequests.Suite{
-		Name:             "CA ClusterIssuer",
-		CreateIssuerFunc: caClusterIssuer.createClusterIssuer,
-		DeleteIssuerFunc: caClusterIssuer.deleteClusterIssuer,
+		Name:                "CA ClusterIssuer",
+		CreateIssuerFunc:    caClusterIssuer.createClusterIssuer,
+		DeleteIssuerFunc:    caClusterIssuer.deleteClusterIssuer,
+		UnsupportedFeatures: unsupportedFeatures,
 	}).Define()
 })
 
@@ -31,6 +31,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/cert-manager/cert-manager/e2e-tests/framework"
+	"github.com/cert-manager/cert-manager/e2e-tests/framework/helper/featureset"
 	"github.com/cert-manager/cert-manager/e2e-tests/suite/conformance/certificatesigningrequests"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -38,19 +39,27 @@ import (
 )
 
 var _ = framework.ConformanceDescribe("CertificateSigningRequests", func() {
+	unsupportedFeatures := featureset.NewFeatureSet(
+		// SelfSigned issuer is not an ACME issuer.
+		featureset.ACMEUseARI,
+		featureset.ACMEProfilesFeature,
+	)
+
 	(&certificatesigningrequests.Suite{
-		Name:             "SelfSigned Issuer",
-		CreateIssuerFunc: createSelfSignedIssuer,
-		ProvisionFunc:    provision,
-		DeProvisionFunc:  deProvision,
+		Name:                "SelfSigned Issuer",
+		CreateIssuerFunc:    createSelfSignedIssuer,
+		ProvisionFunc:       provision,
+		DeProvisionFunc:     deProvision,
+		UnsupportedFeatures: unsupportedFeatures,
 	}).Define()
 
 	(&certificatesigningrequests.Suite{
-		Name:             "SelfSigned ClusterIssuer",
-		CreateIssuerFunc: createSelfSignedClusterIssuer,
-		DeleteIssuerFunc: deleteSelfSignedClusterIssuer,
-		ProvisionFunc:    provision,
-		DeProvisionFunc:  deProvision,
+		Name:                "SelfSigned ClusterIssuer",
+		CreateIssuerFunc:    createSelfSignedClusterIssuer,
+		DeleteIssuerFunc:    deleteSelfSignedClusterIssuer,
+		ProvisionFunc:       provision,
+		DeProvisionFunc:     deProvision,
+		UnsupportedFeatures: unsupportedFeatures,
 	}).Define()
 })
 
@@ -18,13 +18,18 @@ package certificatesigningrequests
 
 import (
 	"context"
+	"crypto"
 	"crypto/x509"
 	"net"
 	"net/url"
 	"time"
 
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	experimentalapi "github.com/cert-manager/cert-manager/pkg/apis/experimental/v1alpha1"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
+	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
 	certificatesv1 "k8s.io/api/certificates/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -394,6 +399,26 @@ func (s *Suite) Define() {
 				},
 				requiredFeatures: []featureset.Feature{featureset.KeyUsagesFeature, featureset.DurationFeature, featureset.CommonNameFeature},
 			},
+			{
+				name:    "should issue a certificate using the ACME profile requested in an annotation",
+				keyAlgo: x509.RSA,
+				csrModifiers: []gen.CSRModifier{
+					gen.SetCSRDNSNames(e2eutil.RandomSubdomain(s.DomainSuffix)),
+				},
+				kubeCSRUsages: []certificatesv1.KeyUsage{
+					certificatesv1.UsageDigitalSignature,
+					certificatesv1.UsageKeyEncipherment,
+				},
+				kubeCSRAnnotations: map[string]string{
+					// The supported profiles are defined in the Pebble
+					// configuration: make/config/pebble/chart/templates/configmap.yaml
+					experimentalapi.CertificateSigningRequestACMEProfileAnnotationKey: "123h",
+				},
+				extraValidations: []certificatesigningrequests.ValidationFunc{
+					certificatesigningrequests.ExpectDuration(time.Hour*123, time.Second),
+				},
+				requiredFeatures: []featureset.Feature{featureset.OnlySAN, featureset.ACMEProfilesFeature},
+			},
 			{
 				name:    "should issue a certificate that defines a long domain",
 				keyAlgo: x509.RSA,
@@ -419,83 +444,130 @@ func (s *Suite) Define() {
 			return annotations
 		}
 
-		defineTest := func(test testCase) {
-			s.it(f, test.name, func(ctx context.Context, signerName string) {
-				// Generate request CSR
-				csr, key, err := gen.CSR(test.keyAlgo, test.csrModifiers...)
-				Expect(err).NotTo(HaveOccurred())
+		// issue creates the CertificateSigningRequest described by the test
+		// case, approves it, and returns it once it has been signed along with
+		// its private key.
+		issue := func(ctx context.Context, signerName string, test testCase) (*certificatesv1.CertificateSigningRequest, crypto.Signer) {
+			// Generate request CSR
+			csr, key, err := gen.CSR(test.keyAlgo, test.csrModifiers...)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Create CertificateSigningRequest
+			randomTestID := rand.String(10)
+			kubeCSR := &certificatesv1.CertificateSigningRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "e2e-conformance-" + randomTestID,
+					Annotations: addAnnotation(
+						test.kubeCSRAnnotations,
+						"conformance.cert-manager.io/test-name",
+						s.Name+" "+test.name,
+					),
+				},
+				Spec: certificatesv1.CertificateSigningRequestSpec{
+					Request:           csr,
+					SignerName:        signerName,
+					Usages:            test.kubeCSRUsages,
+					ExpirationSeconds: test.kubeCSRExpirationSeconds,
+				},
+			}
 
-				// Create CertificateSigningRequest
-				randomTestID := rand.String(10)
-				kubeCSR := &certificatesv1.CertificateSigningRequest{
-					ObjectMeta: metav1.ObjectMeta{
-						Name: "e2e-conformance-" + randomTestID,
-						Annotations: addAnnotation(
-							test.kubeCSRAnnotations,
-							"conformance.cert-manager.io/test-name",
-							s.Name+" "+test.name,
-						),
-					},
-					Spec: certificatesv1.CertificateSigningRequestSpec{
-						Request:           csr,
-						SignerName:        signerName,
-						Usages:            test.kubeCSRUsages,
-						ExpirationSeconds: test.kubeCSRExpirationSeconds,
-					},
-				}
-
-				// Provision any resources needed for the request, or modify the
-				// request based on Issuer requirements
-				if s.ProvisionFunc != nil {
-					s.ProvisionFunc(ctx, f, kubeCSR, key)
-				}
-				// Ensure related resources are cleaned up at the end of the test
-				if s.DeProvisionFunc != nil {
-					defer s.DeProvisionFunc(ctx, f, kubeCSR)
-				}
-
-				// Create the request, and delete at the end of the test
-				By("Creating a CertificateSigningRequest")
-				Expect(f.CRClient.Create(ctx, kubeCSR)).NotTo(HaveOccurred())
-				defer func() {
-					cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
-					defer cancel()
-
-					err := f.CRClient.Delete(cleanupCtx, kubeCSR)
-					Expect(err).NotTo(HaveOccurred())
-				}()
-
-				// Approve the request for testing, so that cert-manager may sign the
-				// request.
-				By("Approving CertificateSigningRequest")
-				kubeCSR.Status.Conditions = append(kubeCSR.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
-					Type:    certificatesv1.CertificateApproved,
-					Status:  corev1.ConditionTrue,
-					Reason:  "e2e.cert-manager.io",
-					Message: "Request approved for e2e testing.",
+			// Provision any resources needed for the request, or modify the
+			// request based on Issuer requirements
+			if s.ProvisionFunc != nil {
+				s.ProvisionFunc(ctx, f, kubeCSR, key)
+			}
+			// Ensure related resources are cleaned up at the end of the test
+			if s.DeProvisionFunc != nil {
+				DeferCleanup(func(ctx context.Context) {
+					s.DeProvisionFunc(ctx, f, kubeCSR)
 				})
-				kubeCSR, err = f.KubeClientSet.CertificatesV1().CertificateSigningRequests().UpdateApproval(ctx, kubeCSR.Name, kubeCSR, metav1.UpdateOptions{})
-				Expect(err).NotTo(HaveOccurred())
+			}
 
-				// Wait for the status.Certificate and CA annotation to be populated in
-				// a reasonable amount of time.
-				By("Waiting for the CertificateSigningRequest to be issued...")
-				kubeCSR, err = f.Helper().WaitForCertificateSigningRequestSigned(ctx, kubeCSR.Name, time.Minute*5)
-				Expect(err).NotTo(HaveOccurred())
+			// Create the request, and delete at the end of the test
+			By("Creating a CertificateSigningRequest")
+			Expect(f.CRClient.Create(ctx, kubeCSR)).NotTo(HaveOccurred())
+			DeferCleanup(func(ctx context.Context) {
+				cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+				defer cancel()
 
-				// Validate that the request was signed as expected. Add extra
-				// validations which may be required for this test.
-				By("Validating the issued CertificateSigningRequest...")
-				validations := []certificatesigningrequests.ValidationFunc(nil)
-				validations = append(validations, test.extraValidations...)
-				validations = append(validations, validation.CertificateSigningRequestSetForUnsupportedFeatureSet(s.UnsupportedFeatures)...)
-				err = f.Helper().ValidateCertificateSigningRequest(kubeCSR.Name, key, validations...)
+				err := f.CRClient.Delete(cleanupCtx, kubeCSR)
 				Expect(err).NotTo(HaveOccurred())
+			})
+
+			// Approve the request for testing, so that cert-manager may sign the
+			// request.
+			By("Approving CertificateSigningRequest")
+			kubeCSR.Status.Conditions = append(kubeCSR.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+				Type:    certificatesv1.CertificateApproved,
+				Status:  corev1.ConditionTrue,
+				Reason:  "e2e.cert-manager.io",
+				Message: "Request approved for e2e testing.",
+			})
+			kubeCSR, err = f.KubeClientSet.CertificatesV1().CertificateSigningRequests().UpdateApproval(ctx, kubeCSR.Name, kubeCSR, metav1.UpdateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			// Wait for the status.Certificate and CA annotation to be populated in
+			// a reasonable amount of time.
+			By("Waiting for the CertificateSigningRequest to be issued...")
+			kubeCSR, err = f.Helper().WaitForCertificateSigningRequestSigned(ctx, kubeCSR.Name, time.Minute*5)
+			Expect(err).NotTo(HaveOccurred())
+
+			return kubeCSR, key
+		}
+
+		// validate checks that the request was signed as expected, running the
+		// extra validations of the test case along with the default ones.
+		validate := func(kubeCSR *certificatesv1.CertificateSigningRequest, key crypto.Signer, test testCase) {
+			By("Validating the issued CertificateSigningRequest...")
+			validations := []certificatesigningrequests.ValidationFunc(nil)
+			validations = append(validations, test.extraValidations...)
+			validations = append(validations, validation.CertificateSigningRequestSetForUnsupportedFeatureSet(s.UnsupportedFeatures)...)
+			err := f.Helper().ValidateCertificateSigningRequest(kubeCSR.Name, key, validations...)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		defineTest := func(test testCase) {
+			s.it(f, test.name, func(ctx context.Context, signerName string) {
+				kubeCSR, key := issue(ctx, signerName, test)
+				validate(kubeCSR, key, test)
 			}, test.requiredFeatures...)
 		}
 
 		for _, tc := range tests {
 			defineTest(tc)
 		}
+
+		s.it(f, "should issue a replacement for the certificate whose ARI CertID is passed in an annotation", func(ctx context.Context, signerName string) {
+			framework.RequireFeatureGate(utilfeature.DefaultFeatureGate, feature.ACMEUseARI)
+
+			test := testCase{
+				name:    "ARI replaces",
+				keyAlgo: x509.RSA,
+				csrModifiers: []gen.CSRModifier{
+					gen.SetCSRDNSNames(e2eutil.RandomSubdomain(s.DomainSuffix)),
+				},
+				kubeCSRUsages: []certificatesv1.KeyUsage{
+					certificatesv1.UsageDigitalSignature,
+					certificatesv1.UsageKeyEncipherment,
+				},
+			}
+
+			By("Issuing the certificate to be replaced")
+			replaced, _ := issue(ctx, signerName, test)
+			replacedCert, err := pki.DecodeX509CertificateBytes(replaced.Status.Certificate)
+			Expect(err).NotTo(HaveOccurred())
+			certID, err := acmeapi.CertificateARIID(replacedCert)
+			Expect(err).NotTo(HaveOccurred())
+
+			// The ACME server rejects the Order if the replaced certificate is
+			// unknown or was not issued to the same account, so the replacement
+			// is only issued if the CertID was sent.
+			By("Issuing the replacement certificate")
+			test.kubeCSRAnnotations = map[string]string{
+				experimentalapi.CertificateSigningRequestACMEReplacesAnnotationKey: certID,
+			}
+			kubeCSR, key := issue(ctx, signerName, test)
+			validate(kubeCSR, key, test)
+		}, featureset.OnlySAN, featureset.ACMEUseARI)
 	})
 }
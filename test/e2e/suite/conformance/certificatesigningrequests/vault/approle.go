@@ -57,6 +57,9 @@ var _ = framework.ConformanceDescribe("CertificateSigningRequests", func() {
 		featureset.KeyUsagesFeature,
 		featureset.Ed25519FeatureSet,
 		featureset.IssueCAFeature,
+		// Vault issuer is not an ACME issuer.
+		featureset.ACMEUseARI,
+		featureset.ACMEProfilesFeature,
 	)
 
 	issuer := &approle{
@@ -54,6 +54,9 @@ var _ = framework.ConformanceDescribe("CertificateSigningRequests", func() {
 		featureset.OnlySAN,
 		// Venafi doesn't setting key usages.
 		featureset.KeyUsagesFeature,
+		// Venafi issuer is not an ACME issuer.
+		featureset.ACMEUseARI,
+		featureset.ACMEProfilesFeature,
 	)
 
 	venafiIssuer := new(cloud)
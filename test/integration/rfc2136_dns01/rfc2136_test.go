@@ -382,6 +382,93 @@ func TestRFC2136TCPUpdate(t *testing.T) {
 	}
 }
 
+// newSIG0Key generates a SIG(0) key pair, returning the public KEY record
+// and the private key in the BIND private key format.
+func newSIG0Key(t *testing.T) (*dns.KEY, []byte) {
+	key := &dns.KEY{DNSKEY: dns.DNSKEY{
+		Hdr:       dns.RR_Header{Name: "update." + rfc2136TestZone, Rrtype: dns.TypeKEY, Class: dns.ClassINET},
+		Flags:     512,
+		Protocol:  3,
+		Algorithm: dns.ED25519,
+	}}
+	privateKey, err := key.Generate(256)
+	require.NoError(t, err)
+	return key, []byte(key.PrivateKeyString(privateKey))
+}
+
+func TestRFC2136SIG0Update(t *testing.T) {
+	ctx := logf.NewContext(t.Context(), testr.New(t), t.Name())
+	key, privateKey := newSIG0Key(t)
+	server := &testserver.BasicServer{
+		T:       t,
+		Zones:   []string{rfc2136TestZone},
+		SIG0Key: key,
+	}
+	if err := server.Run(ctx, "UDP"); err != nil {
+		t.Fatalf("failed to start test server: %v", err)
+	}
+	defer func() {
+		if err := server.Shutdown(); err != nil {
+			t.Errorf("failed to gracefully shut down test server: %v", err)
+		}
+	}()
+
+	provider, err := rfc2136.NewDNSProviderCredentials(server.ListenAddr(), "", "", "", rfc2136.WithSIG0(key.String(), privateKey))
+	require.NoError(t, err)
+
+	assert.NoError(t, provider.Present(rfc2136TestDomain, rfc2136TestFqdn, rfc2136TestZone, rfc2136TestValue))
+	assert.NoError(t, provider.CleanUp(rfc2136TestDomain, rfc2136TestFqdn, rfc2136TestZone, rfc2136TestValue))
+}
+
+func TestRFC2136SIG0WrongKey(t *testing.T) {
+	ctx := logf.NewContext(t.Context(), testr.New(t), t.Name())
+	serverKey, _ := newSIG0Key(t)
+	key, privateKey := newSIG0Key(t)
+	server := &testserver.BasicServer{
+		T:       t,
+		Zones:   []string{rfc2136TestZone},
+		SIG0Key: serverKey,
+	}
+	if err := server.Run(ctx, "tcp"); err != nil {
+		t.Fatalf("failed to start test server: %v", err)
+	}
+	defer func() {
+		if err := server.Shutdown(); err != nil {
+			t.Errorf("failed to gracefully shut down test server: %v", err)
+		}
+	}()
+
+	provider, err := rfc2136.NewDNSProviderCredentials(server.ListenAddr(), "", "", "", rfc2136.WithSIG0(key.String(), privateKey), rfc2136.WithNetwork("tcp"))
+	require.NoError(t, err)
+
+	err = provider.Present(rfc2136TestDomain, rfc2136TestFqdn, rfc2136TestZone, rfc2136TestValue)
+	assert.ErrorContains(t, err, "REFUSED")
+}
+
+func TestRFC2136SIG0UnsignedUpdate(t *testing.T) {
+	ctx := logf.NewContext(t.Context(), testr.New(t), t.Name())
+	key, _ := newSIG0Key(t)
+	server := &testserver.BasicServer{
+		T:       t,
+		Zones:   []string{rfc2136TestZone},
+		SIG0Key: key,
+	}
+	if err := server.Run(ctx, "UDP"); err != nil {
+		t.Fatalf("failed to start test server: %v", err)
+	}
+	defer func() {
+		if err := server.Shutdown(); err != nil {
+			t.Errorf("failed to gracefully shut down test server: %v", err)
+		}
+	}()
+
+	provider, err := rfc2136.NewDNSProviderCredentials(server.ListenAddr(), "", "", "")
+	require.NoError(t, err)
+
+	err = provider.Present(rfc2136TestDomain, rfc2136TestFqdn, rfc2136TestZone, rfc2136TestValue)
+	assert.ErrorContains(t, err, "REFUSED")
+}
+
 // testHandlers provides DNS server handlers for use in tests and has a
 // reference to testing.T so that the handlers (which do not return errors) can
 // make test assertions and fail tests.
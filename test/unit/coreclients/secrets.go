@@ -83,15 +83,25 @@ func SetFakeSecretsGetterApplyFn(fn ApplyFn) FakeSecretsGetterModifier {
 	}
 }
 
+// SetFakeSecretsGetterUpdateFn is a function that can be used to inject code
+// when a Secret is updated with the FakeSecretsGetter.
+func SetFakeSecretsGetterUpdateFn(fn UpdateFn) FakeSecretsGetterModifier {
+	return func(f *FakeSecretsGetter) {
+		f.c.UpdateFn = fn
+	}
+}
+
 func (f *FakeSecretsGetter) Secrets(string) typedcorev1.SecretInterface {
 	return f.c
 }
 
 type ApplyFn func(context.Context, *applyconfigurationscorev1.SecretApplyConfiguration, metav1.ApplyOptions) (*corev1.Secret, error)
 
+type UpdateFn func(context.Context, *corev1.Secret, metav1.UpdateOptions) (*corev1.Secret, error)
+
 type fakeSecretClient struct {
 	CreateFn           func() (*corev1.Secret, error)
-	UpdateFn           func() (*corev1.Secret, error)
+	UpdateFn           UpdateFn
 	DeleteFn           func() error
 	DeleteCollectionFn func() error
 	GetFn              func() (*corev1.Secret, error)
@@ -107,8 +117,8 @@ func (f *fakeSecretClient) Create(context.Context, *corev1.Secret, metav1.Create
 	return f.CreateFn()
 }
 
-func (f *fakeSecretClient) Update(context.Context, *corev1.Secret, metav1.UpdateOptions) (*corev1.Secret, error) {
-	return f.UpdateFn()
+func (f *fakeSecretClient) Update(ctx context.Context, secret *corev1.Secret, opts metav1.UpdateOptions) (*corev1.Secret, error) {
+	return f.UpdateFn(ctx, secret, opts)
 }
 
 func (f *fakeSecretClient) Delete(context.Context, string, metav1.DeleteOptions) error {
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crypto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/sha256"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+var (
+	oidPBES2          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidHMACWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidAES256CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+)
+
+type encryptedPrivateKeyInfo struct {
+	EncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedData       []byte
+}
+
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+type pbkdf2Params struct {
+	Salt           []byte
+	IterationCount int
+	KeyLength      int                      `asn1:"optional"`
+	PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+// DecryptPKCS8PrivateKey decrypts an `ENCRYPTED PRIVATE KEY` PEM block which
+// was created by pki.EncryptPKCS8PrivateKey, and returns the DER encoded
+// PKCS#8 private key. An error is returned if the password is incorrect, or
+// if the key was encrypted using any other scheme. It is only intended for
+// tests: cert-manager itself never decrypts private keys.
+func DecryptPKCS8PrivateKey(pemBytes, password []byte) ([]byte, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil || block.Type != pki.EncryptedPKCS8PEMType {
+		return nil, fmt.Errorf("error decoding encrypted private key: no %q PEM block found", pki.EncryptedPKCS8PEMType)
+	}
+
+	var info encryptedPrivateKeyInfo
+	if rest, err := asn1.Unmarshal(block.Bytes, &info); err != nil || len(rest) > 0 {
+		return nil, errors.New("error parsing encrypted private key: invalid EncryptedPrivateKeyInfo")
+	}
+	if !info.EncryptionAlgorithm.Algorithm.Equal(oidPBES2) {
+		return nil, fmt.Errorf("error parsing encrypted private key: unsupported encryption algorithm %s", info.EncryptionAlgorithm.Algorithm)
+	}
+
+	var scheme pbes2Params
+	if _, err := asn1.Unmarshal(info.EncryptionAlgorithm.Parameters.FullBytes, &scheme); err != nil {
+		return nil, errors.New("error parsing encrypted private key: invalid PBES2 parameters")
+	}
+	if !scheme.KeyDerivationFunc.Algorithm.Equal(oidPBKDF2) || !scheme.EncryptionScheme.Algorithm.Equal(oidAES256CBC) {
+		return nil, errors.New("error parsing encrypted private key: only PBKDF2 with AES-256-CBC is supported")
+	}
+
+	var kdf pbkdf2Params
+	if _, err := asn1.Unmarshal(scheme.KeyDerivationFunc.Parameters.FullBytes, &kdf); err != nil {
+		return nil, errors.New("error parsing encrypted private key: invalid PBKDF2 parameters")
+	}
+	if !kdf.PRF.Algorithm.Equal(oidHMACWithSHA256) {
+		return nil, errors.New("error parsing encrypted private key: only the HMAC-SHA256 PBKDF2 PRF is supported")
+	}
+
+	var iv []byte
+	if _, err := asn1.Unmarshal(scheme.EncryptionScheme.Parameters.FullBytes, &iv); err != nil || len(iv) != aes.BlockSize {
+		return nil, errors.New("error parsing encrypted private key: invalid AES-256-CBC IV")
+	}
+	if len(info.EncryptedData) == 0 || len(info.EncryptedData)%aes.BlockSize != 0 {
+		return nil, errors.New("error parsing encrypted private key: invalid encrypted data length")
+	}
+
+	key, err := pbkdf2.Key(sha256.New, string(password), kdf.Salt, kdf.IterationCount, 32)
+	if err != nil {
+		return nil, err
+	}
+	aesBlock, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted := make([]byte, len(info.EncryptedData))
+	cipher.NewCBCDecrypter(aesBlock, iv).CryptBlocks(decrypted, info.EncryptedData)
+
+	// An incorrect password will almost always result in invalid padding.
+	padding := int(decrypted[len(decrypted)-1])
+	if padding == 0 || padding > aes.BlockSize || !bytes.Equal(decrypted[len(decrypted)-padding:], bytes.Repeat([]byte{byte(padding)}, padding)) {
+		return nil, errors.New("error decrypting private key: incorrect password")
+	}
+
+	return decrypted[:len(decrypted)-padding], nil
+}
@@ -101,6 +101,15 @@ func SetCertificateIsCA(isCA bool) CertificateModifier {
 	}
 }
 
+// SetCertificateCSR sets spec.csr, and clears the common name as names are
+// taken from the CSR.
+func SetCertificateCSR(csr v1.CertificateCSRSource) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.CSR = &csr
+		crt.Spec.CommonName = ""
+	}
+}
+
 func SetCertificateKeyAlgorithm(keyAlgorithm v1.PrivateKeyAlgorithm) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Spec.PrivateKey.Algorithm = keyAlgorithm
@@ -303,6 +312,12 @@ func SetCertificateAdditionalOutputFormats(additionalOutputFormats ...v1.Certifi
 	}
 }
 
+func SetCertificateConfigMapTargets(targets ...v1.CertificateConfigMapTarget) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.ConfigMapTargets = targets
+	}
+}
+
 func SetCertificateKeystore(keystores *v1.CertificateKeystores) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Spec.Keystores = keystores
@@ -126,6 +126,16 @@ func SetIssuerACMEEmail(email string) IssuerModifier {
 	}
 }
 
+func SetIssuerACMEContacts(contacts ...string) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		spec := iss.GetSpec()
+		if spec.ACME == nil {
+			spec.ACME = &cmacme.ACMEIssuer{}
+		}
+		spec.ACME.Contacts = contacts
+	}
+}
+
 func SetIssuerACMEProfile(profile string) IssuerModifier {
 	return func(iss v1.GenericIssuer) {
 		spec := iss.GetSpec()
@@ -209,6 +219,22 @@ func SetIssuerACMEEAB(keyID, secretName string) IssuerModifier {
 
 // SetIssuerACMEEABWithKeyAlgorithm returns an ACME Issuer modifier that sets
 // ACME External Account Binding with the legacy keyAlgorithm field set.
+func SetIssuerACMEEABProvisioner(url, tokenURL, secretName string) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		spec := iss.GetSpec()
+		if spec.ACME == nil {
+			spec.ACME = &cmacme.ACMEIssuer{}
+		}
+		spec.ACME.ExternalAccountBindingProvisioner = &cmacme.ACMEExternalAccountBindingProvisioner{
+			URL:      url,
+			TokenURL: tokenURL,
+			CredentialsRef: cmmeta.LocalObjectReference{
+				Name: secretName,
+			},
+		}
+	}
+}
+
 func SetIssuerACMEEABWithKeyAlgorithm(keyID, secretName string, keyAlgorithm cmacme.HMACKeyAlgorithm) IssuerModifier {
 	return func(iss v1.GenericIssuer) {
 		spec := iss.GetSpec()
@@ -113,6 +113,9 @@ func SetFakeSecretNamespaceListerGet(sec *corev1.Secret, err error) FakeSecretLi
 				GetFn: func(name string) (*corev1.Secret, error) {
 					return sec, err
 				},
+				ListFn: func(labels.Selector) ([]*corev1.Secret, error) {
+					return nil, nil
+				},
 			}
 		}
 	}